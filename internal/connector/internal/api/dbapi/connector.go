@@ -67,6 +67,23 @@ type Connector struct {
 	Status ConnectorStatus `gorm:"foreignKey:ID"`
 }
 
+// ConnectorRevision records a change of the spec, channel or desired state of a connector
+type ConnectorRevision struct {
+	db.Model
+	ConnectorID string
+	// Revision is the version of the connector after the change
+	Revision     int64
+	ChangedBy    string
+	Channel      string
+	DesiredState ConnectorDesiredState
+	// ConnectorSpec is the spec of the connector after the change, with its secrets redacted
+	ConnectorSpec api.JSON `gorm:"type:jsonb"`
+	// Diff is the JSON merge patch of the spec from the previous revision, with its secrets redacted
+	Diff api.JSON `gorm:"type:jsonb"`
+}
+
+type ConnectorRevisionList []*ConnectorRevision
+
 type ConnectorAnnotation struct {
 	ConnectorID string `gorm:"primaryKey;index"`
 	Key         string `gorm:"primaryKey;not null"`
@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 )
 
@@ -55,6 +57,8 @@ type ConnectorClusterStatus struct {
 	Platform   ConnectorClusterPlatform `gorm:"embedded;embeddedPrefix:platform_"`
 	Conditions ConditionList            `gorm:"type:jsonb"`
 	Operators  OperatorList             `gorm:"type:jsonb"`
+	// LastHeartbeat is the time the agent last updated the cluster status
+	LastHeartbeat *time.Time `gorm:"index"`
 }
 
 type ConditionList []Condition
@@ -0,0 +1,31 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorRevision A change of the spec, channel or desired state of a connector
+type ConnectorRevision struct {
+	// The resource_version of the connector after the change
+	Revision    int64     `json:"revision"`
+	ConnectorId string    `json:"connector_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	// The user who made the change, empty when it was made by the service
+	ChangedBy    string                `json:"changed_by,omitempty"`
+	Channel      Channel               `json:"channel,omitempty"`
+	DesiredState ConnectorDesiredState `json:"desired_state"`
+	// The connector specific properties after the change, with the secrets redacted
+	Connector map[string]interface{} `json:"connector"`
+	// The JSON merge patch (RFC 7386) of the connector specific properties from the previous revision, with the secrets redacted
+	Diff map[string]interface{} `json:"diff,omitempty"`
+}
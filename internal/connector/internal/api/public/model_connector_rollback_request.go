@@ -0,0 +1,17 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorRollbackRequest struct for ConnectorRollbackRequest
+type ConnectorRollbackRequest struct {
+	// The revision to restore the spec and the desired state of
+	Revision int64 `json:"revision"`
+}
@@ -0,0 +1,191 @@
+package vault
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDelete      = "delete"
+	FlagGracePeriod = "grace-period"
+
+	// existingConnectorsBatchSize is the maximum number of connector ids looked up in the database at once
+	existingConnectorsBatchSize = 500
+
+	gcResultOrphan       = "orphan"
+	gcResultDeleted      = "deleted"
+	gcResultUnknownOwner = "unknown owner, kept"
+	gcResultOwnerFound   = "owner found, kept"
+)
+
+// existingConnectors returns the ids of the connectors that exist among the given ones
+type existingConnectors func(ids []string) (map[string]bool, error)
+
+func NewGCCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Find and delete the vault secrets of the connectors that no longer exist",
+		Long: `Find the vault secrets whose owning connector no longer exists, e.g. because it has been deleted while the vault
+was not reachable, and report them. The secrets are only deleted with --delete. Secrets without an owning resource, or owned
+by another kind of resource, are reported and never deleted.`,
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(func(vaultService vault.VaultService, connectionFactory *db.ConnectionFactory) {
+				runGC(cmd, vaultService, connectionFactory)
+			})
+		},
+	}
+	cmd.Flags().Bool(FlagDelete, false, "Delete the orphan secrets instead of only reporting them")
+	cmd.Flags().Duration(FlagGracePeriod, time.Minute, "How long to wait before checking the owners of the orphan secrets again and deleting them. "+
+		"Connectors being created store their secrets before they are saved in the database")
+	return cmd
+}
+
+func runGC(cmd *cobra.Command, vaultService vault.VaultService, connectionFactory *db.ConnectionFactory) {
+	deleteOrphans, _ := cmd.Flags().GetBool(FlagDelete)
+	gracePeriod, _ := cmd.Flags().GetDuration(FlagGracePeriod)
+
+	failures, err := collectOrphanSecrets(vaultService, newExistingConnectors(connectionFactory), deleteOrphans, gracePeriod, os.Stdout)
+	if err != nil {
+		glog.Fatalf("Unable to collect the orphan secrets: %v", err)
+	}
+	if failures > 0 {
+		glog.Fatalf("%d orphan secrets could not be deleted", failures)
+	}
+}
+
+func newExistingConnectors(connectionFactory *db.ConnectionFactory) existingConnectors {
+	return func(ids []string) (map[string]bool, error) {
+		existing := map[string]bool{}
+		for start := 0; start < len(ids); start += existingConnectorsBatchSize {
+			end := start + existingConnectorsBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			var found []string
+			// the deleted connectors are excluded by the soft delete of the model
+			if err := connectionFactory.New().Model(&dbapi.Connector{}).Where("id IN ?", ids[start:end]).Pluck("id", &found).Error; err != nil {
+				return nil, fmt.Errorf("failed to look up the connectors: %v", err)
+			}
+			for _, id := range found {
+				existing[id] = true
+			}
+		}
+		return existing, nil
+	}
+}
+
+// collectOrphanSecrets reports the secrets whose owning connector does not exist, and deletes them when deleteOrphans is set.
+// It returns the number of orphan secrets that could not be deleted
+func collectOrphanSecrets(vaultService vault.VaultService, exists existingConnectors, deleteOrphans bool, gracePeriod time.Duration, out io.Writer) (int, error) {
+	var secrets []vaultSecret
+	err := vaultService.ForEachSecret(func(name string, owningResource string) bool {
+		secrets = append(secrets, vaultSecret{name: name, owningResource: owningResource})
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	orphans, err := findOrphanSecrets(secrets, exists)
+	if err != nil {
+		return 0, err
+	}
+
+	if deleteOrphans && len(orphans) > 0 && gracePeriod > 0 {
+		fmt.Fprintf(out, "waiting %s before checking the owners of %d orphan secrets again\n", gracePeriod, len(orphans))
+		time.Sleep(gracePeriod)
+	}
+	var stillOrphans map[string]bool
+	if deleteOrphans {
+		if stillOrphans, err = findOrphanSecrets(secrets, exists); err != nil {
+			return 0, err
+		}
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Secret Key", "Owning Resource", "Result"})
+	orphanCount, deleted, failures := 0, 0, 0
+	for _, secret := range secrets {
+		var result string
+		switch {
+		case connectorIDOf(secret.owningResource) == "":
+			result = gcResultUnknownOwner
+		case !orphans[secret.name]:
+			continue
+		case !deleteOrphans:
+			orphanCount++
+			result = gcResultOrphan
+		case !stillOrphans[secret.name]:
+			result = gcResultOwnerFound
+		default:
+			orphanCount++
+			if err := vaultService.DeleteSecretString(secret.name); err != nil && !vault.IsNotFound(err) {
+				failures++
+				result = fmt.Sprintf("delete failed: %v", err)
+			} else {
+				deleted++
+				result = gcResultDeleted
+			}
+		}
+		table.Append([]string{secret.name, secret.owningResource, result})
+	}
+	table.Render()
+	fmt.Fprintf(out, "%d secrets checked, %d orphan secrets found, %d deleted\n", len(secrets), orphanCount, deleted)
+
+	return failures, nil
+}
+
+// findOrphanSecrets returns the names of the secrets owned by a connector that does not exist
+func findOrphanSecrets(secrets []vaultSecret, exists existingConnectors) (map[string]bool, error) {
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, secret := range secrets {
+		if id := connectorIDOf(secret.owningResource); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	existing, err := exists(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := map[string]bool{}
+	for _, secret := range secrets {
+		if id := connectorIDOf(secret.owningResource); id != "" && !existing[id] {
+			orphans[secret.name] = true
+		}
+	}
+	return orphans, nil
+}
+
+// connectorIDOf returns the id of the connector owning a secret, or an empty string when the secret is not owned by a connector.
+// The credentials of the connector clusters are not stored in the vault, so only the connectors own secrets
+func connectorIDOf(owningResource string) string {
+	if !strings.HasPrefix(owningResource, handlers.OwningResourcePrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(owningResource, handlers.OwningResourcePrefix)
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/onsi/gomega"
+)
+
+func Test_collectOrphanSecrets(t *testing.T) {
+	buildVault := func() *vault.TmpVaultService {
+		v, _ := vault.NewTmpVaultService()
+		_ = v.SetSecretString("secret-1", "value", "/v1/connector/connector-1")
+		_ = v.SetSecretString("secret-2", "value", "/v1/connector/deleted-connector")
+		_ = v.SetSecretString("secret-3", "value", "/v1/connector/deleted-connector")
+		_ = v.SetSecretString("secret-4", "value", "")
+		return v
+	}
+
+	tests := []struct {
+		name          string
+		deleteOrphans bool
+		exists        func(calls int) existingConnectors
+		wantErr       bool
+		wantRemaining []string
+		wantReport    string
+	}{
+		{
+			name:          "should only report the orphan secrets in dry run mode",
+			exists:        func(int) existingConnectors { return existingIDs("connector-1") },
+			wantRemaining: []string{"secret-1", "secret-2", "secret-3", "secret-4"},
+			wantReport:    "4 secrets checked, 2 orphan secrets found, 0 deleted",
+		},
+		{
+			name:          "should delete the orphan secrets and keep the secrets without owner",
+			deleteOrphans: true,
+			exists:        func(int) existingConnectors { return existingIDs("connector-1") },
+			wantRemaining: []string{"secret-1", "secret-4"},
+			wantReport:    "4 secrets checked, 2 orphan secrets found, 2 deleted",
+		},
+		{
+			name:          "should keep the secrets of a connector saved during the grace period",
+			deleteOrphans: true,
+			exists: func(calls int) existingConnectors {
+				if calls == 0 {
+					return existingIDs("connector-1")
+				}
+				return existingIDs("connector-1", "deleted-connector")
+			},
+			wantRemaining: []string{"secret-1", "secret-2", "secret-3", "secret-4"},
+			wantReport:    "4 secrets checked, 0 orphan secrets found, 0 deleted",
+		},
+		{
+			name:          "should not delete anything when the connectors cannot be looked up",
+			deleteOrphans: true,
+			exists: func(int) existingConnectors {
+				return func(ids []string) (map[string]bool, error) {
+					return nil, fmt.Errorf("database unavailable")
+				}
+			},
+			wantErr:       true,
+			wantRemaining: []string{"secret-1", "secret-2", "secret-3", "secret-4"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			v := buildVault()
+			calls := 0
+			exists := func(ids []string) (map[string]bool, error) {
+				defer func() { calls++ }()
+				return tt.exists(calls)(ids)
+			}
+
+			var out bytes.Buffer
+			failures, err := collectOrphanSecrets(v, exists, tt.deleteOrphans, 0, &out)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(failures).To(gomega.Equal(0))
+			g.Expect(out.String()).To(gomega.ContainSubstring(tt.wantReport))
+
+			remaining := []string{}
+			g.Expect(v.ForEachSecret(func(name string, owningResource string) bool {
+				remaining = append(remaining, name)
+				return true
+			})).To(gomega.Succeed())
+			g.Expect(remaining).To(gomega.ConsistOf(tt.wantRemaining))
+		})
+	}
+}
+
+func existingIDs(ids ...string) existingConnectors {
+	return func(lookedUp []string) (map[string]bool, error) {
+		existing := map[string]bool{}
+		for _, id := range ids {
+			existing[id] = true
+		}
+		return existing, nil
+	}
+}
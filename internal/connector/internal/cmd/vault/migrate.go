@@ -0,0 +1,184 @@
+package vault
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagFrom = "from"
+	FlagTo   = "to"
+
+	migrationResultCopied          = "copied"
+	migrationResultAlreadyMigrated = "already migrated"
+	migrationResultOwnerUpdated    = "owning resource updated"
+)
+
+// persistentVaultKinds are the kinds of vault secrets can be migrated from and to. The secrets of the tmp vault do not outlive
+// the process
+var persistentVaultKinds = []string{vault.KindAws, vault.KindHashicorp}
+
+func NewMigrateCommand(env *environments.Env) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy the vault secrets to another kind of vault",
+		Long: `Copy every vault secret, together with its owning resource, from one kind of vault to another, and verify the copy.
+Both vaults are configured with the vault flags. Secrets already present in the target vault with the same value are skipped,
+so an interrupted migration is resumed by running the command again, and their owning resource is rewritten when it differs.
+Secrets present in the target vault with another value are reported and left untouched. The secrets are not deleted from the
+source vault.`,
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			err := env.CreateServices()
+			if err != nil {
+				glog.Fatalf("Unable to initialize environment: %s", err.Error())
+			}
+		},
+
+		Run: func(cmd *cobra.Command, args []string) {
+			env.MustInvoke(func(vaultConfig *vault.Config) {
+				runMigrate(cmd, vaultConfig)
+			})
+		},
+	}
+	cmd.Flags().String(FlagFrom, "", fmt.Sprintf("The kind of vault to copy the secrets from: %v", persistentVaultKinds))
+	cmd.Flags().String(FlagTo, "", fmt.Sprintf("The kind of vault to copy the secrets to: %v", persistentVaultKinds))
+	return cmd
+}
+
+func runMigrate(cmd *cobra.Command, vaultConfig *vault.Config) {
+	fromKind, _ := cmd.Flags().GetString(FlagFrom)
+	toKind, _ := cmd.Flags().GetString(FlagTo)
+	if !isPersistentVaultKind(fromKind) || !isPersistentVaultKind(toKind) {
+		glog.Fatalf("--%s and --%s must be one of %v", FlagFrom, FlagTo, persistentVaultKinds)
+	}
+	if fromKind == toKind {
+		glog.Fatalf("--%s and --%s must be different kinds of vault", FlagFrom, FlagTo)
+	}
+
+	from, err := newVaultServiceOfKind(vaultConfig, fromKind)
+	if err != nil {
+		glog.Fatalf("Unable to create the %s vault: %v", fromKind, err)
+	}
+	to, err := newVaultServiceOfKind(vaultConfig, toKind)
+	if err != nil {
+		glog.Fatalf("Unable to create the %s vault: %v", toKind, err)
+	}
+
+	failures, err := migrateSecrets(from, to, os.Stdout)
+	if err != nil {
+		glog.Fatalf("Unable to list the secrets of the %s vault: %v", fromKind, err)
+	}
+	if failures > 0 {
+		glog.Fatalf("%d secrets could not be migrated, run the command again once the errors are fixed", failures)
+	}
+}
+
+// newVaultServiceOfKind creates a vault of the given kind with the vault flags, whatever the kind of vault the fleet manager uses
+func newVaultServiceOfKind(vaultConfig *vault.Config, kind string) (vault.VaultService, error) {
+	config := *vaultConfig
+	config.Kind = kind
+	if err := config.Validate(nil); err != nil {
+		return nil, err
+	}
+	if err := config.ReadFiles(); err != nil {
+		return nil, err
+	}
+	return vault.NewVaultService(&config)
+}
+
+func isPersistentVaultKind(kind string) bool {
+	for _, k := range persistentVaultKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+type vaultSecret struct {
+	name           string
+	owningResource string
+}
+
+// migrateSecrets copies the secrets missing from the target vault and verifies them. It writes a report of the migration to out
+// and returns the number of secrets that could not be migrated
+func migrateSecrets(from vault.VaultService, to vault.VaultService, out io.Writer) (int, error) {
+	var secrets []vaultSecret
+	err := from.ForEachSecret(func(name string, owningResource string) bool {
+		secrets = append(secrets, vaultSecret{name: name, owningResource: owningResource})
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	targetOwners := map[string]string{}
+	err = to.ForEachSecret(func(name string, owningResource string) bool {
+		targetOwners[name] = owningResource
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Secret Key", "Owning Resource", "Result"})
+	failures := 0
+	for _, secret := range secrets {
+		result, err := migrateSecret(from, to, secret, targetOwners)
+		if err != nil {
+			failures++
+			result = fmt.Sprintf("failed: %v", err)
+		}
+		table.Append([]string{secret.name, secret.owningResource, result})
+	}
+	table.Render()
+	fmt.Fprintf(out, "%d secrets migrated from the %s vault to the %s vault, %d failures\n", len(secrets)-failures, from.Kind(), to.Kind(), failures)
+
+	return failures, nil
+}
+
+// migrateSecret copies a secret to the target vault. targetOwners holds the owning resource of the secrets already in the target
+// vault, so that a secret already migrated with another owning resource gets it rewritten
+func migrateSecret(from vault.VaultService, to vault.VaultService, secret vaultSecret, targetOwners map[string]string) (string, error) {
+	value, err := from.GetSecretString(secret.name)
+	if err != nil {
+		return "", fmt.Errorf("could not read the secret: %v", err)
+	}
+
+	existing, err := to.GetSecretString(secret.name)
+	switch {
+	case err == nil && existing == value:
+		if targetOwners[secret.name] == secret.owningResource {
+			return migrationResultAlreadyMigrated, nil
+		}
+		if err := to.SetSecretOwner(secret.name, secret.owningResource); err != nil {
+			return "", fmt.Errorf("could not update the owning resource of the secret: %v", err)
+		}
+		return migrationResultOwnerUpdated, nil
+	case err == nil:
+		return "", fmt.Errorf("the secret already exists in the %s vault with another value", to.Kind())
+	case !vault.IsNotFound(err):
+		return "", fmt.Errorf("could not check the %s vault: %v", to.Kind(), err)
+	}
+
+	if err := to.SetSecretString(secret.name, value, secret.owningResource); err != nil {
+		return "", fmt.Errorf("could not copy the secret: %v", err)
+	}
+
+	copied, err := to.GetSecretString(secret.name)
+	if err != nil {
+		return "", fmt.Errorf("could not verify the copy: %v", err)
+	}
+	if copied != value {
+		return "", fmt.Errorf("the copy does not match the secret")
+	}
+	return migrationResultCopied, nil
+}
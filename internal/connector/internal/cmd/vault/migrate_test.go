@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
+	"github.com/onsi/gomega"
+)
+
+func Test_migrateSecrets(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	from, _ := vault.NewTmpVaultService()
+	to, _ := vault.NewTmpVaultService()
+	g.Expect(from.SetSecretString("secret-1", "value-1", "/v1/connector/connector-1")).To(gomega.Succeed())
+	g.Expect(from.SetSecretString("secret-2", "value-2", "/v1/connector/connector-2")).To(gomega.Succeed())
+	g.Expect(from.SetSecretString("secret-3", "value-3", "")).To(gomega.Succeed())
+	// a secret copied by an interrupted migration
+	g.Expect(to.SetSecretString("secret-2", "value-2", "/v1/connector/connector-2")).To(gomega.Succeed())
+	// a secret that cannot be copied without overwriting another one
+	g.Expect(from.SetSecretString("secret-4", "value-4", "")).To(gomega.Succeed())
+	g.Expect(to.SetSecretString("secret-4", "other-value", "")).To(gomega.Succeed())
+	// a secret copied with another owning resource
+	g.Expect(from.SetSecretString("secret-5", "value-5", "/v1/connector/connector-5")).To(gomega.Succeed())
+	g.Expect(to.SetSecretString("secret-5", "value-5", "/v1/connector/other")).To(gomega.Succeed())
+
+	var out bytes.Buffer
+	failures, err := migrateSecrets(from, to, &out)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(failures).To(gomega.Equal(1))
+	g.Expect(out.String()).To(gomega.ContainSubstring("4 secrets migrated from the tmp vault to the tmp vault, 1 failures"))
+
+	owners := map[string]string{}
+	g.Expect(to.ForEachSecret(func(name string, owningResource string) bool {
+		owners[name] = owningResource
+		return true
+	})).To(gomega.Succeed())
+	g.Expect(owners).To(gomega.Equal(map[string]string{
+		"secret-1": "/v1/connector/connector-1",
+		"secret-2": "/v1/connector/connector-2",
+		"secret-3": "",
+		"secret-4": "",
+		"secret-5": "/v1/connector/connector-5",
+	}))
+	for name, want := range map[string]string{"secret-1": "value-1", "secret-2": "value-2", "secret-3": "value-3", "secret-4": "other-value"} {
+		value, err := to.GetSecretString(name)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(value).To(gomega.Equal(want))
+	}
+
+	// running the migration again resumes it
+	g.Expect(to.DeleteSecretString("secret-4")).To(gomega.Succeed())
+	out.Reset()
+	failures, err = migrateSecrets(from, to, &out)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(failures).To(gomega.Equal(0))
+	g.Expect(to.Counters().Inserts).To(gomega.Equal(int64(6)))
+	g.Expect(to.Counters().Updates).To(gomega.Equal(int64(1)))
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/files"
 
@@ -29,6 +31,22 @@ type ConnectorsConfig struct {
 	ConnectorMetadataDirs               []string                `json:"connector_metadata"`
 	CatalogEntries                      []ConnectorCatalogEntry `json:"connector_type_urls"`
 	CatalogChecksums                    map[string]string       `json:"connector_catalog_checksums"`
+	// ConnectorCatalogSources are the HTTP(S) URLs and OCI artifacts of remote catalogs, which are reloaded every
+	// ConnectorCatalogSourcesRefreshInterval so that connector types can be released without redeploying the fleet manager
+	ConnectorCatalogSources                []string      `json:"connector_catalog_sources"`
+	ConnectorCatalogSourcesPublicKeyFile   string        `json:"connector_catalog_sources_public_key_file"`
+	ConnectorCatalogSourcesRefreshInterval time.Duration `json:"connector_catalog_sources_refresh_interval"`
+	// ConnectorClusterHeartbeatTimeout is how long the agent of a ready cluster can go without updating the cluster
+	// status before the cluster and its namespaces are disconnected
+	ConnectorClusterHeartbeatTimeout time.Duration `json:"connector_cluster_heartbeat_timeout"`
+
+	// catalogLock guards CatalogEntries and CatalogChecksums, which are replaced when the remote catalogs are reloaded
+	catalogLock sync.RWMutex
+	// localCatalogEntries and localCatalogChecksums are read from the catalog directories at startup only,
+	// and merged with the remote catalogs every time they are reloaded
+	localCatalogEntries     []ConnectorCatalogEntry
+	localCatalogChecksums   map[string]string
+	catalogSourcesPublicKey interface{}
 }
 
 var _ environments.ConfigModule = &ConnectorsConfig{}
@@ -51,7 +69,9 @@ type ConnectorMetadata struct {
 
 func NewConnectorsConfig() *ConnectorsConfig {
 	return &ConnectorsConfig{
-		CatalogChecksums: make(map[string]string),
+		CatalogChecksums:                       make(map[string]string),
+		ConnectorCatalogSourcesRefreshInterval: 5 * time.Minute,
+		ConnectorClusterHeartbeatTimeout:       10 * time.Minute,
 	}
 }
 
@@ -62,6 +82,10 @@ func (c *ConnectorsConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringArrayVar(&c.ConnectorEvalOrganizations, "connector-eval-organizations", c.ConnectorEvalOrganizations, "Connector eval organization IDs")
 	fs.BoolVar(&c.ConnectorNamespaceLifecycleAPI, "connector-namespace-lifecycle-api", c.ConnectorNamespaceLifecycleAPI, "Enable APIs to create, update, delete non-eval Namespaces")
 	fs.BoolVar(&c.ConnectorEnableUnassignedConnectors, "connector-enable-unassigned-connectors", c.ConnectorEnableUnassignedConnectors, "Enable support for 'unassigned' state for Connectors")
+	fs.StringArrayVar(&c.ConnectorCatalogSources, "connector-catalog-source", c.ConnectorCatalogSources, "HTTP(S) URL or OCI artifact (oci://registry/repository:tag) of a remote connector catalog")
+	fs.StringVar(&c.ConnectorCatalogSourcesPublicKeyFile, "connector-catalog-sources-public-key-file", c.ConnectorCatalogSourcesPublicKeyFile, "File containing the PEM encoded public key used to verify the signatures of the remote connector catalogs. Their checksums are verified instead when not set")
+	fs.DurationVar(&c.ConnectorCatalogSourcesRefreshInterval, "connector-catalog-sources-refresh-interval", c.ConnectorCatalogSourcesRefreshInterval, "How often the remote connector catalogs are reloaded. They are only loaded at startup when set to 0")
+	fs.DurationVar(&c.ConnectorClusterHeartbeatTimeout, "connector-cluster-heartbeat-timeout", c.ConnectorClusterHeartbeatTimeout, "How long the agent of a connector cluster can go without updating its status before the cluster is disconnected. Clusters are never disconnected when set to 0")
 }
 
 func (c *ConnectorsConfig) ReadFiles() error {
@@ -87,6 +111,25 @@ func (c *ConnectorsConfig) ReadFiles() error {
 		return fmt.Errorf("found %d unrecognized connector metadata with ids: %s", remainingIds, ids)
 	}
 
+	if len(c.ConnectorCatalogSources) > 0 {
+		if err := c.readCatalogSourcesPublicKey(); err != nil {
+			return err
+		}
+		if c.catalogSourcesPublicKey == nil {
+			glog.Warningf("no connector catalog sources public key, the remote catalogs are only verified against their checksums and are not authenticated")
+		}
+
+		// keep the entries of the catalog directories to merge them with the remote catalogs on every reload
+		c.localCatalogEntries = c.CatalogEntries
+		c.localCatalogChecksums = c.CatalogChecksums
+		// a remote catalog that cannot be loaded fails the startup, rather than starting from the catalog directories
+		// only, since the startup reconcile would delete or deprecate all the connector types of the remote catalogs
+		c.CatalogEntries, c.CatalogChecksums, err = c.loadCatalogSources()
+		if err != nil {
+			return err
+		}
+	}
+
 	glog.Infof("loaded %d connector types", len(c.CatalogEntries))
 
 	return nil
@@ -233,6 +276,37 @@ func (c *ConnectorsConfig) readConnectorCatalog(connectorMetadata map[string]Con
 	return nil
 }
 
+// Catalog returns the current catalog entries and their checksums, which must not be modified
+func (c *ConnectorsConfig) Catalog() ([]ConnectorCatalogEntry, map[string]string) {
+	c.catalogLock.RLock()
+	defer c.catalogLock.RUnlock()
+	return c.CatalogEntries, c.CatalogChecksums
+}
+
+// HasCatalogSources returns whether remote catalogs are configured, and need to be reloaded
+func (c *ConnectorsConfig) HasCatalogSources() bool {
+	return len(c.ConnectorCatalogSources) > 0 && c.ConnectorCatalogSourcesRefreshInterval > 0
+}
+
+// ReloadCatalogSources fetches the remote catalogs again and merges them with the entries of the catalog directories.
+// The catalog is left untouched when any of them cannot be fetched or verified, and it returns whether it has changed
+func (c *ConnectorsConfig) ReloadCatalogSources() (bool, error) {
+	entries, checksums, err := c.loadCatalogSources()
+	if err != nil {
+		return false, err
+	}
+
+	c.catalogLock.Lock()
+	defer c.catalogLock.Unlock()
+	if reflect.DeepEqual(checksums, c.CatalogChecksums) {
+		return false, nil
+	}
+	c.CatalogEntries = entries
+	c.CatalogChecksums = checksums
+	glog.Infof("reloaded %d connector types", len(entries))
+	return true, nil
+}
+
 func checksum(spec interface{}) (string, error) {
 	h := sha1.New()
 	err := json.NewEncoder(h).Encode(spec)
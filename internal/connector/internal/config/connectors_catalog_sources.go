@@ -0,0 +1,430 @@
+package config
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang/glog"
+)
+
+const (
+	// ConnectorCatalogMediaType is the media type of the layer holding the catalog in an OCI artifact
+	ConnectorCatalogMediaType = "application/vnd.bf2.cos.catalog.v1+json"
+	// ConnectorCatalogSignatureAnnotation is the annotation of the catalog layer holding the signature of the catalog
+	ConnectorCatalogSignatureAnnotation = "org.bf2.cos.catalog.signature"
+
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	maxCatalogSourceSize = 32 << 20
+)
+
+// RemoteConnectorCatalog is the document served by a remote catalog source. It holds the metadata of its own
+// connector types, the metadata read from the metadata directories only applies to the catalog directories
+type RemoteConnectorCatalog struct {
+	ConnectorTypes    []ConnectorCatalogEntry `json:"connector_types"`
+	ConnectorMetadata []ConnectorMetadata     `json:"connector_metadata"`
+}
+
+var catalogSourcesClient = &http.Client{Timeout: 30 * time.Second}
+
+func (c *ConnectorsConfig) readCatalogSourcesPublicKey() error {
+	if c.ConnectorCatalogSourcesPublicKeyFile == "" {
+		return nil
+	}
+
+	file := shared.BuildFullFilePath(c.ConnectorCatalogSourcesPublicKeyFile)
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading connector catalog sources public key %s: %s", file, err)
+	}
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return fmt.Errorf("error reading connector catalog sources public key %s: no PEM data found", file)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing connector catalog sources public key %s: %s", file, err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		c.catalogSourcesPublicKey = key
+	default:
+		return fmt.Errorf("unsupported connector catalog sources public key type %T", key)
+	}
+	return nil
+}
+
+// loadCatalogSources returns the entries of the catalog directories merged with the entries of the remote catalogs
+func (c *ConnectorsConfig) loadCatalogSources() ([]ConnectorCatalogEntry, map[string]string, error) {
+	entries := make([]ConnectorCatalogEntry, len(c.localCatalogEntries))
+	copy(entries, c.localCatalogEntries)
+	checksums := make(map[string]string, len(c.localCatalogChecksums))
+	origins := make(map[string]string, len(c.localCatalogChecksums))
+	for id, sum := range c.localCatalogChecksums {
+		checksums[id] = sum
+		origins[id] = "the connector catalog directories"
+	}
+
+	for _, source := range c.ConnectorCatalogSources {
+		glog.Infof("loading connectors from catalog source %s", source)
+
+		buf, err := c.fetchCatalogSource(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error loading connector catalog source %s: %s", source, err)
+		}
+
+		var catalog RemoteConnectorCatalog
+		if err := json.Unmarshal(buf, &catalog); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshaling connector catalog source %s: %s", source, err)
+		}
+
+		connectorMetadata := make(map[string]ConnectorMetadata, len(catalog.ConnectorMetadata))
+		for _, m := range catalog.ConnectorMetadata {
+			connectorMetadata[m.ConnectorTypeId] = m
+		}
+
+		for _, entry := range catalog.ConnectorTypes {
+			id := entry.ConnectorType.Id
+			meta, found := connectorMetadata[id]
+			if !found {
+				return nil, nil, fmt.Errorf("missing metadata for connector %s in connector catalog source %s", id, source)
+			}
+			delete(connectorMetadata, id)
+			entry.ConnectorType.FeaturedRank = meta.FeaturedRank
+			entry.ConnectorType.Labels = meta.Labels
+			entry.ConnectorType.Annotations = meta.Annotations
+
+			if prev, found := origins[id]; found {
+				return nil, nil, fmt.Errorf("connector type '%s' defined in '%s' and '%s'", id, source, prev)
+			}
+			sum, err := checksum(entry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error computing checksum for connector %s in connector catalog source %s: %s", id, source, err)
+			}
+			checksums[id] = sum
+			origins[id] = source
+			entries = append(entries, entry)
+		}
+
+		if len(connectorMetadata) > 0 {
+			ids := make([]string, 0, len(connectorMetadata))
+			for id := range connectorMetadata {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			return nil, nil, fmt.Errorf("found %d unrecognized connector metadata in connector catalog source %s with ids: %s", len(ids), source, ids)
+		}
+
+		glog.Infof("loaded %d connectors from catalog source %s", len(catalog.ConnectorTypes), source)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ConnectorType.Id < entries[j].ConnectorType.Id
+	})
+
+	return entries, checksums, nil
+}
+
+// fetchCatalogSource returns the verified catalog document of a source
+func (c *ConnectorsConfig) fetchCatalogSource(source string) ([]byte, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return c.fetchHTTPCatalogSource(u)
+	case "oci":
+		return c.fetchOCICatalogSource(u)
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, expected one of http, https or oci", u.Scheme)
+	}
+}
+
+// fetchHTTPCatalogSource downloads the catalog and verifies it either with the signature served at the same URL with
+// a .sig suffix, when a public key is configured, or with the sha256 checksum served with a .sha256 suffix. The checksum
+// is served by the same server as the catalog, so it only detects a corrupted download and does not authenticate it
+func (c *ConnectorsConfig) fetchHTTPCatalogSource(u *url.URL) ([]byte, error) {
+	buf, err := httpGet(u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.catalogSourcesPublicKey != nil {
+		signature, err := httpGet(withPathSuffix(u, ".sig"), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading signature: %s", err)
+		}
+		return buf, verifyCatalogSignature(c.catalogSourcesPublicKey, buf, string(signature))
+	}
+
+	sum, err := httpGet(withPathSuffix(u, ".sha256"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading checksum: %s", err)
+	}
+	// accept the output of sha256sum, i.e. the checksum followed by the file name
+	fields := strings.Fields(string(sum))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty checksum")
+	}
+	return buf, verifyDigest(buf, "sha256:"+fields[0])
+}
+
+type ociManifest struct {
+	MediaType string `json:"mediaType"`
+	Layers    []struct {
+		MediaType   string            `json:"mediaType"`
+		Digest      string            `json:"digest"`
+		Size        int64             `json:"size"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"layers"`
+}
+
+// fetchOCICatalogSource pulls the catalog layer of an OCI artifact, e.g. pushed with
+// `oras push registry/repository:tag catalog.json:application/vnd.bf2.cos.catalog.v1+json`.
+// The layer is verified against its digest, and against the signature annotation when a public key is configured
+func (c *ConnectorsConfig) fetchOCICatalogSource(u *url.URL) ([]byte, error) {
+	repository, reference := parseOCIReference(strings.TrimPrefix(u.Path, "/"))
+	if u.Host == "" || repository == "" {
+		return nil, fmt.Errorf("invalid OCI artifact, expected oci://registry/repository:tag or oci://registry/repository@digest")
+	}
+
+	// like container runtimes, only talk plain http to a registry running on the loopback interface
+	scheme := "https"
+	if host, _, err := net.SplitHostPort(u.Host); (err == nil && isLoopback(host)) || isLoopback(u.Host) {
+		scheme = "http"
+	}
+	registry := &ociRegistry{baseURL: fmt.Sprintf("%s://%s/v2/%s", scheme, u.Host, repository)}
+
+	buf, err := registry.get("/manifests/"+reference, ociManifestMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading manifest: %s", err)
+	}
+	if strings.HasPrefix(reference, "sha256:") {
+		if err := verifyDigest(buf, reference); err != nil {
+			return nil, fmt.Errorf("invalid manifest: %s", err)
+		}
+	}
+
+	var manifest ociManifest
+	if err := json.Unmarshal(buf, &manifest); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifest: %s", err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != ConnectorCatalogMediaType {
+			continue
+		}
+
+		buf, err := registry.get("/blobs/"+layer.Digest, "")
+		if err != nil {
+			return nil, fmt.Errorf("error downloading catalog layer: %s", err)
+		}
+		if int64(len(buf)) != layer.Size {
+			return nil, fmt.Errorf("catalog layer size %d does not match the manifest size %d", len(buf), layer.Size)
+		}
+		if err := verifyDigest(buf, layer.Digest); err != nil {
+			return nil, err
+		}
+		if c.catalogSourcesPublicKey != nil {
+			signature, found := layer.Annotations[ConnectorCatalogSignatureAnnotation]
+			if !found {
+				return nil, fmt.Errorf("missing %s annotation on the catalog layer", ConnectorCatalogSignatureAnnotation)
+			}
+			if err := verifyCatalogSignature(c.catalogSourcesPublicKey, buf, signature); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	}
+
+	return nil, fmt.Errorf("no layer with media type %s found", ConnectorCatalogMediaType)
+}
+
+// parseOCIReference splits repository:tag and repository@digest, the tag defaulting to latest
+func parseOCIReference(name string) (string, string) {
+	if i := strings.Index(name, "@"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		return name[:i], name[i+1:]
+	}
+	return name, "latest"
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ociRegistry pulls from a repository of a registry, requesting an anonymous bearer token when challenged
+type ociRegistry struct {
+	baseURL string
+	token   string
+}
+
+func (r *ociRegistry) get(path string, accept string) ([]byte, error) {
+	header := http.Header{}
+	if accept != "" {
+		header.Set("Accept", accept)
+	}
+	if r.token != "" {
+		header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	buf, err := httpGet(r.baseURL+path, header)
+	if challenge, ok := err.(*unauthorizedError); ok && r.token == "" {
+		if r.token, err = fetchRegistryToken(challenge.authenticate); err != nil {
+			return nil, err
+		}
+		header.Set("Authorization", "Bearer "+r.token)
+		return httpGet(r.baseURL+path, header)
+	}
+	return buf, err
+}
+
+// fetchRegistryToken requests a token from the realm of a Bearer realm="...",service="...",scope="..." challenge
+func fetchRegistryToken(authenticate string) (string, error) {
+	if !strings.HasPrefix(authenticate, "Bearer ") {
+		return "", fmt.Errorf("unsupported registry authentication challenge %q", authenticate)
+	}
+	params := map[string]string{}
+	for _, param := range strings.Split(strings.TrimPrefix(authenticate, "Bearer "), ",") {
+		if key, value, found := strings.Cut(strings.TrimSpace(param), "="); found {
+			params[key] = strings.Trim(value, `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid registry authentication realm %q", params["realm"])
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	buf, err := httpGet(realm.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("error requesting registry token: %s", err)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(buf, &token); err != nil {
+		return "", fmt.Errorf("error unmarshaling registry token: %s", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("no registry token returned by %s", realm.Host)
+}
+
+type unauthorizedError struct {
+	authenticate string
+}
+
+func (e *unauthorizedError) Error() string {
+	return "unauthorized"
+}
+
+func httpGet(location string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := catalogSourcesClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer shared.CloseQuietly(resp.Body)()
+
+	if resp.StatusCode == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") != "" {
+		return nil, &unauthorizedError{authenticate: resp.Header.Get("WWW-Authenticate")}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %d", req.URL.Redacted(), resp.StatusCode)
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, maxCatalogSourceSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) > maxCatalogSourceSize {
+		return nil, fmt.Errorf("GET %s returned more than %d bytes", req.URL.Redacted(), maxCatalogSourceSize)
+	}
+	return buf, nil
+}
+
+func withPathSuffix(u *url.URL, suffix string) string {
+	withSuffix := *u
+	withSuffix.Path += suffix
+	withSuffix.RawPath = ""
+	return withSuffix.String()
+}
+
+func verifyDigest(buf []byte, digest string) error {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return fmt.Errorf("unsupported digest %q, expected a sha256 digest", digest)
+	}
+	expected := strings.TrimPrefix(digest, "sha256:")
+	sum := sha256.Sum256(buf)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch, expected sha256 %s but got %s", expected, actual)
+	}
+	return nil
+}
+
+// verifyCatalogSignature verifies a base64 encoded signature, e.g. created with `cosign sign-blob` or
+// `openssl dgst -sha256 -sign`, of the catalog document
+func verifyCatalogSignature(publicKey interface{}, buf []byte, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("error decoding signature: %s", err)
+	}
+
+	digest := sha256.Sum256(buf)
+	valid := false
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], sig)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, buf, sig)
+	}
+	if !valid {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
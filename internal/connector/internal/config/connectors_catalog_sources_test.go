@@ -0,0 +1,342 @@
+package config
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/onsi/gomega"
+)
+
+const testCatalogToken = "registry-token"
+
+// catalogSourceServer serves a remote catalog over plain http, and as the catalog layer of an OCI artifact
+type catalogSourceServer struct {
+	*httptest.Server
+	lock      sync.Mutex
+	catalog   []byte
+	checksum  string
+	signature string
+}
+
+func newCatalogSourceServer(t *testing.T, catalog RemoteConnectorCatalog, signer crypto.Signer) *catalogSourceServer {
+	s := &catalogSourceServer{}
+	s.setCatalog(t, catalog, signer)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/catalog.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(s.get(func() []byte { return s.catalog }))
+	})
+	mux.HandleFunc("/catalog.json.sha256", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(s.get(func() []byte { return []byte(s.checksum + "  catalog.json\n") }))
+	})
+	mux.HandleFunc("/catalog.json.sig", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(s.get(func() []byte { return []byte(s.signature) }))
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:catalogs/connectors:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"token": %q}`, testCatalogToken)
+	})
+	mux.HandleFunc("/v2/catalogs/connectors/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testCatalogToken {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:catalogs/connectors:pull"`, s.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		catalog := s.get(func() []byte { return s.catalog })
+		digest := "sha256:" + sha256Hex(catalog)
+		switch r.URL.Path {
+		case "/v2/catalogs/connectors/manifests/v1":
+			annotations := map[string]string{}
+			if signature := string(s.get(func() []byte { return []byte(s.signature) })); signature != "" {
+				annotations[ConnectorCatalogSignatureAnnotation] = signature
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"schemaVersion": 2,
+				"mediaType":     ociManifestMediaType,
+				"layers": []map[string]interface{}{
+					{"mediaType": "application/vnd.oci.image.layer.v1.tar", "digest": "sha256:" + sha256Hex(nil), "size": 0},
+					{"mediaType": ConnectorCatalogMediaType, "digest": digest, "size": len(catalog), "annotations": annotations},
+				},
+			})
+		case "/v2/catalogs/connectors/blobs/" + digest:
+			_, _ = w.Write(catalog)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *catalogSourceServer) get(f func() []byte) []byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return f()
+}
+
+func (s *catalogSourceServer) setCatalog(t *testing.T, catalog RemoteConnectorCatalog, signer crypto.Signer) {
+	buf, err := json.Marshal(catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.catalog = buf
+	s.checksum = sha256Hex(buf)
+	s.signature = ""
+	if signer != nil {
+		digest := sha256.Sum256(buf)
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.signature = base64.StdEncoding.EncodeToString(signature)
+	}
+}
+
+func sha256Hex(buf []byte) string {
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+// remoteCatalog returns a catalog with a copy of the log sink connector type of the test catalog for each id
+func remoteCatalog(t *testing.T, ids ...string) RemoteConnectorCatalog {
+	buf, err := os.ReadFile(shared.BuildFullFilePath("./internal/connector/test/integration/resources/connector-catalog/log_sink_0.1.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	catalog := RemoteConnectorCatalog{}
+	for _, id := range ids {
+		entry := ConnectorCatalogEntry{}
+		if err := json.Unmarshal(buf, &entry); err != nil {
+			t.Fatal(err)
+		}
+		entry.ConnectorType.Id = id
+		catalog.ConnectorTypes = append(catalog.ConnectorTypes, entry)
+		catalog.ConnectorMetadata = append(catalog.ConnectorMetadata, ConnectorMetadata{
+			ConnectorTypeId: id,
+			Labels:          []string{"sink"},
+			Annotations:     map[string]string{"cos.bf2.org/pricing-tier": "essentials"},
+		})
+	}
+	return catalog
+}
+
+func writePublicKey(t *testing.T, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "catalog.pub")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestConnectorsConfig_ReadFiles_CatalogSources(t *testing.T) {
+	signingKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	publicKeyFile := writePublicKey(t, signingKey)
+
+	unsigned := newCatalogSourceServer(t, remoteCatalog(t, "remote_sink_0.1", "remote_sink_0.2"), nil)
+	signed := newCatalogSourceServer(t, remoteCatalog(t, "remote_sink_0.1"), signingKey)
+	wronglySigned := newCatalogSourceServer(t, remoteCatalog(t, "remote_sink_0.1"), otherKey)
+	tampered := newCatalogSourceServer(t, remoteCatalog(t, "remote_sink_0.1"), nil)
+	tampered.checksum = sha256Hex([]byte("another catalog"))
+	duplicated := newCatalogSourceServer(t, remoteCatalog(t, "log_sink_0.1"), nil)
+	missingMetadata := remoteCatalog(t, "remote_sink_0.1")
+	missingMetadata.ConnectorMetadata = nil
+	withoutMetadata := newCatalogSourceServer(t, missingMetadata, nil)
+
+	ociSource := func(server *catalogSourceServer) string {
+		return "oci://" + strings.TrimPrefix(server.URL, "http://") + "/catalogs/connectors:v1"
+	}
+
+	tests := []struct {
+		name          string
+		sources       []string
+		publicKeyFile string
+		wantErr       string
+		connectorsIDs []string
+	}{
+		{
+			name:          "should merge an http source verified with its checksum",
+			sources:       []string{unsigned.URL + "/catalog.json"},
+			connectorsIDs: []string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.1", "remote_sink_0.2"},
+		},
+		{
+			name:    "should fail when the checksum of an http source does not match",
+			sources: []string{tampered.URL + "/catalog.json"},
+			wantErr: "^error loading connector catalog source .+/catalog.json: checksum mismatch, expected sha256 .+$",
+		},
+		{
+			name:          "should merge an http source verified with its signature",
+			sources:       []string{signed.URL + "/catalog.json"},
+			publicKeyFile: publicKeyFile,
+			connectorsIDs: []string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.1"},
+		},
+		{
+			name:          "should fail when the signature of an http source is invalid",
+			sources:       []string{wronglySigned.URL + "/catalog.json"},
+			publicKeyFile: publicKeyFile,
+			wantErr:       "^error loading connector catalog source .+/catalog.json: invalid signature$",
+		},
+		{
+			name:          "should fail when an http source is not signed",
+			sources:       []string{unsigned.URL + "/catalog.json"},
+			publicKeyFile: publicKeyFile,
+			wantErr:       "^error loading connector catalog source .+/catalog.json: invalid signature$",
+		},
+		{
+			name:          "should merge an OCI artifact verified with its digest",
+			sources:       []string{ociSource(unsigned)},
+			connectorsIDs: []string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.1", "remote_sink_0.2"},
+		},
+		{
+			name:          "should merge an OCI artifact verified with its signature",
+			sources:       []string{ociSource(signed)},
+			publicKeyFile: publicKeyFile,
+			connectorsIDs: []string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.1"},
+		},
+		{
+			name:          "should fail when an OCI artifact is not signed",
+			sources:       []string{ociSource(unsigned)},
+			publicKeyFile: publicKeyFile,
+			wantErr:       "^error loading connector catalog source oci://.+: missing org.bf2.cos.catalog.signature annotation on the catalog layer$",
+		},
+		{
+			name:    "should fail when an OCI artifact does not exist",
+			sources: []string{"oci://" + strings.TrimPrefix(unsigned.URL, "http://") + "/catalogs/connectors:v2"},
+			wantErr: "^error loading connector catalog source oci://.+: error downloading manifest: GET .+/manifests/v2 returned status 404$",
+		},
+		{
+			name:    "should fail when an http source cannot be fetched",
+			sources: []string{unsigned.URL + "/missing.json"},
+			wantErr: "^error loading connector catalog source .+/missing.json: GET .+/missing.json returned status 404$",
+		},
+		{
+			name:    "should fail when a connector type is defined in a source and in the catalog directories",
+			sources: []string{duplicated.URL + "/catalog.json"},
+			wantErr: "^connector type 'log_sink_0.1' defined in '.+/catalog.json' and 'the connector catalog directories'$",
+		},
+		{
+			name:    "should fail when a connector type of a source has no metadata",
+			sources: []string{withoutMetadata.URL + "/catalog.json"},
+			wantErr: "^missing metadata for connector remote_sink_0.1 in connector catalog source .+/catalog.json$",
+		},
+		{
+			name:    "should fail on an unsupported source",
+			sources: []string{"ftp://example.com/catalog.json"},
+			wantErr: `^error loading connector catalog source ftp://example.com/catalog.json: unsupported scheme "ftp", expected one of http, https or oci$`,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewConnectorsConfig()
+			c.ConnectorMetadataDirs = []string{"./internal/connector/test/integration/resources/connector-metadata"}
+			c.ConnectorCatalogDirs = []string{"./internal/connector/test/integration/resources/connector-catalog"}
+			c.ConnectorCatalogSources = tt.sources
+			c.ConnectorCatalogSourcesPublicKeyFile = tt.publicKeyFile
+
+			err := c.ReadFiles()
+			if tt.wantErr != "" {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(err.Error()).To(gomega.MatchRegexp(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			entries, checksums := c.Catalog()
+			ids := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				ids = append(ids, entry.ConnectorType.Id)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.connectorsIDs))
+			g.Expect(checksums).To(gomega.HaveLen(len(tt.connectorsIDs)))
+		})
+	}
+}
+
+func TestConnectorsConfig_ReloadCatalogSources(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	server := newCatalogSourceServer(t, remoteCatalog(t, "remote_sink_0.1"), nil)
+	c := NewConnectorsConfig()
+	c.ConnectorMetadataDirs = []string{"./internal/connector/test/integration/resources/connector-metadata"}
+	c.ConnectorCatalogDirs = []string{"./internal/connector/test/integration/resources/connector-catalog"}
+	c.ConnectorCatalogSources = []string{server.URL + "/catalog.json"}
+	g.Expect(c.ReadFiles()).To(gomega.Succeed())
+	g.Expect(c.HasCatalogSources()).To(gomega.BeTrue())
+
+	catalogIDs := func() []string {
+		entries, checksums := c.Catalog()
+		ids := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			g.Expect(checksums).To(gomega.HaveKey(entry.ConnectorType.Id))
+			ids = append(ids, entry.ConnectorType.Id)
+		}
+		return ids
+	}
+
+	// an unchanged catalog
+	changed, err := c.ReloadCatalogSources()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeFalse())
+
+	// a new connector type version is released and the previous one removed
+	server.setCatalog(t, remoteCatalog(t, "remote_sink_0.2"), nil)
+	changed, err = c.ReloadCatalogSources()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(catalogIDs()).To(gomega.Equal([]string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.2"}))
+
+	// an updated connector type
+	updated := remoteCatalog(t, "remote_sink_0.2")
+	updated.ConnectorMetadata[0].FeaturedRank = 10
+	server.setCatalog(t, updated, nil)
+	_, checksums := c.Catalog()
+	previousChecksum := checksums["remote_sink_0.2"]
+	changed, err = c.ReloadCatalogSources()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeTrue())
+	_, checksums = c.Catalog()
+	g.Expect(checksums["remote_sink_0.2"]).ToNot(gomega.Equal(previousChecksum))
+
+	// a catalog that cannot be verified is not loaded
+	server.lock.Lock()
+	server.checksum = sha256Hex([]byte("another catalog"))
+	server.lock.Unlock()
+	changed, err = c.ReloadCatalogSources()
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeFalse())
+	g.Expect(catalogIDs()).To(gomega.Equal([]string{"aws-sqs-source-v1alpha1", "log_sink_0.1", "remote_sink_0.2"}))
+}
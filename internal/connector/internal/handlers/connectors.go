@@ -154,171 +154,291 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			handlers.Validation("Content-Type header", &contentType, handlers.IsOneOf(APPLICATION_JSON, JSON_PATCH, MERGE_PATCH)),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
-			dbresource, serr := h.connectorsService.Get(r.Context(), connectorId)
-			if serr != nil {
-				return nil, serr
-			}
-			originalResource, _ := presenters.PresentConnector(&dbresource.Connector)
-
-			resource, serr := presenters.PresentConnector(&dbresource.Connector)
-			if serr != nil {
-				return nil, serr
-			}
-
-			ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
-			if serr != nil {
-				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
-			}
-
-			originalSecrets, err := getSecretRefs(&dbresource.Connector, ct)
-			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
-			}
-
 			// Apply the patch..
 			patchBytes, err := io.ReadAll(r.Body)
 			if err != nil {
 				return nil, errors.BadRequest("failed to get patch bytes")
 			}
+			return h.patchConnector(r, connectorId, contentType, patchBytes)
+		},
+	}
 
-			// get json resource
-			resourceJson, serr := getResourceJson(resource)
-			if serr != nil {
-				return nil, serr
-			}
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// patchConnector applies a json-patch or merge-patch to a connector, for the Patch and Rollback handlers
+func (h ConnectorsHandler) patchConnector(r *http.Request, connectorId string, contentType string, patchBytes []byte) (interface{}, *errors.ServiceError) {
+	dbresource, serr := h.connectorsService.Get(r.Context(), connectorId)
+	if serr != nil {
+		return nil, serr
+	}
+	originalResource, _ := presenters.PresentConnector(&dbresource.Connector)
+
+	resource, serr := presenters.PresentConnector(&dbresource.Connector)
+	if serr != nil {
+		return nil, serr
+	}
+
+	ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
+	if serr != nil {
+		return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
+	}
+
+	originalSecrets, err := getSecretRefs(&dbresource.Connector, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get existing secrets: %v", err)
+	}
+
+	// get json resource
+	resourceJson, serr := getResourceJson(resource)
+	if serr != nil {
+		return nil, serr
+	}
+
+	// convert json-patch into merge-patch, since validateConnectorPatch can only validate merge-patch
+	if contentType == JSON_PATCH {
+		patchBytes, err = convertToMergePatch(patchBytes, resourceJson)
+		if err != nil {
+			return nil, errors.BadRequest("failed to convert to merge patch: %v", err)
+		}
+		// changed patch bytes to merge patch
+		contentType = MERGE_PATCH
+	}
+
+	// Don't allow updating connector secrets with values like {"ref": "something"}
+	serr = validateConnectorPatch(patchBytes, ct)
+	if serr != nil {
+		return nil, serr
+	}
+
+	patch := public.ConnectorRequest{}
+	serr = PatchResource(resourceJson, contentType, patchBytes, &patch)
+	if serr != nil {
+		return nil, serr
+	}
 
-			// convert json-patch into merge-patch, since validateConnectorPatch can only validate merge-patch
-			if contentType == JSON_PATCH {
-				patchBytes, err = convertToMergePatch(patchBytes, resourceJson)
+	// get and validate patch operation type
+	var operation phase.ConnectorOperation
+	if operation, serr = h.getOperation(resource, patch); err != nil {
+		return nil, serr
+	}
+	if operation == phase.UnassignConnector && !h.connectorsConfig.ConnectorEnableUnassignedConnectors {
+		return nil, errors.FieldValidationError("Unsupported connector state %s", patch.DesiredState)
+	}
+	if serr = ValidateConnectorOperation(r.Context(), h.namespaceService, &dbresource.Connector, operation,
+		func(connector *dbapi.Connector) *errors.ServiceError {
+			resource.DesiredState = public.ConnectorDesiredState(dbresource.DesiredState)
+			return nil
+		}); serr != nil {
+		return nil, serr
+	}
+
+	// But we don't want to allow the user to update ALL fields.. so copy
+	// over the fields that they are allowed to modify..
+	resource.Name = patch.Name
+	resource.Connector = patch.Connector
+	resource.Annotations = patch.Annotations
+	resource.Kafka = patch.Kafka
+	resource.ServiceAccount = patch.ServiceAccount
+	resource.SchemaRegistry = patch.SchemaRegistry
+
+	if h.connectorsConfig.ConnectorEnableUnassignedConnectors {
+		// check namespace id change, from unassigned to assigned and vice versa
+		if operation == phase.AssignConnector && patch.NamespaceId != "" && resource.NamespaceId == "" {
+			resource.NamespaceId = patch.NamespaceId
+		}
+		if operation == phase.UnassignConnector && patch.NamespaceId == "" && resource.NamespaceId != "" {
+			resource.NamespaceId = patch.NamespaceId
+		}
+	}
+
+	// If we didn't change anything, then just skip the update...
+	if reflect.DeepEqual(originalResource, resource) {
+		return originalResource, nil
+	}
+
+	// revalidate
+	user := h.authZService.GetValidationUser(r.Context())
+	validates := []handlers.Validate{
+		handlers.Validation("name", &resource.Name, handlers.MinLen(1), handlers.MaxLen(100)),
+		handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
+		handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
+		handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
+		validatePatchAnnotations(resource.Annotations, originalResource.Annotations),
+		validateConnector(h.connectorTypesService, &resource),
+	}
+
+	// Don't validate user's tenancy in admin api calls
+	if strings.Compare(r.URL.Path, fmt.Sprintf("%s/%s", "/api/connector_mgmt/v1/admin/kafka_connectors", connectorId)) != 0 {
+		validates = append(validates, handlers.Validation("namespace_id", &resource.NamespaceId, handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest)))
+	}
+
+	for _, v := range validates {
+		err := v()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p, svcErr := presenters.ConvertConnector(resource)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	svcErr = moveSecretsToVault(p, ct, h.vaultService, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	// update connector phase before desired state
+	if originalResource.Status.State != public.ConnectorState(dbapi.ConnectorStatusPhaseAssigning) {
+		dbresource.Status.Phase = phase.ConnectorStartingPhase[operation]
+		p.Status.Phase = dbresource.Status.Phase
+		serr = h.connectorsService.SaveStatus(r.Context(), dbresource.Status)
+		if serr != nil {
+			return nil, serr
+		}
+	}
+	// update modified connector including desired state
+	serr = h.connectorsService.Update(r.Context(), p)
+	if serr != nil {
+		return nil, serr
+	}
+
+	newSecrets, err := getSecretRefs(p, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get existing secrets: %v", err)
+	}
+
+	staleSecrets := StringListSubtract(originalSecrets, newSecrets...)
+	if len(staleSecrets) > 0 {
+		_ = db.AddPostCommitAction(r.Context(), func() {
+			for _, s := range staleSecrets {
+				err = h.vaultService.DeleteSecretString(s)
 				if err != nil {
-					return nil, errors.BadRequest("failed to convert to merge patch: %v", err)
+					logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
 				}
-				// changed patch bytes to merge patch
-				contentType = MERGE_PATCH
 			}
+		})
+	}
 
-			// Don't allow updating connector secrets with values like {"ref": "something"}
-			serr = validateConnectorPatch(patchBytes, ct)
-			if serr != nil {
-				return nil, serr
-			}
+	if err := stripSecretReferences(p, ct); err != nil {
+		return nil, err
+	}
 
-			patch := public.ConnectorRequest{}
-			serr = PatchResource(resourceJson, contentType, patchBytes, &patch)
-			if serr != nil {
-				return nil, serr
-			}
+	return presenters.PresentConnector(p)
+}
 
-			// get and validate patch operation type
-			var operation phase.ConnectorOperation
-			if operation, serr = h.getOperation(resource, patch); err != nil {
-				return nil, serr
+// Revisions lists the revisions of a connector, latest first
+func (h ConnectorsHandler) Revisions(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			resources, paging, err := h.connectorsService.ListRevisions(r.Context(), connectorId, listArgs)
+			if err != nil {
+				return nil, err
 			}
-			if operation == phase.UnassignConnector && !h.connectorsConfig.ConnectorEnableUnassignedConnectors {
-				return nil, errors.FieldValidationError("Unsupported connector state %s", patch.DesiredState)
+
+			resourceList := public.ConnectorRevisionList{
+				Kind:  "ConnectorRevisionList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.ConnectorRevision{},
 			}
-			if serr = ValidateConnectorOperation(r.Context(), h.namespaceService, &dbresource.Connector, operation,
-				func(connector *dbapi.Connector) *errors.ServiceError {
-					resource.DesiredState = public.ConnectorDesiredState(dbresource.DesiredState)
-					return nil
-				}); serr != nil {
-				return nil, serr
+			for _, resource := range resources {
+				converted, err := presenters.PresentConnectorRevision(resource)
+				if err != nil {
+					glog.Errorf("connector id='%s' revision %d presentation failed: %v", connectorId, resource.Revision, err)
+					return nil, errors.GeneralError("internal error")
+				}
+				resourceList.Items = append(resourceList.Items, converted)
 			}
 
-			// But we don't want to allow the user to update ALL fields.. so copy
-			// over the fields that they are allowed to modify..
-			resource.Name = patch.Name
-			resource.Connector = patch.Connector
-			resource.Annotations = patch.Annotations
-			resource.Kafka = patch.Kafka
-			resource.ServiceAccount = patch.ServiceAccount
-			resource.SchemaRegistry = patch.SchemaRegistry
+			return resourceList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
 
-			if h.connectorsConfig.ConnectorEnableUnassignedConnectors {
-				// check namespace id change, from unassigned to assigned and vice versa
-				if operation == phase.AssignConnector && patch.NamespaceId != "" && resource.NamespaceId == "" {
-					resource.NamespaceId = patch.NamespaceId
-				}
-				if operation == phase.UnassignConnector && patch.NamespaceId == "" && resource.NamespaceId != "" {
-					resource.NamespaceId = patch.NamespaceId
+// Rollback sets the connector spec and desired state back to the ones of a previous revision.
+// Secrets can't be restored, since the vault entries of replaced secrets are deleted, so the current ones are kept.
+// The channel isn't restored either, as it can't be changed by a patch of the connector.
+func (h ConnectorsHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	var request public.ConnectorRollbackRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			func() *errors.ServiceError {
+				if request.Revision < 1 {
+					return errors.FieldValidationError("revision must be greater than 0")
 				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			revision, serr := h.connectorsService.GetRevision(r.Context(), connectorId, request.Revision)
+			if serr != nil {
+				return nil, serr
 			}
-
-			// If we didn't change anything, then just skip the update...
-			if reflect.DeepEqual(originalResource, resource) {
-				return originalResource, nil
+			if revision.DesiredState == dbapi.ConnectorDeleted {
+				return nil, errors.BadRequest("can't roll back connector %s to revision %d, which deleted it", connectorId, request.Revision)
 			}
 
-			// revalidate
-			user := h.authZService.GetValidationUser(r.Context())
-			validates := []handlers.Validate{
-				handlers.Validation("name", &resource.Name, handlers.MinLen(1), handlers.MaxLen(100)),
-				handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
-				handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
-				handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
-				validatePatchAnnotations(resource.Annotations, originalResource.Annotations),
-				validateConnector(h.connectorTypesService, &resource),
+			dbresource, serr := h.connectorsService.Get(r.Context(), connectorId)
+			if serr != nil {
+				return nil, serr
 			}
-
-			// Don't validate user's tenancy in admin api calls
-			if strings.Compare(r.URL.Path, fmt.Sprintf("%s/%s", "/api/connector_mgmt/v1/admin/kafka_connectors", connectorId)) != 0 {
-				validates = append(validates, handlers.Validation("namespace_id", &resource.NamespaceId, handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest)))
+			ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
+			if serr != nil {
+				return nil, errors.BadRequest("invalid connector type id: %s", dbresource.ConnectorTypeId)
 			}
 
-			for _, v := range validates {
-				err := v()
-				if err != nil {
-					return nil, err
-				}
+			// diff the connector, presented without secrets, with the revision, which has its secrets redacted the same way
+			if serr := stripSecretReferences(&dbresource.Connector, ct); serr != nil {
+				return nil, serr
 			}
-
-			p, svcErr := presenters.ConvertConnector(resource)
-			if svcErr != nil {
-				return nil, svcErr
+			current, serr := presenters.PresentConnector(&dbresource.Connector)
+			if serr != nil {
+				return nil, serr
 			}
-
-			svcErr = moveSecretsToVault(p, ct, h.vaultService, false)
-			if svcErr != nil {
-				return nil, svcErr
+			target := current
+			target.Connector = map[string]interface{}{}
+			if err := revision.ConnectorSpec.Unmarshal(&target.Connector); err != nil {
+				return nil, errors.GeneralError("invalid spec of connector %s revision %d: %v", connectorId, request.Revision, err)
 			}
+			target.DesiredState = public.ConnectorDesiredState(revision.DesiredState)
 
-			// update connector phase before desired state
-			if originalResource.Status.State != public.ConnectorState(dbapi.ConnectorStatusPhaseAssigning) {
-				dbresource.Status.Phase = phase.ConnectorStartingPhase[operation]
-				p.Status.Phase = dbresource.Status.Phase
-				serr = h.connectorsService.SaveStatus(r.Context(), dbresource.Status)
-				if serr != nil {
-					return nil, serr
-				}
+			currentJson, serr := getResourceJson(current)
+			if serr != nil {
+				return nil, serr
 			}
-			// update modified connector including desired state
-			serr = h.connectorsService.Update(r.Context(), p)
+			targetJson, serr := getResourceJson(target)
 			if serr != nil {
 				return nil, serr
 			}
-
-			newSecrets, err := getSecretRefs(p, ct)
+			patchBytes, err := jsonpatch.CreateMergePatch(currentJson, targetJson)
 			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
+				return nil, errors.GeneralError("failed to create rollback patch: %v", err)
 			}
-
-			staleSecrets := StringListSubtract(originalSecrets, newSecrets...)
-			if len(staleSecrets) > 0 {
-				_ = db.AddPostCommitAction(r.Context(), func() {
-					for _, s := range staleSecrets {
-						err = h.vaultService.DeleteSecretString(s)
-						if err != nil {
-							logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
-						}
-					}
-				})
+			if string(patchBytes) == "{}" {
+				return current, nil
 			}
 
-			if err := stripSecretReferences(p, ct); err != nil {
-				return nil, err
+			// a secret that has since been removed can't be restored, it must be set again with a patch
+			if serr := validateRollbackPatch(patchBytes, ct, request.Revision); serr != nil {
+				return nil, serr
 			}
 
-			return presenters.PresentConnector(p)
+			return h.patchConnector(r, connectorId, MERGE_PATCH, patchBytes)
 		},
 	}
 
@@ -326,6 +446,32 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
 
+func validateRollbackPatch(bytes []byte, ct *dbapi.ConnectorType, revision int64) *errors.ServiceError {
+	type Connector struct {
+		ConnectorSpec api.JSON `json:"connector,omitempty"`
+	}
+	c := Connector{}
+	if err := json.Unmarshal(bytes, &c); err != nil {
+		return errors.GeneralError("invalid rollback patch: %v", err)
+	}
+
+	if len(c.ConnectorSpec) > 0 {
+		_, err := secrets.ModifySecrets(ct.JsonSchema, c.ConnectorSpec, func(node *ajson.Node) error {
+			if node.Type() == ajson.Object {
+				return errors.BadRequest("secret %s of revision %d has been removed since and can't be restored", node.Path(), revision)
+			}
+			return nil
+		})
+		if err != nil {
+			if serr, ok := err.(*errors.ServiceError); ok {
+				return serr
+			}
+			return errors.GeneralError("invalid rollback patch: %v", err)
+		}
+	}
+	return nil
+}
+
 func (h ConnectorsHandler) getOperation(resource public.Connector, patch public.ConnectorRequest) (phase.ConnectorOperation, *errors.ServiceError) {
 	operation, ok := stateToOperationsMap[patch.DesiredState]
 	if !ok {
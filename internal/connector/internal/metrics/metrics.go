@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -10,11 +12,16 @@ const (
 
 	// label for operation name
 	labelOperation = "operation"
+	// label for connector cluster id
+	labelClusterId = "cluster_id"
 
 	VaultServiceTotalCount   = "vault_service_total_count"
 	VaultServiceSuccessCount = "vault_service_success_count"
 	VaultServiceFailureCount = "vault_service_failure_count"
 	VaultServiceErrorsCount  = "vault_service_errors_count"
+
+	ConnectorClusterHeartbeatAge      = "connector_cluster_heartbeat_age_seconds"
+	ConnectorClusterDisconnectedCount = "connector_cluster_disconnected_count"
 )
 
 var VaultServiceMetricsLabels = []string{
@@ -81,6 +88,42 @@ func IncreaseVaultServiceErrorsCount(operationType string) {
 
 // #### Metrics for Vault Service - End ####
 
+// #### Metrics for Connector Clusters ####
+
+var ConnectorClusterMetricsLabels = []string{
+	labelClusterId,
+}
+
+var connectorClusterHeartbeatAgeMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: CosFleetManager,
+		Name:      ConnectorClusterHeartbeatAge,
+		Help:      "seconds since the agent of a connector cluster last updated the cluster status",
+	}, ConnectorClusterMetricsLabels)
+
+func UpdateConnectorClusterHeartbeatAge(clusterId string, age time.Duration) {
+	labels := prometheus.Labels{
+		labelClusterId: clusterId,
+	}
+	connectorClusterHeartbeatAgeMetric.With(labels).Set(age.Seconds())
+}
+
+var connectorClusterDisconnectedCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: CosFleetManager,
+		Name:      ConnectorClusterDisconnectedCount,
+		Help:      "count of connector clusters disconnected because their agent stopped updating the cluster status",
+	}, ConnectorClusterMetricsLabels)
+
+func IncreaseConnectorClusterDisconnectedCount(clusterId string) {
+	labels := prometheus.Labels{
+		labelClusterId: clusterId,
+	}
+	connectorClusterDisconnectedCountMetric.With(labels).Inc()
+}
+
+// #### Metrics for Connector Clusters - End ####
+
 // register the metric(s)
 func init() {
 	// metrics for vault service
@@ -88,6 +131,10 @@ func init() {
 	prometheus.MustRegister(vaultServiceSuccessCountMetric)
 	prometheus.MustRegister(vaultServiceFailureCountMetric)
 	prometheus.MustRegister(vaultServiceErrorsCountMetric)
+
+	// metrics for connector clusters
+	prometheus.MustRegister(connectorClusterHeartbeatAgeMetric)
+	prometheus.MustRegister(connectorClusterDisconnectedCountMetric)
 }
 
 // ResetMetricsForVaultService will reset the metrics related to Vault Service requests
@@ -99,7 +146,20 @@ func ResetMetricsForVaultService() {
 	vaultServiceErrorsCountMetric.Reset()
 }
 
+// ResetMetricsForConnectorClusters will reset the metrics related to connector clusters
+// This is needed because if current process is not the leader anymore, the metrics need to be reset otherwise staled data will be scraped
+func ResetMetricsForConnectorClusters() {
+	connectorClusterHeartbeatAgeMetric.Reset()
+	connectorClusterDisconnectedCountMetric.Reset()
+}
+
+// ResetMetricsForConnectorClusterHeartbeats will reset the heartbeat age of connector clusters, so that deleted clusters aren't scraped
+func ResetMetricsForConnectorClusterHeartbeats() {
+	connectorClusterHeartbeatAgeMetric.Reset()
+}
+
 // Reset the metrics we have defined. It is mainly used for testing.
 func Reset() {
 	ResetMetricsForVaultService()
+	ResetMetricsForConnectorClusters()
 }
@@ -0,0 +1,34 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorRevisions(migrationID string) *gormigrate.Migration {
+
+	type ConnectorRevision struct {
+		db.Model
+		ConnectorID   string `gorm:"not null;index:idx_connector_revisions_connector_id_revision,unique"`
+		Revision      int64  `gorm:"not null;index:idx_connector_revisions_connector_id_revision,unique"`
+		ChangedBy     string
+		Channel       string
+		DesiredState  string
+		ConnectorSpec api.JSON `gorm:"type:jsonb"`
+		Diff          api.JSON `gorm:"type:jsonb"`
+	}
+
+	return db.CreateMigrationFromActions(migrationID,
+		db.CreateTableAction(&ConnectorRevision{}),
+		db.ExecAction(
+			"ALTER TABLE connector_revisions ADD CONSTRAINT fk_connectors_revisions "+
+				"FOREIGN KEY (connector_id) REFERENCES connectors(id)",
+			"ALTER TABLE connector_revisions DROP CONSTRAINT IF EXISTS fk_connectors_revisions"),
+	)
+}
@@ -0,0 +1,29 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorClusterLastHeartbeat(migrationId string) *gormigrate.Migration {
+	type ConnectorClusterStatus struct {
+		LastHeartbeat *time.Time `gorm:"index"`
+	}
+
+	type ConnectorCluster struct {
+		Status ConnectorClusterStatus `gorm:"embedded;embeddedPrefix:status_"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.AddTableColumnsAction(&ConnectorCluster{}),
+		// start counting the silence of existing clusters from now, so they aren't all disconnected at once
+		db.ExecAction(`UPDATE connector_clusters SET status_last_heartbeat = NOW() WHERE deleted_at IS NULL`, ``),
+	)
+}
@@ -50,6 +50,8 @@ var migrations = []*gormigrate.Migration{
 	renameNamespaceProfileAnnotations("202211280000"),
 	addOrgIDAnnotations("202212050000"),
 	addConnectorTypeDeprecated("202301180000"),
+	addConnectorRevisions("202305200000"),
+	addConnectorClusterLastHeartbeat("202306010000"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
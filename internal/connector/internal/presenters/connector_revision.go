@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func PresentConnectorRevision(from *dbapi.ConnectorRevision) (public.ConnectorRevision, *errors.ServiceError) {
+	spec := map[string]interface{}{}
+	if len(from.ConnectorSpec) > 0 {
+		if err := from.ConnectorSpec.Unmarshal(&spec); err != nil {
+			return public.ConnectorRevision{}, errors.GeneralError("invalid spec of connector revision: %v", err)
+		}
+	}
+
+	// the first revision of a connector has no diff
+	var diff map[string]interface{}
+	if len(from.Diff) > 0 {
+		if err := from.Diff.Unmarshal(&diff); err != nil {
+			return public.ConnectorRevision{}, errors.GeneralError("invalid diff of connector revision: %v", err)
+		}
+	}
+
+	return public.ConnectorRevision{
+		Revision:     from.Revision,
+		ConnectorId:  from.ConnectorID,
+		CreatedAt:    from.CreatedAt,
+		ChangedBy:    from.ChangedBy,
+		Channel:      public.Channel(from.Channel),
+		DesiredState: public.ConnectorDesiredState(from.DesiredState),
+		Connector:    spec,
+		Diff:         diff,
+	}, nil
+}
@@ -95,6 +95,8 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/revisions", s.ConnectorsHandler.Revisions).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/rollback", s.ConnectorsHandler.Rollback).Methods(http.MethodPost)
 	apiV1ConnectorsRouter.Use(authorizeMiddleware)
 	apiV1ConnectorsRouter.Use(requireOrgID)
 
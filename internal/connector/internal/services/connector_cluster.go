@@ -2,13 +2,17 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"gorm.io/gorm/clause"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -42,7 +46,9 @@ type ConnectorClusterService interface {
 	CleanupDeployments() *errors.ServiceError
 	ReconcileEmptyDeletingClusters(ctx context.Context, clusterIds []string) (int, []*errors.ServiceError)
 	ReconcileNonEmptyDeletingClusters(ctx context.Context, clusterIds []string) (int, []*errors.ServiceError)
+	ReconcileUnresponsiveClusters(ctx context.Context, clusterIds []string, heartbeatCutoff time.Time) (int, []*errors.ServiceError)
 	GetClusterIds(query string, args ...interface{}) ([]string, error)
+	GetClusterHeartbeats(query string, args ...interface{}) (map[string]*time.Time, error)
 	GetClusterOrg(id string) (string, *errors.ServiceError)
 	ResetServiceAccount(ctx context.Context, cluster *dbapi.ConnectorCluster) *errors.ServiceError
 }
@@ -340,6 +346,9 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 
 	// agent doesn't directly modify cluster phase, that's done in PerformClusterOperation()
 	status.Phase = resource.Status.Phase
+	// every status update is a heartbeat, which is saved even when the reported status hasn't changed
+	status.LastHeartbeat = resource.Status.LastHeartbeat
+	now := time.Now()
 
 	if updated || !reflect.DeepEqual(resource.Status, status) {
 
@@ -355,14 +364,18 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 		if err := dbConn.Updates(&dbapi.ConnectorCluster{
 			Model: db.Model{ID: id},
 			Status: dbapi.ConnectorClusterStatus{
-				Phase:      resource.Status.Phase,
-				Version:    status.Version,
-				Conditions: status.Conditions,
-				Operators:  status.Operators,
-				Platform:   status.Platform,
+				Phase:         resource.Status.Phase,
+				Version:       status.Version,
+				Conditions:    status.Conditions,
+				Operators:     status.Operators,
+				Platform:      status.Platform,
+				LastHeartbeat: &now,
 			}}).Error; err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
 		}
+	} else if err := dbConn.Model(&dbapi.ConnectorCluster{Model: db.Model{ID: id}}).
+		UpdateColumn("status_last_heartbeat", now).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status heartbeat")
 	}
 
 	return nil
@@ -655,6 +668,160 @@ func (k *connectorClusterService) ReconcileNonEmptyDeletingClusters(ctx context.
 	return count, errs
 }
 
+// ReconcileUnresponsiveClusters disconnects ready clusters whose agent hasn't updated their status since the heartbeat cutoff,
+// along with their ready namespaces, and reports the disconnection in the conditions of the connectors deployed in them
+func (k *connectorClusterService) ReconcileUnresponsiveClusters(_ context.Context, clusterIds []string, heartbeatCutoff time.Time) (int, []*errors.ServiceError) {
+	count := 0
+	var errs []*errors.ServiceError
+	for _, id := range clusterIds {
+		disconnected := false
+		if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+			var err error
+			disconnected, err = disconnectCluster(dbConn, id, heartbeatCutoff)
+			return err
+		}); err != nil {
+			errs = append(errs, services.HandleUpdateError("Connector cluster", err))
+			continue
+		}
+		if disconnected {
+			metrics.IncreaseConnectorClusterDisconnectedCount(id)
+			count++
+		}
+	}
+	return count, errs
+}
+
+// disconnectCluster returns whether the cluster has been disconnected, which it is not when its agent updated its status
+// since the cluster has been selected
+func disconnectCluster(dbConn *gorm.DB, id string, heartbeatCutoff time.Time) (bool, error) {
+	// the cluster is locked so that a concurrent status update from the agent either happens before, and the
+	// cluster is no longer disconnected, or after, and the agent reconnects the cluster
+	var cluster dbapi.ConnectorCluster
+	if err := dbConn.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "status_phase", "status_last_heartbeat").
+		Where("id = ? AND status_phase = ? AND status_last_heartbeat < ?", id, dbapi.ConnectorClusterPhaseReady, heartbeatCutoff).
+		First(&cluster).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		return false, services.HandleGetError("Connector cluster", "id", id, err)
+	}
+	if _, serr := phase.PerformClusterOperation(&cluster, phase.DisconnectCluster,
+		func(cluster *dbapi.ConnectorCluster) *errors.ServiceError {
+			if err := dbConn.Model(cluster).Update("status_phase", cluster.Status.Phase).Error; err != nil {
+				return errors.GeneralError("failed to disconnect connector cluster %s: %v", id, err)
+			}
+			return nil
+		}); serr != nil {
+		return false, serr
+	}
+	glog.Infof("Disconnected connector cluster %s, last heartbeat at %v", id, cluster.Status.LastHeartbeat)
+
+	var namespaces dbapi.ConnectorNamespaceList
+	if err := dbConn.Select("id", "status_phase").
+		Where("cluster_id = ? AND status_phase = ?", id, dbapi.ConnectorNamespacePhaseReady).
+		Find(&namespaces).Error; err != nil {
+		return false, services.HandleGetError("Connector namespace", "cluster_id", id, err)
+	}
+	var namespaceIds []string
+	for _, namespace := range namespaces {
+		if _, serr := phase.PerformNamespaceOperation(&cluster, namespace, phase.DisconnectNamespace,
+			func(namespace *dbapi.ConnectorNamespace) *errors.ServiceError {
+				if err := dbConn.Model(namespace).Update("status_phase", namespace.Status.Phase).Error; err != nil {
+					return errors.GeneralError("failed to disconnect connector namespace %s: %v", namespace.ID, err)
+				}
+				return nil
+			}); serr != nil {
+			return false, serr
+		}
+		namespaceIds = append(namespaceIds, namespace.ID)
+	}
+	if len(namespaceIds) == 0 {
+		return true, nil
+	}
+
+	lastHeartbeat := "never"
+	if cluster.Status.LastHeartbeat != nil {
+		lastHeartbeat = cluster.Status.LastHeartbeat.UTC().Format(time.RFC3339)
+	}
+	disconnected := private.MetaV1Condition{
+		Type:               "Ready",
+		Status:             "False",
+		Reason:             "ClusterDisconnected",
+		Message:            fmt.Sprintf("the connector cluster stopped updating its status, last update: %s", lastHeartbeat),
+		LastTransitionTime: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	var deployments dbapi.ConnectorDeploymentList
+	if err := dbConn.Select("id").Where("namespace_id IN ?", namespaceIds).
+		Find(&deployments).Error; err != nil {
+		return false, services.HandleGetError("Connector deployment", "namespace_id", namespaceIds, err)
+	}
+	// the phase of the connectors is left as last reported by the agent, which updates the conditions again when it reconnects
+	for _, deployment := range deployments {
+		if err := setDeploymentCondition(dbConn, deployment.ID, disconnected); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// setDeploymentCondition replaces the condition of the same type in the status of a deployment, or adds it
+func setDeploymentCondition(dbConn *gorm.DB, deploymentId string, condition private.MetaV1Condition) error {
+	var status dbapi.ConnectorDeploymentStatus
+	if err := dbConn.Select("id", "conditions").Where("id = ?", deploymentId).
+		First(&status).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			// the agent hasn't reported the status of the deployment yet
+			return nil
+		}
+		return services.HandleGetError("Connector deployment status", "id", deploymentId, err)
+	}
+
+	var conditions []private.MetaV1Condition
+	if len(status.Conditions) > 0 {
+		if err := status.Conditions.Unmarshal(&conditions); err != nil {
+			return errors.GeneralError("invalid conditions of connector deployment %s: %v", deploymentId, err)
+		}
+	}
+	replaced := false
+	for i := range conditions {
+		if conditions[i].Type == condition.Type {
+			conditions[i] = condition
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		conditions = append(conditions, condition)
+	}
+
+	bytes, err := json.Marshal(conditions)
+	if err != nil {
+		return errors.GeneralError("failed to set conditions of connector deployment %s: %v", deploymentId, err)
+	}
+	// the status version isn't changed, so the next status update from the agent replaces the conditions
+	if err := dbConn.Model(&status).UpdateColumn("conditions", api.JSON(bytes)).Error; err != nil {
+		return services.HandleUpdateError("Connector deployment status", err)
+	}
+	return nil
+}
+
+// GetClusterHeartbeats gets the last heartbeat of all clusters that match the query and args
+func (k *connectorClusterService) GetClusterHeartbeats(query string, args ...interface{}) (map[string]*time.Time, error) {
+	var clusters dbapi.ConnectorClusterList
+	if err := k.connectionFactory.New().Select("id", "status_last_heartbeat").
+		Where(query, args...).Find(&clusters).Error; err != nil {
+		return nil, services.HandleGetError("Connector cluster", query, args, err)
+	}
+	result := make(map[string]*time.Time, len(clusters))
+	for _, cluster := range clusters {
+		result[cluster.ID] = cluster.Status.LastHeartbeat
+	}
+	return result, nil
+}
+
 // GetClusterIds gets ids of all clusters that match the query and args
 func (k *connectorClusterService) GetClusterIds(query string, args ...interface{}) ([]string, error) {
 	var clusterIds []string
@@ -49,10 +49,11 @@ type ConnectorNamespaceService interface {
 var _ ConnectorNamespaceService = &connectorNamespaceService{}
 
 type connectorNamespaceService struct {
-	connectionFactory *db.ConnectionFactory
-	connectorsConfig  *config.ConnectorsConfig
-	quotaConfig       *config.ConnectorsQuotaConfig
-	bus               signalbus.SignalBus
+	connectionFactory     *db.ConnectionFactory
+	connectorsConfig      *config.ConnectorsConfig
+	quotaConfig           *config.ConnectorsQuotaConfig
+	bus                   signalbus.SignalBus
+	connectorTypesService ConnectorTypesService
 }
 
 func init() {
@@ -61,12 +62,13 @@ func init() {
 }
 
 func NewConnectorNamespaceService(factory *db.ConnectionFactory, config *config.ConnectorsConfig,
-	quotaConfig *config.ConnectorsQuotaConfig, bus signalbus.SignalBus) *connectorNamespaceService {
+	quotaConfig *config.ConnectorsQuotaConfig, bus signalbus.SignalBus, connectorTypesService ConnectorTypesService) *connectorNamespaceService {
 	return &connectorNamespaceService{
-		connectionFactory: factory,
-		connectorsConfig:  config,
-		quotaConfig:       quotaConfig,
-		bus:               bus,
+		connectionFactory:     factory,
+		connectorsConfig:      config,
+		quotaConfig:           quotaConfig,
+		bus:                   bus,
+		connectorTypesService: connectorTypesService,
 	}
 }
 
@@ -497,19 +499,24 @@ func (k *connectorNamespaceService) deleteNamespaceConnectors(ctx context.Contex
 		clusterIds[ns.ClusterId] = empty
 	}
 
-	// get all connectors that are currently not being deleted or unassigned
-	var connectorIds []string
-	if err := dbConn.Model(&dbapi.Connector{}).Select("id").
+	// get all connectors that are currently not being deleted or unassigned, with their spec, channel and desired state to
+	// record the change of their desired state as a revision
+	var connectors []dbapi.Connector
+	if err := dbConn.Select("id", "connector_spec", "channel", "desired_state").
 		Where("namespace_id IN ? AND desired_state NOT IN ?",
 			namespaceIds, []string{string(dbapi.ConnectorDeleted), string(dbapi.ConnectorUnassigned)}).
-		Find(&connectorIds).Error; err != nil {
+		Find(&connectors).Error; err != nil {
 		return count, services.HandleGetError("Connector", "namespace_id", namespaces, err)
 	}
 
 	// no connectors
-	if len(connectorIds) == 0 {
+	if len(connectors) == 0 {
 		return count, nil
 	}
+	connectorIds := make([]string, 0, len(connectors))
+	for _, connector := range connectors {
+		connectorIds = append(connectorIds, connector.ID)
+	}
 
 	// set connectors' desired state to 'deleted' by default
 	connectorDesiredState := dbapi.ConnectorDeleted
@@ -522,6 +529,11 @@ func (k *connectorNamespaceService) deleteNamespaceConnectors(ctx context.Contex
 		Updates(&dbapi.Connector{DesiredState: connectorDesiredState}).Error; err != nil {
 		return count, services.HandleUpdateError("Connector", err)
 	}
+	for i := range connectors {
+		if err := saveConnectorRevision(ctx, dbConn, k.connectorTypesService, &connectors[i], connectors[i].ID); err != nil {
+			return count, errors.ToServiceError(err)
+		}
+	}
 	if err := dbConn.Where("deleted_at IS NULL AND id IN ? AND phase NOT IN ?",
 		connectorIds, []string{string(dbapi.ConnectorStatusPhaseDeleting), string(dbapi.ConnectorStatusPhaseDeleted)}).
 		Updates(&dbapi.ConnectorStatus{Phase: dbapi.ConnectorStatusPhaseDeleting}).Error; err != nil {
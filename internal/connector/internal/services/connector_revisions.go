@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/spyzhov/ajson"
+	"gorm.io/gorm"
+)
+
+// ListRevisions lists the revisions of a connector the user can access, latest first
+func (k *connectorsService) ListRevisions(ctx context.Context, id string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError) {
+	if _, err := k.Get(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := k.connectionFactory.New().Model(&dbapi.ConnectorRevision{}).Where("connector_id = ?", id)
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return nil, nil, errors.GeneralError("unable to count revisions of connector %s: %s", id, err)
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	var resources dbapi.ConnectorRevisionList
+	if err := dbConn.Order("revision DESC").Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size).
+		Find(&resources).Error; err != nil {
+		return nil, nil, errors.GeneralError("unable to list revisions of connector %s: %s", id, err)
+	}
+
+	return resources, pagingMeta, nil
+}
+
+// GetRevision gets a revision of a connector the user can access
+func (k *connectorsService) GetRevision(ctx context.Context, id string, revision int64) (*dbapi.ConnectorRevision, *errors.ServiceError) {
+	if _, err := k.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	var resource dbapi.ConnectorRevision
+	if err := k.connectionFactory.New().Where("connector_id = ? AND revision = ?", id, revision).
+		First(&resource).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			return nil, errors.NotFound("revision %d of connector %s not found", revision, id)
+		}
+		return nil, errors.GeneralError("unable to get revision %d of connector %s: %s", revision, id, err)
+	}
+	return &resource, nil
+}
+
+// saveConnectorRevision records the spec, channel and desired state saved for a connector when any of them has changed since
+// previous, which is nil for a new connector. It must be called by every write path changing any of them
+func saveConnectorRevision(ctx context.Context, dbConn *gorm.DB, connectorTypesService ConnectorTypesService,
+	previous *dbapi.Connector, connectorID string) error {
+	// read back what has been saved, as gorm skips the zero values of the updated fields
+	var current dbapi.Connector
+	if err := dbConn.Select("connector_type_id", "connector_spec", "channel", "desired_state", "version").
+		Where("id = ?", connectorID).First(&current).Error; err != nil {
+		return services.HandleGetError("Connector", "id", connectorID, err)
+	}
+	if previous != nil && previous.Channel == current.Channel && previous.DesiredState == current.DesiredState &&
+		jsonpatch.Equal(normalizedSpec(previous.ConnectorSpec), normalizedSpec(current.ConnectorSpec)) {
+		return nil
+	}
+
+	ct, serr := connectorTypesService.Get(current.ConnectorTypeId)
+	if serr != nil {
+		return serr
+	}
+
+	revision := dbapi.ConnectorRevision{
+		Model:        db.Model{ID: api.NewID()},
+		ConnectorID:  connectorID,
+		Revision:     current.Version,
+		Channel:      current.Channel,
+		DesiredState: current.DesiredState,
+	}
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		revision.ChangedBy, _ = claims.GetUsername()
+	}
+
+	var err error
+	if revision.ConnectorSpec, err = redactSecrets(ct, normalizedSpec(current.ConnectorSpec)); err != nil {
+		return errors.GeneralError("failed to redact the secrets of connector %s: %v", connectorID, err)
+	}
+	if previous != nil {
+		// the diff is computed on the secret references, so that a changed secret is listed in it
+		diff, err := jsonpatch.CreateMergePatch(normalizedSpec(previous.ConnectorSpec), normalizedSpec(current.ConnectorSpec))
+		if err != nil {
+			return errors.GeneralError("failed to compute the spec diff of connector %s: %v", connectorID, err)
+		}
+		if revision.Diff, err = redactSecrets(ct, diff); err != nil {
+			return errors.GeneralError("failed to redact the secrets of connector %s: %v", connectorID, err)
+		}
+	}
+
+	if err := dbConn.Create(&revision).Error; err != nil {
+		return errors.GeneralError("failed to save revision of connector %s: %v", connectorID, err)
+	}
+	return nil
+}
+
+func normalizedSpec(spec api.JSON) api.JSON {
+	if len(spec) == 0 {
+		return api.JSON("{}")
+	}
+	return spec
+}
+
+// redactSecrets replaces the secrets of a spec, or of a spec diff, with empty objects, the way they are presented
+func redactSecrets(ct *dbapi.ConnectorType, spec api.JSON) (api.JSON, error) {
+	return secrets.ModifySecrets(ct.JsonSchema, spec, func(node *ajson.Node) error {
+		if node.Type() == ajson.Null {
+			// a removed secret
+			return nil
+		}
+		return node.SetObject(map[string]*ajson.Node{})
+	})
+}
@@ -37,6 +37,8 @@ type ConnectorsService interface {
 	Delete(ctx context.Context, id string) *errors.ServiceError
 	ForEach(f func(*dbapi.Connector) *errors.ServiceError, query string, args ...interface{}) []error
 	ForceDelete(ctx context.Context, id string) *errors.ServiceError
+	ListRevisions(ctx context.Context, id string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError)
+	GetRevision(ctx context.Context, id string, revision int64) (*dbapi.ConnectorRevision, *errors.ServiceError)
 
 	ResolveConnectorRefsWithBase64Secrets(resource *dbapi.Connector) (bool, *errors.ServiceError)
 }
@@ -83,6 +85,10 @@ func (k *connectorsService) Create(ctx context.Context, resource *dbapi.Connecto
 		return errors.GeneralError("failed to save status: %v", err)
 	}
 
+	if err := saveConnectorRevision(ctx, dbConn, k.connectorTypesService, nil, resource.ID); err != nil {
+		return errors.ToServiceError(err)
+	}
+
 	_ = db.AddPostCommitAction(ctx, func() {
 		// Wake up the reconcile loop...
 		k.bus.Notify("reconcile:connector")
@@ -168,6 +174,9 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	if err := dbConn.Where("id = ?", id).Delete(&dbapi.ConnectorStatus{}).Error; err != nil {
 		return services.HandleGetError("ConnectorStatus", "id", id, err)
 	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorRevision{}).Error; err != nil {
+		return errors.GeneralError("unable to delete revisions of connector %s: %s", id, err)
+	}
 
 	_ = db.AddPostCommitAction(ctx, func() {
 		// delete related distributed resources...
@@ -317,6 +326,13 @@ func (k *connectorsService) Update(ctx context.Context, resource *dbapi.Connecto
 
 	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
 
+		// read the previous spec, channel and desired state to record their changes as a revision
+		var previous dbapi.Connector
+		if err := dbConn.Select("connector_spec", "channel", "desired_state").
+			Where("id = ?", resource.ID).First(&previous).Error; err != nil {
+			return services.HandleGetError("Connector", "id", resource.ID, err)
+		}
+
 		// remove old annotations
 		if err := dbConn.Where("connector_id = ?", resource.ID).Delete(&dbapi.ConnectorAnnotation{}).Error; err != nil {
 			return services.HandleUpdateError("Connector", err)
@@ -331,7 +347,7 @@ func (k *connectorsService) Update(ctx context.Context, resource *dbapi.Connecto
 			return errors.Conflict("resource version changed")
 		}
 
-		return nil
+		return saveConnectorRevision(ctx, dbConn, k.connectorTypesService, &previous, resource.ID)
 
 	}); err != nil {
 		return errors.ToServiceError(err)
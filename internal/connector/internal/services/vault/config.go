@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
@@ -18,6 +20,17 @@ type Config struct {
 	SecretPrefix        string `json:"secret_prefix"`
 	SecretPrefixEnable  bool   `json:"secret_prefix_enable"`
 	Region              string `json:"region"`
+	// Address, AuthMethod and the fields below configure the HashiCorp Vault KV v2 secrets engine
+	Address           string `json:"address"`
+	AuthMethod        string `json:"auth_method"`
+	Token             string `json:"token"`
+	TokenFile         string `json:"token_file"`
+	AppRoleID         string `json:"app_role_id"`
+	AppRoleIDFile     string `json:"app_role_id_file"`
+	AppRoleSecret     string `json:"app_role_secret"`
+	AppRoleSecretFile string `json:"app_role_secret_file"`
+	AppRoleMountPath  string `json:"app_role_mount_path"`
+	MountPath         string `json:"mount_path"`
 }
 
 func NewConfig() *Config {
@@ -28,22 +41,53 @@ func NewConfig() *Config {
 		Region:              DefaultRegion,
 		SecretPrefixEnable:  false,
 		SecretPrefix:        "managed-connectors",
+		Address:             "http://127.0.0.1:8200",
+		AuthMethod:          AuthMethodToken,
+		TokenFile:           "secrets/vault/token",
+		AppRoleIDFile:       "secrets/vault/approle_role_id",
+		AppRoleSecretFile:   "secrets/vault/approle_secret_id",
+		AppRoleMountPath:    "approle",
+		MountPath:           "secret",
 	}
 }
 
 func (c *Config) AddFlags(fs *pflag.FlagSet) {
-	fs.StringVar(&c.Kind, "vault-kind", c.Kind, "The kind of vault to use: aws|tmp")
+	fs.StringVar(&c.Kind, "vault-kind", c.Kind, "The kind of vault to use: aws|hashicorp|tmp")
 	fs.StringVar(&c.AccessKeyFile, "vault-access-key-file", c.AccessKeyFile, "File containing vault access key")
 	fs.StringVar(&c.SecretAccessKeyFile, "vault-secret-access-key-file", c.SecretAccessKeyFile, "File containing vault secret access key")
-	fs.BoolVar(&c.SecretPrefixEnable, "vault-secret-prefix-enable", c.SecretPrefixEnable, "Enable use of a prefix for all managed connectors secret names in AWS vault, default false")
-	fs.StringVar(&c.SecretPrefix, "vault-secret-prefix", c.SecretPrefix, "Prefix to use for all managed connectors secret names in AWS vault")
+	fs.BoolVar(&c.SecretPrefixEnable, "vault-secret-prefix-enable", c.SecretPrefixEnable, "Enable use of a prefix for all managed connectors secret names in AWS or HashiCorp vault, default false")
+	fs.StringVar(&c.SecretPrefix, "vault-secret-prefix", c.SecretPrefix, "Prefix to use for all managed connectors secret names in AWS or HashiCorp vault")
 	fs.StringVar(&c.Region, "vault-region", c.Region, "The region of the vault")
+	fs.StringVar(&c.Address, "vault-address", c.Address, "The address of the HashiCorp vault")
+	fs.StringVar(&c.AuthMethod, "vault-auth-method", c.AuthMethod, "The method used to authenticate to the HashiCorp vault: token|approle")
+	fs.StringVar(&c.TokenFile, "vault-token-file", c.TokenFile, "File containing the HashiCorp vault token, used with the token auth method")
+	fs.StringVar(&c.AppRoleIDFile, "vault-approle-role-id-file", c.AppRoleIDFile, "File containing the HashiCorp vault AppRole role id, used with the approle auth method")
+	fs.StringVar(&c.AppRoleSecretFile, "vault-approle-secret-id-file", c.AppRoleSecretFile, "File containing the HashiCorp vault AppRole secret id, used with the approle auth method")
+	fs.StringVar(&c.AppRoleMountPath, "vault-approle-mount-path", c.AppRoleMountPath, "The path the AppRole auth method is mounted at in the HashiCorp vault")
+	fs.StringVar(&c.MountPath, "vault-mount-path", c.MountPath, "The path the KV v2 secrets engine storing the secrets is mounted at in the HashiCorp vault")
 }
 
 func (c *Config) Validate(env *environments.Env) error {
 	if c.Kind == KindAws && c.SecretPrefixEnable && len(c.SecretPrefix) == 0 {
 		return fmt.Errorf("error validating AWS vault config, vault-secret-prefix must be set to a non-empty value if vault-secret-prefix-enable is true")
 	}
+	if c.Kind == KindHashicorp {
+		if c.SecretPrefixEnable && len(c.SecretPrefix) == 0 {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-secret-prefix must be set to a non-empty value if vault-secret-prefix-enable is true")
+		}
+		if _, err := url.ParseRequestURI(c.Address); err != nil {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-address %q is not a valid URL: %v", c.Address, err)
+		}
+		if c.AuthMethod != AuthMethodToken && c.AuthMethod != AuthMethodAppRole {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-auth-method %q is not valid, must be one of: %s, %s", c.AuthMethod, AuthMethodToken, AuthMethodAppRole)
+		}
+		if strings.Trim(c.MountPath, "/") == "" {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-mount-path must be set to a non-empty value")
+		}
+		if c.AuthMethod == AuthMethodAppRole && strings.Trim(c.AppRoleMountPath, "/") == "" {
+			return fmt.Errorf("error validating HashiCorp vault config, vault-approle-mount-path must be set to a non-empty value")
+		}
+	}
 	return nil
 }
 
@@ -58,5 +102,17 @@ func (c *Config) ReadFiles() error {
 			return err
 		}
 	}
+	if c.Kind == KindHashicorp {
+		switch c.AuthMethod {
+		case AuthMethodToken:
+			return shared.ReadFileValueString(c.TokenFile, &c.Token)
+		case AuthMethodAppRole:
+			err := shared.ReadFileValueString(c.AppRoleIDFile, &c.AppRoleID)
+			if err != nil {
+				return err
+			}
+			return shared.ReadFileValueString(c.AppRoleSecretFile, &c.AppRoleSecret)
+		}
+	}
 	return nil
 }
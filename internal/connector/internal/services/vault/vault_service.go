@@ -2,18 +2,25 @@ package vault
 
 import (
 	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 )
 
 const (
-	KindTmp = "tmp"
-	KindAws = "aws"
+	KindTmp       = "tmp"
+	KindAws       = "aws"
+	KindHashicorp = "hashicorp"
+
+	AuthMethodToken   = "token"
+	AuthMethodAppRole = "approle"
 
 	DefaultRegion = "us-east-1"
 )
 
 type VaultService interface {
 	SetSecretString(name string, value string, owningResource string) error
+	SetSecretOwner(name string, owningResource string) error
 	GetSecretString(name string) (string, error)
 	DeleteSecretString(name string) error
 	ForEachSecret(f func(name string, owningResource string) bool) error
@@ -25,9 +32,20 @@ func NewVaultService(vaultConfig *Config) (VaultService, error) {
 	switch vaultConfig.Kind {
 	case KindAws:
 		return NewAwsVaultService(vaultConfig)
+	case KindHashicorp:
+		return NewHashicorpVaultService(vaultConfig)
 	case KindTmp:
 		return NewTmpVaultService()
 	default:
 		return nil, fmt.Errorf("invalid vault kind: %s", vaultConfig.Kind)
 	}
 }
+
+// IsNotFound returns whether the error was returned for a secret missing from the vault, whatever the kind of the vault
+func IsNotFound(err error) bool {
+	if err == NotFound {
+		return true
+	}
+	_, ok := err.(*secretsmanager.ResourceNotFoundException)
+	return ok
+}
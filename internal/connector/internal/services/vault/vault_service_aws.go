@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -99,6 +101,30 @@ func (k *awsVaultService) SetSecretString(name string, value string, owningResou
 	return nil
 }
 
+// SetSecretOwner replaces the owning resource of an existing secret, or removes it when empty
+func (k *awsVaultService) SetSecretOwner(name string, owningResource string) error {
+	name = k.getVaultSecretName(name)
+	metrics.IncreaseVaultServiceTotalCount("set")
+	var err error
+	if owningResource != "" {
+		_, err = k.secretClient.TagResource(&secretsmanager.TagResourceInput{
+			SecretId: &name,
+			Tags:     []*secretsmanager.Tag{{Key: &OwnerResourceTagKey, Value: &owningResource}},
+		})
+	} else {
+		_, err = k.secretClient.UntagResource(&secretsmanager.UntagResourceInput{
+			SecretId: &name,
+			TagKeys:  []*string{&OwnerResourceTagKey},
+		})
+	}
+	if err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
 func (k *awsVaultService) ForEachSecret(f func(name string, owningResource string) bool) error {
 	filterKey := `name`
 	var paging *secretsmanager.ListSecretsInput
@@ -117,7 +143,8 @@ func (k *awsVaultService) ForEachSecret(f func(name string, owningResource strin
 			owner := getTag(entry.Tags, OwnerResourceTagKey)
 			name := ""
 			if entry.Name != nil {
-				name = *entry.Name
+				// the prefix is removed so that the name can be used with the other methods of the service
+				name = k.getSecretNameWithoutPrefix(*entry.Name)
 			}
 			metrics.IncreaseVaultServiceSuccessCount("get")
 			if !f(name, owner) {
@@ -167,3 +194,10 @@ func (k *awsVaultService) getVaultSecretName(name string) string {
 	}
 	return name
 }
+
+func (k *awsVaultService) getSecretNameWithoutPrefix(name string) string {
+	if k.secretPrefixEnable {
+		return strings.TrimPrefix(name, k.secretPrefix)
+	}
+	return name
+}
@@ -0,0 +1,377 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
+)
+
+const (
+	hashicorpVaultRequestTimeout = 30 * time.Second
+	// hashicorpVaultTokenRenewalMargin is how long before its expiration the AppRole token is replaced by a new one
+	hashicorpVaultTokenRenewalMargin = 30 * time.Second
+	// hashicorpVaultValueKey is the key the secret is stored at in the data of the KV v2 secret
+	hashicorpVaultValueKey = "value"
+)
+
+var _ VaultService = &hashicorpVaultService{}
+
+// hashicorpVaultService stores the secrets in a HashiCorp Vault KV v2 secrets engine. The owning resource of a secret is stored
+// in the custom metadata of the secret, so that the secrets can be listed without reading their values
+type hashicorpVaultService struct {
+	client             *http.Client
+	address            string
+	mountPath          string
+	authMethod         string
+	appRoleID          string
+	appRoleSecret      string
+	appRoleMountPath   string
+	secretPrefixEnable bool
+	secretPrefix       string
+
+	mu             sync.Mutex
+	token          string
+	tokenExpiresAt time.Time
+}
+
+// hashicorpVaultError is the error returned by the vault for the requests that failed
+type hashicorpVaultError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *hashicorpVaultError) Error() string {
+	return fmt.Sprintf("vault request failed with status %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+}
+
+func NewHashicorpVaultService(vaultConfig *Config) (*hashicorpVaultService, error) {
+	address := strings.TrimSuffix(vaultConfig.Address, "/")
+	if _, err := url.ParseRequestURI(address); err != nil {
+		return nil, fmt.Errorf("invalid vault address %q: %v", vaultConfig.Address, err)
+	}
+
+	k := &hashicorpVaultService{
+		client:             &http.Client{Timeout: hashicorpVaultRequestTimeout},
+		address:            address,
+		mountPath:          strings.Trim(vaultConfig.MountPath, "/"),
+		authMethod:         vaultConfig.AuthMethod,
+		appRoleID:          vaultConfig.AppRoleID,
+		appRoleSecret:      vaultConfig.AppRoleSecret,
+		appRoleMountPath:   strings.Trim(vaultConfig.AppRoleMountPath, "/"),
+		secretPrefixEnable: vaultConfig.SecretPrefixEnable,
+		secretPrefix:       strings.Trim(vaultConfig.SecretPrefix, "/") + "/",
+	}
+
+	switch k.authMethod {
+	case AuthMethodToken:
+		if vaultConfig.Token == "" {
+			return nil, fmt.Errorf("a vault token is required with the %s auth method", AuthMethodToken)
+		}
+		k.token = vaultConfig.Token
+	case AuthMethodAppRole:
+		if k.appRoleID == "" || k.appRoleSecret == "" {
+			return nil, fmt.Errorf("a role id and a secret id are required with the %s auth method", AuthMethodAppRole)
+		}
+	default:
+		return nil, fmt.Errorf("invalid vault auth method: %s", k.authMethod)
+	}
+
+	return k, nil
+}
+
+func (k *hashicorpVaultService) Kind() string {
+	return KindHashicorp
+}
+
+func (k *hashicorpVaultService) GetSecretString(name string) (string, error) {
+	metrics.IncreaseVaultServiceTotalCount("get")
+
+	var result struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	err := k.doRequest(http.MethodGet, k.dataPath(name), nil, &result)
+	if err != nil {
+		if isHashicorpVaultNotFound(err) {
+			metrics.IncreaseVaultServiceErrorsCount("get")
+			return "", NotFound
+		}
+		metrics.IncreaseVaultServiceFailureCount("get")
+		return "", err
+	}
+
+	value, found := result.Data.Data[hashicorpVaultValueKey]
+	if !found {
+		metrics.IncreaseVaultServiceErrorsCount("get")
+		return "", NotFound
+	}
+	metrics.IncreaseVaultServiceSuccessCount("get")
+	return value, nil
+}
+
+// SetSecretString creates the secret. Like with the other kinds of vault, it fails when the secret already exists
+func (k *hashicorpVaultService) SetSecretString(name string, value string, owningResource string) error {
+	metrics.IncreaseVaultServiceTotalCount("set")
+
+	data := map[string]interface{}{
+		// a check-and-set version of 0 only allows the write when the secret does not exist yet
+		"options": map[string]interface{}{"cas": 0},
+		"data":    map[string]string{hashicorpVaultValueKey: value},
+	}
+	if err := k.doRequest(http.MethodPost, k.dataPath(name), data, nil); err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+
+	if owningResource != "" {
+		metadata := map[string]interface{}{
+			"custom_metadata": map[string]string{OwnerResourceTagKey: owningResource},
+		}
+		if err := k.doRequest(http.MethodPost, k.metadataPath(name), metadata, nil); err != nil {
+			metrics.IncreaseVaultServiceFailureCount("set")
+			// the secret did not exist before, see the check-and-set above: it is deleted so that it is not left without
+			// owner, and so that the write can be retried
+			if deleteErr := k.doRequest(http.MethodDelete, k.metadataPath(name), nil, nil); deleteErr != nil {
+				return fmt.Errorf("%v, and the secret could not be deleted: %v", err, deleteErr)
+			}
+			return err
+		}
+	}
+
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
+// SetSecretOwner replaces the owning resource of an existing secret, or removes it when empty
+func (k *hashicorpVaultService) SetSecretOwner(name string, owningResource string) error {
+	metrics.IncreaseVaultServiceTotalCount("set")
+
+	customMetadata := map[string]string{}
+	if owningResource != "" {
+		customMetadata[OwnerResourceTagKey] = owningResource
+	}
+	if err := k.doRequest(http.MethodPost, k.metadataPath(name), map[string]interface{}{"custom_metadata": customMetadata}, nil); err != nil {
+		metrics.IncreaseVaultServiceFailureCount("set")
+		return err
+	}
+
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
+// DeleteSecretString deletes all the versions of the secret together with its metadata
+func (k *hashicorpVaultService) DeleteSecretString(name string) error {
+	metrics.IncreaseVaultServiceTotalCount("delete")
+
+	// the vault does not fail the deletion of missing secrets, so their metadata is read first
+	if err := k.doRequest(http.MethodGet, k.metadataPath(name), nil, nil); err != nil {
+		if isHashicorpVaultNotFound(err) {
+			metrics.IncreaseVaultServiceErrorsCount("delete")
+			return NotFound
+		}
+		metrics.IncreaseVaultServiceFailureCount("delete")
+		return err
+	}
+
+	if err := k.doRequest(http.MethodDelete, k.metadataPath(name), nil, nil); err != nil {
+		metrics.IncreaseVaultServiceFailureCount("delete")
+		return err
+	}
+	metrics.IncreaseVaultServiceSuccessCount("delete")
+	return nil
+}
+
+// ForEachSecret calls f with the secrets stored under the secret prefix, if enabled. The names passed to f do not include the
+// prefix, so that they can be used with the other methods of the service
+func (k *hashicorpVaultService) ForEachSecret(f func(name string, owningResource string) bool) error {
+	_, err := k.forEachSecret("", f)
+	if err != nil {
+		metrics.IncreaseVaultServiceFailureCount("get")
+	}
+	return err
+}
+
+// forEachSecret walks the folder recursively. It returns false once f asked to stop
+func (k *hashicorpVaultService) forEachSecret(folder string, f func(name string, owningResource string) bool) (bool, error) {
+	var list struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	if err := k.doRequest("LIST", k.metadataPath(folder), nil, &list); err != nil {
+		if isHashicorpVaultNotFound(err) {
+			// the folder is empty
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, key := range list.Data.Keys {
+		name := folder + key
+		if strings.HasSuffix(key, "/") {
+			next, err := k.forEachSecret(name, f)
+			if err != nil || !next {
+				return next, err
+			}
+			continue
+		}
+
+		metrics.IncreaseVaultServiceTotalCount("get")
+		var metadata struct {
+			Data struct {
+				CustomMetadata map[string]string `json:"custom_metadata"`
+			} `json:"data"`
+		}
+		if err := k.doRequest(http.MethodGet, k.metadataPath(name), nil, &metadata); err != nil {
+			return false, err
+		}
+		metrics.IncreaseVaultServiceSuccessCount("get")
+		if !f(name, metadata.Data.CustomMetadata[OwnerResourceTagKey]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (k *hashicorpVaultService) dataPath(name string) string {
+	return k.mountPath + "/data/" + k.getVaultSecretName(name)
+}
+
+func (k *hashicorpVaultService) metadataPath(name string) string {
+	return k.mountPath + "/metadata/" + k.getVaultSecretName(name)
+}
+
+func (k *hashicorpVaultService) getVaultSecretName(name string) string {
+	if k.secretPrefixEnable {
+		return k.secretPrefix + name
+	}
+	return name
+}
+
+// doRequest sends the request to the vault API and decodes the response into out, when set. A request rejected by the vault
+// because of an expired AppRole token is retried once with a new token
+func (k *hashicorpVaultService) doRequest(method string, path string, body interface{}, out interface{}) error {
+	token, err := k.getToken(false)
+	if err != nil {
+		return err
+	}
+
+	err = k.send(method, path, token, body, out)
+	if vaultErr, ok := err.(*hashicorpVaultError); ok && vaultErr.StatusCode == http.StatusForbidden && k.authMethod == AuthMethodAppRole {
+		if token, err = k.getToken(true); err != nil {
+			return err
+		}
+		err = k.send(method, path, token, body, out)
+	}
+	return err
+}
+
+func (k *hashicorpVaultService) send(method string, path string, token string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequest(method, k.address+"/v1/"+escapeHashicorpVaultPath(path), reader)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		vaultErr := &hashicorpVaultError{StatusCode: resp.StatusCode}
+		// the errors are only returned as JSON by the vault itself, not by the proxies in front of it
+		_ = json.Unmarshal(content, &struct {
+			Errors *[]string `json:"errors"`
+		}{&vaultErr.Errors})
+		return vaultErr
+	}
+
+	if out != nil && len(content) > 0 {
+		if err := json.Unmarshal(content, out); err != nil {
+			return fmt.Errorf("failed to decode the vault response: %v", err)
+		}
+	}
+	return nil
+}
+
+// getToken returns the token of the requests. With the AppRole auth method, a new token is requested when the current one is
+// about to expire or when renew is set
+func (k *hashicorpVaultService) getToken(renew bool) (string, error) {
+	if k.authMethod != AuthMethodAppRole {
+		return k.token, nil
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if !renew && k.token != "" && (k.tokenExpiresAt.IsZero() || time.Now().Add(hashicorpVaultTokenRenewalMargin).Before(k.tokenExpiresAt)) {
+		return k.token, nil
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	credentials := map[string]string{
+		"role_id":   k.appRoleID,
+		"secret_id": k.appRoleSecret,
+	}
+	if err := k.send(http.MethodPost, "auth/"+k.appRoleMountPath+"/login", "", credentials, &login); err != nil {
+		return "", fmt.Errorf("failed to log in to the vault with the %s auth method: %v", AuthMethodAppRole, err)
+	}
+	if login.Auth.ClientToken == "" {
+		return "", fmt.Errorf("failed to log in to the vault with the %s auth method: no token returned", AuthMethodAppRole)
+	}
+
+	k.token = login.Auth.ClientToken
+	k.tokenExpiresAt = time.Time{}
+	if login.Auth.LeaseDuration > 0 {
+		k.tokenExpiresAt = time.Now().Add(time.Duration(login.Auth.LeaseDuration) * time.Second)
+	}
+	return k.token, nil
+}
+
+func escapeHashicorpVaultPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func isHashicorpVaultNotFound(err error) bool {
+	vaultErr, ok := err.(*hashicorpVaultError)
+	return ok && vaultErr.StatusCode == http.StatusNotFound
+}
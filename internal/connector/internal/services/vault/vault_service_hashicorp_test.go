@@ -0,0 +1,391 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+// HashicorpVaultStandIn is a minimal in-memory implementation of the HashiCorp Vault KV v2 secrets engine and of the AppRole
+// auth method, for the tests that cannot run against a dev mode vault
+type HashicorpVaultStandIn struct {
+	*httptest.Server
+	mu        sync.Mutex
+	secrets   map[string]map[string]interface{}
+	metadata  map[string]map[string]string
+	tokens    map[string]bool
+	logins    int
+	RootToken string
+	RoleID    string
+	SecretID  string
+	// failMetadataWrites fails the writes of the metadata of the secrets
+	failMetadataWrites bool
+}
+
+func NewHashicorpVaultStandIn() *HashicorpVaultStandIn {
+	v := &HashicorpVaultStandIn{
+		secrets:   map[string]map[string]interface{}{},
+		metadata:  map[string]map[string]string{},
+		tokens:    map[string]bool{"root-token": true},
+		RootToken: "root-token",
+		RoleID:    "role-id",
+		SecretID:  "secret-id",
+	}
+	v.Server = httptest.NewServer(http.HandlerFunc(v.handle))
+	return v
+}
+
+// RevokeTokens revokes all the tokens but the root token, like the expiration of their lease would
+func (v *HashicorpVaultStandIn) RevokeTokens() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.tokens = map[string]bool{v.RootToken: true}
+}
+
+// FailMetadataWrites makes the writes of the metadata of the secrets fail, like an unavailable vault would
+func (v *HashicorpVaultStandIn) FailMetadataWrites(fail bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.failMetadataWrites = fail
+}
+
+func (v *HashicorpVaultStandIn) Logins() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.logins
+}
+
+func (v *HashicorpVaultStandIn) handle(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	reply := func(status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if body != nil {
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}
+	fail := func(status int, message string) {
+		reply(status, map[string]interface{}{"errors": []string{message}})
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if path == "auth/approle/login" && r.Method == http.MethodPost {
+		var credentials map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil || credentials["role_id"] != v.RoleID || credentials["secret_id"] != v.SecretID {
+			fail(http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+		v.logins++
+		token := fmt.Sprintf("approle-token-%d", v.logins)
+		v.tokens[token] = true
+		reply(http.StatusOK, map[string]interface{}{"auth": map[string]interface{}{"client_token": token, "lease_duration": 3600}})
+		return
+	}
+
+	if !v.tokens[r.Header.Get("X-Vault-Token")] {
+		fail(http.StatusForbidden, "permission denied")
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path, "secret/data/"):
+		name := strings.TrimPrefix(path, "secret/data/")
+		switch r.Method {
+		case http.MethodGet:
+			data, found := v.secrets[name]
+			if !found {
+				reply(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			reply(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"data": data}})
+		case http.MethodPost, http.MethodPut:
+			var write struct {
+				Options map[string]interface{} `json:"options"`
+				Data    map[string]interface{} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&write); err != nil {
+				fail(http.StatusBadRequest, err.Error())
+				return
+			}
+			if _, found := v.secrets[name]; found && write.Options["cas"] == float64(0) {
+				fail(http.StatusBadRequest, "check-and-set parameter did not match the current version")
+				return
+			}
+			v.secrets[name] = write.Data
+			reply(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": 1}})
+		default:
+			fail(http.StatusMethodNotAllowed, "unsupported operation")
+		}
+	case strings.HasPrefix(path, "secret/metadata/"):
+		name := strings.TrimPrefix(path, "secret/metadata/")
+		switch {
+		case r.Method == "LIST" || (r.Method == http.MethodGet && r.URL.Query().Get("list") == "true"):
+			keys := map[string]bool{}
+			for secret := range v.secrets {
+				if rest := strings.TrimPrefix(secret, name); rest != secret || name == "" {
+					if i := strings.Index(rest, "/"); i >= 0 {
+						keys[rest[:i+1]] = true
+					} else {
+						keys[rest] = true
+					}
+				}
+			}
+			if len(keys) == 0 {
+				reply(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			list := []string{}
+			for key := range keys {
+				list = append(list, key)
+			}
+			sort.Strings(list)
+			reply(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"keys": list}})
+		case r.Method == http.MethodGet:
+			if _, found := v.secrets[name]; !found {
+				reply(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+				return
+			}
+			reply(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"custom_metadata": v.metadata[name]}})
+		case r.Method == http.MethodPost || r.Method == http.MethodPut:
+			if v.failMetadataWrites {
+				fail(http.StatusInternalServerError, "internal error")
+				return
+			}
+			var write struct {
+				CustomMetadata map[string]string `json:"custom_metadata"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&write); err != nil {
+				fail(http.StatusBadRequest, err.Error())
+				return
+			}
+			v.metadata[name] = write.CustomMetadata
+			reply(http.StatusNoContent, nil)
+		case r.Method == http.MethodDelete:
+			delete(v.secrets, name)
+			delete(v.metadata, name)
+			reply(http.StatusNoContent, nil)
+		default:
+			fail(http.StatusMethodNotAllowed, "unsupported operation")
+		}
+	default:
+		fail(http.StatusNotFound, "no handler for route")
+	}
+}
+
+func Test_hashicorpVaultService(t *testing.T) {
+	standIn := NewHashicorpVaultStandIn()
+	defer standIn.Close()
+
+	tests := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name: "token auth without prefix",
+			config: &Config{
+				Kind:       KindHashicorp,
+				Address:    standIn.URL,
+				AuthMethod: AuthMethodToken,
+				Token:      standIn.RootToken,
+				MountPath:  "secret",
+			},
+		},
+		{
+			name: "approle auth with prefix",
+			config: &Config{
+				Kind:               KindHashicorp,
+				Address:            standIn.URL + "/",
+				AuthMethod:         AuthMethodAppRole,
+				AppRoleID:          standIn.RoleID,
+				AppRoleSecret:      standIn.SecretID,
+				AppRoleMountPath:   "approle",
+				MountPath:          "/secret/",
+				SecretPrefixEnable: true,
+				SecretPrefix:       "managed-connectors",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			svc, err := NewHashicorpVaultService(tt.config)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			g.Expect(svc.SetSecretString("secret-1", "hello", "connector/1")).To(gomega.Succeed())
+			g.Expect(svc.SetSecretString("secret-2", "world", "")).To(gomega.Succeed())
+			// secrets are not overwritten
+			g.Expect(svc.SetSecretString("secret-1", "overwritten", "connector/1")).ToNot(gomega.Succeed())
+
+			value, err := svc.GetSecretString("secret-1")
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(value).To(gomega.Equal("hello"))
+
+			_, err = svc.GetSecretString("missing")
+			g.Expect(err).To(gomega.Equal(NotFound))
+
+			owners := map[string]string{}
+			g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+				owners[name] = owningResource
+				return true
+			})).To(gomega.Succeed())
+			g.Expect(owners).To(gomega.Equal(map[string]string{"secret-1": "connector/1", "secret-2": ""}))
+
+			g.Expect(svc.SetSecretOwner("secret-1", "")).To(gomega.Succeed())
+			g.Expect(svc.SetSecretOwner("secret-2", "connector/2")).To(gomega.Succeed())
+			g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+				owners[name] = owningResource
+				return true
+			})).To(gomega.Succeed())
+			g.Expect(owners).To(gomega.Equal(map[string]string{"secret-1": "", "secret-2": "connector/2"}))
+
+			visited := 0
+			g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+				visited++
+				return false
+			})).To(gomega.Succeed())
+			g.Expect(visited).To(gomega.Equal(1))
+
+			g.Expect(svc.DeleteSecretString("secret-1")).To(gomega.Succeed())
+			g.Expect(svc.DeleteSecretString("secret-2")).To(gomega.Succeed())
+			g.Expect(svc.DeleteSecretString("secret-1")).To(gomega.Equal(NotFound))
+
+			_, err = svc.GetSecretString("secret-1")
+			g.Expect(err).To(gomega.Equal(NotFound))
+		})
+	}
+}
+
+func Test_hashicorpVaultService_SetSecretStringMetadataFailure(t *testing.T) {
+	g := gomega.NewWithT(t)
+	standIn := NewHashicorpVaultStandIn()
+	defer standIn.Close()
+
+	svc, err := NewHashicorpVaultService(&Config{
+		Kind:       KindHashicorp,
+		Address:    standIn.URL,
+		AuthMethod: AuthMethodToken,
+		Token:      standIn.RootToken,
+		MountPath:  "secret",
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	standIn.FailMetadataWrites(true)
+	g.Expect(svc.SetSecretString("secret-1", "hello", "connector/1")).ToNot(gomega.Succeed())
+
+	// the secret is not left without owner
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).To(gomega.Equal(NotFound))
+
+	// and the write can be retried
+	standIn.FailMetadataWrites(false)
+	g.Expect(svc.SetSecretString("secret-1", "hello", "connector/1")).To(gomega.Succeed())
+	owners := map[string]string{}
+	g.Expect(svc.ForEachSecret(func(name string, owningResource string) bool {
+		owners[name] = owningResource
+		return true
+	})).To(gomega.Succeed())
+	g.Expect(owners).To(gomega.Equal(map[string]string{"secret-1": "connector/1"}))
+}
+
+func Test_hashicorpVaultService_AppRoleLogin(t *testing.T) {
+	g := gomega.NewWithT(t)
+	standIn := NewHashicorpVaultStandIn()
+	defer standIn.Close()
+
+	svc, err := NewHashicorpVaultService(&Config{
+		Kind:             KindHashicorp,
+		Address:          standIn.URL,
+		AuthMethod:       AuthMethodAppRole,
+		AppRoleID:        standIn.RoleID,
+		AppRoleSecret:    standIn.SecretID,
+		AppRoleMountPath: "approle",
+		MountPath:        "secret",
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	g.Expect(svc.SetSecretString("secret-1", "hello", "")).To(gomega.Succeed())
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	// the token is reused until it expires
+	g.Expect(standIn.Logins()).To(gomega.Equal(1))
+
+	standIn.RevokeTokens()
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(standIn.Logins()).To(gomega.Equal(2))
+
+	svc.appRoleSecret = "wrong-secret-id"
+	standIn.RevokeTokens()
+	_, err = svc.GetSecretString("secret-1")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func Test_NewHashicorpVaultService(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "should fail without a token with the token auth method",
+			config:  &Config{Kind: KindHashicorp, Address: "http://127.0.0.1:8200", AuthMethod: AuthMethodToken, MountPath: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "should fail without a secret id with the approle auth method",
+			config:  &Config{Kind: KindHashicorp, Address: "http://127.0.0.1:8200", AuthMethod: AuthMethodAppRole, AppRoleID: "role-id", MountPath: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "should fail with an unknown auth method",
+			config:  &Config{Kind: KindHashicorp, Address: "http://127.0.0.1:8200", AuthMethod: "kubernetes", MountPath: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "should fail with an invalid address",
+			config:  &Config{Kind: KindHashicorp, Address: "127.0.0.1", AuthMethod: AuthMethodToken, Token: "token", MountPath: "secret"},
+			wantErr: true,
+		},
+		{
+			name:   "should create the service with the approle auth method",
+			config: &Config{Kind: KindHashicorp, Address: "http://127.0.0.1:8200", AuthMethod: AuthMethodAppRole, AppRoleID: "role-id", AppRoleSecret: "secret-id", MountPath: "secret"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := NewHashicorpVaultService(tt.config)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+// HashicorpDevVaultConfig returns the config of the dev mode vault set with the VAULT_ADDR and VAULT_TOKEN environment
+// variables, e.g. one started with `vault server -dev`, or nil when they are not set
+func HashicorpDevVaultConfig() *Config {
+	address, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if address == "" || token == "" {
+		return nil
+	}
+	return &Config{
+		Kind:       KindHashicorp,
+		Address:    address,
+		AuthMethod: AuthMethodToken,
+		Token:      token,
+		MountPath:  "secret",
+	}
+}
@@ -27,6 +27,16 @@ func TestNewVaultService(t *testing.T) {
 	}
 	g.Expect(vc.ReadFiles()).To(gomega.BeNil())
 
+	standIn := vault.NewHashicorpVaultStandIn()
+	defer standIn.Close()
+
+	// Enable testing against a dev mode HashiCorp vault if it is configured..
+	devVaultConfig := vault.HashicorpDevVaultConfig()
+	skipDevVault := devVaultConfig == nil
+	if skipDevVault {
+		devVaultConfig = &vault.Config{Kind: vault.KindTmp}
+	}
+
 	tests := []struct {
 		config       *vault.Config
 		wantErrOnNew bool
@@ -61,6 +71,21 @@ func TestNewVaultService(t *testing.T) {
 			skip: vc.Kind != vault.KindAws,
 			name: vault.KindAws + "-with-prefix",
 		},
+		{
+			config: &vault.Config{
+				Kind:       vault.KindHashicorp,
+				Address:    standIn.URL,
+				AuthMethod: vault.AuthMethodToken,
+				Token:      standIn.RootToken,
+				MountPath:  "secret",
+			},
+			name: vault.KindHashicorp + "-stand-in",
+		},
+		{
+			config: devVaultConfig,
+			skip:   skipDevVault,
+			name:   vault.KindHashicorp + "-dev",
+		},
 		{
 			config:       &vault.Config{Kind: "wrong"},
 			wantErrOnNew: true,
@@ -85,6 +85,24 @@ func (k *TmpVaultService) SetSecretString(name string, value string, owningResou
 	return nil
 }
 
+func (k *TmpVaultService) SetSecretOwner(name string, owningResource string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	metrics.IncreaseVaultServiceTotalCount("set")
+
+	entry, found := k.secrets[name]
+	if !found {
+		metrics.IncreaseVaultServiceErrorsCount("set")
+		return NotFound
+	}
+	k.updateCounter += 1
+	entry.owningResource = owningResource
+	k.secrets[name] = entry
+	metrics.IncreaseVaultServiceSuccessCount("set")
+	return nil
+}
+
 func (k *TmpVaultService) GetSecretString(name string) (string, error) {
 	k.mu.Lock()
 	defer k.mu.Unlock()
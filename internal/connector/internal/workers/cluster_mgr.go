@@ -2,7 +2,11 @@ package workers
 
 import (
 	"context"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -15,9 +19,10 @@ var _ workers.Worker = &ClusterManager{}
 
 type ClusterManager struct {
 	workers.BaseWorker
-	clusterService services.ConnectorClusterService
-	db             *db.ConnectionFactory
-	ctx            context.Context
+	clusterService   services.ConnectorClusterService
+	connectorsConfig *config.ConnectorsConfig
+	db               *db.ConnectionFactory
+	ctx              context.Context
 }
 
 func (m *ClusterManager) Start() {
@@ -26,17 +31,20 @@ func (m *ClusterManager) Start() {
 
 func (m *ClusterManager) Stop() {
 	m.StopWorker(m)
+	metrics.ResetMetricsForConnectorClusters()
 }
 
-func NewClusterManager(clusterService services.ConnectorClusterService, db *db.ConnectionFactory, reconciler workers.Reconciler) *ClusterManager {
+func NewClusterManager(clusterService services.ConnectorClusterService, connectorsConfig *config.ConnectorsConfig,
+	db *db.ConnectionFactory, reconciler workers.Reconciler) *ClusterManager {
 	return &ClusterManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
 			WorkerType: "connector_cluster",
 			Reconciler: reconciler,
 		},
-		clusterService: clusterService,
-		db:             db,
+		clusterService:   clusterService,
+		connectorsConfig: connectorsConfig,
+		db:               db,
 	}
 }
 
@@ -61,16 +69,43 @@ func (m *ClusterManager) Reconcile() []error {
 		"connector_clusters.status_phase = ? AND "+
 			"connector_clusters.deleted_at IS NULL AND cluster_id IS NOT NULL", dbapi.ConnectorClusterPhaseDeleting)
 
+	// reconcile ready clusters whose agent stopped updating their status, disconnecting them
+	m.updateHeartbeatMetrics(&errs)
+	if timeout := m.connectorsConfig.ConnectorClusterHeartbeatTimeout; timeout > 0 {
+		heartbeatCutoff := time.Now().Add(-timeout)
+		m.doReconcile(&errs, "unresponsive", func(ctx context.Context, clusterIds []string) (int, []*errors.ServiceError) {
+			return m.clusterService.ReconcileUnresponsiveClusters(ctx, clusterIds, heartbeatCutoff)
+		}, "connector_clusters.status_phase = ? AND connector_clusters.deleted_at IS NULL AND "+
+			"connector_clusters.status_last_heartbeat < ?", dbapi.ConnectorClusterPhaseReady, heartbeatCutoff)
+	}
+
 	return errs
 }
 
+func (m *ClusterManager) updateHeartbeatMetrics(errs *[]error) {
+	heartbeats, err := m.clusterService.GetClusterHeartbeats("status_phase IN ?",
+		[]string{string(dbapi.ConnectorClusterPhaseReady), string(dbapi.ConnectorClusterPhaseDisconnected)})
+	if err != nil {
+		glog.Errorf("Error retrieving connector cluster heartbeats: %s", err)
+		*errs = append(*errs, err)
+		return
+	}
+	// forget deleted clusters
+	metrics.ResetMetricsForConnectorClusterHeartbeats()
+	for id, heartbeat := range heartbeats {
+		if heartbeat != nil {
+			metrics.UpdateConnectorClusterHeartbeatAge(id, time.Since(*heartbeat))
+		}
+	}
+}
+
 func (m *ClusterManager) doReconcile(errs *[]error, kind string,
 	reconcileFunc func(context.Context, []string) (int, []*errors.ServiceError),
 	query string, args ...interface{}) {
 
 	glog.V(5).Infof("Reconciling %s clusters...", kind)
 
-	clusterIds, err := m.clusterService.GetClusterIds(query, args)
+	clusterIds, err := m.clusterService.GetClusterIds(query, args...)
 	if err != nil {
 		glog.Errorf("Error retrieving %s clusters: %s", kind, err)
 		*errs = append(*errs, err)
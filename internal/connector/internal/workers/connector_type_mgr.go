@@ -2,6 +2,7 @@ package workers
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"sync"
 	"time"
@@ -22,13 +23,18 @@ import (
 
 const checkCatalogEntriesDuration = 5 * time.Second
 
-// ConnectorTypeManager represents a connector manager that reconciles connector types at startup
+// ConnectorTypeManager represents a connector manager that reconciles connector types at startup,
+// and whenever the remote connector catalogs change
 type ConnectorTypeManager struct {
 	workers.BaseWorker
 	connectorClusterService services.ConnectorClusterService
 	connectorTypesService   services.ConnectorTypesService
+	connectorsConfig        *config.ConnectorsConfig
 	startupReconcileDone    bool
 	startupReconcileWG      sync.WaitGroup
+	lastCatalogReload       time.Time
+	// catalogReconcilePending is set when a reloaded catalog could not be reconciled, to retry without waiting for a change
+	catalogReconcilePending bool
 }
 
 // NewApiServerReadyCondition is used to inject a server.ApiServerReadyCondition into the server.ApiServer
@@ -42,6 +48,7 @@ func NewConnectorTypeManager(
 	connectorTypesService services.ConnectorTypesService,
 	connectorService services.ConnectorsService,
 	connectorClusterService services.ConnectorClusterService,
+	connectorsConfig *config.ConnectorsConfig,
 	vaultService vault.VaultService,
 	db *db.ConnectionFactory,
 	reconciler workers.Reconciler,
@@ -55,7 +62,10 @@ func NewConnectorTypeManager(
 		},
 		connectorClusterService: connectorClusterService,
 		connectorTypesService:   connectorTypesService,
+		connectorsConfig:        connectorsConfig,
 		startupReconcileDone:    false,
+		// the remote catalogs have just been loaded with the configuration
+		lastCatalogReload: time.Now(),
 	}
 
 	// The release of this waiting group signal the http service to start serving request
@@ -81,9 +91,10 @@ func (k *ConnectorTypeManager) Stop() {
 	k.StopWorker(k)
 }
 
-// HasTerminated indicates whether the worker should be stopped and terminated
+// HasTerminated indicates whether the worker should be stopped and terminated,
+// it keeps running to reload the remote connector catalogs when they are configured
 func (k *ConnectorTypeManager) HasTerminated() bool {
-	return k.startupReconcileDone
+	return k.startupReconcileDone && !k.connectorsConfig.HasCatalogSources()
 }
 
 func (k *ConnectorTypeManager) Reconcile() []error {
@@ -113,8 +124,42 @@ func (k *ConnectorTypeManager) Reconcile() []error {
 
 		k.startupReconcileDone = true
 		glog.V(5).Infoln("Catalog updates processed")
+		return nil
 	}
 
+	if k.connectorsConfig.HasCatalogSources() &&
+		(k.catalogReconcilePending || time.Since(k.lastCatalogReload) >= k.connectorsConfig.ConnectorCatalogSourcesRefreshInterval) {
+		return k.reloadCatalogSources()
+	}
+
+	return nil
+}
+
+// reloadCatalogSources reloads the remote connector catalogs and, when they have changed, adds and updates their types
+// and deletes or deprecates the removed ones, while requests are being served
+func (k *ConnectorTypeManager) reloadCatalogSources() []error {
+	if !k.catalogReconcilePending {
+		k.lastCatalogReload = time.Now()
+		changed, err := k.connectorsConfig.ReloadCatalogSources()
+		if err != nil {
+			return []error{fmt.Errorf("failed to reload the connector catalog sources, the current catalog is kept: %w", err)}
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	glog.V(5).Infoln("Reconciling reloaded connector catalog updates...")
+	k.catalogReconcilePending = true
+	if err := k.connectorTypesService.DeleteOrDeprecateRemovedTypes(); err != nil {
+		return []error{err}
+	}
+	if err := k.connectorTypesService.ForEachConnectorCatalogEntry(k.ReconcileConnectorCatalogEntry); err != nil {
+		return []error{err}
+	}
+	k.catalogReconcilePending = false
+	glog.V(5).Infoln("Reloaded catalog updates processed")
+
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
@@ -188,6 +189,32 @@ func (s *extender) iDeleteOrDeprecateRemovedTypes() error {
 	return nil
 }
 
+func (s *extender) iDisconnectConnectorClusterIfItsAgentHasNotUpdatedItsStatusForSeconds(clusterIdVar string, seconds int64) error {
+	clusterId, ok := s.Variables[clusterIdVar].(string)
+	if !ok {
+		return fmt.Errorf("variable %s is not set", clusterIdVar)
+	}
+	var service services.ConnectorClusterService
+	if err := s.Suite.Helper.Env.ServiceContainer.Resolve(&service); err != nil {
+		return err
+	}
+	// only look at the scenario cluster, since features run in parallel
+	heartbeatCutoff := time.Now().Add(-time.Duration(seconds) * time.Second)
+	clusterIds, err := service.GetClusterIds("connector_clusters.id = ? AND connector_clusters.status_phase = ? AND "+
+		"connector_clusters.deleted_at IS NULL AND connector_clusters.status_last_heartbeat < ?",
+		clusterId, dbapi.ConnectorClusterPhaseReady, heartbeatCutoff)
+	if err != nil {
+		return err
+	}
+	if len(clusterIds) == 0 {
+		return fmt.Errorf("connector cluster %s is not ready or its agent has updated its status in the last %d seconds", clusterId, seconds)
+	}
+	if _, serrs := service.ReconcileUnresponsiveClusters(context.Background(), clusterIds, heartbeatCutoff); len(serrs) > 0 {
+		return fmt.Errorf("failed to disconnect connector cluster %s: %v", clusterId, serrs)
+	}
+	return nil
+}
+
 func init() {
 	// This is how we can contribute additional steps over the standard ones provided in the cucumber package.
 	cucumber.StepModules = append(cucumber.StepModules, func(ctx *godog.ScenarioContext, s *cucumber.TestScenario) {
@@ -199,6 +226,7 @@ func init() {
 		ctx.Step(`I remember keycloak client for cleanup with clientID: \${([^"]*)}$`, e.rememberKeycloakClientForCleanup)
 		ctx.Step(`I can forget keycloak clientID: \${([^"]*)}$`, e.forgetKeycloakClientForCleanup)
 		ctx.Step(`^I delete or deprecate types not in latest connector catalog$`, e.iDeleteOrDeprecateRemovedTypes)
+		ctx.Step(`^I disconnect connector cluster \${([^"]*)} if its agent has not updated its status for (\d+) seconds$`, e.iDisconnectConnectorClusterIfItsAgentHasNotUpdatedItsStatusForSeconds)
 
 		ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
 			e.deleteKeycloakClients(sc, err)
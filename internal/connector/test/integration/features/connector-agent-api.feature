@@ -2148,6 +2148,71 @@ Feature: connector agent API
     And the ".items[0].id" selection from the response should match "${connector_id}"
     And the ".items[0].namespace_id" selection from the response should match "${connector_namespace_id}"
 
+    #-----------------------------------------------------------------------------------------------------------------
+    # Shard stops updating the cluster status, the cluster and its namespace are disconnected, and the connector keeps
+    # its last reported state with a ClusterDisconnected condition, until Shard updates the status again
+    #-----------------------------------------------------------------------------------------------------------------
+    Given I sleep for 2 seconds
+    And I disconnect connector cluster ${connector_cluster_id} if its agent has not updated its status for 1 seconds
+    When I GET path "/v1/kafka_connector_clusters/${connector_cluster_id}"
+    Then the ".status.state" selection from the response should match "disconnected"
+    When I GET path "/v1/kafka_connector_namespaces/${connector_namespace_id}"
+    Then the ".status.state" selection from the response should match "disconnected"
+    When I GET path "/v1/kafka_connectors/${connector_id}"
+    Then the ".status.state" selection from the response should match "ready"
+    And I run SQL "SELECT conditions->0->>'reason' AS reason FROM connector_deployment_statuses WHERE id='${connector_deployment_id}'" gives results:
+      | reason              |
+      | ClusterDisconnected |
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/status" with json body:
+      """
+      {
+        "phase":"ready",
+        "version": "0.0.1",
+        "conditions": [{
+          "type": "Ready",
+          "status": "True",
+          "lastTransitionTime": "2018-01-01T00:00:00Z"
+        }],
+        "namespaces": [{
+          "id": "${connector_namespace_id}",
+          "phase": "ready",
+          "version": "0.0.4",
+          "connectors_deployed": 1,
+          "conditions": [{
+            "type": "Ready",
+            "status": "True",
+            "lastTransitionTime": "2018-01-01T00:00:00Z"
+          }]
+        }],
+        "operators": [{
+          "id":"camelk",
+          "version": "1.0",
+          "namespace": "openshift-mcs-camelk-1.0",
+          "status": "ready"
+        }]
+      }
+      """
+    Then the response code should be 204
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}/status" with json body:
+      """
+      {
+        "phase":"ready",
+        "resource_version": 45
+      }
+      """
+    Then the response code should be 204
+
+    Given I am logged in as "Bobby"
+    When I GET path "/v1/kafka_connector_clusters/${connector_cluster_id}"
+    Then the ".status.state" selection from the response should match "ready"
+    When I GET path "/v1/kafka_connector_namespaces/${connector_namespace_id}"
+    Then the ".status.state" selection from the response should match "ready"
+    When I GET path "/v1/kafka_connectors/${connector_id}"
+    Then the ".status.state" selection from the response should match "ready"
+
     #-----------------------------------------------------------------------------------------------------------------
     # Bobby sets desired state to stopped.. Agent sees deployment stopped, it updates status to stopped,, Bobby then see stopped status
     #-----------------------------------------------------------------------------------------------------------------
@@ -2359,6 +2359,139 @@ Feature: create a connector
     When I GET path "/v1/kafka_connectors/${connector_id}"
     Then the response code should be 410
 
+  Scenario: Gary lists the revisions of a connector and rolls it back to a previous revision
+    Given I am logged in as "Gary"
+    When I POST path "/v1/kafka_connectors?async=true" with json body:
+      """
+      {
+        "kind": "Connector",
+        "name": "example 1",
+        "connector_type_id": "aws-sqs-source-v1alpha1",
+        "kafka": {
+          "id":"mykafka",
+          "url": "kafka.hostname"
+        },
+        "service_account": {
+          "client_secret": "test",
+          "client_id": "myclient"
+        },
+        "connector": {
+            "aws_queue_name_or_arn": "test",
+            "aws_access_key": "test",
+            "aws_secret_key": "test",
+            "aws_region": "east",
+            "kafka_topic": "test"
+        }
+      }
+      """
+    Then the response code should be 202
+    Given I store the ".id" selection from the response as ${connector_id}
+
+    When I GET path "/v1/kafka_connectors/${connector_id}/revisions"
+    Then the response code should be 200
+    And the ".kind" selection from the response should match "ConnectorRevisionList"
+    And the ".total" selection from the response should match "1"
+    And the ".items[0].connector_id" selection from the response should match "${connector_id}"
+    And the ".items[0].desired_state" selection from the response should match "ready"
+    And the ".items[0].connector" selection from the response should match json:
+      """
+      {
+          "aws_queue_name_or_arn": "test",
+          "aws_access_key": {},
+          "aws_secret_key": {},
+          "aws_region": "east",
+          "kafka_topic": "test"
+      }
+      """
+    Given I store the ".items[0].revision" selection from the response as ${first_revision}
+
+    # changing the name isn't recorded, changing the spec is
+    Given I set the "Content-Type" header to "application/merge-patch+json"
+    When I PATCH path "/v1/kafka_connectors/${connector_id}" with json body:
+      """
+      {
+          "name": "my-new-name"
+      }
+      """
+    Then the response code should be 202
+    When I PATCH path "/v1/kafka_connectors/${connector_id}" with json body:
+      """
+      {
+          "connector": {
+              "aws_secret_key": "changed",
+              "kafka_topic": "changed"
+          }
+      }
+      """
+    Then the response code should be 202
+
+    When I GET path "/v1/kafka_connectors/${connector_id}/revisions"
+    Then the response code should be 200
+    And the ".total" selection from the response should match "2"
+    And the ".items[1].revision" selection from the response should match "${first_revision}"
+    And the ".items[0].diff" selection from the response should match json:
+      """
+      {
+          "aws_secret_key": {},
+          "kafka_topic": "changed"
+      }
+      """
+
+    # Evil Bob can't see the revisions of Gary's connector, nor roll it back
+    Given I am logged in as "Evil Bob"
+    When I GET path "/v1/kafka_connectors/${connector_id}/revisions"
+    Then the response code should be 404
+    When I POST path "/v1/kafka_connectors/${connector_id}/rollback" with json body:
+      """
+      { "revision": ${first_revision} }
+      """
+    Then the response code should be 404
+
+    Given I am logged in as "Gary"
+    When I POST path "/v1/kafka_connectors/${connector_id}/rollback" with json body:
+      """
+      { "revision": 0 }
+      """
+    Then the response code should be 400
+    When I POST path "/v1/kafka_connectors/${connector_id}/rollback" with json body:
+      """
+      { "revision": 999999 }
+      """
+    Then the response code should be 404
+    And the ".reason" selection from the response should match "revision 999999 of connector ${connector_id} not found"
+
+    # the spec is rolled back, but the changed secret is kept
+    When I POST path "/v1/kafka_connectors/${connector_id}/rollback" with json body:
+      """
+      { "revision": ${first_revision} }
+      """
+    Then the response code should be 202
+    And the ".name" selection from the response should match "my-new-name"
+    And the ".connector" selection from the response should match json:
+      """
+      {
+          "aws_queue_name_or_arn": "test",
+          "aws_access_key": {},
+          "aws_secret_key": {},
+          "aws_region": "east",
+          "kafka_topic": "test"
+      }
+      """
+
+    When I GET path "/v1/kafka_connectors/${connector_id}/revisions?page=1&size=1"
+    Then the response code should be 200
+    And the ".total" selection from the response should match "3"
+    And the ".size" selection from the response should match "1"
+    And the ".items[0].diff" selection from the response should match json:
+      """
+      {
+          "kafka_topic": "test"
+      }
+      """
+
+    When I DELETE path "/v1/kafka_connectors/${connector_id}"
+    Then the response code should be 204
+
   Scenario: Gary can discover the API endpoints
     Given I am logged in as "Gary"
     When I GET path ""
@@ -32,6 +32,7 @@ const (
 	KafkaInstancePartitionLimitDesc                          = "Maximum number of partitions for this Kafka"
 	KafkaInstanceConnectionLimitDesc                         = "Maximum number of connections for this Kafka"
 	KafkaInstanceConnectionCreationRateLimitDesc             = "Maximum rate of new connections for this Kafka"
+	KafkaConsumergroupLagDesc                                = "Current approximate lag of a consumer group at topic/partition"
 )
 
 type MetricsMetadata struct {
@@ -248,5 +249,12 @@ func GetMetricsMetaData() map[string]MetricsMetadata {
 			TypeName:       "GAUGE",
 			VariableLabels: []string{"instance_name", "broker_id"},
 		},
+		"kafka_consumergroup_lag": {
+			Name:           "kafka_consumergroup_lag",
+			Help:           KafkaConsumergroupLagDesc,
+			Type:           prometheus.GaugeValue,
+			TypeName:       "GAUGE",
+			VariableLabels: []string{"consumergroup", "topic", "partition"},
+		},
 	}
 }
@@ -0,0 +1,35 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AccessControlListEntry Entry of the deny list or of the access list managed through the admin API
+type AccessControlListEntry struct {
+	Id        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Href      string    `json:"href"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Values: [denied_user, accepted_organisation]
+	Type string `json:"type"`
+	// The username of the denied user or the ID of the accepted organisation
+	Value string `json:"value"`
+	// Why the entry has been added
+	Reason string `json:"reason,omitempty"`
+	// When the entry stops being enforced. Not set when the entry never expires
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// The username of the administrator who added the entry
+	CreatedBy string `json:"created_by,omitempty"`
+	// Whether the entry has expired and is no longer enforced
+	Expired bool `json:"expired"`
+}
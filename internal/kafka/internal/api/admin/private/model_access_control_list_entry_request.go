@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AccessControlListEntryRequest Entry of the deny list or of the access list to add
+type AccessControlListEntryRequest struct {
+	// The type of the entry. Values: [denied_user, accepted_organisation]
+	Type string `json:"type"`
+	// The username of the user to deny or the ID of the organisation to accept
+	Value string `json:"value"`
+	// Why the entry is added, e.g. the incident the user is denied for
+	Reason string `json:"reason,omitempty"`
+	// When the entry stops being enforced. It must be in the future. The entry never expires when it is not set
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,43 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// AuditRecord A call to an audited endpoint of the API, who made it, what it asked for on which resource and its outcome
+type AuditRecord struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	// The username of the caller
+	Actor string `json:"actor"`
+	// The organisation of the caller
+	OrgId string `json:"org_id,omitempty"`
+	// The realm roles of the caller
+	Roles []string `json:"roles,omitempty"`
+	// The action that was called, e.g. admin-delete-kafka, or the method of the request for the endpoints without action name
+	Action     string `json:"action"`
+	Method     string `json:"method"`
+	RequestUri string `json:"request_uri"`
+	RemoteAddr string `json:"remote_addr,omitempty"`
+	// The type of the resource the call was made on, e.g. kafkas
+	ResourceType string `json:"resource_type,omitempty"`
+	// The ID of the resource the call was made on. Not set for the calls made on a collection
+	ResourceId string `json:"resource_id,omitempty"`
+	// The JSON body of the request, i.e. the changes asked for by the caller. Not set when the request had no JSON body
+	RequestDiff map[string]interface{} `json:"request_diff,omitempty"`
+	// The status code of the response
+	StatusCode int32 `json:"status_code"`
+	// The ID of the operation returned to the caller, correlating the record with the logs of the call
+	OperationId string    `json:"operation_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterDrainKafka A Kafka instance still deployed on a data plane cluster along with its readiness to leave it
+type ClusterDrainKafka struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	InstanceType string `json:"instance_type"`
+	SizeId       string `json:"size_id"`
+	// The status of the migration of the Kafka instance, if any
+	MigrationStatus string `json:"migration_status,omitempty"`
+	// One of migratable, migrating, deleting or blocked
+	Readiness string `json:"readiness"`
+	// Explains the readiness of the Kafka instance. Empty when the Kafka instance is migratable
+	Reason string `json:"reason,omitempty"`
+}
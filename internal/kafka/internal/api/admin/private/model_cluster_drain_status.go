@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterDrainStatus The cordon and drain status of a data plane cluster along with the Kafka instances still deployed on it
+type ClusterDrainStatus struct {
+	Kind      string `json:"kind"`
+	ClusterId string `json:"cluster_id"`
+	// The status of the data plane cluster
+	Status string `json:"status"`
+	// true when no new Kafka instance can be placed on the data plane cluster
+	Cordoned bool `json:"cordoned"`
+	// true when the data plane cluster is removed once all its Kafka instances have left it
+	Draining bool `json:"draining"`
+	// true when no Kafka instance is deployed on the data plane cluster anymore
+	Drained     bool                          `json:"drained"`
+	KafkaCounts ClusterDrainStatusKafkaCounts `json:"kafka_counts"`
+	// The Kafka instances still deployed on the data plane cluster
+	Items []ClusterDrainKafka `json:"items"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterDrainStatusKafkaCounts The number of Kafka instances still deployed on the data plane cluster for each drain readiness
+type ClusterDrainStatusKafkaCounts struct {
+	Migratable int32 `json:"migratable"`
+	Migrating  int32 `json:"migrating"`
+	Deleting   int32 `json:"deleting"`
+	Blocked    int32 `json:"blocked"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementCandidate The evaluation of a data plane cluster as the placement of a Kafka instance
+type ClusterPlacementCandidate struct {
+	ClusterId             string `json:"cluster_id"`
+	Status                string `json:"status"`
+	ClusterType           string `json:"cluster_type,omitempty"`
+	MultiAz               bool   `json:"multi_az"`
+	SupportedInstanceType string `json:"supported_instance_type,omitempty"`
+	// The streaming units capacity of the cluster for each instance type it supports
+	StreamingUnits []ClusterPlacementCandidateStreamingUnits `json:"streaming_units"`
+	// The score given to the cluster by the placement strategy. Only set when the placement strategy scores the clusters
+	Score *float64 `json:"score,omitempty"`
+	// Whether the Kafka instance would be placed on this cluster
+	Chosen bool `json:"chosen"`
+	// Why the Kafka instance would not be placed on this cluster. Empty when the cluster is chosen
+	RejectionReason string `json:"rejection_reason,omitempty"`
+}
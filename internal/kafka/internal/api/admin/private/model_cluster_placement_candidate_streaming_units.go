@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementCandidateStreamingUnits The streaming units capacity of a data plane cluster for an instance type
+type ClusterPlacementCandidateStreamingUnits struct {
+	InstanceType   string `json:"instance_type"`
+	MaxUnits       int64  `json:"max_units"`
+	ConsumedUnits  int64  `json:"consumed_units"`
+	RemainingUnits int64  `json:"remaining_units"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementEvaluation The result of the evaluation of the placement of a Kafka instance on the data plane clusters
+type ClusterPlacementEvaluation struct {
+	Kind string `json:"kind"`
+	// The name of the placement strategy used for the instance type of the Kafka instance
+	PlacementStrategy string `json:"placement_strategy"`
+	// false when the limits of the region for the instance type of the Kafka instance have been reached. A Kafka instance cannot be created in that case, independently of the evaluated clusters
+	RegionCapacityAvailable bool `json:"region_capacity_available"`
+	// The ID of the cluster where the Kafka instance would be placed. Empty when no cluster can receive the Kafka instance
+	ClusterId string `json:"cluster_id,omitempty"`
+	// The evaluation of every data plane cluster of the cloud provider and region
+	Candidates []ClusterPlacementCandidate `json:"candidates"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterPlacementEvaluationRequest Hypothetical Kafka instance to evaluate the placement of
+type ClusterPlacementEvaluationRequest struct {
+	// The cloud provider where the Kafka instance would be created
+	CloudProvider string `json:"cloud_provider"`
+	// The region where the Kafka instance would be created
+	Region string `json:"region"`
+	// Whether the Kafka instance would be multi AZ. When not provided, the value is determined by the instance type as it is done when creating a Kafka instance
+	MultiAz *bool `json:"multi_az,omitempty"`
+	// The instance type of the Kafka instance
+	InstanceType string `json:"instance_type"`
+	// The size id of the Kafka instance within its instance type
+	SizeId string `json:"size_id"`
+}
@@ -56,4 +56,16 @@ type Kafka struct {
 	Namespace                  string                           `json:"namespace,omitempty"`
 	SizeId                     string                           `json:"size_id,omitempty"`
 	MaxDataRetentionSize       SupportedKafkaSizeBytesValueItem `json:"max_data_retention_size,omitempty"`
+	// Status of the migration of the Kafka instance to another data plane cluster. Values: [pending, provisioning, target_ready, source_deleting, failed]
+	MigrationStatus string `json:"migration_status,omitempty"`
+	// The data plane cluster at the other end of the migration. It is the target cluster until the DNS records are switched over to it, and the source cluster while the Kafka instance is deleted from it
+	MigrationClusterId string                  `json:"migration_cluster_id,omitempty"`
+	MigrationDetails   string                  `json:"migration_details,omitempty"`
+	MaintenanceWindow  *KafkaMaintenanceWindow `json:"maintenance_window,omitempty"`
+	// Whether the desired versions are rolled out regardless of the maintenance window of the Kafka instance
+	ForceUpgrade bool `json:"force_upgrade,omitempty"`
+	// The user defined key/value labels of the Kafka instance
+	Labels map[string]string `json:"labels,omitempty"`
+	// Why the Kafka instance has been suspended. Possible values: ['admin', 'user', 'idle', 'grace_period']. It is only set while the Kafka instance is suspending or suspended. The Kafka instances suspended for the 'user' and 'idle' reasons can be resumed by their owners
+	SuspensionReason string `json:"suspension_reason,omitempty"`
 }
@@ -0,0 +1,41 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaEvent An entry of the history of a Kafka instance: either a transition of its status or a condition reported for it by the data plane
+type KafkaEvent struct {
+	Id      string `json:"id"`
+	Kind    string `json:"kind"`
+	KafkaId string `json:"kafka_id"`
+	// Values: [status_change, condition]
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// The status of the Kafka instance before the transition. Only set for the 'status_change' events, except for the creation of the Kafka instance
+	OldStatus string `json:"old_status,omitempty"`
+	// The status of the Kafka instance after the transition. Only set for the 'status_change' events
+	NewStatus string `json:"new_status,omitempty"`
+	// The user who requested the status transition, or 'kas-fleet-manager' for the transitions done by the fleet manager or reported by the data plane. Only set for the 'status_change' events
+	Actor string `json:"actor,omitempty"`
+	// The data plane cluster that reported the condition. Only set for the 'condition' events
+	ClusterId string `json:"cluster_id,omitempty"`
+	// The type of the condition, e.g. Ready. Only set for the 'condition' events
+	ConditionType string `json:"condition_type,omitempty"`
+	// The status of the condition. Values: [True, False, Unknown]. Only set for the 'condition' events
+	ConditionStatus string `json:"condition_status,omitempty"`
+	// Why the status transition happened or why the condition has its status
+	Reason string `json:"reason,omitempty"`
+	// The message of the condition as reported by the data plane. Only set for the 'condition' events
+	Message string `json:"message,omitempty"`
+}
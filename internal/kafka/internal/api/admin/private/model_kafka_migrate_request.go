@@ -0,0 +1,17 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaMigrateRequest struct for KafkaMigrateRequest
+type KafkaMigrateRequest struct {
+	// The data plane cluster to migrate the Kafka instance to. If not set, the cluster is chosen by the placement strategy
+	TargetClusterId string `json:"target_cluster_id,omitempty"`
+}
@@ -0,0 +1,35 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaOwnershipTransfer Transfer of a Kafka instance to a new owner
+type KafkaOwnershipTransfer struct {
+	Id                     string    `json:"id"`
+	Kind                   string    `json:"kind"`
+	Href                   string    `json:"href"`
+	CreatedAt              time.Time `json:"created_at,omitempty"`
+	KafkaId                string    `json:"kafka_id"`
+	PreviousOwner          string    `json:"previous_owner"`
+	PreviousOrganisationId string    `json:"previous_organisation_id"`
+	// The subscription of the Kafka instance before the transfer
+	PreviousSubscriptionId string `json:"previous_subscription_id,omitempty"`
+	Owner                  string `json:"owner"`
+	OrganisationId         string `json:"organisation_id"`
+	// The subscription of the Kafka instance after the transfer. It differs from the previous one when the quota has been reserved in a new organisation
+	SubscriptionId string `json:"subscription_id,omitempty"`
+	// The username of the administrator who transferred the Kafka instance
+	Actor  string `json:"actor,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
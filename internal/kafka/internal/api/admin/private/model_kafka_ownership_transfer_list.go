@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaOwnershipTransferList struct for KafkaOwnershipTransferList
+type KafkaOwnershipTransferList struct {
+	Kind  string                   `json:"kind"`
+	Page  int32                    `json:"page"`
+	Size  int32                    `json:"size"`
+	Total int32                    `json:"total"`
+	Items []KafkaOwnershipTransfer `json:"items"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaOwnershipTransferRequest Kafka instances to transfer to a new owner. Exactly one of kafka_id and previous_owner must be set
+type KafkaOwnershipTransferRequest struct {
+	// The ID of the Kafka instance to transfer
+	KafkaId string `json:"kafka_id,omitempty"`
+	// The username of the owner whose Kafka instances are all transferred, e.g. a user leaving the organisation
+	PreviousOwner string `json:"previous_owner,omitempty"`
+	// Only transfer the Kafka instances of the previous owner in this organisation
+	PreviousOrganisationId string `json:"previous_organisation_id,omitempty"`
+	// The username of the new owner
+	Owner string `json:"owner"`
+	// The organisation of the new owner. The Kafka instances stay in their organisation when it is not set
+	OrganisationId string `json:"organisation_id,omitempty"`
+	// The account ID of the new owner. The account ID of the Kafka instances is left untouched when it is not set
+	OwnerAccountId string `json:"owner_account_id,omitempty"`
+	// Why the Kafka instances are transferred
+	Reason string `json:"reason,omitempty"`
+}
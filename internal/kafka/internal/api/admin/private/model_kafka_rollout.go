@@ -0,0 +1,47 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaRollout A rollout upgrading Kafka instances to the target versions in batches
+type KafkaRollout struct {
+	Id        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Href      string    `json:"href"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Values: [in_progress, paused, completed, failed]
+	Status string `json:"status"`
+	// The reason why the rollout was stopped
+	FailedReason           string `json:"failed_reason,omitempty"`
+	InstanceType           string `json:"instance_type,omitempty"`
+	ClusterId              string `json:"cluster_id,omitempty"`
+	StrimziVersion         string `json:"strimzi_version,omitempty"`
+	DesiredStrimziVersion  string `json:"desired_strimzi_version,omitempty"`
+	DesiredKafkaVersion    string `json:"desired_kafka_version,omitempty"`
+	DesiredKafkaIbpVersion string `json:"desired_kafka_ibp_version,omitempty"`
+	BatchSize              int32  `json:"batch_size"`
+	BatchPauseSeconds      int32  `json:"batch_pause_seconds"`
+	UpgradeTimeoutSeconds  int32  `json:"upgrade_timeout_seconds"`
+	MaxFailurePercentage   int32  `json:"max_failure_percentage"`
+	// The number of batches of the rollout
+	BatchCount int32 `json:"batch_count"`
+	// The last batch that has been started, 0 when none has been started yet
+	CurrentBatch int32 `json:"current_batch"`
+	// The time from which the next batch can be started, once the current batch has been processed
+	NextBatchAt *time.Time              `json:"next_batch_at,omitempty"`
+	KafkaCounts KafkaRolloutKafkaCounts `json:"kafka_counts"`
+	// The Kafka instances upgraded by the rollout, in batch order
+	Items []KafkaRolloutItem `json:"items"`
+}
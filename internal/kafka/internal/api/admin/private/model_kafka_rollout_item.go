@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaRolloutItem A Kafka instance upgraded by a rollout
+type KafkaRolloutItem struct {
+	KafkaId string `json:"kafka_id"`
+	Batch   int32  `json:"batch"`
+	// Values: [pending, upgrading, upgraded, failed, skipped]
+	Status string `json:"status"`
+	// The reason why the Kafka instance failed to be upgraded or was skipped
+	FailedReason string     `json:"failed_reason,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaRolloutKafkaCounts The number of Kafka instances of the rollout for each upgrade status
+type KafkaRolloutKafkaCounts struct {
+	Pending   int32 `json:"pending"`
+	Upgrading int32 `json:"upgrading"`
+	Upgraded  int32 `json:"upgraded"`
+	Failed    int32 `json:"failed"`
+	Skipped   int32 `json:"skipped"`
+}
@@ -0,0 +1,35 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// KafkaRolloutRequest Selects the Kafka instances to upgrade to the target versions and how to roll the upgrade out. Only ready Kafka instances are selected
+type KafkaRolloutRequest struct {
+	// Selects the Kafka instances of the given instance type. All instance types are selected if not set
+	InstanceType string `json:"instance_type,omitempty"`
+	// Selects the Kafka instances placed on the given data plane cluster. All clusters are selected if not set
+	ClusterId string `json:"cluster_id,omitempty"`
+	// Selects the Kafka instances whose actual strimzi version is the given one. All strimzi versions are selected if not set
+	StrimziVersion string `json:"strimzi_version,omitempty"`
+	// The strimzi version to upgrade the Kafka instances to. Left untouched if not set
+	DesiredStrimziVersion string `json:"desired_strimzi_version,omitempty"`
+	// The Kafka version to upgrade the Kafka instances to. Left untouched if not set
+	DesiredKafkaVersion string `json:"desired_kafka_version,omitempty"`
+	// The Kafka IBP version to upgrade the Kafka instances to. Left untouched if not set
+	DesiredKafkaIbpVersion string `json:"desired_kafka_ibp_version,omitempty"`
+	// The number of Kafka instances upgraded at the same time
+	BatchSize int32 `json:"batch_size"`
+	// The time to wait for once a batch has been processed before starting the next one
+	BatchPauseSeconds int32 `json:"batch_pause_seconds,omitempty"`
+	// The time after which a Kafka instance that has not reported the target versions is considered as failed. The time during which the upgrade is held back by the maintenance window of the Kafka instance does not count towards it. Defaults to 3600
+	UpgradeTimeoutSeconds int32 `json:"upgrade_timeout_seconds,omitempty"`
+	// The percentage of failed Kafka instances, out of all the Kafka instances of the rollout, above which the rollout is stopped. Defaults to 0, i.e. the rollout is stopped at the first failure
+	MaxFailurePercentage int32 `json:"max_failure_percentage,omitempty"`
+}
@@ -22,4 +22,6 @@ type KafkaUpdateRequest struct {
 	MaxDataRetentionSize string `json:"max_data_retention_size,omitempty"`
 	// boolean value indicating whether kafka should be suspended or not depending on the value provided. Suspended kafkas have their certain resources removed and become inaccessible until fully unsuspended (restored to Ready state).
 	Suspended *bool `json:"suspended,omitempty"`
+	// Whether the desired versions are rolled out straight away regardless of the maintenance window of the Kafka instance. It is reset once the Kafka instance has been upgraded to its desired versions
+	ForceUpgrade *bool `json:"force_upgrade,omitempty"`
 }
@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// QuotaGrant Quota of an instance type and billing model granted to an organisation or to an account
+type QuotaGrant struct {
+	Id        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Href      string    `json:"href"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Values: [organisation, account]
+	SubjectType string `json:"subject_type"`
+	// The ID of the organisation or the username of the account the quota is granted to
+	SubjectId      string `json:"subject_id"`
+	InstanceTypeId string `json:"instance_type_id"`
+	BillingModelId string `json:"billing_model_id"`
+	// The number of streaming units the subject can use. The default maximum allowed instances of the fleet manager apply when it is 0
+	MaxAllowedInstances int32 `json:"max_allowed_instances"`
+	// The date the quota expires at, in the YYYY-MM-DD {+,-}{HH:MM} format. Not set when the quota never expires
+	ExpirationDate string `json:"expiration_date,omitempty"`
+	// Whether the quota has expired
+	Expired bool `json:"expired"`
+}
@@ -0,0 +1,27 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// QuotaGrantRequest Quota of an instance type and billing model to grant to an organisation or to an account
+type QuotaGrantRequest struct {
+	// The type of the subject the quota is granted to. Values: [organisation, account]
+	SubjectType string `json:"subject_type"`
+	// The ID of the organisation or the username of the account the quota is granted to
+	SubjectId string `json:"subject_id"`
+	// The instance type the quota is granted for. It must be one of the supported instance types
+	InstanceTypeId string `json:"instance_type_id"`
+	// The Kafka billing model the quota is granted for. It must be one of the billing models supported by the instance type
+	BillingModelId string `json:"billing_model_id"`
+	// The number of streaming units the subject can use. The default maximum allowed instances of the fleet manager apply when it is 0 or not set
+	MaxAllowedInstances int32 `json:"max_allowed_instances,omitempty"`
+	// The date the quota expires at, in the YYYY-MM-DD {+,-}{HH:MM} format. The quota never expires when it is not set
+	ExpirationDate string `json:"expiration_date,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// QuotaGrantUpdateRequest The fields of a quota grant to update. The fields that are not set are left untouched
+type QuotaGrantUpdateRequest struct {
+	// The number of streaming units the subject can use. The default maximum allowed instances of the fleet manager apply when it is 0
+	MaxAllowedInstances *int32 `json:"max_allowed_instances,omitempty"`
+	// The date the quota expires at, in the YYYY-MM-DD {+,-}{HH:MM} format. The expiration date is removed when it is empty
+	ExpirationDate *string `json:"expiration_date,omitempty"`
+}
@@ -0,0 +1,113 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+type KafkaAlertRuleMetric string
+
+const (
+	// KafkaAlertRuleMetricDiskUsagePercentage is the percentage of the storage of the kafka that is used
+	KafkaAlertRuleMetricDiskUsagePercentage KafkaAlertRuleMetric = "disk_usage_percentage"
+	// KafkaAlertRuleMetricConsumerLag is the highest lag of the consumer groups of the kafka, in messages
+	KafkaAlertRuleMetricConsumerLag KafkaAlertRuleMetric = "consumer_lag"
+	// KafkaAlertRuleMetricPartitionCount is the number of partitions of the topics of the kafka
+	KafkaAlertRuleMetricPartitionCount KafkaAlertRuleMetric = "partition_count"
+	// KafkaAlertRuleMetricConnectionCount is the number of client connections to the kafka
+	KafkaAlertRuleMetricConnectionCount KafkaAlertRuleMetric = "connection_count"
+)
+
+func (m KafkaAlertRuleMetric) String() string {
+	return string(m)
+}
+
+// KafkaAlertRuleMetrics are all the metrics alert rules can be defined on
+var KafkaAlertRuleMetrics = []KafkaAlertRuleMetric{
+	KafkaAlertRuleMetricDiskUsagePercentage,
+	KafkaAlertRuleMetricConsumerLag,
+	KafkaAlertRuleMetricPartitionCount,
+	KafkaAlertRuleMetricConnectionCount,
+}
+
+type KafkaAlertRuleState string
+
+const (
+	// KafkaAlertRuleStateInactive is the state of the rules whose metric has not exceeded the threshold, or has not been evaluated yet
+	KafkaAlertRuleStateInactive KafkaAlertRuleState = "inactive"
+	// KafkaAlertRuleStateFiring is the state of the rules whose metric exceeds the threshold
+	KafkaAlertRuleStateFiring KafkaAlertRuleState = "firing"
+)
+
+func (s KafkaAlertRuleState) String() string {
+	return string(s)
+}
+
+// KafkaAlertNotificationTargetTypeWebhook is the type of the notification targets the alerts are posted to
+const KafkaAlertNotificationTargetTypeWebhook = "webhook"
+
+// KafkaAlertNotificationTarget is where the alerts of a rule are delivered when it starts firing and when it is resolved
+type KafkaAlertNotificationTarget struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// KafkaAlertRule is a threshold defined by the owners of a kafka on one of its metrics. The rule fires when the value of the
+// metric exceeds the threshold, and is resolved once it no longer does
+type KafkaAlertRule struct {
+	api.Meta
+	KafkaID   string
+	Name      string
+	Metric    KafkaAlertRuleMetric
+	Threshold float64
+	// NotificationTargets are the KafkaAlertNotificationTargets of the rule
+	NotificationTargets api.JSON
+	State               KafkaAlertRuleState
+	StateChangedAt      *time.Time
+	// Value is the value of the metric at the last evaluation. It is not set when the metric was not reported
+	Value       *float64
+	EvaluatedAt *time.Time
+	// NotifiedState is the last state delivered to all the notification targets. The notifications are delivered again at the
+	// next evaluation as long as it differs from the State
+	NotifiedState KafkaAlertRuleState
+	// NotificationError is the error of the last delivery of the notifications that failed
+	NotificationError string
+	// CreatedBy is the username of the user who created the rule
+	CreatedBy string
+}
+
+type KafkaAlertRuleList []*KafkaAlertRule
+
+// GetNotificationTargets returns the notification targets of the rule
+func (r *KafkaAlertRule) GetNotificationTargets() ([]KafkaAlertNotificationTarget, error) {
+	targets := []KafkaAlertNotificationTarget{}
+	if len(r.NotificationTargets) == 0 || string(r.NotificationTargets) == "null" {
+		return targets, nil
+	}
+	if err := json.Unmarshal(r.NotificationTargets, &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// SetNotificationTargets sets the notification targets of the rule
+func (r *KafkaAlertRule) SetNotificationTargets(targets []KafkaAlertNotificationTarget) error {
+	t, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+	r.NotificationTargets = t
+	return nil
+}
+
+// IsFiringFor returns whether the rule fires for the given value of its metric
+func (r *KafkaAlertRule) IsFiringFor(value float64) bool {
+	return value > r.Threshold
+}
+
+// HasPendingNotifications returns whether the last state change of the rule has not been delivered to all its notification targets yet
+func (r *KafkaAlertRule) HasPendingNotifications() bool {
+	return r.State != r.NotifiedState
+}
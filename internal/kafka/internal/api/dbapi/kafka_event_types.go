@@ -0,0 +1,60 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// KafkaConditionEvent is a condition of a kafka as reported by the data plane. A condition is only recorded when it differs from
+// the last condition of the same type recorded for the kafka
+type KafkaConditionEvent struct {
+	api.Meta
+	KafkaID    string
+	ClusterID  string
+	Type       string
+	Status     string
+	Reason     string
+	Message    string
+	ObservedAt time.Time
+}
+
+// Matches returns whether the condition reported by the data plane is the one recorded by the event
+func (e *KafkaConditionEvent) Matches(condition DataPlaneKafkaStatusCondition) bool {
+	return e.Type == condition.Type &&
+		e.Status == condition.Status &&
+		e.Reason == condition.Reason &&
+		e.Message == condition.Message
+}
+
+type KafkaEventType string
+
+const (
+	// KafkaEventTypeStatusChange is the type of the events recording a status transition of a kafka
+	KafkaEventTypeStatusChange KafkaEventType = "status_change"
+	// KafkaEventTypeCondition is the type of the events recording a condition of a kafka reported by the data plane
+	KafkaEventTypeCondition KafkaEventType = "condition"
+)
+
+func (t KafkaEventType) String() string {
+	return string(t)
+}
+
+// KafkaEvent is an entry of the history of a kafka: either one of its status transitions or one of the conditions reported
+// for it by the data plane
+type KafkaEvent struct {
+	ID              string
+	KafkaID         string
+	Type            KafkaEventType
+	OccurredAt      time.Time
+	OldStatus       string
+	NewStatus       string
+	Actor           string
+	ClusterID       string
+	ConditionType   string
+	ConditionStatus string
+	Reason          string
+	Message         string
+}
+
+type KafkaEventList []*KafkaEvent
@@ -0,0 +1,31 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// KafkaOwnershipTransfer records the transfer of a kafka to a new owner, possibly of another organisation. It is recorded in
+// the same transaction as the update of the kafka
+type KafkaOwnershipTransfer struct {
+	api.Meta
+	KafkaID                string
+	PreviousOwner          string
+	PreviousOrganisationID string
+	PreviousSubscriptionID string
+	Owner                  string
+	OrganisationID         string
+	SubscriptionID         string
+	// Actor is the username of the administrator who transferred the kafka
+	Actor  string
+	Reason string
+}
+
+type KafkaOwnershipTransferList []*KafkaOwnershipTransfer
+
+// KafkaOwner is the owner a kafka is transferred to
+type KafkaOwner struct {
+	Owner          string
+	OrganisationID string
+	// OwnerAccountID is the account id of the new owner. The account id of the kafka is left untouched when it is not set
+	OwnerAccountID string
+}
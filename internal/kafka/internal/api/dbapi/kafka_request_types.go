@@ -67,6 +67,100 @@ type KafkaRequest struct {
 	// ExpiresAt contains the timestamp of when a Kafka instance is scheduled to expire.
 	// On expiration, the Kafka instance will be marked for deletion, its status will be set to 'deprovision'.
 	ExpiresAt sql.NullTime `json:"expires_at"`
+	// MigrationStatus is the status of the migration of the kafka instance to another data plane cluster
+	MigrationStatus KafkaMigrationStatus `json:"migration_status" gorm:"index"`
+	// MigrationClusterID is the data plane cluster at the other end of the migration.
+	// It is the target cluster until the DNS records are switched over to it and the source cluster afterwards,
+	// while the kafka is being deleted from it.
+	MigrationClusterID string `json:"migration_cluster_id" gorm:"index"`
+	// MigrationPlacementId is the placement id of the kafka instance on the MigrationClusterID
+	MigrationPlacementId string `json:"migration_placement_id"`
+	// MigrationRoutes routes mapping of the kafka instance on the target cluster of the migration
+	MigrationRoutes  api.JSON `json:"migration_routes"`
+	MigrationDetails string   `json:"migration_details"`
+	// MaintenanceWindow is the weekly window in which the desired versions of the kafka instance are rolled out to the data plane.
+	// Upgrades are rolled out straight away when it is not set
+	MaintenanceWindow api.JSON `json:"maintenance_window"`
+	// ForceUpgrade rolls out the desired versions regardless of the maintenance window.
+	// It is reset once the kafka instance has been upgraded to its desired versions
+	ForceUpgrade bool `json:"force_upgrade"`
+	// Labels are the user defined key/value pairs attached to the kafka instance
+	Labels []KafkaLabel `json:"labels" gorm:"foreignKey:KafkaID;references:ID"`
+	// SuspensionReason is why the kafka instance has been suspended. It is only set while the kafka is suspending or suspended
+	SuspensionReason KafkaSuspensionReason `json:"suspension_reason"`
+	// ResumedAt is the timestamp of the last resumption of the kafka instance. Together with the creation timestamp
+	// it tells for how long the kafka has been running, e.g. to know whether it has been idle for long enough to be suspended
+	ResumedAt sql.NullTime `json:"resumed_at"`
+}
+
+// KafkaLabel is a user defined key/value pair attached to a kafka instance.
+// The labels are stored in their own table so that the kafkas can be searched by label
+type KafkaLabel struct {
+	KafkaID string `gorm:"primaryKey;index"`
+	Key     string `gorm:"primaryKey;not null"`
+	Value   string `gorm:"not null"`
+}
+
+// KafkaMaintenanceWindow is a weekly window in which the upgrades of a kafka instance are rolled out
+type KafkaMaintenanceWindow struct {
+	// DayOfWeek is the lower case english name of the day the window starts on e.g. "sunday"
+	DayOfWeek string `json:"day_of_week"`
+	// StartHour is the hour of the day the window starts at, from 0 to 23
+	StartHour int `json:"start_hour"`
+	// DurationHours is the duration of the window in hours, from 1 to 24
+	DurationHours int `json:"duration_hours"`
+	// Timezone is the IANA time zone name in which the window is defined e.g. "Europe/Dublin"
+	Timezone string `json:"timezone"`
+}
+
+const (
+	maxMaintenanceWindowDurationHours = 24
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Validate returns an error describing the first invalid field of the maintenance window, if any
+func (w KafkaMaintenanceWindow) Validate() error {
+	if _, ok := weekdays[w.DayOfWeek]; !ok {
+		return fmt.Errorf("day_of_week %q is not valid, it must be the lower case name of a day of the week e.g. \"sunday\"", w.DayOfWeek)
+	}
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return fmt.Errorf("start_hour %d is not valid, it must be between 0 and 23", w.StartHour)
+	}
+	if w.DurationHours < 1 || w.DurationHours > maxMaintenanceWindowDurationHours {
+		return fmt.Errorf("duration_hours %d is not valid, it must be between 1 and %d", w.DurationHours, maxMaintenanceWindowDurationHours)
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil || w.Timezone == "" {
+		return fmt.Errorf("timezone %q is not a valid IANA time zone name", w.Timezone)
+	}
+	return nil
+}
+
+// IsOpenAt returns whether the given time falls within the maintenance window
+func (w KafkaMaintenanceWindow) IsOpenAt(t time.Time) (bool, error) {
+	if err := w.Validate(); err != nil {
+		return false, err
+	}
+
+	location, _ := time.LoadLocation(w.Timezone)
+	localTime := t.In(location)
+
+	// find the start of the latest window starting before the given time
+	daysSinceWindowDay := (int(localTime.Weekday()) - int(weekdays[w.DayOfWeek]) + 7) % 7
+	start := time.Date(localTime.Year(), localTime.Month(), localTime.Day()-daysSinceWindowDay, w.StartHour, 0, 0, 0, location)
+	if start.After(localTime) {
+		start = start.AddDate(0, 0, -7)
+	}
+
+	return localTime.Before(start.Add(time.Duration(w.DurationHours) * time.Hour)), nil
 }
 
 type KafkaPromotionStatus string
@@ -97,6 +191,86 @@ func ParseKafkaPromotionStatus(status string) (KafkaPromotionStatus, error) {
 	return parsedStatus, nil
 }
 
+type KafkaSuspensionReason string
+
+const (
+	KafkaSuspensionReasonNone KafkaSuspensionReason = ""
+	// KafkaSuspensionReasonAdmin is the reason of the suspensions requested through the admin API
+	KafkaSuspensionReasonAdmin KafkaSuspensionReason = "admin"
+	// KafkaSuspensionReasonUser is the reason of the suspensions requested by the owner of the kafka through the public API
+	KafkaSuspensionReasonUser KafkaSuspensionReason = "user"
+	// KafkaSuspensionReasonIdle is the reason of the suspensions of the kafkas that had no traffic during the idle suspension period
+	KafkaSuspensionReasonIdle KafkaSuspensionReason = "idle"
+	// KafkaSuspensionReasonGracePeriod is the reason of the suspensions of the kafkas that entered the grace period of their expiration
+	KafkaSuspensionReasonGracePeriod KafkaSuspensionReason = "grace_period"
+)
+
+func (r KafkaSuspensionReason) String() string {
+	return string(r)
+}
+
+// UserResumable returns whether a kafka suspended for this reason can be resumed by its owner
+func (r KafkaSuspensionReason) UserResumable() bool {
+	return r == KafkaSuspensionReasonUser || r == KafkaSuspensionReasonIdle
+}
+
+type KafkaMigrationStatus string
+
+const (
+	// KafkaMigrationStatusPending is the status of a migration that has been requested but for which no capacity has been reserved yet.
+	// The MigrationClusterID is the requested target cluster, if any
+	KafkaMigrationStatusPending KafkaMigrationStatus = "pending"
+	// KafkaMigrationStatusProvisioning is the status of a migration whose kafka is being provisioned on the target cluster
+	KafkaMigrationStatusProvisioning KafkaMigrationStatus = "provisioning"
+	// KafkaMigrationStatusTargetReady is the status of a migration whose kafka is ready on the target cluster.
+	// The DNS records still point to the source cluster
+	KafkaMigrationStatusTargetReady KafkaMigrationStatus = "target_ready"
+	// KafkaMigrationStatusSourceDeleting is the status of a migration whose DNS records point to the target cluster
+	// and whose kafka is being deleted from the source cluster
+	KafkaMigrationStatusSourceDeleting KafkaMigrationStatus = "source_deleting"
+	// KafkaMigrationStatusFailed is the status of a failed migration. If the kafka was provisioned on the target cluster
+	// it is deleted from it, after which the MigrationClusterID is cleared
+	KafkaMigrationStatusFailed      KafkaMigrationStatus = "failed"
+	KafkaMigrationStatusNoMigration KafkaMigrationStatus = ""
+)
+
+// KafkaMigrationStatusesWithTargetCapacity are the migration statuses in which the kafka is provisioned,
+// and consumes capacity, on the MigrationClusterID as well as on the ClusterID
+var KafkaMigrationStatusesWithTargetCapacity = []KafkaMigrationStatus{
+	KafkaMigrationStatusProvisioning,
+	KafkaMigrationStatusTargetReady,
+	KafkaMigrationStatusSourceDeleting,
+	KafkaMigrationStatusFailed,
+}
+
+func (s KafkaMigrationStatus) String() string {
+	return string(s)
+}
+
+// InProgress returns whether a migration of the kafka is being carried out
+func (s KafkaMigrationStatus) InProgress() bool {
+	return s != KafkaMigrationStatusNoMigration && s != KafkaMigrationStatusFailed
+}
+
+func ParseKafkaMigrationStatus(status string) (KafkaMigrationStatus, error) {
+	validMigrationStatuses := map[KafkaMigrationStatus]struct{}{
+		KafkaMigrationStatusPending:        {},
+		KafkaMigrationStatusProvisioning:   {},
+		KafkaMigrationStatusTargetReady:    {},
+		KafkaMigrationStatusSourceDeleting: {},
+		KafkaMigrationStatusFailed:         {},
+		KafkaMigrationStatusNoMigration:    {},
+	}
+
+	parsedStatus := KafkaMigrationStatus(status)
+	_, ok := validMigrationStatuses[parsedStatus]
+	if !ok {
+		return parsedStatus, fmt.Errorf("cannot parse %q as KafkaMigrationStatus: invalid status", parsedStatus)
+	}
+
+	return parsedStatus, nil
+}
+
 type KafkaList []*KafkaRequest
 type KafkaIndex map[string]*KafkaRequest
 
@@ -138,6 +312,84 @@ func (k *KafkaRequest) SetRoutes(routes []DataPlaneKafkaRoute) error {
 	}
 }
 
+// GetMigrationRoutes returns the routes of the kafka instance on the target cluster of its migration
+func (k *KafkaRequest) GetMigrationRoutes() ([]DataPlaneKafkaRoute, error) {
+	var routes []DataPlaneKafkaRoute
+	if k.MigrationRoutes == nil {
+		return routes, nil
+	}
+	if err := json.Unmarshal(k.MigrationRoutes, &routes); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+func (k *KafkaRequest) SetMigrationRoutes(routes []DataPlaneKafkaRoute) error {
+	r, err := json.Marshal(routes)
+	if err != nil {
+		return err
+	}
+	k.MigrationRoutes = r
+	return nil
+}
+
+// GetMaintenanceWindow returns the maintenance window of the kafka instance or nil if it does not have one
+func (k *KafkaRequest) GetMaintenanceWindow() (*KafkaMaintenanceWindow, error) {
+	if len(k.MaintenanceWindow) == 0 || string(k.MaintenanceWindow) == "null" {
+		return nil, nil
+	}
+	var window KafkaMaintenanceWindow
+	if err := json.Unmarshal(k.MaintenanceWindow, &window); err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// SetMaintenanceWindow sets the maintenance window of the kafka instance. A nil window removes it
+func (k *KafkaRequest) SetMaintenanceWindow(window *KafkaMaintenanceWindow) error {
+	if window == nil {
+		k.MaintenanceWindow = nil
+		return nil
+	}
+	w, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+	k.MaintenanceWindow = w
+	return nil
+}
+
+// CanBeUpgradedAt returns whether the desired versions of the kafka instance can be rolled out to the data plane at the given time.
+// This is the case when an upgrade is forced, the kafka has no maintenance window or its maintenance window is open.
+// A maintenance window which cannot be read does not hold back upgrades
+func (k *KafkaRequest) CanBeUpgradedAt(t time.Time) bool {
+	if k.ForceUpgrade {
+		return true
+	}
+
+	window, err := k.GetMaintenanceWindow()
+	if err != nil || window == nil {
+		return true
+	}
+
+	open, err := window.IsOpenAt(t)
+	return err != nil || open
+}
+
+// HasPendingUpgrade returns whether any of the actual versions of the kafka instance differs from its desired version
+func (k *KafkaRequest) HasPendingUpgrade() bool {
+	return k.DesiredKafkaVersion != k.ActualKafkaVersion ||
+		k.DesiredStrimziVersion != k.ActualStrimziVersion ||
+		k.DesiredKafkaIBPVersion != k.ActualKafkaIBPVersion ||
+		k.KafkaUpgrading || k.StrimziUpgrading || k.KafkaIBPUpgrading
+}
+
+// IsMigrationPeer returns whether the given data plane cluster is the other end of an ongoing migration of the kafka instance,
+// i.e. the kafka instance is provisioned on the cluster in addition to its ClusterID
+func (k *KafkaRequest) IsMigrationPeer(clusterID string) bool {
+	return clusterID != "" && k.MigrationClusterID == clusterID && arrays.Contains(KafkaMigrationStatusesWithTargetCapacity, k.MigrationStatus)
+}
+
 // GetExpirationTime returns when the Kafka request will expire based on the
 // provided lifespanSeconds value. lifespanSeconds is assumed to be greater
 // than 0
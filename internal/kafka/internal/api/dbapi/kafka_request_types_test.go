@@ -2,6 +2,7 @@ package dbapi
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/onsi/gomega"
@@ -44,3 +45,164 @@ func TestKafkaRequest_DesiredBillingModelIsEnterprise(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaMaintenanceWindow_IsOpenAt(t *testing.T) {
+	// 2023-03-05 is a sunday
+	window := KafkaMaintenanceWindow{DayOfWeek: "sunday", StartHour: 22, DurationHours: 4, Timezone: "Europe/Dublin"}
+	tests := []struct {
+		name    string
+		window  KafkaMaintenanceWindow
+		time    time.Time
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "should be open at the start of the window",
+			window: window,
+			time:   time.Date(2023, 3, 5, 22, 0, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "should be open when the window spans into the next day",
+			window: window,
+			time:   time.Date(2023, 3, 6, 1, 59, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "should be closed at the end of the window",
+			window: window,
+			time:   time.Date(2023, 3, 6, 2, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "should be closed before the start of the window",
+			window: window,
+			time:   time.Date(2023, 3, 5, 21, 59, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "should be closed on another day of the week",
+			window: window,
+			time:   time.Date(2023, 3, 8, 23, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "should evaluate the window in its time zone",
+			window: KafkaMaintenanceWindow{DayOfWeek: "monday", StartHour: 9, DurationHours: 1, Timezone: "America/New_York"},
+			time:   time.Date(2023, 3, 6, 14, 30, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:    "should return an error when the window is not valid",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "someday", StartHour: 9, DurationHours: 1, Timezone: "UTC"},
+			time:    time.Date(2023, 3, 6, 14, 30, 0, 0, time.UTC),
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			got, err := testcase.window.IsOpenAt(testcase.time)
+			g.Expect(err != nil).To(gomega.Equal(testcase.wantErr))
+			g.Expect(got).To(gomega.Equal(testcase.want))
+		})
+	}
+}
+
+func TestKafkaMaintenanceWindow_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  KafkaMaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:    "should accept a valid window",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 23, DurationHours: 24, Timezone: "UTC"},
+			wantErr: false,
+		},
+		{
+			name:    "should reject a capitalised day of the week",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "Saturday", StartHour: 0, DurationHours: 1, Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a start hour out of range",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 24, DurationHours: 1, Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an empty duration",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 0, DurationHours: 0, Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject a duration longer than a day",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 0, DurationHours: 25, Timezone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an unknown time zone",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 0, DurationHours: 1, Timezone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an empty time zone",
+			window:  KafkaMaintenanceWindow{DayOfWeek: "saturday", StartHour: 0, DurationHours: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			g.Expect(testcase.window.Validate() != nil).To(gomega.Equal(testcase.wantErr))
+		})
+	}
+}
+
+func TestKafkaRequest_CanBeUpgradedAt(t *testing.T) {
+	now := time.Date(2023, 3, 8, 12, 0, 0, 0, time.UTC)
+	closedWindow := &KafkaMaintenanceWindow{DayOfWeek: "sunday", StartHour: 0, DurationHours: 4, Timezone: "UTC"}
+	openWindow := &KafkaMaintenanceWindow{DayOfWeek: "wednesday", StartHour: 10, DurationHours: 4, Timezone: "UTC"}
+	tests := []struct {
+		name         string
+		window       *KafkaMaintenanceWindow
+		forceUpgrade bool
+		want         bool
+	}{
+		{
+			name: "return true when the kafka has no maintenance window",
+			want: true,
+		},
+		{
+			name:   "return true when the maintenance window is open",
+			window: openWindow,
+			want:   true,
+		},
+		{
+			name:   "return false when the maintenance window is closed",
+			window: closedWindow,
+			want:   false,
+		},
+		{
+			name:         "return true when the upgrade is forced outside of the maintenance window",
+			window:       closedWindow,
+			forceUpgrade: true,
+			want:         true,
+		},
+	}
+	for _, tt := range tests {
+		testcase := tt
+		t.Run(testcase.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			k := &KafkaRequest{ForceUpgrade: testcase.forceUpgrade}
+			g.Expect(k.SetMaintenanceWindow(testcase.window)).To(gomega.Succeed())
+			g.Expect(k.CanBeUpgradedAt(now)).To(gomega.Equal(testcase.want))
+		})
+	}
+}
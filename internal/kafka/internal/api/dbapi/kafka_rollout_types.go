@@ -0,0 +1,153 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+type KafkaRolloutStatus string
+
+const (
+	// KafkaRolloutStatusInProgress is the status of a rollout whose batches are being upgraded one after the other
+	KafkaRolloutStatusInProgress KafkaRolloutStatus = "in_progress"
+	// KafkaRolloutStatusPaused is the status of a rollout for which no new batch is started.
+	// The kafkas of the current batch keep being upgraded by the data plane
+	KafkaRolloutStatusPaused KafkaRolloutStatus = "paused"
+	// KafkaRolloutStatusCompleted is the status of a rollout whose kafkas have all been processed
+	KafkaRolloutStatusCompleted KafkaRolloutStatus = "completed"
+	// KafkaRolloutStatusFailed is the status of a rollout that was stopped because too many of its kafkas failed to be upgraded
+	KafkaRolloutStatusFailed KafkaRolloutStatus = "failed"
+)
+
+func (s KafkaRolloutStatus) String() string {
+	return string(s)
+}
+
+// IsActive returns whether the rollout has not finished yet
+func (s KafkaRolloutStatus) IsActive() bool {
+	return s == KafkaRolloutStatusInProgress || s == KafkaRolloutStatusPaused
+}
+
+// KafkaRolloutStatusesActive are the statuses of the rollouts that have not finished yet
+var KafkaRolloutStatusesActive = []KafkaRolloutStatus{
+	KafkaRolloutStatusInProgress,
+	KafkaRolloutStatusPaused,
+}
+
+type KafkaRolloutItemStatus string
+
+const (
+	// KafkaRolloutItemStatusPending is the status of a kafka whose batch has not been started yet
+	KafkaRolloutItemStatusPending KafkaRolloutItemStatus = "pending"
+	// KafkaRolloutItemStatusUpgrading is the status of a kafka whose desired versions have been set to the target versions of the rollout
+	KafkaRolloutItemStatusUpgrading KafkaRolloutItemStatus = "upgrading"
+	// KafkaRolloutItemStatusUpgraded is the status of a kafka that is ready and runs the target versions of the rollout
+	KafkaRolloutItemStatusUpgraded KafkaRolloutItemStatus = "upgraded"
+	// KafkaRolloutItemStatusFailed is the status of a kafka that could not be upgraded. It counts towards the failure percentage of the rollout
+	KafkaRolloutItemStatusFailed KafkaRolloutItemStatus = "failed"
+	// KafkaRolloutItemStatusSkipped is the status of a kafka that was not upgraded because it was no longer ready when its batch started,
+	// or because it was deleted while being upgraded
+	KafkaRolloutItemStatusSkipped KafkaRolloutItemStatus = "skipped"
+)
+
+func (s KafkaRolloutItemStatus) String() string {
+	return string(s)
+}
+
+// KafkaRolloutItemStatuses are all the statuses a kafka of a rollout can be in
+var KafkaRolloutItemStatuses = []KafkaRolloutItemStatus{
+	KafkaRolloutItemStatusPending,
+	KafkaRolloutItemStatusUpgrading,
+	KafkaRolloutItemStatusUpgraded,
+	KafkaRolloutItemStatusFailed,
+	KafkaRolloutItemStatusSkipped,
+}
+
+// KafkaRollout upgrades the kafkas matching its selector to its target versions, in batches of BatchSize kafkas.
+// A batch is started once all the kafkas of the previous batch have been processed and BatchPauseSeconds have elapsed.
+type KafkaRollout struct {
+	api.Meta
+	Status       KafkaRolloutStatus `json:"status" gorm:"index"`
+	FailedReason string             `json:"failed_reason"`
+
+	// InstanceType selects the kafkas of the given instance type. All instance types are selected when empty
+	InstanceType string `json:"instance_type"`
+	// ClusterID selects the kafkas placed on the given data plane cluster. All clusters are selected when empty
+	ClusterID string `json:"cluster_id"`
+	// StrimziVersion selects the kafkas whose actual strimzi version is the given one. All strimzi versions are selected when empty
+	StrimziVersion string `json:"strimzi_version"`
+
+	// The target versions of the rollout. The desired version of a kafka is left untouched when the corresponding target version is empty
+	DesiredStrimziVersion  string `json:"desired_strimzi_version"`
+	DesiredKafkaVersion    string `json:"desired_kafka_version"`
+	DesiredKafkaIBPVersion string `json:"desired_kafka_ibp_version"`
+
+	BatchSize         int `json:"batch_size"`
+	BatchPauseSeconds int `json:"batch_pause_seconds"`
+	// UpgradeTimeoutSeconds is the time after which a kafka that has not reported the target versions is considered as failed
+	UpgradeTimeoutSeconds int `json:"upgrade_timeout_seconds"`
+	// MaxFailurePercentage is the percentage of failed kafkas, out of all the kafkas of the rollout, above which the rollout is stopped
+	MaxFailurePercentage int `json:"max_failure_percentage"`
+
+	// CurrentBatch is the last batch that has been started, 0 when none has been started yet
+	CurrentBatch int `json:"current_batch"`
+	// NextBatchAt is the time from which the next batch can be started. It is set once all the kafkas of the current batch have been processed
+	NextBatchAt *time.Time `json:"next_batch_at"`
+
+	Items []KafkaRolloutItem `json:"items" gorm:"foreignKey:RolloutID"`
+}
+
+type KafkaRolloutList []*KafkaRollout
+
+// KafkaRolloutItem is a kafka selected by a rollout
+type KafkaRolloutItem struct {
+	api.Meta
+	RolloutID    string                 `json:"rollout_id" gorm:"index"`
+	KafkaID      string                 `json:"kafka_id" gorm:"index"`
+	Batch        int                    `json:"batch"`
+	Status       KafkaRolloutItemStatus `json:"status"`
+	FailedReason string                 `json:"failed_reason"`
+	StartedAt    *time.Time             `json:"started_at"`
+	CompletedAt  *time.Time             `json:"completed_at"`
+}
+
+// BatchCount returns the number of batches of the rollout
+func (r *KafkaRollout) BatchCount() int {
+	count := 0
+	for _, item := range r.Items {
+		if item.Batch > count {
+			count = item.Batch
+		}
+	}
+	return count
+}
+
+// CountItemsByStatus returns the number of kafkas of the rollout for each status, including the statuses no kafka is in
+func (r *KafkaRollout) CountItemsByStatus() map[KafkaRolloutItemStatus]int {
+	counts := map[KafkaRolloutItemStatus]int{}
+	for _, status := range KafkaRolloutItemStatuses {
+		counts[status] = 0
+	}
+	for _, item := range r.Items {
+		counts[item.Status]++
+	}
+	return counts
+}
+
+// FailurePercentageExceeded returns whether the percentage of failed kafkas, out of all the kafkas of the rollout, is above its MaxFailurePercentage
+func (r *KafkaRollout) FailurePercentageExceeded() bool {
+	if len(r.Items) == 0 {
+		return false
+	}
+	failed := r.CountItemsByStatus()[KafkaRolloutItemStatusFailed]
+	return failed*100 > r.MaxFailurePercentage*len(r.Items)
+}
+
+// IsUpgradedBy returns whether the kafka runs the target versions of the rollout
+func (r *KafkaRollout) IsUpgradedBy(kafka *KafkaRequest) bool {
+	return (r.DesiredStrimziVersion == "" || r.DesiredStrimziVersion == kafka.ActualStrimziVersion) &&
+		(r.DesiredKafkaVersion == "" || r.DesiredKafkaVersion == kafka.ActualKafkaVersion) &&
+		(r.DesiredKafkaIBPVersion == "" || r.DesiredKafkaIBPVersion == kafka.ActualKafkaIBPVersion) &&
+		!kafka.StrimziUpgrading && !kafka.KafkaUpgrading && !kafka.KafkaIBPUpgrading
+}
@@ -0,0 +1,27 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// KafkaStatusEventActorSystem is the actor of the status transitions that are not requested by a user,
+// e.g. the transitions done by the workers or reported by the data plane
+const KafkaStatusEventActorSystem = "kas-fleet-manager"
+
+// KafkaStatusEvent is a status transition of a kafka. It is recorded in the same transaction as the status update
+// of the kafka, and is published by the KafkaStatusEventsManager afterwards
+type KafkaStatusEvent struct {
+	api.Meta
+	KafkaID     string
+	OldStatus   string
+	NewStatus   string
+	Reason      string
+	Actor       string
+	OccurredAt  time.Time
+	PublishedAt *time.Time
+	Attempts    int
+}
+
+type KafkaStatusEventList []*KafkaStatusEvent
@@ -0,0 +1,62 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+)
+
+type QuotaGrantSubjectType string
+
+const (
+	// QuotaGrantSubjectTypeOrganisation is the subject type of the quota granted to all the registered users of an organisation
+	QuotaGrantSubjectTypeOrganisation QuotaGrantSubjectType = "organisation"
+	// QuotaGrantSubjectTypeAccount is the subject type of the quota granted to an account, regardless of its organisation
+	QuotaGrantSubjectTypeAccount QuotaGrantSubjectType = "account"
+)
+
+func (t QuotaGrantSubjectType) String() string {
+	return string(t)
+}
+
+// QuotaGrantSubjectTypes are all the types of subjects quota can be granted to
+var QuotaGrantSubjectTypes = []QuotaGrantSubjectType{
+	QuotaGrantSubjectTypeOrganisation,
+	QuotaGrantSubjectTypeAccount,
+}
+
+// QuotaGrant is the quota of an instance type and billing model granted to an organisation or to an account of the
+// quota management list. There is at most one grant per subject, instance type and billing model
+type QuotaGrant struct {
+	api.Meta
+	SubjectType QuotaGrantSubjectType
+	// SubjectID is the id of the organisation or the username of the account the quota is granted to
+	SubjectID      string
+	InstanceTypeID string
+	BillingModelID string
+	// MaxAllowedInstances is the number of streaming units the subject can use. The default maximum allowed instances apply when it is 0
+	MaxAllowedInstances int
+	// ExpirationDate is when the quota expires. The quota never expires when it is not set
+	ExpirationDate *time.Time
+}
+
+type QuotaGrantList []*QuotaGrant
+
+// GetExpirationDate returns the expiration date of the quota in the format of the quota management list
+func (g *QuotaGrant) GetExpirationDate() *quota_management.ExpirationDate {
+	if g.ExpirationDate == nil {
+		return nil
+	}
+	expirationDate := quota_management.ExpirationDate(*g.ExpirationDate)
+	return &expirationDate
+}
+
+// BillingModel returns the billing model of the quota management list granted by the quota grant
+func (g *QuotaGrant) BillingModel() quota_management.BillingModel {
+	return quota_management.BillingModel{
+		Id:                  g.BillingModelID,
+		ExpirationDate:      g.GetExpirationDate(),
+		MaxAllowedInstances: g.MaxAllowedInstances,
+	}
+}
@@ -15,6 +15,8 @@ import (
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
+
+	"github.com/antihax/optional"
 )
 
 // Linger please
@@ -122,14 +124,23 @@ func (a *AgentClustersApiService) GetKafkaAgent(ctx _context.Context, id string)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// GetKafkasOpts Optional parameters for the method 'GetKafkas'
+type GetKafkasOpts struct {
+	Since       optional.String
+	IfNoneMatch optional.String
+}
+
 /*
 GetKafkas Get the list of ManagedaKafkas for the specified agent cluster
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param id The ID of record
+  - @param optional nil or *GetKafkasOpts - Optional Parameters:
+  - @param "Since" (optional.String) -  The resource version of a previous list of the ManagedKafkas of the agent cluster. Only the ManagedKafkas that changed since then are returned when it can be used, and a 304 is returned when none changed
+  - @param "IfNoneMatch" (optional.String) -  The resource version of a previous list of the ManagedKafkas of the agent cluster. A 304 is returned when none of them changed since then
 
 @return ManagedKafkaList
 */
-func (a *AgentClustersApiService) GetKafkas(ctx _context.Context, id string) (ManagedKafkaList, *_nethttp.Response, error) {
+func (a *AgentClustersApiService) GetKafkas(ctx _context.Context, id string, localVarOptionals *GetKafkasOpts) (ManagedKafkaList, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
@@ -147,6 +158,9 @@ func (a *AgentClustersApiService) GetKafkas(ctx _context.Context, id string) (Ma
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Since.IsSet() {
+		localVarQueryParams.Add("since", parameterToString(localVarOptionals.Since.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -164,6 +178,9 @@ func (a *AgentClustersApiService) GetKafkas(ctx _context.Context, id string) (Ma
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if localVarOptionals != nil && localVarOptionals.IfNoneMatch.IsSet() {
+		localVarHeaderParams["If-None-Match"] = parameterToString(localVarOptionals.IfNoneMatch.Value(), "")
+	}
 	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
 	if err != nil {
 		return localVarReturnValue, nil, err
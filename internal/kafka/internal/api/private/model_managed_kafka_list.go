@@ -13,4 +13,10 @@ package private
 type ManagedKafkaList struct {
 	Kind  string         `json:"kind"`
 	Items []ManagedKafka `json:"items"`
+	// The resource version of the list. It is returned in the ETag header of the response too, and can be sent back in the since query parameter or in the If-None-Match header of the next request
+	ResourceVersion string `json:"resource_version,omitempty"`
+	// Whether only the ManagedKafkas that changed since the resource version of the since query parameter are returned. Otherwise, all the ManagedKafkas of the agent cluster are returned
+	Incremental bool `json:"incremental,omitempty"`
+	// The IDs of the Kafka instances whose ManagedKafka has been removed from the agent cluster since the resource version of the since query parameter. The IDs of the Kafka instances that are not known to the agent cluster must be ignored
+	RemovedIds []string `json:"removed_ids,omitempty"`
 }
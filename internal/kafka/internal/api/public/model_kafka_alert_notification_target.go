@@ -0,0 +1,19 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaAlertNotificationTarget Where the changes of the state of an alert rule are notified
+type KafkaAlertNotificationTarget struct {
+	// Values: [webhook]
+	Type string `json:"type"`
+	// The https URL the notifications are posted to. It must target a public host. The loopback, private and link-local addresses and the internal host names are refused
+	Url string `json:"url"`
+}
@@ -0,0 +1,40 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaAlertRule A rule notifying its targets when a metric of a Kafka instance exceeds a threshold
+type KafkaAlertRule struct {
+	Id      string `json:"id"`
+	Kind    string `json:"kind"`
+	Href    string `json:"href"`
+	KafkaId string `json:"kafka_id"`
+	Name    string `json:"name"`
+	// The metric the rule is evaluated against. Values: [disk_usage_percentage, consumer_lag, partition_count, connection_count]
+	Metric string `json:"metric"`
+	// The rule fires when the value of the metric exceeds the threshold
+	Threshold           float64                        `json:"threshold"`
+	NotificationTargets []KafkaAlertNotificationTarget `json:"notification_targets"`
+	// Values: [inactive, firing]
+	State string `json:"state"`
+	// When the rule last started firing or got resolved
+	StateChangedAt *time.Time `json:"state_changed_at,omitempty"`
+	// The value of the metric at the latest evaluation of the rule
+	Value       *float64   `json:"value,omitempty"`
+	EvaluatedAt *time.Time `json:"evaluated_at,omitempty"`
+	// Why the targets could not be notified of the latest change of the state of the rule. The notification is retried at the next evaluation
+	NotificationError string    `json:"notification_error,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at,omitempty"`
+}
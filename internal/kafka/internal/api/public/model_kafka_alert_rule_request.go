@@ -0,0 +1,20 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaAlertRuleRequest Schema for the request to create an alert rule
+type KafkaAlertRuleRequest struct {
+	Name string `json:"name"`
+	// Values: [disk_usage_percentage, consumer_lag, partition_count, connection_count]
+	Metric              string                         `json:"metric"`
+	Threshold           float64                        `json:"threshold"`
+	NotificationTargets []KafkaAlertNotificationTarget `json:"notification_targets"`
+}
@@ -0,0 +1,34 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaEvent An entry of the history of a Kafka instance: either a transition of its status or a condition reported for it by the data plane
+type KafkaEvent struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	// Values: [status_change, condition]
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// The status of the Kafka instance before the transition. Only set for the 'status_change' events, except for the creation of the Kafka instance
+	OldStatus string `json:"old_status,omitempty"`
+	// The status of the Kafka instance after the transition. Only set for the 'status_change' events
+	NewStatus string `json:"new_status,omitempty"`
+	// The type of the condition, e.g. Ready. Only set for the 'condition' events
+	ConditionType string `json:"condition_type,omitempty"`
+	// The status of the condition. Values: [True, False, Unknown]. Only set for the 'condition' events
+	ConditionStatus string `json:"condition_status,omitempty"`
+	// Why the status transition happened or why the condition has its status
+	Reason string `json:"reason,omitempty"`
+}
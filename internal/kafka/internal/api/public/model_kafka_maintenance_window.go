@@ -0,0 +1,23 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.15.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaMaintenanceWindow Weekly window in which upgrades of the Kafka instance are rolled out. Upgrades are rolled out as soon as they are available when a Kafka instance has no maintenance window
+type KafkaMaintenanceWindow struct {
+	// The day of the week the window starts on. Values: [sunday, monday, tuesday, wednesday, thursday, friday, saturday]
+	DayOfWeek string `json:"day_of_week,omitempty"`
+	// The hour of the day the window starts at, from 0 to 23
+	StartHour int32 `json:"start_hour,omitempty"`
+	// The duration of the window in hours, from 1 to 24
+	DurationHours int32 `json:"duration_hours,omitempty"`
+	// The IANA time zone name in which the window is defined. For example: Europe/Dublin
+	Timezone string `json:"timezone,omitempty"`
+}
@@ -73,5 +73,10 @@ type KafkaRequest struct {
 	// The ID of the data plane where Kafka is deployed on. This information is only returned for kafka whose billing model is enterprise
 	ClusterId *string `json:"cluster_id,omitempty"`
 	// Details of the Kafka request promotion. It can be set when a Kafka request promotion is in progress or has failed
-	PromotionDetails string `json:"promotion_details,omitempty"`
+	PromotionDetails  string                  `json:"promotion_details,omitempty"`
+	MaintenanceWindow *KafkaMaintenanceWindow `json:"maintenance_window,omitempty"`
+	// The user defined key/value labels of the Kafka instance
+	Labels map[string]string `json:"labels,omitempty"`
+	// Why the Kafka instance has been suspended. Possible values: ['admin', 'user', 'idle', 'grace_period']. It is only set while the Kafka instance is suspending or suspended. The Kafka instances suspended for the 'user' and 'idle' reasons can be resumed by their owners
+	SuspensionReason string `json:"suspension_reason,omitempty"`
 }
@@ -29,5 +29,8 @@ type KafkaRequestPayload struct {
 	// billing model to use
 	BillingModel *string `json:"billing_model,omitempty"`
 	// enterprise OSD cluster ID to be used for kafka creation
-	ClusterId *string `json:"cluster_id,omitempty"`
+	ClusterId         *string                 `json:"cluster_id,omitempty"`
+	MaintenanceWindow *KafkaMaintenanceWindow `json:"maintenance_window,omitempty"`
+	// The user defined key/value labels of the Kafka instance
+	Labels map[string]string `json:"labels,omitempty"`
 }
@@ -15,4 +15,12 @@ type KafkaUpdateRequest struct {
 	Owner *string `json:"owner,omitempty"`
 	// Whether connection reauthentication is enabled or not. If set to true, connection reauthentication on the Kafka instance will be required every 5 minutes.
 	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	// The weekly maintenance window of the Kafka instance. An empty object removes the maintenance window
+	MaintenanceWindow *KafkaMaintenanceWindow `json:"maintenance_window,omitempty"`
+	// The plan the Kafka instance is resized to. The plan must be a size of the instance type of the Kafka instance, in the format `<instance_type>.<size_id>`
+	Plan *string `json:"plan,omitempty"`
+	// The user defined key/value labels replacing the labels of the Kafka instance. An empty object removes all the labels
+	Labels map[string]string `json:"labels,omitempty"`
+	// Whether the Kafka instance is suspended. Only the Kafka instances of some instance types, e.g. developer, can be suspended and resumed by their owners. A suspended Kafka instance keeps its data but cannot be connected to. The Kafka instances suspended because they had no traffic are resumed by their owner in the same way
+	Suspended *bool `json:"suspended,omitempty"`
 }
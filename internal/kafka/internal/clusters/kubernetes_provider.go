@@ -0,0 +1,487 @@
+package clusters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	strimziOperatorResourceSetName       = "strimzi-operator"
+	kasFleetShardOperatorResourceSetName = "kas-fleetshard-operator"
+
+	// resourceSetInventoryNamespace is the namespace holding the inventories of the resource sets applied on a kubernetes cluster.
+	// kube-system is used as it exists in every conformant cluster
+	resourceSetInventoryNamespace = "kube-system"
+	// resourceSetInventoryNamePrefix is the prefix of the name of the ConfigMap holding the inventory of a resource set
+	resourceSetInventoryNamePrefix = "kas-fleet-manager-"
+	// resourceSetInventoryDataKey is the ConfigMap key under which the applied resources of a resource set are stored
+	resourceSetInventoryDataKey = "resources"
+)
+
+// KubernetesClients are the clients used to communicate with a kubernetes cluster
+type KubernetesClients struct {
+	Dynamic   dynamic.Interface
+	Discovery discovery.DiscoveryInterface
+	Mapper    meta.RESTMapper
+}
+
+// KubernetesClientsFactory returns the clients used to communicate with the kubernetes cluster with the given id
+type KubernetesClientsFactory func(clusterID string) (*KubernetesClients, error)
+
+// KubernetesProvider is a Provider for any conformant Kubernetes cluster reached through a kubeconfig file
+// or a service account token. The resources are applied using server-side apply.
+type KubernetesProvider struct {
+	connectionFactory      *db.ConnectionFactory
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+	// clientsFactory overrides the clients built from the data plane cluster configuration. Used in tests
+	clientsFactory KubernetesClientsFactory
+}
+
+// blank assignment to verify that KubernetesProvider implements Provider
+var _ Provider = &KubernetesProvider{}
+
+func newKubernetesProvider(connectionFactory *db.ConnectionFactory, dataplaneClusterConfig *config.DataplaneClusterConfig) *KubernetesProvider {
+	return &KubernetesProvider{
+		connectionFactory:      connectionFactory,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+// clients returns the clients used to communicate with the kubernetes cluster with the given id.
+// They are built from the credentials of the cluster specified in the data plane cluster configuration.
+func (k *KubernetesProvider) clients(clusterID string) (*KubernetesClients, error) {
+	if k.clientsFactory != nil {
+		return k.clientsFactory(clusterID)
+	}
+
+	manualCluster, ok := k.getManualCluster(clusterID)
+	if !ok {
+		return nil, errors.Errorf("kubernetes cluster with id %s is not in the data plane cluster configuration", clusterID)
+	}
+
+	restConfig, err := buildKubernetesRestConfig(manualCluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build the rest config of kubernetes cluster with id %s", clusterID)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesClients{
+		Dynamic:   dynamicClient,
+		Discovery: discoveryClient,
+		Mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+	}, nil
+}
+
+func (k *KubernetesProvider) getManualCluster(clusterID string) (config.ManualCluster, bool) {
+	if k.dataplaneClusterConfig == nil || k.dataplaneClusterConfig.ClusterConfig == nil {
+		return config.ManualCluster{}, false
+	}
+	return k.dataplaneClusterConfig.ClusterConfig.GetManualCluster(clusterID)
+}
+
+// buildKubernetesRestConfig builds the rest config from the kubeconfig file of the cluster if set, otherwise from
+// its API server URL and service account token
+func buildKubernetesRestConfig(cluster config.ManualCluster) (*rest.Config, error) {
+	if cluster.KubeconfigFile != "" {
+		loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: shared.BuildFullFilePath(cluster.KubeconfigFile)}
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	}
+
+	return &rest.Config{
+		Host:            cluster.APIServerURL,
+		BearerTokenFile: shared.BuildFullFilePath(cluster.ServiceAccountTokenFile),
+		TLSClientConfig: rest.TLSClientConfig{
+			CAFile: shared.BuildFullFilePath(cluster.CertificateAuthorityFile),
+		},
+	}, nil
+}
+
+func unsupportedByKubernetesProviderError(operation string) error {
+	return errors.Errorf("%s is not supported by the %q cluster provider", operation, api.ClusterProviderKubernetes)
+}
+
+// Create is not supported as kubernetes clusters are registered through the data plane cluster configuration
+func (k *KubernetesProvider) Create(request *types.ClusterRequest) (*types.ClusterSpec, error) {
+	return nil, unsupportedByKubernetesProviderError("cluster creation")
+}
+
+// Delete only unregisters the cluster, the cluster itself is not owned by the fleet manager
+func (k *KubernetesProvider) Delete(spec *types.ClusterSpec) (bool, error) {
+	return true, nil
+}
+
+// CheckClusterStatus marks the cluster as provisioned as soon as its API server can be reached
+func (k *KubernetesProvider) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
+	clients, err := k.clients(spec.InternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.Discovery.ServerVersion(); err != nil {
+		return nil, errors.Wrapf(err, "failed to reach the API server of kubernetes cluster with id %s", spec.InternalID)
+	}
+
+	spec.Status = api.ClusterProvisioned
+	return spec, nil
+}
+
+// AddIdentityProvider is not supported as identity providers are configured through the OpenShift OAuth server
+func (k *KubernetesProvider) AddIdentityProvider(clusterSpec *types.ClusterSpec, identityProvider types.IdentityProviderInfo) (*types.IdentityProviderInfo, error) {
+	return nil, unsupportedByKubernetesProviderError("identity provider configuration")
+}
+
+func (k *KubernetesProvider) GetClusterDNS(clusterSpec *types.ClusterSpec) (string, error) {
+	if manualCluster, ok := k.getManualCluster(clusterSpec.InternalID); ok {
+		return manualCluster.ClusterDNS, nil
+	}
+	return "", nil
+}
+
+func (k *KubernetesProvider) GetClusterSpec(clusterID string) (types.ClusterSpec, error) {
+	return types.ClusterSpec{}, unsupportedByKubernetesProviderError("retrieving the cluster spec")
+}
+
+func (k *KubernetesProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return getCloudProvidersOfProviderType(k.connectionFactory, api.ClusterProviderKubernetes)
+}
+
+func (k *KubernetesProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return getCloudProviderRegionsOfProviderType(k.connectionFactory, api.ClusterProviderKubernetes, providerInf)
+}
+
+// InstallStrimzi installs the Strimzi operator using the configured plain manifests if any, otherwise through OLM
+func (k *KubernetesProvider) InstallStrimzi(clusterSpec *types.ClusterSpec) (bool, error) {
+	olmResourcesBuilder := k.olmResourcesBuilder()
+	resources := []interface{}{olmResourcesBuilder.buildStrimziOperatorNamespace()}
+	if manifests := k.dataplaneClusterConfig.StrimziOperatorOLMConfig.Manifests; len(manifests) > 0 {
+		resources = appendManifests(resources, manifests)
+	} else {
+		resources = append(resources,
+			olmResourcesBuilder.buildStrimziOperatorCatalogSource(),
+			olmResourcesBuilder.buildStrimziOperatorOperatorGroup(),
+			olmResourcesBuilder.buildStrimziOperatorSubscription(),
+		)
+	}
+
+	return k.installOperator(clusterSpec, types.ResourceSet{
+		Name:      strimziOperatorResourceSetName,
+		Resources: resources,
+	})
+}
+
+// InstallKasFleetshard installs the kas-fleetshard operator using the configured plain manifests if any, otherwise through OLM
+func (k *KubernetesProvider) InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	olmResourcesBuilder := k.olmResourcesBuilder()
+	resources := []interface{}{
+		olmResourcesBuilder.buildKASFleetShardOperatorNamespace(),
+		olmResourcesBuilder.buildKASFleetShardSyncSecret(params),
+	}
+	if manifests := k.dataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Manifests; len(manifests) > 0 {
+		resources = appendManifests(resources, manifests)
+	} else {
+		resources = append(resources,
+			olmResourcesBuilder.buildKASFleetShardOperatorCatalogSource(),
+			olmResourcesBuilder.buildKASFleetShardOperatorOperatorGroup(),
+			olmResourcesBuilder.buildKASFleetShardOperatorSubscription(),
+		)
+	}
+
+	return k.installOperator(clusterSpec, types.ResourceSet{
+		Name:      kasFleetShardOperatorResourceSetName,
+		Resources: resources,
+	})
+}
+
+// installOperator applies the resources of an operator. Unlike ApplyResources, it fails when a resource's kind is not
+// served by the cluster, e.g. when OLM is not installed, so that the installation is not reported as done and is retried
+func (k *KubernetesProvider) installOperator(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (bool, error) {
+	if _, err := k.applyResources(clusterSpec, resources, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// olmResourcesBuilder returns a StandaloneProvider used to build the operators OLM resources,
+// which are the same for standalone and kubernetes clusters
+func (k *KubernetesProvider) olmResourcesBuilder() *StandaloneProvider {
+	return &StandaloneProvider{dataplaneClusterConfig: k.dataplaneClusterConfig}
+}
+
+func appendManifests(resources []interface{}, manifests []map[string]interface{}) []interface{} {
+	for _, manifest := range manifests {
+		resources = append(resources, manifest)
+	}
+	return resources
+}
+
+func (k *KubernetesProvider) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error) {
+	return true, nil // NOOP as the cluster logging operator is specific to OpenShift
+}
+
+// ApplyResources applies the resources through server-side apply. Resources of a kind which is not served by the cluster
+// are skipped. When the resource set is named, the applied resources are recorded in an inventory so that the resources
+// which are no longer part of the set are pruned and that the set can be removed by RemoveResources.
+func (k *KubernetesProvider) ApplyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
+	return k.applyResources(clusterSpec, resources, true)
+}
+
+func (k *KubernetesProvider) applyResources(clusterSpec *types.ClusterSpec, resources types.ResourceSet, skipUnservedKinds bool) (*types.ResourceSet, error) {
+	clients, err := k.clients(clusterSpec.InternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := []resourceReference{}
+	for _, resource := range resources.Resources {
+		obj, err := toUnstructured(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		dr, err := resourceInterfaceFor(clients, obj.GroupVersionKind(), obj.GetNamespace())
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				if !skipUnservedKinds {
+					return nil, errors.Wrapf(err, "failed to apply %s %q on kubernetes cluster %s as its kind is not served by the cluster", obj.GetKind(), obj.GetName(), clusterSpec.InternalID)
+				}
+				glog.Warningf("skipping %s %q on kubernetes cluster %s as its kind is not served by the cluster", obj.GetKind(), obj.GetName(), clusterSpec.InternalID)
+				continue
+			}
+			return nil, err
+		}
+
+		if _, err := dr.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{FieldManager: fieldManager, Force: true}); err != nil {
+			return nil, errors.Wrapf(err, "failed to apply %s %q on kubernetes cluster %s", obj.GetKind(), obj.GetName(), clusterSpec.InternalID)
+		}
+		applied = append(applied, newResourceReference(obj))
+	}
+
+	if resources.Name == "" {
+		return &resources, nil
+	}
+
+	previouslyApplied, err := getResourceSetInventory(clients, resources.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteResources(clients, pruneableResources(previouslyApplied, applied)); err != nil {
+		return nil, errors.Wrapf(err, "failed to prune resources of resource set %q on kubernetes cluster %s", resources.Name, clusterSpec.InternalID)
+	}
+
+	if err := saveResourceSetInventory(clients, resources.Name, applied); err != nil {
+		return nil, errors.Wrapf(err, "failed to save the inventory of resource set %q on kubernetes cluster %s", resources.Name, clusterSpec.InternalID)
+	}
+
+	return &resources, nil
+}
+
+// RemoveResources deletes the resources recorded in the inventory of the given resource set, then the inventory itself
+func (k *KubernetesProvider) RemoveResources(clusterSpec *types.ClusterSpec, syncSetName string) error {
+	clients, err := k.clients(clusterSpec.InternalID)
+	if err != nil {
+		return err
+	}
+
+	applied, err := getResourceSetInventory(clients, syncSetName)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteResources(clients, applied); err != nil {
+		return errors.Wrapf(err, "failed to remove resources of resource set %q on kubernetes cluster %s", syncSetName, clusterSpec.InternalID)
+	}
+
+	err = clients.Dynamic.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).
+		Namespace(resourceSetInventoryNamespace).
+		Delete(ctx, resourceSetInventoryName(syncSetName), metav1.DeleteOptions{})
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to remove the inventory of resource set %q on kubernetes cluster %s", syncSetName, clusterSpec.InternalID)
+	}
+
+	return nil
+}
+
+func (k *KubernetesProvider) GetMachinePool(clusterID string, id string) (*types.MachinePoolInfo, error) {
+	return nil, unsupportedByKubernetesProviderError("retrieving machine pools")
+}
+
+func (k *KubernetesProvider) CreateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+	return nil, unsupportedByKubernetesProviderError("creating machine pools")
+}
+
+// noop method, it will always return a nil slice as a kubernetes provider does not have any resource quotas
+func (k *KubernetesProvider) GetClusterResourceQuotaCosts() ([]types.QuotaCost, error) {
+	var quotaCostList []types.QuotaCost
+	return quotaCostList, nil
+}
+
+// resourceReference identifies a resource applied on a kubernetes cluster
+type resourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func newResourceReference(obj *unstructured.Unstructured) resourceReference {
+	return resourceReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func toUnstructured(resource interface{}) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, err
+	}
+
+	// fields managed by the API server must not be part of an apply request
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	return obj, nil
+}
+
+func resourceInterfaceFor(clients *KubernetesClients, gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	mapping, err := clients.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace != "" && mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return clients.Dynamic.Resource(mapping.Resource).Namespace(namespace), nil
+	}
+	return clients.Dynamic.Resource(mapping.Resource), nil
+}
+
+// pruneableResources returns the previously applied resources which are not part of the currently applied ones
+func pruneableResources(previouslyApplied []resourceReference, applied []resourceReference) []resourceReference {
+	current := map[resourceReference]struct{}{}
+	for _, ref := range applied {
+		current[ref] = struct{}{}
+	}
+
+	pruneable := []resourceReference{}
+	for _, ref := range previouslyApplied {
+		if _, ok := current[ref]; !ok {
+			pruneable = append(pruneable, ref)
+		}
+	}
+	return pruneable
+}
+
+// deleteResources deletes the given resources in the reverse order of their application.
+// Resources which no longer exist, or whose kind is no longer served, are ignored.
+func deleteResources(clients *KubernetesClients, refs []resourceReference) error {
+	for i := len(refs) - 1; i >= 0; i-- {
+		ref := refs[i]
+		dr, err := resourceInterfaceFor(clients, schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), ref.Namespace)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return err
+		}
+
+		if err := dr.Delete(ctx, ref.Name, metav1.DeleteOptions{}); err != nil && !apiErrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete %s %q", ref.Kind, ref.Name)
+		}
+	}
+	return nil
+}
+
+func resourceSetInventoryName(resourceSetName string) string {
+	return resourceSetInventoryNamePrefix + resourceSetName
+}
+
+func getResourceSetInventory(clients *KubernetesClients, resourceSetName string) ([]resourceReference, error) {
+	inventory, err := clients.Dynamic.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).
+		Namespace(resourceSetInventoryNamespace).
+		Get(ctx, resourceSetInventoryName(resourceSetName), metav1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return []resourceReference{}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get the inventory of resource set %q", resourceSetName)
+	}
+
+	data, _, err := unstructured.NestedString(inventory.Object, "data", resourceSetInventoryDataKey)
+	if err != nil || data == "" {
+		return []resourceReference{}, err
+	}
+
+	refs := []resourceReference{}
+	if err := json.Unmarshal([]byte(data), &refs); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the inventory of resource set %q", resourceSetName)
+	}
+	return refs, nil
+}
+
+func saveResourceSetInventory(clients *KubernetesClients, resourceSetName string, refs []resourceReference) error {
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+
+	inventory, err := toUnstructured(&v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceSetInventoryName(resourceSetName),
+			Namespace: resourceSetInventoryNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": fieldManager,
+			},
+		},
+		Data: map[string]string{
+			resourceSetInventoryDataKey: string(data),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.Dynamic.Resource(v1.SchemeGroupVersion.WithResource("configmaps")).
+		Namespace(resourceSetInventoryNamespace).
+		Apply(ctx, inventory.GetName(), inventory, metav1.ApplyOptions{FieldManager: fieldManager, Force: true})
+	return err
+}
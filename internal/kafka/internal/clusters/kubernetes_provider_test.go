@@ -0,0 +1,441 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var (
+	namespacesResource = v1.SchemeGroupVersion.WithResource("namespaces")
+	configMapsResource = v1.SchemeGroupVersion.WithResource("configmaps")
+	secretsResource    = v1.SchemeGroupVersion.WithResource("secrets")
+)
+
+// newFakeKubernetesClients returns clients backed by an in-memory object tracker. Server-side apply requests are
+// handled as create or update requests as the fake dynamic client does not support them.
+func newFakeKubernetesClients(objects ...runtime.Object) *KubernetesClients {
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	tracker := dynamicClient.Tracker()
+	dynamicClient.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patchAction := action.(clienttesting.PatchAction)
+		if patchAction.GetPatchType() != k8sTypes.ApplyPatchType {
+			return false, nil, nil
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patchAction.GetPatch()); err != nil {
+			return true, nil, err
+		}
+
+		if _, err := tracker.Get(action.GetResource(), action.GetNamespace(), obj.GetName()); apiErrors.IsNotFound(err) {
+			return true, obj, tracker.Create(action.GetResource(), obj, action.GetNamespace())
+		}
+		return true, obj, tracker.Update(action.GetResource(), obj, action.GetNamespace())
+	})
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(v1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "CatalogSource"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha2", Kind: "OperatorGroup"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "Subscription"}, meta.RESTScopeNamespace)
+
+	return &KubernetesClients{
+		Dynamic:   dynamicClient,
+		Discovery: &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}},
+		Mapper:    mapper,
+	}
+}
+
+func newUnstructured(apiVersion string, kind string, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newInventory(resourceSetName string, data string) *unstructured.Unstructured {
+	inventory := newUnstructured("v1", "ConfigMap", resourceSetInventoryNamespace, resourceSetInventoryName(resourceSetName))
+	_ = unstructured.SetNestedField(inventory.Object, data, "data", resourceSetInventoryDataKey)
+	return inventory
+}
+
+func kubernetesClientsFactoryFor(clients *KubernetesClients) KubernetesClientsFactory {
+	return func(clusterID string) (*KubernetesClients, error) {
+		return clients, nil
+	}
+}
+
+func TestKubernetesProvider_ApplyResources(t *testing.T) {
+	namespace := &v1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"},
+	}
+	configMap := &v1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "test-namespace"},
+		Data:       map[string]string{"key": "value"},
+	}
+	// OpenShift Group kind which is not served by the fake cluster
+	group := map[string]interface{}{
+		"apiVersion": "user.openshift.io/v1",
+		"kind":       "Group",
+		"metadata":   map[string]interface{}{"name": "test-group"},
+	}
+
+	type want struct {
+		err       bool
+		existing  []schema.GroupVersionResource
+		names     []string
+		missing   []schema.GroupVersionResource
+		missNames []string
+		inventory bool
+	}
+
+	tests := []struct {
+		name           string
+		clientsFactory func(clients *KubernetesClients) KubernetesClientsFactory
+		objects        []runtime.Object
+		resources      types.ResourceSet
+		want           want
+	}{
+		{
+			name: "should return an error when the clients of the cluster cannot be built",
+			clientsFactory: func(clients *KubernetesClients) KubernetesClientsFactory {
+				return func(clusterID string) (*KubernetesClients, error) {
+					return nil, errors.New("failed to build clients")
+				}
+			},
+			resources: types.ResourceSet{Resources: []interface{}{namespace}},
+			want:      want{err: true},
+		},
+		{
+			name:           "should apply the resources and skip the ones whose kind is not served by the cluster",
+			clientsFactory: kubernetesClientsFactoryFor,
+			resources:      types.ResourceSet{Resources: []interface{}{namespace, configMap, group}},
+			want: want{
+				existing: []schema.GroupVersionResource{namespacesResource, configMapsResource},
+				names:    []string{"test-namespace", "test-configmap"},
+			},
+		},
+		{
+			name:           "should record the applied resources of a named resource set in its inventory",
+			clientsFactory: kubernetesClientsFactoryFor,
+			resources:      types.ResourceSet{Name: "test-set", Resources: []interface{}{namespace, configMap}},
+			want: want{
+				existing:  []schema.GroupVersionResource{namespacesResource, configMapsResource},
+				names:     []string{"test-namespace", "test-configmap"},
+				inventory: true,
+			},
+		},
+		{
+			name:           "should prune the resources which are no longer part of a named resource set",
+			clientsFactory: kubernetesClientsFactoryFor,
+			objects: []runtime.Object{
+				newUnstructured("v1", "Secret", "test-namespace", "pruned-secret"),
+				newInventory("test-set", `[{"apiVersion":"v1","kind":"Secret","namespace":"test-namespace","name":"pruned-secret"}]`),
+			},
+			resources: types.ResourceSet{Name: "test-set", Resources: []interface{}{namespace, configMap}},
+			want: want{
+				existing:  []schema.GroupVersionResource{namespacesResource, configMapsResource},
+				names:     []string{"test-namespace", "test-configmap"},
+				missing:   []schema.GroupVersionResource{secretsResource},
+				missNames: []string{"pruned-secret"},
+				inventory: true,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clients := newFakeKubernetesClients(tt.objects...)
+			provider := &KubernetesProvider{clientsFactory: tt.clientsFactory(clients)}
+
+			_, err := provider.ApplyResources(&types.ClusterSpec{InternalID: "cluster-id"}, tt.resources)
+			g.Expect(err != nil).To(gomega.Equal(tt.want.err))
+			if tt.want.err {
+				return
+			}
+
+			for i, gvr := range tt.want.existing {
+				_, err := clients.Dynamic.Resource(gvr).Namespace(namespaceOf(gvr, "test-namespace")).Get(ctx, tt.want.names[i], metav1.GetOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+			for i, gvr := range tt.want.missing {
+				_, err := clients.Dynamic.Resource(gvr).Namespace(namespaceOf(gvr, "test-namespace")).Get(ctx, tt.want.missNames[i], metav1.GetOptions{})
+				g.Expect(apiErrors.IsNotFound(err)).To(gomega.BeTrue())
+			}
+
+			inventory, err := getResourceSetInventory(clients, tt.resources.Name)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			if tt.want.inventory {
+				g.Expect(inventory).To(gomega.Equal([]resourceReference{
+					{APIVersion: "v1", Kind: "Namespace", Name: "test-namespace"},
+					{APIVersion: "v1", Kind: "ConfigMap", Namespace: "test-namespace", Name: "test-configmap"},
+				}))
+			} else {
+				g.Expect(inventory).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func namespaceOf(gvr schema.GroupVersionResource, namespace string) string {
+	if gvr == namespacesResource {
+		return ""
+	}
+	return namespace
+}
+
+func TestKubernetesProvider_RemoveResources(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		wantErr bool
+	}{
+		{
+			name: "should succeed when the resource set has never been applied",
+		},
+		{
+			name: "should remove the resources recorded in the inventory of the resource set and the inventory itself",
+			objects: []runtime.Object{
+				newUnstructured("v1", "Namespace", "", "test-namespace"),
+				newUnstructured("v1", "ConfigMap", "test-namespace", "test-configmap"),
+				newInventory("test-set", `[{"apiVersion":"v1","kind":"Namespace","name":"test-namespace"},{"apiVersion":"v1","kind":"ConfigMap","namespace":"test-namespace","name":"test-configmap"},{"apiVersion":"v1","kind":"Secret","namespace":"test-namespace","name":"already-deleted"}]`),
+			},
+		},
+		{
+			name: "should return an error when the inventory cannot be read",
+			objects: []runtime.Object{
+				newInventory("test-set", "not-json"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clients := newFakeKubernetesClients(tt.objects...)
+			provider := &KubernetesProvider{clientsFactory: kubernetesClientsFactoryFor(clients)}
+
+			err := provider.RemoveResources(&types.ClusterSpec{InternalID: "cluster-id"}, "test-set")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			_, err = clients.Dynamic.Resource(namespacesResource).Get(ctx, "test-namespace", metav1.GetOptions{})
+			g.Expect(apiErrors.IsNotFound(err)).To(gomega.BeTrue())
+			_, err = clients.Dynamic.Resource(configMapsResource).Namespace("test-namespace").Get(ctx, "test-configmap", metav1.GetOptions{})
+			g.Expect(apiErrors.IsNotFound(err)).To(gomega.BeTrue())
+			_, err = clients.Dynamic.Resource(configMapsResource).Namespace(resourceSetInventoryNamespace).Get(ctx, resourceSetInventoryName("test-set"), metav1.GetOptions{})
+			g.Expect(apiErrors.IsNotFound(err)).To(gomega.BeTrue())
+		})
+	}
+}
+
+func TestKubernetesProvider_CheckClusterStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		clientsFactory KubernetesClientsFactory
+		want           *types.ClusterSpec
+		wantErr        bool
+	}{
+		{
+			name: "should return an error when the clients of the cluster cannot be built",
+			clientsFactory: func(clusterID string) (*KubernetesClients, error) {
+				return nil, errors.New("failed to build clients")
+			},
+			wantErr: true,
+		},
+		{
+			name:           "should mark the cluster as provisioned when its API server can be reached",
+			clientsFactory: kubernetesClientsFactoryFor(newFakeKubernetesClients()),
+			want:           &types.ClusterSpec{InternalID: "cluster-id", Status: api.ClusterProvisioned},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := &KubernetesProvider{clientsFactory: tt.clientsFactory}
+			got, err := provider.CheckClusterStatus(&types.ClusterSpec{InternalID: "cluster-id", Status: api.ClusterProvisioning})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestKubernetesProvider_InstallStrimzi(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests []map[string]interface{}
+		noOLM     bool
+		wantErr   bool
+		want      []resourceReference
+	}{
+		{
+			name: "should install the strimzi operator through OLM when no manifests are configured",
+			want: []resourceReference{
+				{APIVersion: "v1", Kind: "Namespace", Name: "strimzi-namespace"},
+				{APIVersion: "operators.coreos.com/v1alpha1", Kind: "CatalogSource", Namespace: "strimzi-namespace", Name: strimziOperatorCatalogSourceName},
+				{APIVersion: "operators.coreos.com/v1alpha2", Kind: "OperatorGroup", Namespace: "strimzi-namespace", Name: strimziOperatorOperatorGroupName},
+				{APIVersion: "operators.coreos.com/v1alpha1", Kind: "Subscription", Namespace: "strimzi-namespace", Name: strimziOperatorSubscriptionName},
+			},
+		},
+		{
+			name: "should install the strimzi operator from the configured manifests",
+			manifests: []map[string]interface{}{
+				{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]interface{}{"name": "strimzi-cluster-operator", "namespace": "strimzi-namespace"},
+				},
+			},
+			want: []resourceReference{
+				{APIVersion: "v1", Kind: "Namespace", Name: "strimzi-namespace"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "strimzi-namespace", Name: "strimzi-cluster-operator"},
+			},
+		},
+		{
+			name:    "should return an error and not be ready when OLM is not installed on the cluster",
+			noOLM:   true,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			clients := newFakeKubernetesClients()
+			if tt.noOLM {
+				mapper := meta.NewDefaultRESTMapper(nil)
+				mapper.Add(v1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+				clients.Mapper = mapper
+			}
+			provider := &KubernetesProvider{
+				dataplaneClusterConfig: &config.DataplaneClusterConfig{
+					StrimziOperatorOLMConfig: config.OperatorInstallationConfig{
+						Namespace: "strimzi-namespace",
+						Manifests: tt.manifests,
+					},
+				},
+				clientsFactory: kubernetesClientsFactoryFor(clients),
+			}
+
+			ok, err := provider.InstallStrimzi(&types.ClusterSpec{InternalID: "cluster-id"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(ok).To(gomega.Equal(!tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			inventory, err := getResourceSetInventory(clients, strimziOperatorResourceSetName)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(inventory).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestKubernetesProvider_InstallKasFleetshard(t *testing.T) {
+	clients := newFakeKubernetesClients()
+	provider := &KubernetesProvider{
+		dataplaneClusterConfig: &config.DataplaneClusterConfig{
+			KasFleetshardOperatorOLMConfig: config.OperatorInstallationConfig{
+				Namespace: "kas-fleetshard-namespace",
+			},
+		},
+		clientsFactory: kubernetesClientsFactoryFor(clients),
+	}
+
+	g := gomega.NewWithT(t)
+	ok, err := provider.InstallKasFleetshard(&types.ClusterSpec{InternalID: "cluster-id"}, []types.Parameter{{Id: "param", Value: "value"}})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(ok).To(gomega.BeTrue())
+
+	inventory, err := getResourceSetInventory(clients, kasFleetShardOperatorResourceSetName)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(inventory).To(gomega.Equal([]resourceReference{
+		{APIVersion: "v1", Kind: "Namespace", Name: "kas-fleetshard-namespace"},
+		{APIVersion: "v1", Kind: "Secret", Namespace: "kas-fleetshard-namespace", Name: kasFleetShardOperatorParametersSecretName},
+		{APIVersion: "operators.coreos.com/v1alpha1", Kind: "CatalogSource", Namespace: "kas-fleetshard-namespace", Name: kasFleetShardOperatorCatalogSourceName},
+		{APIVersion: "operators.coreos.com/v1alpha2", Kind: "OperatorGroup", Namespace: "kas-fleetshard-namespace", Name: kasFleetShardOperatorOperatorGroupName},
+		{APIVersion: "operators.coreos.com/v1alpha1", Kind: "Subscription", Namespace: "kas-fleetshard-namespace", Name: kasFleetShardOperatorSubscriptionName},
+	}))
+}
+
+func TestKubernetesProvider_GetClusterDNS(t *testing.T) {
+	tests := []struct {
+		name      string
+		clusterID string
+		want      string
+	}{
+		{
+			name:      "should return the cluster dns of the configured cluster",
+			clusterID: "cluster-id",
+			want:      "apps.example.com",
+		},
+		{
+			name:      "should return an empty cluster dns for an unknown cluster",
+			clusterID: "unknown-cluster-id",
+			want:      "",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newKubernetesProvider(nil, &config.DataplaneClusterConfig{
+				ClusterConfig: config.NewClusterConfig(config.ClusterList{
+					{ClusterId: "cluster-id", ProviderType: api.ClusterProviderKubernetes, ClusterDNS: "apps.example.com"},
+				}),
+			})
+			got, err := provider.GetClusterDNS(&types.ClusterSpec{InternalID: tt.clusterID})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestKubernetesProvider_UnsupportedOperations(t *testing.T) {
+	g := gomega.NewWithT(t)
+	provider := newKubernetesProvider(nil, config.NewDataplaneClusterConfig())
+
+	_, err := provider.GetMachinePool("cluster-id", "machine-pool-id")
+	g.Expect(err).To(gomega.MatchError(`retrieving machine pools is not supported by the "kubernetes" cluster provider`))
+
+	_, err = provider.CreateMachinePool(&types.MachinePoolRequest{})
+	g.Expect(err).To(gomega.MatchError(`creating machine pools is not supported by the "kubernetes" cluster provider`))
+
+	_, err = provider.Create(&types.ClusterRequest{})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	_, err = provider.AddIdentityProvider(&types.ClusterSpec{}, types.IdentityProviderInfo{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
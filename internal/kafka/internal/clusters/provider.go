@@ -72,10 +72,12 @@ func NewDefaultProviderFactory(
 	clusterBuilder := NewClusterBuilder(awsConfig, gcpConfig, dataplaneClusterConfig)
 	ocmProvider := newOCMProvider(ocmClient, clusterBuilder, ocmConfig)
 	standaloneProvider := newStandaloneProvider(connectionFactory, dataplaneClusterConfig)
+	kubernetesProvider := newKubernetesProvider(connectionFactory, dataplaneClusterConfig)
 	return &DefaultProviderFactory{
 		providerContainer: map[api.ClusterProviderType]Provider{
 			api.ClusterProviderStandalone: standaloneProvider,
 			api.ClusterProviderOCM:        ocmProvider,
+			api.ClusterProviderKubernetes: kubernetesProvider,
 		},
 	}
 
@@ -30,6 +30,7 @@ func TestNewDefaultProviderFactory(t *testing.T) {
 			want: &DefaultProviderFactory{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderKubernetes: &KubernetesProvider{},
 					api.ClusterProviderOCM: &OCMProvider{
 						clusterBuilder: &clusterBuilder{
 							idGenerator: ocm.NewIDGenerator("mk-"),
@@ -68,6 +69,7 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderKubernetes: &KubernetesProvider{},
 					api.ClusterProviderOCM:        &OCMProvider{},
 				},
 			},
@@ -81,6 +83,7 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderKubernetes: &KubernetesProvider{},
 					api.ClusterProviderOCM:        &OCMProvider{},
 				},
 			},
@@ -94,6 +97,7 @@ func TestDefaultProviderFactory_GetProvider(t *testing.T) {
 			fields: fields{
 				providerContainer: map[api.ClusterProviderType]Provider{
 					api.ClusterProviderStandalone: &StandaloneProvider{},
+					api.ClusterProviderKubernetes: &KubernetesProvider{},
 					api.ClusterProviderOCM:        &OCMProvider{},
 				},
 			},
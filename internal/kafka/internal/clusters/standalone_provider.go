@@ -408,13 +408,23 @@ func (s *StandaloneProvider) ApplyResources(clusterSpec *types.ClusterSpec, reso
 }
 
 func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList, error) {
+	return getCloudProvidersOfProviderType(s.connectionFactory, api.ClusterProviderStandalone)
+}
+
+func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+	return getCloudProviderRegionsOfProviderType(s.connectionFactory, api.ClusterProviderStandalone, providerInf)
+}
+
+// getCloudProvidersOfProviderType returns the cloud providers of the non deleted clusters of the given provider type.
+// It is used by the providers whose clusters are not created by the fleet manager but registered in the database.
+func getCloudProvidersOfProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType) (*types.CloudProviderInfoList, error) {
 	type Cluster struct {
 		CloudProvider string
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("cloud_provider").
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
@@ -435,16 +445,18 @@ func (s *StandaloneProvider) GetCloudProviders() (*types.CloudProviderInfoList,
 	return &types.CloudProviderInfoList{Items: items}, nil
 }
 
-func (s *StandaloneProvider) GetCloudProviderRegions(providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
+// getCloudProviderRegionsOfProviderType returns the regions of the given cloud provider in which the non deleted clusters
+// of the given provider type are located.
+func getCloudProviderRegionsOfProviderType(connectionFactory *db.ConnectionFactory, providerType api.ClusterProviderType, providerInf types.CloudProviderInfo) (*types.CloudProviderRegionInfoList, error) {
 	type Cluster struct {
 		Region  string
 		MultiAZ bool
 	}
-	dbConn := s.connectionFactory.New().
+	dbConn := connectionFactory.New().
 		Model(&Cluster{}).
 		Distinct("region", "multi_az").
 		Where("cloud_provider = ?", providerInf.ID).
-		Where("provider_type = ?", api.ClusterProviderStandalone.String()).
+		Where("provider_type = ?", providerType.String()).
 		Where("status NOT IN (?)", api.ClusterDeletionStatuses)
 
 	var results []Cluster
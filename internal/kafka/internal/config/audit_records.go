@@ -0,0 +1,51 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/spf13/pflag"
+)
+
+type AuditRecordsConfig struct {
+	WebhookURL       string
+	WebhookTimeout   time.Duration
+	WebhookBatchSize int
+}
+
+var _ environments.ServiceValidator = &AuditRecordsConfig{}
+
+func NewAuditRecordsConfig() *AuditRecordsConfig {
+	return &AuditRecordsConfig{
+		WebhookTimeout:   10 * time.Second,
+		WebhookBatchSize: 100,
+	}
+}
+
+func (c *AuditRecordsConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.WebhookURL, "audit-records-webhook-url", c.WebhookURL, "The URL the audit records are also posted to. The audit records are only stored in the database when it is not set")
+	fs.DurationVar(&c.WebhookTimeout, "audit-records-webhook-timeout", c.WebhookTimeout, "The timeout of the requests posting the audit records to the webhook")
+	fs.IntVar(&c.WebhookBatchSize, "audit-records-webhook-batch-size", c.WebhookBatchSize, "The maximum number of audit records posted to the webhook at each reconcile")
+}
+
+func (c *AuditRecordsConfig) ReadFiles() error {
+	return nil
+}
+
+func (c *AuditRecordsConfig) Validate(env *environments.Env) error {
+	if c.WebhookURL == "" {
+		return nil
+	}
+
+	if _, err := url.ParseRequestURI(c.WebhookURL); err != nil {
+		return fmt.Errorf("invalid audit records webhook url %q: %v", c.WebhookURL, err)
+	}
+
+	if c.WebhookBatchSize < 1 {
+		return fmt.Errorf("audit records webhook batch size must be positive, got %d", c.WebhookBatchSize)
+	}
+
+	return nil
+}
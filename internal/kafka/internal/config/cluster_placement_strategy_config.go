@@ -0,0 +1,145 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/pkg/errors"
+)
+
+type ClusterPlacementStrategyType string
+
+func (t ClusterPlacementStrategyType) String() string {
+	return string(t)
+}
+
+const (
+	// FirstReadyPlacementStrategy places the kafka on the first ready cluster, in creation order, that has enough remaining capacity.
+	// This is the default placement strategy when none is configured for an instance type
+	FirstReadyPlacementStrategy ClusterPlacementStrategyType = "first-ready"
+	// BinPackPlacementStrategy places the kafka on the most utilised cluster that still has enough remaining capacity
+	BinPackPlacementStrategy ClusterPlacementStrategyType = "bin-pack"
+	// SpreadPlacementStrategy places the kafka on the least utilised cluster that has enough remaining capacity
+	SpreadPlacementStrategy ClusterPlacementStrategyType = "spread"
+	// WeightedPlacementStrategy places the kafka on the cluster with the highest score computed from the configured weights
+	WeightedPlacementStrategy ClusterPlacementStrategyType = "weighted"
+)
+
+var supportedClusterPlacementStrategyTypes = []ClusterPlacementStrategyType{
+	FirstReadyPlacementStrategy,
+	BinPackPlacementStrategy,
+	SpreadPlacementStrategy,
+	WeightedPlacementStrategy,
+}
+
+// ClusterPlacementStrategyConfig holds the cluster placement strategy to be used for each Kafka instance type.
+// The configuration is only taken into account when the dynamic scaling of data plane clusters is enabled.
+type ClusterPlacementStrategyConfig struct {
+	filePath      string
+	Configuration map[string]InstanceTypeClusterPlacementStrategyConfig
+}
+
+func NewClusterPlacementStrategyConfig() ClusterPlacementStrategyConfig {
+	return ClusterPlacementStrategyConfig{
+		filePath:      "config/cluster-placement-strategy-configuration.yaml",
+		Configuration: make(map[string]InstanceTypeClusterPlacementStrategyConfig),
+	}
+}
+
+// ForInstanceType returns the placement strategy configuration of the given instance type.
+// If the instance type is not configured, the first-ready strategy configuration is returned
+func (c *ClusterPlacementStrategyConfig) ForInstanceType(instanceTypeID string) InstanceTypeClusterPlacementStrategyConfig {
+	instanceTypeConfig, found := c.Configuration[instanceTypeID]
+	if !found || instanceTypeConfig.Strategy == "" {
+		return InstanceTypeClusterPlacementStrategyConfig{Strategy: FirstReadyPlacementStrategy}
+	}
+
+	return instanceTypeConfig
+}
+
+func (c *ClusterPlacementStrategyConfig) validate(kafkaConfig *KafkaConfig) error {
+	for instanceType, configuration := range c.Configuration {
+		err := configuration.validate(instanceType, kafkaConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *ClusterPlacementStrategyConfig) readFile() error {
+	err := shared.ReadYamlFile(c.filePath, &c.Configuration)
+	if err != nil {
+		if os.IsNotExist(err) {
+			logger.Logger.Warningf("the cluster placement strategy configuration file '%s' does not exist. The %q strategy will be used for all instance types", c.filePath, FirstReadyPlacementStrategy)
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+type InstanceTypeClusterPlacementStrategyConfig struct {
+	Strategy ClusterPlacementStrategyType `yaml:"strategy"`
+	// Weights is only taken into account for the weighted strategy
+	Weights ClusterPlacementWeights `yaml:"weights"`
+}
+
+// ClusterPlacementWeights are the weights given to each criteria when scoring a candidate data plane cluster.
+// Each criteria evaluates to a value between 0 and 1 and the cluster with the highest weighted sum wins.
+type ClusterPlacementWeights struct {
+	// Utilisation is the weight given to the ratio of consumed streaming units once the kafka is placed.
+	// A positive value favours the most utilised clusters (bin packing) while a negative value favours the least utilised ones (spread).
+	Utilisation float64 `yaml:"utilisation"`
+	// StrimziVersion is the weight given to clusters whose latest available strimzi version is ready
+	StrimziVersion float64 `yaml:"strimzi_version"`
+	// MultiAZ is the weight given to clusters whose availability zone configuration matches the one requested for the kafka
+	MultiAZ float64 `yaml:"multi_az"`
+}
+
+// EffectiveWeights returns the weights that the strategy scores candidate clusters with.
+// The bin-pack and spread strategies are predefined weights on the utilisation only.
+func (c InstanceTypeClusterPlacementStrategyConfig) EffectiveWeights() ClusterPlacementWeights {
+	switch c.Strategy {
+	case BinPackPlacementStrategy:
+		return ClusterPlacementWeights{Utilisation: 1}
+	case SpreadPlacementStrategy:
+		return ClusterPlacementWeights{Utilisation: -1}
+	default:
+		return c.Weights
+	}
+}
+
+func (c *InstanceTypeClusterPlacementStrategyConfig) validate(instanceType string, kafkaConfig *KafkaConfig) error {
+	_, err := kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	if err != nil {
+		return errors.Wrapf(err, "error validating cluster placement strategy configuration for instance type %s", instanceType)
+	}
+
+	if c.Strategy == "" {
+		return nil
+	}
+
+	supported := false
+	for _, strategy := range supportedClusterPlacementStrategyTypes {
+		if strategy == c.Strategy {
+			supported = true
+			break
+		}
+	}
+
+	if !supported {
+		return fmt.Errorf("cluster placement strategy %q for instance type %s is not supported. Supported values are %v", c.Strategy, instanceType, supportedClusterPlacementStrategyTypes)
+	}
+
+	if c.Strategy == WeightedPlacementStrategy && c.Weights == (ClusterPlacementWeights{}) {
+		return fmt.Errorf("at least one weight has to be defined for the %q cluster placement strategy of instance type %s", WeightedPlacementStrategy, instanceType)
+	}
+
+	return nil
+}
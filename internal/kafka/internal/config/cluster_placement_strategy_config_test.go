@@ -0,0 +1,142 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestClusterPlacementStrategyConfig_Validate(t *testing.T) {
+	kafkaConfig := &KafkaConfig{
+		SupportedInstanceTypes: &KafkaSupportedInstanceTypesConfig{
+			Configuration: SupportedKafkaInstanceTypesConfig{
+				[]KafkaInstanceType{
+					{
+						Id: "standard",
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                           string
+		clusterPlacementStrategyConfig ClusterPlacementStrategyConfig
+		wantErr                        bool
+	}{
+		{
+			name: "should return an error when the instance type does not exists in supported instance types",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"instance-type": {Strategy: BinPackPlacementStrategy},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the strategy is not supported",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {Strategy: "random"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the weighted strategy has no weights",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {Strategy: WeightedPlacementStrategy},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not return an error when the weighted strategy has weights",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {
+						Strategy: WeightedPlacementStrategy,
+						Weights:  ClusterPlacementWeights{Utilisation: -1, MultiAZ: 0.5},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:                           "should not return an error when the configuration is empty",
+			clusterPlacementStrategyConfig: NewClusterPlacementStrategyConfig(),
+			wantErr:                        false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := tt.clusterPlacementStrategyConfig.validate(kafkaConfig)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestClusterPlacementStrategyConfig_ForInstanceType(t *testing.T) {
+	tests := []struct {
+		name                           string
+		clusterPlacementStrategyConfig ClusterPlacementStrategyConfig
+		instanceType                   string
+		wantStrategy                   ClusterPlacementStrategyType
+		wantWeights                    ClusterPlacementWeights
+	}{
+		{
+			name:                           "should default to the first-ready strategy when the instance type is not configured",
+			clusterPlacementStrategyConfig: NewClusterPlacementStrategyConfig(),
+			instanceType:                   "standard",
+			wantStrategy:                   FirstReadyPlacementStrategy,
+			wantWeights:                    ClusterPlacementWeights{},
+		},
+		{
+			name: "should return a positive utilisation weight for the bin-pack strategy",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {Strategy: BinPackPlacementStrategy},
+				},
+			},
+			instanceType: "standard",
+			wantStrategy: BinPackPlacementStrategy,
+			wantWeights:  ClusterPlacementWeights{Utilisation: 1},
+		},
+		{
+			name: "should return a negative utilisation weight for the spread strategy",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {Strategy: SpreadPlacementStrategy, Weights: ClusterPlacementWeights{MultiAZ: 1}},
+				},
+			},
+			instanceType: "standard",
+			wantStrategy: SpreadPlacementStrategy,
+			wantWeights:  ClusterPlacementWeights{Utilisation: -1},
+		},
+		{
+			name: "should return the configured weights for the weighted strategy",
+			clusterPlacementStrategyConfig: ClusterPlacementStrategyConfig{
+				Configuration: map[string]InstanceTypeClusterPlacementStrategyConfig{
+					"standard": {Strategy: WeightedPlacementStrategy, Weights: ClusterPlacementWeights{StrimziVersion: 2, MultiAZ: 1}},
+				},
+			},
+			instanceType: "standard",
+			wantStrategy: WeightedPlacementStrategy,
+			wantWeights:  ClusterPlacementWeights{StrimziVersion: 2, MultiAZ: 1},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := tt.clusterPlacementStrategyConfig.ForInstanceType(tt.instanceType)
+			g.Expect(got.Strategy).To(gomega.Equal(tt.wantStrategy))
+			g.Expect(got.EffectiveWeights()).To(gomega.Equal(tt.wantWeights))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -23,6 +24,8 @@ import (
 
 	k8sYaml "sigs.k8s.io/yaml"
 
+	k8sYamlUtil "k8s.io/apimachinery/pkg/util/yaml"
+
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
@@ -55,6 +58,7 @@ type DataplaneClusterConfig struct {
 	ObservabilityOperatorOLMConfig              OperatorInstallationConfig
 	DynamicScalingConfig                        DynamicScalingConfig
 	NodePrewarmingConfig                        NodePrewarmingConfig
+	ClusterPlacementStrategyConfig              ClusterPlacementStrategyConfig
 }
 
 type OperatorInstallationConfig struct {
@@ -65,6 +69,10 @@ type OperatorInstallationConfig struct {
 	SubscriptionConfig      *operatorsv1alpha1.SubscriptionConfig
 	SubscriptionConfigFile  string
 	SubscriptionStartingCSV string
+	// ManifestsFile is a file containing the plain Kubernetes manifests used to install the operator
+	// on `kubernetes` clusters. When empty, the operator is installed through OLM.
+	ManifestsFile string
+	Manifests     []map[string]interface{}
 }
 
 const (
@@ -115,7 +123,7 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 		ClusterConfig:                               &ClusterConfig{},
 		EnableReadyDataPlaneClustersReconcile:       true,
 		EnableKafkaSreIdentityProviderConfiguration: true,
-		Kubeconfig:                                  getDefaultKubeconfig(),
+		Kubeconfig: getDefaultKubeconfig(),
 		StrimziOperatorOLMConfig: OperatorInstallationConfig{
 			IndexImage:             defaultStrimziOperatorIndexImage,
 			Namespace:              constants.StrimziOperatorNamespace,
@@ -136,8 +144,9 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 			IndexImage:              defaultObservabilityOperatorIndexImage,
 			SubscriptionStartingCSV: defaultObservabilityOperatorStartingCSV,
 		},
-		DynamicScalingConfig: NewDynamicScalingConfig(),
-		NodePrewarmingConfig: NewNodePrewarmingConfig(),
+		DynamicScalingConfig:           NewDynamicScalingConfig(),
+		NodePrewarmingConfig:           NewNodePrewarmingConfig(),
+		ClusterPlacementStrategyConfig: NewClusterPlacementStrategyConfig(),
 	}
 }
 
@@ -154,6 +163,12 @@ type ManualCluster struct {
 	ProviderType          api.ClusterProviderType `yaml:"provider_type"`
 	ClusterDNS            string                  `yaml:"cluster_dns"`
 	SupportedInstanceType string                  `yaml:"supported_instance_type"`
+	// The following fields are used to connect to `kubernetes` clusters only.
+	// Either a kubeconfig file or an API server URL with a service account token file has to be provided.
+	KubeconfigFile           string `yaml:"kubeconfig_file"`
+	APIServerURL             string `yaml:"api_server_url"`
+	ServiceAccountTokenFile  string `yaml:"service_account_token_file"`
+	CertificateAuthorityFile string `yaml:"certificate_authority_file"`
 }
 
 func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -187,6 +202,24 @@ func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	if c.ProviderType == api.ClusterProviderKubernetes {
+		if c.ClusterDNS == "" {
+			return errors.Errorf("kubernetes cluster with id %s does not have the cluster dns field provided", c.ClusterId)
+		}
+
+		if c.KubeconfigFile == "" && (c.APIServerURL == "" || c.ServiceAccountTokenFile == "") {
+			return errors.Errorf("kubernetes cluster with id %s must provide either the kubeconfig_file field or both the api_server_url and service_account_token_file fields", c.ClusterId)
+		}
+
+		if c.KubeconfigFile != "" && (c.APIServerURL != "" || c.ServiceAccountTokenFile != "") {
+			return errors.Errorf("kubernetes cluster with id %s cannot provide both the kubeconfig_file field and the api_server_url or service_account_token_file fields", c.ClusterId)
+		}
+
+		if c.Status == api.ClusterAccepted {
+			c.Status = api.ClusterProvisioning // force to cluster provisioning status as we do not want to call KubernetesProvider to create the cluster.
+		}
+	}
+
 	if c.SupportedInstanceType == "" {
 		c.SupportedInstanceType = api.AllInstanceTypeSupport.String()
 	}
@@ -210,6 +243,12 @@ func NewClusterConfig(clusters ClusterList) *ClusterConfig {
 	})
 }
 
+// GetManualCluster returns the manual cluster configuration of the cluster with the given id, if any
+func (conf *ClusterConfig) GetManualCluster(clusterId string) (ManualCluster, bool) {
+	manualCluster, exist := conf.clusterConfigMap[clusterId]
+	return manualCluster, exist
+}
+
 func (conf *ClusterConfig) GetCapacityForRegion(region string) int {
 	var capacity = 0
 	for _, cluster := range conf.clusterList {
@@ -325,16 +364,19 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.StrimziOperatorOLMConfig.SubscriptionStartingCSV, "strimzi-operator-starting-csv", c.StrimziOperatorOLMConfig.SubscriptionStartingCSV, "Strimzi operator subscription starting CSV")
 	fs.StringVar(&c.StrimziOperatorOLMConfig.SubscriptionChannel, "strimzi-operator-sub-channel", c.StrimziOperatorOLMConfig.SubscriptionChannel, "Strimzi operator subscription channel")
 	fs.StringVar(&c.StrimziOperatorOLMConfig.SubscriptionConfigFile, "strimzi-operator-subscription-config-file", c.StrimziOperatorOLMConfig.SubscriptionConfigFile, "Strimzi operator subscription config. This is applied for standalone clusters only. The configuration must be of type https://pkg.go.dev/github.com/operator-framework/api@v0.3.25/pkg/operators/v1alpha1?utm_source=gopls#SubscriptionConfig")
+	fs.StringVar(&c.StrimziOperatorOLMConfig.ManifestsFile, "strimzi-operator-manifests-file", c.StrimziOperatorOLMConfig.ManifestsFile, "File containing the plain manifests used to install the Strimzi operator on kubernetes clusters. When not set, the operator is installed through OLM")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.IndexImage, "kas-fleetshard-operator-index-image", c.KasFleetshardOperatorOLMConfig.IndexImage, "kas-fleetshard operator index image")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.Namespace, "kas-fleetshard-operator-namespace", c.KasFleetshardOperatorOLMConfig.Namespace, "kas-fleetshard operator namespace")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.Package, "kas-fleetshard-operator-package", c.KasFleetshardOperatorOLMConfig.Package, "kas-fleetshard operator package")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionStartingCSV, "kas-fleetshard-operator-starting-csv", c.KasFleetshardOperatorOLMConfig.SubscriptionStartingCSV, "kas-fleetshard operator subscription starting CSV")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard-operator-sub-channel", c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard operator subscription channel")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionConfigFile, "kas-fleetshard-operator-subscription-config-file", c.KasFleetshardOperatorOLMConfig.SubscriptionConfigFile, "kas-fleetshard operator subscription config. This is applied for standalone clusters only. The configuration must be of type https://pkg.go.dev/github.com/operator-framework/api@v0.3.25/pkg/operators/v1alpha1?utm_source=gopls#SubscriptionConfig")
+	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.ManifestsFile, "kas-fleetshard-operator-manifests-file", c.KasFleetshardOperatorOLMConfig.ManifestsFile, "File containing the plain manifests used to install the kas-fleetshard operator on kubernetes clusters. When not set, the operator is installed through OLM")
 	fs.StringVar(&c.ObservabilityOperatorOLMConfig.IndexImage, "observability-operator-index-image", c.ObservabilityOperatorOLMConfig.IndexImage, "Observability operator index image")
 	fs.StringVar(&c.ObservabilityOperatorOLMConfig.SubscriptionStartingCSV, "observability-operator-starting-csv", c.ObservabilityOperatorOLMConfig.SubscriptionStartingCSV, "Observability operator subscription starting CSV")
 	fs.StringVar(&c.DynamicScalingConfig.filePath, "dynamic-scaling-config-file", c.DynamicScalingConfig.filePath, "File path to a file containing the dynamic scaling configuration")
 	fs.StringVar(&c.NodePrewarmingConfig.filePath, "node-prewarming-config-file", c.NodePrewarmingConfig.filePath, "File path to a file containing the node prewarming configuration")
+	fs.StringVar(&c.ClusterPlacementStrategyConfig.filePath, "cluster-placement-strategy-config-file", c.ClusterPlacementStrategyConfig.filePath, "File path to a file containing the cluster placement strategy configuration per instance type")
 }
 
 func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
@@ -347,6 +389,11 @@ func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
 		if err != nil {
 			return err
 		}
+
+		err = c.ClusterPlacementStrategyConfig.validate(kafkaConfig)
+		if err != nil {
+			return err
+		}
 	}
 
 	return c.NodePrewarmingConfig.validate(kafkaConfig)
@@ -390,6 +437,17 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 			}
 		}
 
+		// validate that the credentials of kubernetes clusters can be found
+		for _, cluster := range c.ClusterConfig.clusterList {
+			if cluster.ProviderType != api.ClusterProviderKubernetes {
+				continue
+			}
+			validationErr := validateKubernetesClusterCredentialFiles(cluster)
+			if validationErr != nil {
+				return validationErr
+			}
+		}
+
 		err = readOperatorsSubscriptionConfigFile(c.StrimziOperatorOLMConfig.SubscriptionConfigFile, &c.StrimziOperatorOLMConfig.SubscriptionConfig)
 		if err != nil {
 			if os.IsNotExist(err) {
@@ -407,6 +465,16 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 				return err
 			}
 		}
+
+		err = readOperatorManifestsFile(c.StrimziOperatorOLMConfig.ManifestsFile, &c.StrimziOperatorOLMConfig.Manifests)
+		if err != nil {
+			return err
+		}
+
+		err = readOperatorManifestsFile(c.KasFleetshardOperatorOLMConfig.ManifestsFile, &c.KasFleetshardOperatorOLMConfig.Manifests)
+		if err != nil {
+			return err
+		}
 	}
 
 	if c.IsDataPlaneAutoScalingEnabled() {
@@ -414,6 +482,11 @@ func (c *DataplaneClusterConfig) ReadFiles() error {
 		if err != nil {
 			return err
 		}
+
+		err = c.ClusterPlacementStrategyConfig.readFile()
+		if err != nil {
+			return err
+		}
 	}
 
 	err := readOnlyUserListFile(c.ReadOnlyUserListFile, &c.ReadOnlyUserList)
@@ -460,6 +533,21 @@ func validateClusterIsInKubeconfigContext(rawConfig clientcmdapi.Config, cluster
 	return errors.Errorf("standalone cluster with ID: %s, and Name %s not in kubeconfig context", cluster.ClusterId, cluster.Name)
 }
 
+func validateKubernetesClusterCredentialFiles(cluster ManualCluster) error {
+	for _, file := range []string{cluster.KubeconfigFile, cluster.ServiceAccountTokenFile, cluster.CertificateAuthorityFile} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(shared.BuildFullFilePath(file)); err != nil {
+			if os.IsNotExist(err) {
+				return errors.Errorf("kubernetes cluster with ID: %s references the file %s which does not exist", cluster.ClusterId, file)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func readDataPlaneClusterConfig(file string) (ClusterList, error) {
 	fileContents, err := shared.ReadFile(file)
 	if err != nil {
@@ -486,6 +574,35 @@ func readOperatorsSubscriptionConfigFile(file string, subscriptionConfig **opera
 	return k8sYaml.UnmarshalStrict([]byte(fileContents), subscriptionConfig)
 }
 
+// readOperatorManifestsFile reads the plain Kubernetes manifests, separated by '---', contained in the given file.
+// Nothing is read when the file is not specified.
+func readOperatorManifestsFile(file string, manifests *[]map[string]interface{}) error {
+	if file == "" {
+		return nil
+	}
+
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	decoder := k8sYamlUtil.NewYAMLOrJSONDecoder(strings.NewReader(fileContents), 4096)
+	for {
+		manifest := map[string]interface{}{}
+		err := decoder.Decode(&manifest)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read manifests file %s", file)
+		}
+		if len(manifest) == 0 {
+			continue
+		}
+		*manifests = append(*manifests, manifest)
+	}
+}
+
 func (c *DataplaneClusterConfig) FindClusterNameByClusterId(clusterId string) string {
 	for _, cluster := range c.ClusterConfig.clusterList {
 		if cluster.ClusterId == clusterId {
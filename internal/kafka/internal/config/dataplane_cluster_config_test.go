@@ -1,6 +1,7 @@
 package config
 
 import (
+	"os"
 	"testing"
 
 	"gopkg.in/yaml.v2"
@@ -9,6 +10,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 
 	"github.com/onsi/gomega"
 )
@@ -510,6 +512,119 @@ supported_instance_type: "developer"
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return no error if ProviderType is kubernetes with a kubeconfig file",
+			input: `
+---
+cluster_id: "test"
+cloud_provider: "aws"
+cluster_dns: "test"
+region: "east-1"
+schedulable: true
+kafka_instance_limit: 1
+provider_type: "kubernetes"
+kubeconfig_file: "secrets/kubeconfig"
+`,
+			output: ManualCluster{
+				ClusterId:             "test",
+				CloudProvider:         "aws",
+				ClusterDNS:            "test",
+				Region:                "east-1",
+				Schedulable:           true,
+				KafkaInstanceLimit:    1,
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+				KubeconfigFile:        "secrets/kubeconfig",
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return no error if ProviderType is kubernetes with an API server URL and a service account token file",
+			input: `
+---
+cluster_id: "test"
+cloud_provider: "aws"
+cluster_dns: "test"
+region: "east-1"
+provider_type: "kubernetes"
+api_server_url: "https://api.example.com:6443"
+service_account_token_file: "secrets/token"
+certificate_authority_file: "secrets/ca.crt"
+`,
+			output: ManualCluster{
+				ClusterId:                "test",
+				CloudProvider:            "aws",
+				ClusterDNS:               "test",
+				Region:                   "east-1",
+				Status:                   api.ClusterProvisioning,
+				ProviderType:             api.ClusterProviderKubernetes,
+				SupportedInstanceType:    api.AllInstanceTypeSupport.String(),
+				APIServerURL:             "https://api.example.com:6443",
+				ServiceAccountTokenFile:  "secrets/token",
+				CertificateAuthorityFile: "secrets/ca.crt",
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if ProviderType is kubernetes and no credentials are set",
+			input: `
+---
+cluster_id: "test"
+cluster_dns: "test"
+provider_type: "kubernetes"
+api_server_url: "https://api.example.com:6443"
+`,
+			output: ManualCluster{
+				ClusterId:             "test",
+				ClusterDNS:            "test",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+				APIServerURL:          "https://api.example.com:6443",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if ProviderType is kubernetes and both a kubeconfig file and a service account token are set",
+			input: `
+---
+cluster_id: "test"
+cluster_dns: "test"
+provider_type: "kubernetes"
+kubeconfig_file: "secrets/kubeconfig"
+api_server_url: "https://api.example.com:6443"
+service_account_token_file: "secrets/token"
+`,
+			output: ManualCluster{
+				ClusterId:               "test",
+				ClusterDNS:              "test",
+				Status:                  api.ClusterProvisioning,
+				ProviderType:            api.ClusterProviderKubernetes,
+				SupportedInstanceType:   api.AllInstanceTypeSupport.String(),
+				KubeconfigFile:          "secrets/kubeconfig",
+				APIServerURL:            "https://api.example.com:6443",
+				ServiceAccountTokenFile: "secrets/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if ProviderType is kubernetes and no ClusterDNS is set",
+			input: `
+---
+cluster_id: "test"
+provider_type: "kubernetes"
+kubeconfig_file: "secrets/kubeconfig"
+`,
+			output: ManualCluster{
+				ClusterId:             "test",
+				Status:                api.ClusterProvisioning,
+				ProviderType:          api.ClusterProviderKubernetes,
+				SupportedInstanceType: api.AllInstanceTypeSupport.String(),
+				KubeconfigFile:        "secrets/kubeconfig",
+			},
+			wantErr: true,
+		},
 		{
 			name: "should use the provided value if they are set",
 			input: `
@@ -1030,3 +1145,112 @@ func Test_readKubeconfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateKubernetesClusterCredentialFiles(t *testing.T) {
+	existingFile, err := shared.CreateTempFileFromStringData("kubeconfig", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(existingFile)
+
+	tests := []struct {
+		name    string
+		cluster ManualCluster
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error when the kubeconfig file exists",
+			cluster: ManualCluster{ClusterId: "test01", KubeconfigFile: existingFile},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error when the service account token file does not exist",
+			cluster: ManualCluster{ClusterId: "test01", APIServerURL: "https://api.example.com:6443", ServiceAccountTokenFile: "invalid"},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when the certificate authority file does not exist",
+			cluster: ManualCluster{ClusterId: "test01", APIServerURL: "https://api.example.com:6443", ServiceAccountTokenFile: existingFile, CertificateAuthorityFile: "invalid"},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := validateKubernetesClusterCredentialFiles(tt.cluster)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_readOperatorManifestsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		noFile   bool
+		want     []map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:   "should not read anything when no file is specified",
+			noFile: true,
+		},
+		{
+			name: "should read all the manifests of the file and skip the empty documents",
+			contents: `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: strimzi
+---
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: strimzi-cluster-operator
+  namespace: strimzi
+`,
+			want: []map[string]interface{}{
+				{
+					"apiVersion": "v1",
+					"kind":       "Namespace",
+					"metadata":   map[string]interface{}{"name": "strimzi"},
+				},
+				{
+					"apiVersion": "v1",
+					"kind":       "ServiceAccount",
+					"metadata":   map[string]interface{}{"name": "strimzi-cluster-operator", "namespace": "strimzi"},
+				},
+			},
+		},
+		{
+			name:     "should return an error when the file contains invalid manifests",
+			contents: "apiVersion: [v1",
+			wantErr:  true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			file := ""
+			if !tt.noFile {
+				var err error
+				file, err = shared.CreateTempFileFromStringData("manifests", tt.contents)
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				defer os.Remove(file)
+			}
+
+			var manifests []map[string]interface{}
+			err := readOperatorManifestsFile(file, &manifests)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(manifests).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
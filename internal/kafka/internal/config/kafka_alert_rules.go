@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/spf13/pflag"
+)
+
+// minKafkaAlertRulesEvaluationInterval is the shortest interval the alert rules can be evaluated at. The metrics of the kafkas
+// are scraped by Observatorium every few minutes, evaluating the rules more often would only add load to Observatorium
+const minKafkaAlertRulesEvaluationInterval = time.Minute
+
+type KafkaAlertRulesConfig struct {
+	EvaluationInterval time.Duration
+	// MaxRulesPerKafka is the maximum number of alert rules the owners of a kafka can define on it
+	MaxRulesPerKafka int
+	WebhookTimeout   time.Duration
+}
+
+var _ environments.ServiceValidator = &KafkaAlertRulesConfig{}
+
+func NewKafkaAlertRulesConfig() *KafkaAlertRulesConfig {
+	return &KafkaAlertRulesConfig{
+		EvaluationInterval: 5 * time.Minute,
+		MaxRulesPerKafka:   10,
+		WebhookTimeout:     10 * time.Second,
+	}
+}
+
+func (c *KafkaAlertRulesConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.EvaluationInterval, "kafka-alert-rules-evaluation-interval", c.EvaluationInterval, "The interval the alert rules of the Kafka instances are evaluated at")
+	fs.IntVar(&c.MaxRulesPerKafka, "kafka-alert-rules-max-per-kafka", c.MaxRulesPerKafka, "The maximum number of alert rules that can be defined on a Kafka instance")
+	fs.DurationVar(&c.WebhookTimeout, "kafka-alert-rules-webhook-timeout", c.WebhookTimeout, "The timeout of the requests posting the alerts to the webhooks of the alert rules")
+}
+
+func (c *KafkaAlertRulesConfig) ReadFiles() error {
+	return nil
+}
+
+func (c *KafkaAlertRulesConfig) Validate(env *environments.Env) error {
+	if c.EvaluationInterval < minKafkaAlertRulesEvaluationInterval {
+		return fmt.Errorf("kafka alert rules evaluation interval must be at least %s, got %s", minKafkaAlertRulesEvaluationInterval, c.EvaluationInterval)
+	}
+
+	if c.MaxRulesPerKafka < 1 {
+		return fmt.Errorf("the maximum number of alert rules per kafka must be positive, got %d", c.MaxRulesPerKafka)
+	}
+
+	return nil
+}
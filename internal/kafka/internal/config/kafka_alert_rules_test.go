@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_KafkaAlertRulesConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaAlertRulesConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "should accept the default configuration",
+			wantErr: false,
+		},
+		{
+			name: "should accept an evaluation interval of one minute",
+			modifyFn: func(c *KafkaAlertRulesConfig) {
+				c.EvaluationInterval = time.Minute
+			},
+			wantErr: false,
+		},
+		{
+			name: "should reject an evaluation interval shorter than one minute",
+			modifyFn: func(c *KafkaAlertRulesConfig) {
+				c.EvaluationInterval = 30 * time.Second
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject a maximum number of rules per kafka that is not positive",
+			modifyFn: func(c *KafkaAlertRulesConfig) {
+				c.MaxRulesPerKafka = 0
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewKafkaAlertRulesConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(c)
+			}
+			g.Expect(c.Validate(nil) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
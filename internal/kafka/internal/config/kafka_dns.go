@@ -0,0 +1,111 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// KafkaDNSRoute53Provider manages the CNAME records of the kafkas in AWS Route53
+	KafkaDNSRoute53Provider = "route53"
+	// KafkaDNSRFC2136Provider manages the CNAME records of the kafkas with RFC 2136 dynamic updates, e.g. in BIND or PowerDNS
+	KafkaDNSRFC2136Provider = "rfc2136"
+	// KafkaDNSNoopProvider does not manage the CNAME records of the kafkas, e.g. when they are resolved by a static wildcard record
+	KafkaDNSNoopProvider = "noop"
+)
+
+// KafkaDNSProviders are the names of the available DNS providers
+var KafkaDNSProviders = []string{KafkaDNSRoute53Provider, KafkaDNSRFC2136Provider, KafkaDNSNoopProvider}
+
+// rfc2136TSIGAlgorithms are the TSIG algorithms supported to sign the RFC 2136 dynamic updates
+var rfc2136TSIGAlgorithms = []string{"hmac-sha1", "hmac-sha256", "hmac-sha512"}
+
+type KafkaDNSConfig struct {
+	Provider string
+
+	// Used by the rfc2136 provider
+	RFC2136Server         string
+	RFC2136Zone           string
+	RFC2136Transport      string
+	RFC2136Timeout        time.Duration
+	RFC2136TSIGKeyName    string
+	RFC2136TSIGAlgorithm  string
+	RFC2136TSIGSecret     string
+	RFC2136TSIGSecretFile string
+}
+
+var _ environments.ServiceValidator = &KafkaDNSConfig{}
+
+func NewKafkaDNSConfig() *KafkaDNSConfig {
+	return &KafkaDNSConfig{
+		Provider:             KafkaDNSRoute53Provider,
+		RFC2136Transport:     "udp",
+		RFC2136Timeout:       10 * time.Second,
+		RFC2136TSIGAlgorithm: "hmac-sha256",
+	}
+}
+
+func (c *KafkaDNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Provider, "kafka-dns-provider", c.Provider, "The DNS provider managing the CNAME records of the Kafka instances when their registration is enabled. The available options are: 'route53' (default), 'rfc2136' and 'noop'")
+	fs.StringVar(&c.RFC2136Server, "kafka-dns-rfc2136-server", c.RFC2136Server, "The address (host:port) of the DNS server the RFC 2136 dynamic updates are sent to")
+	fs.StringVar(&c.RFC2136Zone, "kafka-dns-rfc2136-zone", c.RFC2136Zone, "The DNS zone updated by the RFC 2136 dynamic updates. Defaults to the Kafka domain name")
+	fs.StringVar(&c.RFC2136Transport, "kafka-dns-rfc2136-transport", c.RFC2136Transport, "The transport used to send the RFC 2136 dynamic updates. The available options are: 'udp' (default) and 'tcp'")
+	fs.DurationVar(&c.RFC2136Timeout, "kafka-dns-rfc2136-timeout", c.RFC2136Timeout, "The timeout of the RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136TSIGKeyName, "kafka-dns-rfc2136-tsig-key-name", c.RFC2136TSIGKeyName, "The name of the TSIG key signing the RFC 2136 dynamic updates. The updates are not signed when empty")
+	fs.StringVar(&c.RFC2136TSIGAlgorithm, "kafka-dns-rfc2136-tsig-algorithm", c.RFC2136TSIGAlgorithm, "The algorithm of the TSIG key signing the RFC 2136 dynamic updates. The available options are: 'hmac-sha1', 'hmac-sha256' (default) and 'hmac-sha512'")
+	fs.StringVar(&c.RFC2136TSIGSecretFile, "kafka-dns-rfc2136-tsig-secret-file", c.RFC2136TSIGSecretFile, "File containing the base64 encoded secret of the TSIG key signing the RFC 2136 dynamic updates")
+}
+
+func (c *KafkaDNSConfig) ReadFiles() error {
+	if c.RFC2136TSIGSecretFile == "" {
+		return nil
+	}
+
+	return shared.ReadFileValueString(c.RFC2136TSIGSecretFile, &c.RFC2136TSIGSecret)
+}
+
+func (c *KafkaDNSConfig) Validate(env *environments.Env) error {
+	switch c.Provider {
+	case KafkaDNSRoute53Provider, KafkaDNSNoopProvider:
+		return nil
+	case KafkaDNSRFC2136Provider:
+		return c.validateRFC2136()
+	default:
+		return fmt.Errorf("unknown kafka dns provider %q: the available options are %q", c.Provider, KafkaDNSProviders)
+	}
+}
+
+func (c *KafkaDNSConfig) validateRFC2136() error {
+	if _, _, err := net.SplitHostPort(c.RFC2136Server); err != nil {
+		return fmt.Errorf("invalid kafka dns rfc2136 server %q: %v", c.RFC2136Server, err)
+	}
+
+	if c.RFC2136Transport != "udp" && c.RFC2136Transport != "tcp" {
+		return fmt.Errorf("unknown kafka dns rfc2136 transport %q: the available options are \"udp\" and \"tcp\"", c.RFC2136Transport)
+	}
+
+	if c.RFC2136Timeout <= 0 {
+		return fmt.Errorf("kafka dns rfc2136 timeout must be positive, got %s", c.RFC2136Timeout)
+	}
+
+	if c.RFC2136TSIGKeyName == "" {
+		return nil
+	}
+
+	if !arrays.Contains(rfc2136TSIGAlgorithms, c.RFC2136TSIGAlgorithm) {
+		return fmt.Errorf("unknown kafka dns rfc2136 tsig algorithm %q: the available options are %q", c.RFC2136TSIGAlgorithm, rfc2136TSIGAlgorithms)
+	}
+
+	if secret, err := base64.StdEncoding.DecodeString(c.RFC2136TSIGSecret); err != nil || len(secret) == 0 {
+		return fmt.Errorf("the secret of the kafka dns rfc2136 tsig key %q must be a non empty base64 encoded string", c.RFC2136TSIGKeyName)
+	}
+
+	return nil
+}
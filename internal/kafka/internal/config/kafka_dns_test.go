@@ -0,0 +1,103 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_KafkaDNSConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaDNSConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "should accept the default configuration",
+			wantErr: false,
+		},
+		{
+			name: "should accept the noop provider",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSNoopProvider
+			},
+			wantErr: false,
+		},
+		{
+			name: "should accept the rfc2136 provider with a signing key",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "ns1.example.com:53"
+				c.RFC2136TSIGKeyName = "kas-fleet-manager"
+				c.RFC2136TSIGSecret = "c2VjcmV0"
+			},
+			wantErr: false,
+		},
+		{
+			name: "should accept the rfc2136 provider without signing key",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "127.0.0.1:5353"
+				c.RFC2136Transport = "tcp"
+			},
+			wantErr: false,
+		},
+		{
+			name: "should reject the rfc2136 provider without port in the server address",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "ns1.example.com"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unknown rfc2136 transport",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "ns1.example.com:53"
+				c.RFC2136Transport = "quic"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unknown tsig algorithm",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "ns1.example.com:53"
+				c.RFC2136TSIGKeyName = "kas-fleet-manager"
+				c.RFC2136TSIGAlgorithm = "hmac-md5"
+				c.RFC2136TSIGSecret = "c2VjcmV0"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject a tsig key without secret",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = KafkaDNSRFC2136Provider
+				c.RFC2136Server = "ns1.example.com:53"
+				c.RFC2136TSIGKeyName = "kas-fleet-manager"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unknown provider",
+			modifyFn: func(c *KafkaDNSConfig) {
+				c.Provider = "cloudflare"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewKafkaDNSConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(c)
+			}
+			g.Expect(c.Validate(nil) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// KafkaStatusEventsLogSink logs the kafka status events
+	KafkaStatusEventsLogSink = "log"
+	// KafkaStatusEventsWebhookSink posts the kafka status events to a HTTP webhook
+	KafkaStatusEventsWebhookSink = "webhook"
+)
+
+type KafkaStatusEventsConfig struct {
+	Sink           string
+	WebhookURL     string
+	WebhookTimeout time.Duration
+	BatchSize      int
+	MaxAttempts    int
+}
+
+var _ environments.ServiceValidator = &KafkaStatusEventsConfig{}
+
+func NewKafkaStatusEventsConfig() *KafkaStatusEventsConfig {
+	return &KafkaStatusEventsConfig{
+		Sink:           KafkaStatusEventsLogSink,
+		WebhookTimeout: 10 * time.Second,
+		BatchSize:      100,
+		MaxAttempts:    100,
+	}
+}
+
+func (c *KafkaStatusEventsConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Sink, "kafka-status-events-sink", c.Sink, "The sink the kafka status events are published to. The available options are: 'log' (default) and 'webhook'")
+	fs.StringVar(&c.WebhookURL, "kafka-status-events-webhook-url", c.WebhookURL, "The URL the kafka status events are posted to when the 'webhook' sink is used")
+	fs.DurationVar(&c.WebhookTimeout, "kafka-status-events-webhook-timeout", c.WebhookTimeout, "The timeout of the requests posting the kafka status events to the webhook")
+	fs.IntVar(&c.BatchSize, "kafka-status-events-batch-size", c.BatchSize, "The maximum number of kafka status events published at each reconcile")
+	fs.IntVar(&c.MaxAttempts, "kafka-status-events-max-attempts", c.MaxAttempts, "The number of failed attempts to publish a kafka status event after which it is dead-lettered, so that it no longer holds back the following events")
+}
+
+func (c *KafkaStatusEventsConfig) ReadFiles() error {
+	return nil
+}
+
+func (c *KafkaStatusEventsConfig) Validate(env *environments.Env) error {
+	switch c.Sink {
+	case KafkaStatusEventsLogSink:
+	case KafkaStatusEventsWebhookSink:
+		if _, err := url.ParseRequestURI(c.WebhookURL); err != nil {
+			return fmt.Errorf("invalid kafka status events webhook url %q: %v", c.WebhookURL, err)
+		}
+	default:
+		return fmt.Errorf("unknown kafka status events sink %q: the available options are %q and %q", c.Sink, KafkaStatusEventsLogSink, KafkaStatusEventsWebhookSink)
+	}
+
+	if c.BatchSize < 1 {
+		return fmt.Errorf("kafka status events batch size must be positive, got %d", c.BatchSize)
+	}
+
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("kafka status events max attempts must be positive, got %d", c.MaxAttempts)
+	}
+
+	return nil
+}
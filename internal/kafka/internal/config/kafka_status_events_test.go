@@ -0,0 +1,69 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_KafkaStatusEventsConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaStatusEventsConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "should accept the default configuration",
+			wantErr: false,
+		},
+		{
+			name: "should accept the webhook sink with a valid url",
+			modifyFn: func(c *KafkaStatusEventsConfig) {
+				c.Sink = KafkaStatusEventsWebhookSink
+				c.WebhookURL = "https://portal.example.com/hooks/kafkas"
+			},
+			wantErr: false,
+		},
+		{
+			name: "should reject the webhook sink without url",
+			modifyFn: func(c *KafkaStatusEventsConfig) {
+				c.Sink = KafkaStatusEventsWebhookSink
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unknown sink",
+			modifyFn: func(c *KafkaStatusEventsConfig) {
+				c.Sink = "kafka"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject a batch size that is not positive",
+			modifyFn: func(c *KafkaStatusEventsConfig) {
+				c.BatchSize = 0
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject a maximum number of attempts that is not positive",
+			modifyFn: func(c *KafkaStatusEventsConfig) {
+				c.MaxAttempts = 0
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewKafkaStatusEventsConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(c)
+			}
+			g.Expect(c.Validate(nil) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
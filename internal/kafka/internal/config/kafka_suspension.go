@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/spf13/pflag"
+)
+
+// minKafkaIdleSuspensionPeriod is the shortest period without traffic after which a kafka can be considered idle.
+// The traffic of the kafkas is scraped by Observatorium every few minutes, shorter periods would suspend kafkas that are in use
+const minKafkaIdleSuspensionPeriod = time.Hour
+
+type KafkaSuspensionConfig struct {
+	// UserSuspendableInstanceTypes are the instance types of the kafkas that can be suspended and resumed by their owners
+	UserSuspendableInstanceTypes []string
+	// EnableIdleSuspension enables the suspension of the kafkas of the user suspendable instance types that had no traffic
+	// during the IdleSuspensionPeriod
+	EnableIdleSuspension bool
+	IdleSuspensionPeriod time.Duration
+}
+
+var _ environments.ServiceValidator = &KafkaSuspensionConfig{}
+
+func NewKafkaSuspensionConfig() *KafkaSuspensionConfig {
+	return &KafkaSuspensionConfig{
+		UserSuspendableInstanceTypes: []string{types.DEVELOPER.String()},
+		EnableIdleSuspension:         false,
+		IdleSuspensionPeriod:         24 * time.Hour,
+	}
+}
+
+func (c *KafkaSuspensionConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.UserSuspendableInstanceTypes, "kafka-user-suspendable-instance-types", c.UserSuspendableInstanceTypes, "The instance types of the Kafka instances that can be suspended and resumed by their owners. Defaults to 'developer'")
+	fs.BoolVar(&c.EnableIdleSuspension, "enable-kafka-idle-suspension", c.EnableIdleSuspension, "Enable the suspension of the Kafka instances of the user suspendable instance types that had no produce nor consume traffic during the idle suspension period")
+	fs.DurationVar(&c.IdleSuspensionPeriod, "kafka-idle-suspension-period", c.IdleSuspensionPeriod, "The period without produce nor consume traffic after which a Kafka instance is suspended when the idle suspension is enabled")
+}
+
+func (c *KafkaSuspensionConfig) ReadFiles() error {
+	return nil
+}
+
+func (c *KafkaSuspensionConfig) Validate(env *environments.Env) error {
+	for _, instanceType := range c.UserSuspendableInstanceTypes {
+		if !arrays.Contains(types.ValidKafkaInstanceTypes, instanceType) {
+			return fmt.Errorf("unknown user suspendable kafka instance type %q: the available options are %q", instanceType, types.ValidKafkaInstanceTypes)
+		}
+	}
+
+	if c.EnableIdleSuspension && c.IdleSuspensionPeriod < minKafkaIdleSuspensionPeriod {
+		return fmt.Errorf("kafka idle suspension period must be at least %s, got %s", minKafkaIdleSuspensionPeriod, c.IdleSuspensionPeriod)
+	}
+
+	return nil
+}
+
+// IsUserSuspendable returns whether the kafkas of the given instance type can be suspended and resumed by their owners
+func (c *KafkaSuspensionConfig) IsUserSuspendable(instanceType string) bool {
+	return arrays.Contains(c.UserSuspendableInstanceTypes, instanceType)
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_KafkaSuspensionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaSuspensionConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "should accept the default configuration",
+			wantErr: false,
+		},
+		{
+			name: "should accept the idle suspension with a period of at least one hour",
+			modifyFn: func(c *KafkaSuspensionConfig) {
+				c.EnableIdleSuspension = true
+				c.IdleSuspensionPeriod = time.Hour
+			},
+			wantErr: false,
+		},
+		{
+			name: "should accept a period shorter than one hour when the idle suspension is disabled",
+			modifyFn: func(c *KafkaSuspensionConfig) {
+				c.IdleSuspensionPeriod = time.Minute
+			},
+			wantErr: false,
+		},
+		{
+			name: "should reject the idle suspension with a period shorter than one hour",
+			modifyFn: func(c *KafkaSuspensionConfig) {
+				c.EnableIdleSuspension = true
+				c.IdleSuspensionPeriod = 30 * time.Minute
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unknown instance type",
+			modifyFn: func(c *KafkaSuspensionConfig) {
+				c.UserSuspendableInstanceTypes = []string{"developer", "eval"}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewKafkaSuspensionConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(c)
+			}
+			g.Expect(c.Validate(nil) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
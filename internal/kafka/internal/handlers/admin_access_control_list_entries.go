@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminAccessControlListEntriesHandler struct {
+	accessControlListEntryService services.AccessControlListEntryService
+	accessControlListReloader     *acl.AccessControlListReloader
+}
+
+func NewAdminAccessControlListEntriesHandler(accessControlListEntryService services.AccessControlListEntryService, accessControlListReloader *acl.AccessControlListReloader) *adminAccessControlListEntriesHandler {
+	return &adminAccessControlListEntriesHandler{
+		accessControlListEntryService: accessControlListEntryService,
+		accessControlListReloader:     accessControlListReloader,
+	}
+}
+
+// Create denies a user or accepts an organisation. The entries are reloaded straight away, so that the entry is enforced by this
+// instance of the fleet manager without waiting for the next reload
+func (h adminAccessControlListEntriesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var entryRequest private.AccessControlListEntryRequest
+	ctx := r.Context()
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &entryRequest,
+		Validate: []handlers.Validate{
+			validateAccessControlListEntryRequest(&entryRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+			username, _ := claims.GetUsername()
+
+			entry := presenters.ConvertAccessControlListEntryRequest(entryRequest, username)
+			if err := h.accessControlListEntryService.Create(entry); err != nil {
+				return nil, err
+			}
+			// the other replicas only pick up the change at their next reload, i.e. up to the access control list reload interval later
+			h.accessControlListReloader.ReloadEntries()
+
+			return presenters.PresentAccessControlListEntry(entry), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the access control list entries selected by the type and value query parameters
+func (h adminAccessControlListEntriesHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			queryParams := r.URL.Query()
+			query := services.AccessControlListEntryQuery{
+				Type:  api.AccessControlListEntryType(queryParams.Get("type")),
+				Value: queryParams.Get("value"),
+			}
+
+			if query.Type != "" {
+				if err := validateAccessControlListEntryType(query.Type.String()); err != nil {
+					return nil, err
+				}
+			}
+
+			listArgs := coreServices.NewListArguments(queryParams)
+			entries, paging, err := h.accessControlListEntryService.List(query, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			entryList := private.AccessControlListEntryList{
+				Kind:  "AccessControlListEntryList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.AccessControlListEntry{},
+			}
+
+			for _, entry := range entries {
+				entryList.Items = append(entryList.Items, presenters.PresentAccessControlListEntry(entry))
+			}
+
+			return entryList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminAccessControlListEntriesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			entry, err := h.accessControlListEntryService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentAccessControlListEntry(entry), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Delete removes an access control list entry, e.g. to lift the denial of a user before the entry expires
+func (h adminAccessControlListEntriesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&id, "id", handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			if _, err := h.accessControlListEntryService.Get(id); err != nil {
+				return nil, err
+			}
+
+			if err := h.accessControlListEntryService.Delete(id); err != nil {
+				return nil, err
+			}
+			// the other replicas only pick up the change at their next reload, i.e. up to the access control list reload interval later
+			h.accessControlListReloader.ReloadEntries()
+
+			return nil, nil
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
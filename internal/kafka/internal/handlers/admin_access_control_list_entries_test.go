@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildAccessControlListReloader() (*acl.AccessControlListReloader, *acl.AccessControlListSourceMock) {
+	source := &acl.AccessControlListSourceMock{
+		ListActiveEntriesFunc: func() (api.AccessControlListEntryList, *errors.ServiceError) {
+			return api.AccessControlListEntryList{}, nil
+		},
+	}
+	return acl.NewAccessControlListReloader(&acl.AccessControlListConfig{}, source), source
+}
+
+func Test_adminAccessControlListEntriesHandler_Create(t *testing.T) {
+	const entriesUrl = "/api/kafkas_mgmt/v1/admin/access_control_list_entries"
+
+	entryService := &services.AccessControlListEntryServiceMock{
+		CreateFunc: func(entry *api.AccessControlListEntry) *errors.ServiceError {
+			entry.ID = "entry-id"
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		service        services.AccessControlListEntryService
+		wantStatusCode int
+		wantEntry      *private.AccessControlListEntry
+	}{
+		{
+			name:           "should deny a user",
+			body:           []byte(`{"type": "denied_user", "value": "abusive-user", "reason": "incident 42"}`),
+			service:        entryService,
+			wantStatusCode: http.StatusCreated,
+			wantEntry: &private.AccessControlListEntry{
+				Id:        "entry-id",
+				Kind:      "AccessControlListEntry",
+				Href:      "/api/kafkas_mgmt/v1/admin/access_control_list_entries/entry-id",
+				Type:      "denied_user",
+				Value:     "abusive-user",
+				Reason:    "incident 42",
+				CreatedBy: "test-user",
+			},
+		},
+		{
+			name:           "should return bad request when the type is not valid",
+			body:           []byte(`{"type": "denied_organisation", "value": "13640203"}`),
+			service:        &services.AccessControlListEntryServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the value is not set",
+			body:           []byte(`{"type": "denied_user"}`),
+			service:        &services.AccessControlListEntryServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the entry has already expired",
+			body:           []byte(`{"type": "denied_user", "value": "abusive-user", "expires_at": "2020-01-31T00:00:00Z"}`),
+			service:        &services.AccessControlListEntryServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return conflict when the user is already denied",
+			body: []byte(`{"type": "denied_user", "value": "abusive-user"}`),
+			service: &services.AccessControlListEntryServiceMock{
+				CreateFunc: func(entry *api.AccessControlListEntry) *errors.ServiceError {
+					return errors.Conflict("an active %s entry already exists for %q", entry.Type, entry.Value)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			reloader, source := buildAccessControlListReloader()
+			h := NewAdminAccessControlListEntriesHandler(tt.service, reloader)
+			req, rw := GetHandlerParams(http.MethodPost, entriesUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req.WithContext(ctx))
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantEntry != nil {
+				entry := private.AccessControlListEntry{}
+				err := json.NewDecoder(resp.Body).Decode(&entry)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(entry).To(gomega.Equal(*tt.wantEntry))
+				// the entries are reloaded so that the user is denied straight away
+				g.Expect(source.ListActiveEntriesCalls()).To(gomega.HaveLen(1))
+			}
+		})
+	}
+}
+
+func Test_adminAccessControlListEntriesHandler(t *testing.T) {
+	const entryID = "entry-id"
+
+	entryService := &services.AccessControlListEntryServiceMock{
+		GetFunc: func(id string) (*api.AccessControlListEntry, *errors.ServiceError) {
+			if id != entryID {
+				return nil, errors.NotFound("AccessControlListEntry with id='%s' not found", id)
+			}
+			return &api.AccessControlListEntry{
+				Meta:  api.Meta{ID: entryID},
+				Type:  api.AccessControlListEntryTypeDeniedUser,
+				Value: "abusive-user",
+			}, nil
+		},
+		DeleteFunc: func(id string) *errors.ServiceError {
+			return nil
+		},
+	}
+
+	type args struct {
+		method string
+		id     string
+		handle func(h *adminAccessControlListEntriesHandler) http.HandlerFunc
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantStatusCode int
+		wantReload     bool
+	}{
+		{
+			name: "should return the access control list entry",
+			args: args{
+				method: http.MethodGet,
+				id:     entryID,
+				handle: func(h *adminAccessControlListEntriesHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return not found when the access control list entry does not exist",
+			args: args{
+				method: http.MethodGet,
+				id:     "unknown-id",
+				handle: func(h *adminAccessControlListEntriesHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should delete the access control list entry and reload the entries",
+			args: args{
+				method: http.MethodDelete,
+				id:     entryID,
+				handle: func(h *adminAccessControlListEntriesHandler) http.HandlerFunc { return h.Delete },
+			},
+			wantStatusCode: http.StatusNoContent,
+			wantReload:     true,
+		},
+		{
+			name: "should return not found when deleting an access control list entry that does not exist",
+			args: args{
+				method: http.MethodDelete,
+				id:     "unknown-id",
+				handle: func(h *adminAccessControlListEntriesHandler) http.HandlerFunc { return h.Delete },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			reloader, source := buildAccessControlListReloader()
+			h := NewAdminAccessControlListEntriesHandler(entryService, reloader)
+			req, rw := GetHandlerParams(tt.args.method, "/access_control_list_entries/"+tt.args.id, nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.args.id})
+			tt.args.handle(h)(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(len(source.ListActiveEntriesCalls()) == 1).To(gomega.Equal(tt.wantReload))
+		})
+	}
+
+	t.Run("should list the access control list entries", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		service := &services.AccessControlListEntryServiceMock{
+			ListFunc: func(query services.AccessControlListEntryQuery, listArgs *coreServices.ListArguments) (api.AccessControlListEntryList, *api.PagingMeta, *errors.ServiceError) {
+				g.Expect(query).To(gomega.Equal(services.AccessControlListEntryQuery{Type: api.AccessControlListEntryTypeDeniedUser, Value: "abusive-user"}))
+				return api.AccessControlListEntryList{
+					{Meta: api.Meta{ID: entryID}, Type: api.AccessControlListEntryTypeDeniedUser, Value: "abusive-user"},
+				}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+			},
+		}
+		reloader, _ := buildAccessControlListReloader()
+		h := NewAdminAccessControlListEntriesHandler(service, reloader)
+		req, rw := GetHandlerParams(http.MethodGet, "/access_control_list_entries?type=denied_user&value=abusive-user", nil, t)
+		h.List(rw, req)
+		resp := rw.Result()
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+		list := private.AccessControlListEntryList{}
+		err := json.NewDecoder(resp.Body).Decode(&list)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(list.Kind).To(gomega.Equal("AccessControlListEntryList"))
+		g.Expect(list.Total).To(gomega.Equal(int32(1)))
+		g.Expect(list.Items).To(gomega.HaveLen(1))
+	})
+
+	t.Run("should return bad request when listing the entries of an unknown type", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		reloader, _ := buildAccessControlListReloader()
+		h := NewAdminAccessControlListEntriesHandler(&services.AccessControlListEntryServiceMock{}, reloader)
+		req, rw := GetHandlerParams(http.MethodGet, "/access_control_list_entries?type=denied_organisation", nil, t)
+		h.List(rw, req)
+		resp := rw.Result()
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusBadRequest))
+	})
+}
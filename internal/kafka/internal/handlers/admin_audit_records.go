@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+type adminAuditRecordsHandler struct {
+	auditRecordService services.AuditRecordService
+}
+
+func NewAdminAuditRecordsHandler(auditRecordService services.AuditRecordService) *adminAuditRecordsHandler {
+	return &adminAuditRecordsHandler{
+		auditRecordService: auditRecordService,
+	}
+}
+
+// List returns the audit records selected by the actor, resource_type, resource_id, from and to query parameters
+func (h adminAuditRecordsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			queryParams := r.URL.Query()
+			query := services.AuditRecordQuery{
+				Actor:        queryParams.Get("actor"),
+				ResourceType: queryParams.Get("resource_type"),
+				ResourceID:   queryParams.Get("resource_id"),
+			}
+
+			var err *errors.ServiceError
+			if query.From, err = parseTimeQueryParam(queryParams, "from"); err != nil {
+				return nil, err
+			}
+			if query.To, err = parseTimeQueryParam(queryParams, "to"); err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(queryParams)
+			records, paging, err := h.auditRecordService.List(query, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			recordList := private.AuditRecordList{
+				Kind:  "AuditRecordList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.AuditRecord{},
+			}
+
+			for _, record := range records {
+				recordList.Items = append(recordList.Items, presenters.PresentAuditRecord(record))
+			}
+
+			return recordList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func parseTimeQueryParam(queryParams url.Values, name string) (*time.Time, *errors.ServiceError) {
+	value := queryParams.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.BadRequest("%s must be a time in RFC3339 format, got %q", name, value)
+	}
+	return &t, nil
+}
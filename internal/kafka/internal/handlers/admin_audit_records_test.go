@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminAuditRecordsHandler_List(t *testing.T) {
+	createdAt := time.Date(2023, 4, 26, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		url                string
+		auditRecordService *services.AuditRecordServiceMock
+		wantStatusCode     int
+		wantQuery          *services.AuditRecordQuery
+		wantRecords        *private.AuditRecordList
+	}{
+		{
+			name: "should return the audit records selected by the query parameters",
+			url:  "/audit_records?actor=admin-user&resource_type=kafkas&resource_id=kafka-id&from=2023-04-01T00:00:00Z",
+			auditRecordService: &services.AuditRecordServiceMock{
+				ListFunc: func(query services.AuditRecordQuery, listArgs *coreServices.ListArguments) (api.AuditRecordList, *api.PagingMeta, *errors.ServiceError) {
+					return api.AuditRecordList{
+						{
+							Meta:         api.Meta{ID: "record-id", CreatedAt: createdAt},
+							Actor:        "admin-user",
+							Action:       "admin-delete-kafka",
+							Method:       http.MethodDelete,
+							RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id?async=true",
+							ResourceType: "kafkas",
+							ResourceID:   "kafka-id",
+							StatusCode:   http.StatusAccepted,
+						},
+					}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantQuery: &services.AuditRecordQuery{
+				Actor:        "admin-user",
+				ResourceType: "kafkas",
+				ResourceID:   "kafka-id",
+				From:         &from,
+			},
+			wantRecords: &private.AuditRecordList{
+				Kind:  "AuditRecordList",
+				Page:  1,
+				Size:  1,
+				Total: 1,
+				Items: []private.AuditRecord{
+					{
+						Id:           "record-id",
+						Kind:         "AuditRecord",
+						Actor:        "admin-user",
+						Action:       "admin-delete-kafka",
+						Method:       http.MethodDelete,
+						RequestUri:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id?async=true",
+						ResourceType: "kafkas",
+						ResourceId:   "kafka-id",
+						StatusCode:   http.StatusAccepted,
+						CreatedAt:    createdAt,
+					},
+				},
+			},
+		},
+		{
+			name:               "should return bad request when a time is not in RFC3339 format",
+			url:                "/audit_records?to=yesterday",
+			auditRecordService: &services.AuditRecordServiceMock{},
+			wantStatusCode:     http.StatusBadRequest,
+		},
+		{
+			name: "should return an error when the audit records cannot be listed",
+			url:  "/audit_records",
+			auditRecordService: &services.AuditRecordServiceMock{
+				ListFunc: func(query services.AuditRecordQuery, listArgs *coreServices.ListArguments) (api.AuditRecordList, *api.PagingMeta, *errors.ServiceError) {
+					return nil, nil, errors.GeneralError("unable to list the audit records")
+				},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminAuditRecordsHandler(tt.auditRecordService)
+			req, rw := GetHandlerParams(http.MethodGet, tt.url, nil, t)
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantQuery != nil {
+				g.Expect(tt.auditRecordService.ListCalls()).To(gomega.HaveLen(1))
+				g.Expect(tt.auditRecordService.ListCalls()[0].Query).To(gomega.Equal(*tt.wantQuery))
+			}
+
+			if tt.wantRecords != nil {
+				records := private.AuditRecordList{}
+				err := json.NewDecoder(resp.Body).Decode(&records)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(records).To(gomega.Equal(*tt.wantRecords))
+			}
+		})
+	}
+}
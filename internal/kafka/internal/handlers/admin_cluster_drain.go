@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminClusterDrainHandler struct {
+	clusterDrainService services.ClusterDrainService
+}
+
+func NewAdminClusterDrainHandler(clusterDrainService services.ClusterDrainService) *adminClusterDrainHandler {
+	return &adminClusterDrainHandler{
+		clusterDrainService: clusterDrainService,
+	}
+}
+
+// Cordon prevents new kafkas from being placed on the data plane cluster
+func (h adminClusterDrainHandler) Cordon(w http.ResponseWriter, r *http.Request) {
+	h.handleCordon(w, r, func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+		return h.clusterDrainService.Cordon(clusterID, false)
+	})
+}
+
+// Drain cordons the data plane cluster and marks it for removal once all its kafkas have been migrated or deleted
+func (h adminClusterDrainHandler) Drain(w http.ResponseWriter, r *http.Request) {
+	h.handleCordon(w, r, func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+		return h.clusterDrainService.Cordon(clusterID, true)
+	})
+}
+
+// Uncordon allows new kafkas to be placed on the data plane cluster again and cancels any ongoing drain
+func (h adminClusterDrainHandler) Uncordon(w http.ResponseWriter, r *http.Request) {
+	h.handleCordon(w, r, h.clusterDrainService.Uncordon)
+}
+
+// Get returns the kafkas still deployed on the data plane cluster along with their readiness to leave it
+func (h adminClusterDrainHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			clusterID := mux.Vars(r)["cluster_id"]
+			drainStatus, err := h.clusterDrainService.GetDrainStatus(clusterID)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentClusterDrainStatus(drainStatus), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminClusterDrainHandler) handleCordon(w http.ResponseWriter, r *http.Request, update func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError)) {
+	clusterID := mux.Vars(r)["cluster_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&clusterID, "cluster_id", handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			drainStatus, err := update(clusterID)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentClusterDrainStatus(drainStatus), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminClusterDrainHandler(t *testing.T) {
+	const clusterID = "cluster-id"
+
+	drainStatus := func(clusterID string, cordoned bool, draining bool) *services.ClusterDrainStatus {
+		return &services.ClusterDrainStatus{
+			Cluster: &api.Cluster{ClusterID: clusterID, Status: api.ClusterReady, Cordoned: cordoned, Draining: draining},
+			Kafkas: []services.KafkaDrainStatus{
+				{
+					Kafka:     &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, Name: "kafka-1", Status: "ready", InstanceType: "standard", SizeId: "x1"},
+					Readiness: services.KafkaDrainReadinessMigratable,
+				},
+				{
+					Kafka:     &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}, Name: "kafka-2", Status: "deprovision", InstanceType: "standard", SizeId: "x1"},
+					Readiness: services.KafkaDrainReadinessDeleting,
+					Reason:    `kafka is in "deprovision" status`,
+				},
+			},
+		}
+	}
+
+	clusterDrainService := &services.ClusterDrainServiceMock{
+		CordonFunc: func(clusterID string, drain bool) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus(clusterID, true, drain), nil
+		},
+		UncordonFunc: func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus(clusterID, false, false), nil
+		},
+		GetDrainStatusFunc: func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return drainStatus(clusterID, true, true), nil
+		},
+	}
+
+	notFoundClusterDrainService := &services.ClusterDrainServiceMock{
+		CordonFunc: func(clusterID string, drain bool) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return nil, errors.NotFound("cluster with id %q not found", clusterID)
+		},
+		GetDrainStatusFunc: func(clusterID string) (*services.ClusterDrainStatus, *errors.ServiceError) {
+			return nil, errors.NotFound("cluster with id %q not found", clusterID)
+		},
+	}
+
+	wantDrainStatus := func(cordoned bool, draining bool) *private.ClusterDrainStatus {
+		return &private.ClusterDrainStatus{
+			Kind:      "ClusterDrainStatus",
+			ClusterId: clusterID,
+			Status:    api.ClusterReady.String(),
+			Cordoned:  cordoned,
+			Draining:  draining,
+			Drained:   false,
+			KafkaCounts: private.ClusterDrainStatusKafkaCounts{
+				Migratable: 1,
+				Deleting:   1,
+			},
+			Items: []private.ClusterDrainKafka{
+				{Id: "kafka-1", Name: "kafka-1", Status: "ready", InstanceType: "standard", SizeId: "x1", Readiness: "migratable"},
+				{Id: "kafka-2", Name: "kafka-2", Status: "deprovision", InstanceType: "standard", SizeId: "x1", Readiness: "deleting", Reason: `kafka is in "deprovision" status`},
+			},
+		}
+	}
+
+	type fields struct {
+		clusterDrainService services.ClusterDrainService
+	}
+	type args struct {
+		method    string
+		clusterID string
+		handle    func(h *adminClusterDrainHandler) http.HandlerFunc
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		args            args
+		wantStatusCode  int
+		wantDrainStatus *private.ClusterDrainStatus
+	}{
+		{
+			name: "should cordon the cluster",
+			fields: fields{
+				clusterDrainService: clusterDrainService,
+			},
+			args: args{
+				method:    http.MethodPost,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Cordon },
+			},
+			wantStatusCode:  http.StatusOK,
+			wantDrainStatus: wantDrainStatus(true, false),
+		},
+		{
+			name: "should drain the cluster",
+			fields: fields{
+				clusterDrainService: clusterDrainService,
+			},
+			args: args{
+				method:    http.MethodPost,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Drain },
+			},
+			wantStatusCode:  http.StatusOK,
+			wantDrainStatus: wantDrainStatus(true, true),
+		},
+		{
+			name: "should uncordon the cluster",
+			fields: fields{
+				clusterDrainService: clusterDrainService,
+			},
+			args: args{
+				method:    http.MethodPost,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Uncordon },
+			},
+			wantStatusCode:  http.StatusOK,
+			wantDrainStatus: wantDrainStatus(false, false),
+		},
+		{
+			name: "should return the drain status of the cluster",
+			fields: fields{
+				clusterDrainService: clusterDrainService,
+			},
+			args: args{
+				method:    http.MethodGet,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode:  http.StatusOK,
+			wantDrainStatus: wantDrainStatus(true, true),
+		},
+		{
+			name: "should return bad request when the cluster id is empty",
+			fields: fields{
+				clusterDrainService: &services.ClusterDrainServiceMock{},
+			},
+			args: args{
+				method: http.MethodPost,
+				handle: func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Cordon },
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return not found when cordoning a cluster that does not exist",
+			fields: fields{
+				clusterDrainService: notFoundClusterDrainService,
+			},
+			args: args{
+				method:    http.MethodPost,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Cordon },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return not found when getting the drain status of a cluster that does not exist",
+			fields: fields{
+				clusterDrainService: notFoundClusterDrainService,
+			},
+			args: args{
+				method:    http.MethodGet,
+				clusterID: clusterID,
+				handle:    func(h *adminClusterDrainHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterDrainHandler(tt.fields.clusterDrainService)
+			req, rw := GetHandlerParams(tt.args.method, "/clusters/"+tt.args.clusterID+"/drain", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"cluster_id": tt.args.clusterID})
+			tt.args.handle(h)(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantDrainStatus != nil {
+				status := private.ClusterDrainStatus{}
+				err := json.NewDecoder(resp.Body).Decode(&status)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(status).To(gomega.Equal(*tt.wantDrainStatus))
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminClusterPlacementEvaluationHandler struct {
+	clusterPlacementEvaluationService services.ClusterPlacementEvaluationService
+	providerConfig                    *config.ProviderConfig
+	kafkaConfig                       *config.KafkaConfig
+}
+
+func NewAdminClusterPlacementEvaluationHandler(clusterPlacementEvaluationService services.ClusterPlacementEvaluationService, providerConfig *config.ProviderConfig, kafkaConfig *config.KafkaConfig) *adminClusterPlacementEvaluationHandler {
+	return &adminClusterPlacementEvaluationHandler{
+		clusterPlacementEvaluationService: clusterPlacementEvaluationService,
+		providerConfig:                    providerConfig,
+		kafkaConfig:                       kafkaConfig,
+	}
+}
+
+// Create evaluates the placement of the hypothetical kafka given in the request body.
+// The evaluation is a dry run: no kafka is created and no capacity is reserved.
+func (h adminClusterPlacementEvaluationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var evaluationRequest private.ClusterPlacementEvaluationRequest
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &evaluationRequest,
+		Validate: []handlers.Validate{
+			validateClusterPlacementEvaluationRequest(&evaluationRequest, h.providerConfig, h.kafkaConfig),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafka := presenters.ConvertClusterPlacementEvaluationRequest(evaluationRequest)
+			evaluation, err := h.clusterPlacementEvaluationService.EvaluatePlacement(kafka)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentClusterPlacementEvaluation(evaluation), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+const clusterPlacementEvaluationsUrl = "/cluster_placement_evaluations"
+
+func Test_adminClusterPlacementEvaluationHandler_Create(t *testing.T) {
+	type fields struct {
+		clusterPlacementEvaluationService services.ClusterPlacementEvaluationService
+	}
+	type args struct {
+		body []byte
+	}
+	evaluationWithChosenCluster := &services.ClusterPlacementEvaluation{
+		PlacementStrategy:       "bin-pack",
+		RegionCapacityAvailable: true,
+		ChosenCluster:           &api.Cluster{ClusterID: "cluster-1"},
+		Candidates: []services.ClusterPlacementCandidateEvaluation{
+			{
+				Cluster: &api.Cluster{ClusterID: "cluster-1", Status: api.ClusterReady, MultiAZ: true, SupportedInstanceType: "standard"},
+				StreamingUnits: []services.InstanceTypeStreamingUnits{
+					{InstanceType: "standard", MaxUnits: 10, Consumed: 4},
+				},
+			},
+			{
+				Cluster:         &api.Cluster{ClusterID: "cluster-2", Status: api.ClusterProvisioning, MultiAZ: true, SupportedInstanceType: "standard"},
+				StreamingUnits:  []services.InstanceTypeStreamingUnits{},
+				RejectionReason: "cluster is not ready",
+			},
+		},
+	}
+	tests := []struct {
+		name               string
+		fields             fields
+		args               args
+		wantStatusCode     int
+		wantEvaluation     *private.ClusterPlacementEvaluation
+		wantEvaluatedKafka *dbapi.KafkaRequest
+	}{
+		{
+			name: "should return bad request when the body is not valid json",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{},
+			},
+			args: args{
+				body: []byte(`{`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return bad request when the cloud provider is not supported",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "gcp", "region": "us-east-1", "instance_type": "standard", "size_id": "x1"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return bad request when the region is not supported",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "eu-west-1", "instance_type": "standard", "size_id": "x1"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return bad request when the instance type is not supported in the region",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "enterprise", "size_id": "x1"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return bad request when the size is not supported",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x5"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error when the evaluation fails",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{
+					EvaluatePlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementEvaluation, *errors.ServiceError) {
+						return nil, errors.GeneralError("test")
+					},
+				},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1"}`),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "should return the evaluation of the hypothetical kafka, defaulting multi AZ from the instance type",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{
+					EvaluatePlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementEvaluation, *errors.ServiceError) {
+						return evaluationWithChosenCluster, nil
+					},
+				},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1"}`),
+			},
+			wantStatusCode: http.StatusOK,
+			wantEvaluatedKafka: &dbapi.KafkaRequest{
+				CloudProvider: "aws",
+				Region:        "us-east-1",
+				InstanceType:  "standard",
+				SizeId:        "x1",
+				MultiAZ:       true,
+			},
+			wantEvaluation: &private.ClusterPlacementEvaluation{
+				Kind:                    "ClusterPlacementEvaluation",
+				PlacementStrategy:       "bin-pack",
+				RegionCapacityAvailable: true,
+				ClusterId:               "cluster-1",
+				Candidates: []private.ClusterPlacementCandidate{
+					{
+						ClusterId:             "cluster-1",
+						Status:                api.ClusterReady.String(),
+						MultiAz:               true,
+						SupportedInstanceType: "standard",
+						StreamingUnits: []private.ClusterPlacementCandidateStreamingUnits{
+							{InstanceType: "standard", MaxUnits: 10, ConsumedUnits: 4, RemainingUnits: 6},
+						},
+						Chosen: true,
+					},
+					{
+						ClusterId:             "cluster-2",
+						Status:                api.ClusterProvisioning.String(),
+						MultiAz:               true,
+						SupportedInstanceType: "standard",
+						StreamingUnits:        []private.ClusterPlacementCandidateStreamingUnits{},
+						RejectionReason:       "cluster is not ready",
+					},
+				},
+			},
+		},
+		{
+			name: "should evaluate the hypothetical kafka with the multi AZ value given in the request",
+			fields: fields{
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{
+					EvaluatePlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementEvaluation, *errors.ServiceError) {
+						return &services.ClusterPlacementEvaluation{PlacementStrategy: "first-ready"}, nil
+					},
+				},
+			},
+			args: args{
+				body: []byte(`{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "size_id": "x1", "multi_az": false}`),
+			},
+			wantStatusCode: http.StatusOK,
+			wantEvaluatedKafka: &dbapi.KafkaRequest{
+				CloudProvider: "aws",
+				Region:        "us-east-1",
+				InstanceType:  "standard",
+				SizeId:        "x1",
+				MultiAZ:       false,
+			},
+			wantEvaluation: &private.ClusterPlacementEvaluation{
+				Kind:              "ClusterPlacementEvaluation",
+				PlacementStrategy: "first-ready",
+				Candidates:        []private.ClusterPlacementCandidate{},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterPlacementEvaluationHandler(tt.fields.clusterPlacementEvaluationService, &supportedProviders, &fullKafkaConfig)
+			req, rw := GetHandlerParams("POST", clusterPlacementEvaluationsUrl, bytes.NewBuffer(tt.args.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantEvaluation != nil {
+				evaluation := private.ClusterPlacementEvaluation{}
+				err := json.NewDecoder(resp.Body).Decode(&evaluation)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(evaluation).To(gomega.Equal(*tt.wantEvaluation))
+			}
+
+			if tt.wantEvaluatedKafka != nil {
+				mock := tt.fields.clusterPlacementEvaluationService.(*services.ClusterPlacementEvaluationServiceMock)
+				g.Expect(mock.EvaluatePlacementCalls()).To(gomega.HaveLen(1))
+				g.Expect(mock.EvaluatePlacementCalls()[0].Kafka).To(gomega.Equal(tt.wantEvaluatedKafka))
+			}
+		})
+	}
+}
@@ -3,9 +3,6 @@ package handlers
 import (
 	"fmt"
 	"net/http"
-	"time"
-
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
@@ -68,6 +65,10 @@ func (h adminKafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list kafka requests: %s", err.Error())
 			}
 
+			if err := listArgs.ValidateFields(handlers.GetJSONFieldNames(private.Kafka{})); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list kafka requests: %s", err.Error())
+			}
+
 			kafkaRequests, paging, err := h.kafkaService.List(ctx, listArgs)
 			if err != nil {
 				return nil, err
@@ -89,7 +90,7 @@ func (h adminKafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 				kafkaRequestList.Items = append(kafkaRequestList.Items, *converted)
 			}
 
-			return kafkaRequestList, nil
+			return handlers.PresentListWithFields(kafkaRequestList, listArgs.Fields)
 		},
 	}
 
@@ -177,23 +178,6 @@ func (h *adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				return false
 			}
 
-			getStatusBasedOnSuspendedParam := func(susp *bool, kafka *dbapi.KafkaRequest) string {
-				if shared.IsNil(susp) {
-					return kafka.Status
-				} else {
-					if *susp {
-						if kafka.Status == constants.KafkaRequestStatusReady.String() {
-							return constants.KafkaRequestStatusSuspending.String()
-						}
-					} else {
-						if kafka.Status == constants.KafkaRequestStatusSuspended.String() || kafka.Status == constants.KafkaRequestStatusSuspending.String() {
-							return constants.KafkaRequestStatusResuming.String()
-						}
-					}
-				}
-				return kafka.Status
-			}
-
 			requestedStorageSize, _ := arrays.FirstNonEmpty(kafkaUpdateReq.MaxDataRetentionSize, kafkaUpdateReq.DeprecatedKafkaStorageSize)
 
 			updateRequired := update(&kafkaRequest.DesiredKafkaVersion, kafkaUpdateReq.KafkaVersion)
@@ -201,8 +185,10 @@ func (h *adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			updateRequired = update(&kafkaRequest.DesiredKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion) || updateRequired
 			updateRequired = update(&kafkaRequest.KafkaStorageSize, requestedStorageSize) || updateRequired
 
-			newStatus := getStatusBasedOnSuspendedParam(kafkaUpdateReq.Suspended, kafkaRequest)
-			updateRequired = update(&kafkaRequest.Status, newStatus) || updateRequired
+			if kafkaUpdateReq.ForceUpgrade != nil && kafkaRequest.ForceUpgrade != *kafkaUpdateReq.ForceUpgrade {
+				kafkaRequest.ForceUpgrade = *kafkaUpdateReq.ForceUpgrade
+				updateRequired = true
+			}
 
 			if updateRequired {
 				err := h.kafkaService.VerifyAndUpdateKafkaAdmin(ctx, kafkaRequest)
@@ -210,12 +196,125 @@ func (h *adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 					return nil, err
 				}
 			}
+
+			// the suspension goes through the same service methods as the one requested by the owner of the kafka,
+			// so that, e.g., the capacity of the cluster is verified before the kafka is resumed
+			if kafkaUpdateReq.Suspended != nil {
+				switch {
+				case *kafkaUpdateReq.Suspended && kafkaRequest.Status == constants.KafkaRequestStatusReady.String():
+					if suspendErr := h.kafkaService.Suspend(ctx, kafkaRequest, dbapi.KafkaSuspensionReasonAdmin); suspendErr != nil {
+						return nil, suspendErr
+					}
+				case !*kafkaUpdateReq.Suspended && kafkaRequest.Status == constants.KafkaRequestStatusSuspended.String():
+					if resumeErr := h.kafkaService.Resume(ctx, kafkaRequest); resumeErr != nil {
+						return nil, resumeErr
+					}
+				}
+			}
+
 			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
+func (h *adminKafkaHandler) Migrate(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	ctx := r.Context()
+	kafkaRequest, err := h.kafkaService.Get(ctx, id)
+
+	var kafkaMigrateReq private.KafkaMigrateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &kafkaMigrateReq,
+		Validate: []handlers.Validate{
+			handlers.ValidateAsyncEnabled(r, "migrating kafka requests"),
+			func() *errors.ServiceError { // Validate kafka found
+				if err != nil {
+					return err
+				}
+				if kafkaRequest == nil {
+					return errors.NotFound("unable to find kafka with id '%s'", id)
+				}
+				return nil
+			},
+			h.validateKafkaCanBeMigrated(kafkaRequest),
+			h.validateKafkaMigrationTargetCluster(kafkaRequest, &kafkaMigrateReq),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			// the migration is carried out by the MigratingKafkaManager. The requested target cluster, if any, is only
+			// reserved by it once it has verified that the cluster can receive the kafka
+			kafkaRequest.MigrationStatus = dbapi.KafkaMigrationStatusPending
+			kafkaRequest.MigrationClusterID = kafkaMigrateReq.TargetClusterId
+			kafkaRequest.MigrationPlacementId = ""
+			kafkaRequest.MigrationRoutes = nil
+			kafkaRequest.MigrationDetails = ""
+			updateErr := h.kafkaService.Updates(kafkaRequest, map[string]interface{}{
+				"migration_status":       kafkaRequest.MigrationStatus,
+				"migration_cluster_id":   kafkaRequest.MigrationClusterID,
+				"migration_placement_id": kafkaRequest.MigrationPlacementId,
+				"migration_routes":       nil,
+				"migration_details":      kafkaRequest.MigrationDetails,
+			})
+			if updateErr != nil {
+				return nil, updateErr
+			}
+
+			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h *adminKafkaHandler) validateKafkaCanBeMigrated(kafkaRequest *dbapi.KafkaRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaRequest.Status != constants.KafkaRequestStatusReady.String() {
+			return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be migrated. Kafka instances can only be migrated in the %q status", kafkaRequest.Status, constants.KafkaRequestStatusReady)
+		}
+
+		if kafkaRequest.DesiredBillingModelIsEnterprise() {
+			return errors.New(errors.ErrorValidation, "enterprise kafka instances are placed on the customer's data plane cluster and cannot be migrated")
+		}
+
+		if kafkaRequest.MigrationStatus.InProgress() {
+			return errors.New(errors.ErrorValidation, "a migration of the kafka instance is already in progress with a status of %q", kafkaRequest.MigrationStatus)
+		}
+
+		if kafkaRequest.MigrationClusterID != "" {
+			return errors.New(errors.ErrorValidation, "the kafka instance of the previous failed migration is still being deleted from cluster %q", kafkaRequest.MigrationClusterID)
+		}
+
+		return nil
+	}
+}
+
+func (h *adminKafkaHandler) validateKafkaMigrationTargetCluster(kafkaRequest *dbapi.KafkaRequest, kafkaMigrateReq *private.KafkaMigrateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		targetClusterID := kafkaMigrateReq.TargetClusterId
+		if targetClusterID == "" {
+			return nil
+		}
+
+		if targetClusterID == kafkaRequest.ClusterID {
+			return errors.New(errors.ErrorValidation, "kafka instance is already placed on cluster %q", targetClusterID)
+		}
+
+		targetCluster, err := h.clusterService.FindClusterByID(targetClusterID)
+		if err != nil {
+			return err
+		}
+
+		if targetCluster == nil {
+			return errors.New(errors.ErrorValidation, "target cluster %q does not exist", targetClusterID)
+		}
+
+		if targetCluster.CloudProvider != kafkaRequest.CloudProvider || targetCluster.Region != kafkaRequest.Region {
+			return errors.New(errors.ErrorValidation, "kafka instances can only be migrated within their cloud provider and region. Target cluster %q is in region %q of cloud provider %q", targetClusterID, targetCluster.Region, targetCluster.CloudProvider)
+		}
+
+		return nil
+	}
+}
+
 func (h *adminKafkaHandler) validateUpdateKafkaSuspended(kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		if kafkaUpdateReq.Suspended == nil {
@@ -249,24 +348,6 @@ func (h *adminKafkaHandler) validateUpdateKafkaCanBeResumed(kafkaRequest *dbapi.
 			return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be resumed. Kafka instances can only be resumed in the following states: %s", kafkaRequest.Status, resumableStates)
 		}
 
-		kafkaRequestHasExpirationSet := kafkaRequest.ExpiresAt.Valid
-		if !kafkaRequestHasExpirationSet {
-			return nil
-		}
-
-		timeNow := time.Now()
-		kafkaBillingModelConfig, err := h.kafkaConfig.GetBillingModelByID(kafkaRequest.InstanceType, kafkaRequest.ActualKafkaBillingModel)
-		if err != nil {
-			return errors.ToServiceError(err)
-		}
-		gracePeriodDays := kafkaBillingModelConfig.GracePeriodDays
-		durationGracePeriodDays := time.Duration(gracePeriodDays*86400) * time.Second
-		startOfGracePeriod := kafkaRequest.ExpiresAt.Time.Add(-durationGracePeriodDays)
-		isWithinOrAfterGracePeriod := timeNow.After(startOfGracePeriod)
-		if isWithinOrAfterGracePeriod {
-			return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be resumed due to the instance is suspended and it is within its grace period: start of grace period: %s ", kafkaRequest.Status, startOfGracePeriod)
-		}
-
-		return nil
+		return validateKafkaNotInGracePeriod(h.kafkaConfig, kafkaRequest)
 	}
 }
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaEventsHandler_List(t *testing.T) {
+	occurredAt := time.Date(2023, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	kafkaService := &services.KafkaServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "kafka-id" {
+				return nil, errors.NotFound("KafkaResource with id='%s' not found", id)
+			}
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}}, nil
+		},
+	}
+
+	kafkaEventService := &services.KafkaEventServiceMock{
+		ListFunc: func(kafkaID string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+			return dbapi.KafkaEventList{
+				{
+					ID:              "event-2",
+					KafkaID:         kafkaID,
+					Type:            dbapi.KafkaEventTypeCondition,
+					OccurredAt:      occurredAt,
+					ClusterID:       "cluster-id",
+					ConditionType:   "Ready",
+					ConditionStatus: "False",
+					Reason:          "Error",
+					Message:         "kafka resource could not be reconciled",
+				},
+				{
+					ID:         "event-1",
+					KafkaID:    kafkaID,
+					Type:       dbapi.KafkaEventTypeStatusChange,
+					OccurredAt: occurredAt,
+					OldStatus:  "provisioning",
+					NewStatus:  "ready",
+					Actor:      "kas-fleet-manager",
+				},
+			}, &api.PagingMeta{Page: 1, Size: 2, Total: 2}, nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		kafkaID        string
+		wantStatusCode int
+		wantEvents     *private.KafkaEventList
+	}{
+		{
+			name:           "should return the history of the kafka with the data plane details",
+			kafkaID:        "kafka-id",
+			wantStatusCode: http.StatusOK,
+			wantEvents: &private.KafkaEventList{
+				Kind:  "KafkaEventList",
+				Page:  1,
+				Size:  2,
+				Total: 2,
+				Items: []private.KafkaEvent{
+					{
+						Id:              "event-2",
+						Kind:            "KafkaEvent",
+						KafkaId:         "kafka-id",
+						Type:            "condition",
+						OccurredAt:      occurredAt,
+						ClusterId:       "cluster-id",
+						ConditionType:   "Ready",
+						ConditionStatus: "False",
+						Reason:          "Error",
+						Message:         "kafka resource could not be reconciled",
+					},
+					{
+						Id:         "event-1",
+						Kind:       "KafkaEvent",
+						KafkaId:    "kafka-id",
+						Type:       "status_change",
+						OccurredAt: occurredAt,
+						OldStatus:  "provisioning",
+						NewStatus:  "ready",
+						Actor:      "kas-fleet-manager",
+					},
+				},
+			},
+		},
+		{
+			name:           "should return not found when the kafka does not exist",
+			kafkaID:        "unknown-kafka-id",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaEventsHandler(kafkaService, kafkaEventService)
+			req, rw := GetHandlerParams(http.MethodGet, "/kafkas/"+tt.kafkaID+"/events", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.kafkaID})
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantEvents != nil {
+				events := private.KafkaEventList{}
+				err := json.NewDecoder(resp.Body).Decode(&events)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(events).To(gomega.Equal(*tt.wantEvents))
+			}
+		})
+	}
+}
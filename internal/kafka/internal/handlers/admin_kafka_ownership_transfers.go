@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+type adminKafkaOwnershipTransfersHandler struct {
+	kafkaOwnershipTransferService services.KafkaOwnershipTransferService
+	kafkaService                  services.KafkaService
+}
+
+func NewAdminKafkaOwnershipTransfersHandler(kafkaOwnershipTransferService services.KafkaOwnershipTransferService, kafkaService services.KafkaService) *adminKafkaOwnershipTransfersHandler {
+	return &adminKafkaOwnershipTransfersHandler{
+		kafkaOwnershipTransferService: kafkaOwnershipTransferService,
+		kafkaService:                  kafkaService,
+	}
+}
+
+// Create transfers the kafka with the given kafka_id, or all the kafkas of the given previous_owner, to the new owner
+func (h adminKafkaOwnershipTransfersHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var transferRequest private.KafkaOwnershipTransferRequest
+	ctx := r.Context()
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &transferRequest,
+		Validate: []handlers.Validate{
+			validateKafkaOwnershipTransferRequest(&transferRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			newOwner := presenters.ConvertKafkaOwnershipTransferRequest(transferRequest)
+
+			var transfers dbapi.KafkaOwnershipTransferList
+			if transferRequest.KafkaId != "" {
+				kafkaRequest, err := h.kafkaService.GetByID(transferRequest.KafkaId)
+				if err != nil {
+					return nil, err
+				}
+
+				transfer, err := h.kafkaOwnershipTransferService.Transfer(ctx, kafkaRequest, newOwner, transferRequest.Reason)
+				if err != nil {
+					return nil, err
+				}
+				transfers = append(transfers, transfer)
+			} else {
+				var err *errors.ServiceError
+				transfers, err = h.kafkaOwnershipTransferService.ReassignOwner(ctx, transferRequest.PreviousOwner, transferRequest.PreviousOrganisationId, newOwner, transferRequest.Reason)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return presentKafkaOwnershipTransferList(transfers, &api.PagingMeta{Page: 1, Size: len(transfers), Total: len(transfers)}), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the kafka ownership transfers selected by the kafka_id, owner and organisation_id query parameters
+func (h adminKafkaOwnershipTransfersHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			queryParams := r.URL.Query()
+			query := services.KafkaOwnershipTransferQuery{
+				KafkaID:        queryParams.Get("kafka_id"),
+				Owner:          queryParams.Get("owner"),
+				OrganisationID: queryParams.Get("organisation_id"),
+			}
+
+			listArgs := coreServices.NewListArguments(queryParams)
+			transfers, paging, err := h.kafkaOwnershipTransferService.List(query, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			return presentKafkaOwnershipTransferList(transfers, paging), nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func presentKafkaOwnershipTransferList(transfers dbapi.KafkaOwnershipTransferList, paging *api.PagingMeta) private.KafkaOwnershipTransferList {
+	transferList := private.KafkaOwnershipTransferList{
+		Kind:  "KafkaOwnershipTransferList",
+		Page:  int32(paging.Page),
+		Size:  int32(paging.Size),
+		Total: int32(paging.Total),
+		Items: []private.KafkaOwnershipTransfer{},
+	}
+
+	for _, transfer := range transfers {
+		transferList.Items = append(transferList.Items, presenters.PresentKafkaOwnershipTransfer(transfer))
+	}
+
+	return transferList
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaOwnershipTransfersHandler_Create(t *testing.T) {
+	const transfersUrl = "/api/kafkas_mgmt/v1/admin/kafka_ownership_transfers"
+
+	kafkaService := &services.KafkaServiceMock{
+		GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "kafka-1" {
+				return nil, errors.NotFound("unable to find kafka with id %q", id)
+			}
+			return &dbapi.KafkaRequest{
+				Meta:           api.Meta{ID: id},
+				Owner:          "previous-owner",
+				OrganisationId: "org-1",
+				Status:         constants.KafkaRequestStatusReady.String(),
+			}, nil
+		},
+	}
+
+	transfer := func(kafkaRequest *dbapi.KafkaRequest, newOwner dbapi.KafkaOwner) *dbapi.KafkaOwnershipTransfer {
+		return &dbapi.KafkaOwnershipTransfer{
+			Meta:                   api.Meta{ID: "transfer-" + kafkaRequest.ID},
+			KafkaID:                kafkaRequest.ID,
+			PreviousOwner:          kafkaRequest.Owner,
+			PreviousOrganisationID: kafkaRequest.OrganisationId,
+			Owner:                  newOwner.Owner,
+			OrganisationID:         newOwner.OrganisationID,
+		}
+	}
+
+	transferService := &services.KafkaOwnershipTransferServiceMock{
+		TransferFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, newOwner dbapi.KafkaOwner, reason string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+			return transfer(kafkaRequest, newOwner), nil
+		},
+		ReassignOwnerFunc: func(ctx context.Context, owner string, organisationID string, newOwner dbapi.KafkaOwner, reason string) (dbapi.KafkaOwnershipTransferList, *errors.ServiceError) {
+			return dbapi.KafkaOwnershipTransferList{
+				transfer(&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}, Owner: owner, OrganisationId: "org-1"}, newOwner),
+				transfer(&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}, Owner: owner, OrganisationId: "org-1"}, newOwner),
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		service        services.KafkaOwnershipTransferService
+		wantStatusCode int
+		wantKafkaIDs   []string
+	}{
+		{
+			name:           "should transfer a kafka to a new owner",
+			body:           []byte(`{"kafka_id": "kafka-1", "owner": "new-owner", "organisation_id": "org-2", "reason": "moved to another team"}`),
+			service:        transferService,
+			wantStatusCode: http.StatusCreated,
+			wantKafkaIDs:   []string{"kafka-1"},
+		},
+		{
+			name:           "should reassign all the kafkas of a previous owner",
+			body:           []byte(`{"previous_owner": "leaving-user", "owner": "new-owner"}`),
+			service:        transferService,
+			wantStatusCode: http.StatusCreated,
+			wantKafkaIDs:   []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name:           "should return bad request when the owner is not set",
+			body:           []byte(`{"kafka_id": "kafka-1"}`),
+			service:        &services.KafkaOwnershipTransferServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when both kafka_id and previous_owner are set",
+			body:           []byte(`{"kafka_id": "kafka-1", "previous_owner": "leaving-user", "owner": "new-owner"}`),
+			service:        &services.KafkaOwnershipTransferServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when neither kafka_id nor previous_owner is set",
+			body:           []byte(`{"owner": "new-owner"}`),
+			service:        &services.KafkaOwnershipTransferServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return not found when the kafka does not exist",
+			body:           []byte(`{"kafka_id": "unknown-kafka", "owner": "new-owner"}`),
+			service:        &services.KafkaOwnershipTransferServiceMock{},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return the error of the transfer",
+			body: []byte(`{"kafka_id": "kafka-1", "owner": "new-owner", "organisation_id": "org-2"}`),
+			service: &services.KafkaOwnershipTransferServiceMock{
+				TransferFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, newOwner dbapi.KafkaOwner, reason string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+					return nil, errors.Conflict("kafka %q has changed while it was being transferred", kafkaRequest.ID)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaOwnershipTransfersHandler(tt.service, kafkaService)
+			req, rw := GetHandlerParams(http.MethodPost, transfersUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req.WithContext(ctx))
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantKafkaIDs != nil {
+				transferList := private.KafkaOwnershipTransferList{}
+				err := json.NewDecoder(resp.Body).Decode(&transferList)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(transferList.Kind).To(gomega.Equal("KafkaOwnershipTransferList"))
+				g.Expect(transferList.Total).To(gomega.Equal(int32(len(tt.wantKafkaIDs))))
+				var kafkaIDs []string
+				for _, item := range transferList.Items {
+					g.Expect(item.Owner).To(gomega.Equal("new-owner"))
+					kafkaIDs = append(kafkaIDs, item.KafkaId)
+				}
+				g.Expect(kafkaIDs).To(gomega.Equal(tt.wantKafkaIDs))
+			}
+		})
+	}
+}
+
+func Test_adminKafkaOwnershipTransfersHandler_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var gotQuery services.KafkaOwnershipTransferQuery
+	transferService := &services.KafkaOwnershipTransferServiceMock{
+		ListFunc: func(query services.KafkaOwnershipTransferQuery, listArgs *coreServices.ListArguments) (dbapi.KafkaOwnershipTransferList, *api.PagingMeta, *errors.ServiceError) {
+			gotQuery = query
+			return dbapi.KafkaOwnershipTransferList{
+				{Meta: api.Meta{ID: "transfer-1"}, KafkaID: "kafka-1", PreviousOwner: "leaving-user", Owner: "new-owner"},
+			}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+	}
+
+	h := NewAdminKafkaOwnershipTransfersHandler(transferService, &services.KafkaServiceMock{})
+	req, rw := GetHandlerParams(http.MethodGet, "/kafka_ownership_transfers?kafka_id=kafka-1&owner=leaving-user", nil, t)
+	h.List(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(gotQuery).To(gomega.Equal(services.KafkaOwnershipTransferQuery{KafkaID: "kafka-1", Owner: "leaving-user"}))
+
+	transferList := private.KafkaOwnershipTransferList{}
+	g.Expect(json.NewDecoder(resp.Body).Decode(&transferList)).To(gomega.Succeed())
+	g.Expect(transferList.Items).To(gomega.HaveLen(1))
+	g.Expect(transferList.Items[0].Kind).To(gomega.Equal("KafkaOwnershipTransfer"))
+}
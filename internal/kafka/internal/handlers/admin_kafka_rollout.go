@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminKafkaRolloutHandler struct {
+	kafkaRolloutService services.KafkaRolloutService
+}
+
+func NewAdminKafkaRolloutHandler(kafkaRolloutService services.KafkaRolloutService) *adminKafkaRolloutHandler {
+	return &adminKafkaRolloutHandler{
+		kafkaRolloutService: kafkaRolloutService,
+	}
+}
+
+// Create starts a rollout upgrading the kafkas matching the selector of the request to its target versions.
+// The batches of the rollout are progressed by the KafkaRolloutManager
+func (h adminKafkaRolloutHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var rolloutRequest private.KafkaRolloutRequest
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &rolloutRequest,
+		Validate: []handlers.Validate{
+			validateKafkaRolloutRequest(&rolloutRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout := presenters.ConvertKafkaRolloutRequest(rolloutRequest)
+			if err := h.kafkaRolloutService.Create(rollout); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaRollout(rollout), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the rollouts along with their progress, the most recent ones first
+func (h adminKafkaRolloutHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			rollouts, paging, err := h.kafkaRolloutService.List(listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			rolloutList := private.KafkaRolloutList{
+				Kind:  "KafkaRolloutList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.KafkaRollout{},
+			}
+
+			for _, rollout := range rollouts {
+				rolloutList.Items = append(rolloutList.Items, presenters.PresentKafkaRollout(rollout))
+			}
+
+			return rolloutList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+// Get returns the rollout along with the progress of each of its kafkas
+func (h adminKafkaRolloutHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout, err := h.kafkaRolloutService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaRollout(rollout), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Pause stops the rollout from starting new batches. The kafkas of the current batch keep being upgraded
+func (h adminKafkaRolloutHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.handleStatusUpdate(w, r, h.kafkaRolloutService.Pause)
+}
+
+// Resume lets a paused rollout start new batches again
+func (h adminKafkaRolloutHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.handleStatusUpdate(w, r, h.kafkaRolloutService.Resume)
+}
+
+func (h adminKafkaRolloutHandler) handleStatusUpdate(w http.ResponseWriter, r *http.Request, update func(id string) (*dbapi.KafkaRollout, *errors.ServiceError)) {
+	id := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&id, "id", handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			rollout, err := update(id)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaRollout(rollout), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
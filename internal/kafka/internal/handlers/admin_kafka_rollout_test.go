@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_adminKafkaRolloutHandler_Create(t *testing.T) {
+	const kafkaRolloutsUrl = "/api/kafkas_mgmt/v1/admin/kafka_rollouts"
+
+	kafkaRolloutService := &services.KafkaRolloutServiceMock{
+		CreateFunc: func(rollout *dbapi.KafkaRollout) *errors.ServiceError {
+			rollout.ID = "rollout-id"
+			rollout.Status = dbapi.KafkaRolloutStatusInProgress
+			rollout.Items = []dbapi.KafkaRolloutItem{
+				{KafkaID: "kafka-1", Batch: 1, Status: dbapi.KafkaRolloutItemStatusPending},
+				{KafkaID: "kafka-2", Batch: 1, Status: dbapi.KafkaRolloutItemStatusPending},
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		service        services.KafkaRolloutService
+		wantStatusCode int
+		wantRollout    *private.KafkaRollout
+	}{
+		{
+			name:           "should create the rollout with the default upgrade timeout",
+			body:           []byte(`{"instance_type": "standard", "desired_strimzi_version": "strimzi-cluster-operator.v0.32.0-0", "batch_size": 2}`),
+			service:        kafkaRolloutService,
+			wantStatusCode: http.StatusCreated,
+			wantRollout: &private.KafkaRollout{
+				Id:                    "rollout-id",
+				Kind:                  "KafkaRollout",
+				Href:                  "/api/kafkas_mgmt/v1/admin/kafka_rollouts/rollout-id",
+				Status:                "in_progress",
+				InstanceType:          "standard",
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             2,
+				UpgradeTimeoutSeconds: 3600,
+				BatchCount:            1,
+				KafkaCounts:           private.KafkaRolloutKafkaCounts{Pending: 2},
+				Items: []private.KafkaRolloutItem{
+					{KafkaId: "kafka-1", Batch: 1, Status: "pending"},
+					{KafkaId: "kafka-2", Batch: 1, Status: "pending"},
+				},
+			},
+		},
+		{
+			name:           "should return bad request when the batch size is not set",
+			body:           []byte(`{"desired_strimzi_version": "strimzi-cluster-operator.v0.32.0-0"}`),
+			service:        &services.KafkaRolloutServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when no target version is set",
+			body:           []byte(`{"batch_size": 2}`),
+			service:        &services.KafkaRolloutServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the failure percentage is above 100",
+			body:           []byte(`{"desired_kafka_version": "3.3.1", "batch_size": 2, "max_failure_percentage": 101}`),
+			service:        &services.KafkaRolloutServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the pause between batches is negative",
+			body:           []byte(`{"desired_kafka_version": "3.3.1", "batch_size": 2, "batch_pause_seconds": -1}`),
+			service:        &services.KafkaRolloutServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return bad request when no kafka has to be upgraded",
+			body: []byte(`{"desired_kafka_version": "3.3.1", "batch_size": 2}`),
+			service: &services.KafkaRolloutServiceMock{
+				CreateFunc: func(rollout *dbapi.KafkaRollout) *errors.ServiceError {
+					return errors.BadRequest("no kafka to be upgraded matches the selector of the rollout")
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaRolloutHandler(tt.service)
+			req, rw := GetHandlerParams(http.MethodPost, kafkaRolloutsUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantRollout != nil {
+				rollout := private.KafkaRollout{}
+				err := json.NewDecoder(resp.Body).Decode(&rollout)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(rollout).To(gomega.Equal(*tt.wantRollout))
+			}
+		})
+	}
+}
+
+func Test_adminKafkaRolloutHandler(t *testing.T) {
+	const rolloutID = "rollout-id"
+
+	rollout := func(status dbapi.KafkaRolloutStatus) *dbapi.KafkaRollout {
+		return &dbapi.KafkaRollout{
+			Meta:                api.Meta{ID: rolloutID},
+			Status:              status,
+			DesiredKafkaVersion: "3.3.1",
+			BatchSize:           1,
+			Items: []dbapi.KafkaRolloutItem{
+				{KafkaID: "kafka-1", Batch: 1, Status: dbapi.KafkaRolloutItemStatusUpgrading},
+			},
+		}
+	}
+
+	kafkaRolloutService := &services.KafkaRolloutServiceMock{
+		GetFunc: func(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+			if id != rolloutID {
+				return nil, errors.NotFound("KafkaRollout with id='%s' not found", id)
+			}
+			return rollout(dbapi.KafkaRolloutStatusInProgress), nil
+		},
+		ListFunc: func(listArgs *coreServices.ListArguments) (dbapi.KafkaRolloutList, *api.PagingMeta, *errors.ServiceError) {
+			return dbapi.KafkaRolloutList{rollout(dbapi.KafkaRolloutStatusInProgress)}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+		},
+		PauseFunc: func(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+			return rollout(dbapi.KafkaRolloutStatusPaused), nil
+		},
+		ResumeFunc: func(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+			return nil, errors.BadRequest("rollout %q is in %q status", id, dbapi.KafkaRolloutStatusCompleted)
+		},
+	}
+
+	type args struct {
+		method string
+		id     string
+		handle func(h *adminKafkaRolloutHandler) http.HandlerFunc
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantStatusCode int
+		wantStatus     string
+	}{
+		{
+			name: "should return the rollout",
+			args: args{
+				method: http.MethodGet,
+				id:     rolloutID,
+				handle: func(h *adminKafkaRolloutHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusOK,
+			wantStatus:     "in_progress",
+		},
+		{
+			name: "should return not found when the rollout does not exist",
+			args: args{
+				method: http.MethodGet,
+				id:     "unknown-id",
+				handle: func(h *adminKafkaRolloutHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should pause the rollout",
+			args: args{
+				method: http.MethodPost,
+				id:     rolloutID,
+				handle: func(h *adminKafkaRolloutHandler) http.HandlerFunc { return h.Pause },
+			},
+			wantStatusCode: http.StatusOK,
+			wantStatus:     "paused",
+		},
+		{
+			name: "should return bad request when the rollout cannot be resumed",
+			args: args{
+				method: http.MethodPost,
+				id:     rolloutID,
+				handle: func(h *adminKafkaRolloutHandler) http.HandlerFunc { return h.Resume },
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaRolloutHandler(kafkaRolloutService)
+			req, rw := GetHandlerParams(tt.args.method, "/kafka_rollouts/"+tt.args.id, nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.args.id})
+			tt.args.handle(h)(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantStatus != "" {
+				rollout := private.KafkaRollout{}
+				err := json.NewDecoder(resp.Body).Decode(&rollout)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(rollout.Id).To(gomega.Equal(rolloutID))
+				g.Expect(rollout.Status).To(gomega.Equal(tt.wantStatus))
+				g.Expect(rollout.KafkaCounts.Upgrading).To(gomega.Equal(int32(1)))
+			}
+		})
+	}
+
+	t.Run("should list the rollouts", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		h := NewAdminKafkaRolloutHandler(kafkaRolloutService)
+		req, rw := GetHandlerParams(http.MethodGet, "/kafka_rollouts", nil, t)
+		h.List(rw, req)
+		resp := rw.Result()
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+		list := private.KafkaRolloutList{}
+		err := json.NewDecoder(resp.Body).Decode(&list)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(list.Kind).To(gomega.Equal("KafkaRolloutList"))
+		g.Expect(list.Total).To(gomega.Equal(int32(1)))
+		g.Expect(list.Items).To(gomega.HaveLen(1))
+	})
+}
@@ -629,6 +629,11 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						kafkaRequest.Status = constants.KafkaRequestStatusSuspending.String()
+						kafkaRequest.SuspensionReason = reason
+						return nil
+					},
 				},
 				accountService: account.NewMockAccountService(),
 			},
@@ -916,6 +921,10 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
+					ResumeFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+						return nil
+					},
 				},
 				accountService: account.NewMockAccountService(),
 			},
@@ -1051,6 +1060,10 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
+					ResumeFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+						return nil
+					},
 				},
 				accountService: account.NewMockAccountService(),
 			},
@@ -1119,6 +1132,10 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 					VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
+					ResumeFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						kafkaRequest.Status = constants.KafkaRequestStatusResuming.String()
+						return nil
+					},
 				},
 				accountService: account.NewMockAccountService(),
 			},
@@ -1129,6 +1146,54 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 			wantStatusCode:  http.StatusOK,
 			wantKafkaStatus: constants.KafkaRequestStatusResuming,
 		},
+		{
+			name: "should return an error when the cluster of a suspended instance does not have the capacity to resume it",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID: clusterID,
+						}, nil
+					},
+					IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion, kafkaVersion, ibpVersion string) (bool, error) {
+						return true, nil
+					},
+					CheckStrimziVersionReadyFunc: func(cluster *api.Cluster, strimziVersion string) (bool, error) {
+						return true, nil
+					},
+				},
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{
+							Status: constants.KafkaRequestStatusSuspended.String(),
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID:              "cluster-id",
+							ActualKafkaIBPVersion:  "2.8",
+							DesiredKafkaIBPVersion: "2.8",
+							ActualKafkaVersion:     "2.8",
+							DesiredKafkaVersion:    "2.8",
+							DesiredStrimziVersion:  "2.8",
+							KafkaStorageSize:       "100",
+						}, nil
+					},
+					ResumeFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.TooManyKafkaInstancesReached("cluster %q cannot accept more streaming units", kafkaRequest.ClusterID)
+					},
+				},
+				accountService: account.NewMockAccountService(),
+				kafkaConfig:    &config.KafkaConfig{},
+			},
+			args: args{
+				url:  kafkaByIdUrl,
+				body: []byte(`{"suspended": false}`),
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -1150,3 +1215,228 @@ func Test_adminKafkaHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_adminKafkaHandler_Migrate(t *testing.T) {
+	type fields struct {
+		kafkaService   services.KafkaService
+		clusterService services.ClusterService
+	}
+	type args struct {
+		url  string
+		body []byte
+	}
+
+	readyKafka := func(options ...mocks.KafkaRequestBuildOption) func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+		return func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			return mocks.BuildKafkaRequest(append([]mocks.KafkaRequestBuildOption{mocks.WithPredefinedTestValues()}, options...)...), nil
+		}
+	}
+
+	clusterService := &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			switch clusterID {
+			case "target-cluster-id":
+				return &api.Cluster{ClusterID: clusterID, CloudProvider: mocks.DefaultKafkaRequestProvider, Region: mocks.DefaultKafkaRequestRegion}, nil
+			case "other-region-cluster-id":
+				return &api.Cluster{ClusterID: clusterID, CloudProvider: mocks.DefaultKafkaRequestProvider, Region: "eu-west-1"}, nil
+			default:
+				return nil, nil
+			}
+		},
+	}
+
+	migrateUrl := "/kafkas/{id}/migrate?async=true"
+
+	tests := []struct {
+		name                   string
+		fields                 fields
+		args                   args
+		wantStatusCode         int
+		wantMigrationClusterID string
+	}{
+		{
+			name: "should return an error if async flag is not set to true",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(),
+				},
+			},
+			args: args{
+				url:  "/kafkas/{id}/migrate",
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if kafka to migrate can't be found",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.NotFound("not found")
+					},
+				},
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return an error if kafka is not ready",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(mocks.With(mocks.STATUS, constants.KafkaRequestStatusSuspended.String())),
+				},
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if kafka is an enterprise kafka",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(mocks.With(mocks.DESIRED_KAFKA_BILLING_MODEL, constants.BillingModelEnterprise.String())),
+				},
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if a migration of the kafka is already in progress",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(mocks.With(mocks.MIGRATION_STATUS, dbapi.KafkaMigrationStatusProvisioning.String())),
+				},
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if the kafka of a failed migration is still being deleted from the target cluster",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(
+						mocks.With(mocks.MIGRATION_STATUS, dbapi.KafkaMigrationStatusFailed.String()),
+						mocks.With(mocks.MIGRATION_CLUSTER_ID, "target-cluster-id"),
+					),
+				},
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if the target cluster is the current cluster of the kafka",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(),
+				},
+				clusterService: clusterService,
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{"target_cluster_id": "` + mocks.DefaultClusterID + `"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if the target cluster does not exist",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(),
+				},
+				clusterService: clusterService,
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{"target_cluster_id": "unknown-cluster-id"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if the target cluster is in another region",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(),
+				},
+				clusterService: clusterService,
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{"target_cluster_id": "other-region-cluster-id"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should accept the migration of the kafka to the requested target cluster",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(),
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
+				},
+				clusterService: clusterService,
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{"target_cluster_id": "target-cluster-id"}`),
+			},
+			wantStatusCode:         http.StatusAccepted,
+			wantMigrationClusterID: "target-cluster-id",
+		},
+		{
+			name: "should accept the migration of the kafka after a failed one, letting the placement strategy choose the target cluster",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: readyKafka(
+						mocks.With(mocks.MIGRATION_STATUS, dbapi.KafkaMigrationStatusFailed.String()),
+						mocks.With(mocks.MIGRATION_DETAILS, "no other data plane cluster can receive the kafka"),
+					),
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
+				},
+				clusterService: clusterService,
+			},
+			args: args{
+				url:  migrateUrl,
+				body: []byte(`{}`),
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, account.NewMockAccountService(), nil, tt.fields.clusterService, nil)
+			req, rw := GetHandlerParams("POST", tt.args.url, bytes.NewBuffer(tt.args.body), t)
+			h.Migrate(rw, req)
+			resp := rw.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode == http.StatusAccepted {
+				kafka := &private.Kafka{}
+				err := json.NewDecoder(resp.Body).Decode(&kafka)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(kafka.MigrationStatus).To(gomega.Equal(dbapi.KafkaMigrationStatusPending.String()))
+				g.Expect(kafka.MigrationClusterId).To(gomega.Equal(tt.wantMigrationClusterID))
+				g.Expect(kafka.MigrationDetails).To(gomega.BeEmpty())
+			}
+			resp.Body.Close()
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type adminQuotaGrantsHandler struct {
+	quotaGrantService services.QuotaGrantService
+	kafkaConfig       *config.KafkaConfig
+}
+
+func NewAdminQuotaGrantsHandler(quotaGrantService services.QuotaGrantService, kafkaConfig *config.KafkaConfig) *adminQuotaGrantsHandler {
+	return &adminQuotaGrantsHandler{
+		quotaGrantService: quotaGrantService,
+		kafkaConfig:       kafkaConfig,
+	}
+}
+
+// Create grants quota of a supported instance type and billing model to an organisation or to an account
+func (h adminQuotaGrantsHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var grantRequest private.QuotaGrantRequest
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &grantRequest,
+		Validate: []handlers.Validate{
+			validateQuotaGrantRequest(&grantRequest, h.kafkaConfig),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			grant, err := presenters.ConvertQuotaGrantRequest(grantRequest)
+			if err != nil {
+				return nil, errors.FieldValidationError("expiration_date must be in the YYYY-MM-DD {+,-}{HH:MM} format: %v", err)
+			}
+
+			if svcErr := h.quotaGrantService.Create(grant); svcErr != nil {
+				return nil, svcErr
+			}
+
+			return presenters.PresentQuotaGrant(grant), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the quota grants selected by the subject_type, subject_id, instance_type_id and billing_model_id query parameters
+func (h adminQuotaGrantsHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			queryParams := r.URL.Query()
+			query := services.QuotaGrantQuery{
+				SubjectType:    dbapi.QuotaGrantSubjectType(queryParams.Get("subject_type")),
+				SubjectID:      queryParams.Get("subject_id"),
+				InstanceTypeID: queryParams.Get("instance_type_id"),
+				BillingModelID: queryParams.Get("billing_model_id"),
+			}
+
+			if query.SubjectType != "" {
+				if err := validateQuotaGrantSubjectType(query.SubjectType.String()); err != nil {
+					return nil, err
+				}
+			}
+
+			listArgs := coreServices.NewListArguments(queryParams)
+			grants, paging, err := h.quotaGrantService.List(query, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			grantList := private.QuotaGrantList{
+				Kind:  "QuotaGrantList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []private.QuotaGrant{},
+			}
+
+			for _, grant := range grants {
+				grantList.Items = append(grantList.Items, presenters.PresentQuotaGrant(grant))
+			}
+
+			return grantList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminQuotaGrantsHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			grant, err := h.quotaGrantService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentQuotaGrant(grant), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Update changes the maximum allowed instances or the expiration date of a quota grant. An empty expiration date removes it,
+// i.e. the quota never expires
+func (h adminQuotaGrantsHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var updateRequest private.QuotaGrantUpdateRequest
+	id := mux.Vars(r)["id"]
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &updateRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&id, "id", handlers.MinRequiredFieldLength, nil),
+			validateQuotaGrantUpdateRequest(&updateRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			grant, svcErr := h.quotaGrantService.Get(id)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+
+			if updateRequest.MaxAllowedInstances != nil {
+				grant.MaxAllowedInstances = int(*updateRequest.MaxAllowedInstances)
+			}
+
+			if updateRequest.ExpirationDate != nil {
+				expirationDate, err := presenters.ConvertQuotaGrantExpirationDate(*updateRequest.ExpirationDate)
+				if err != nil {
+					return nil, errors.FieldValidationError("expiration_date must be empty or in the YYYY-MM-DD {+,-}{HH:MM} format: %v", err)
+				}
+				grant.ExpirationDate = expirationDate
+			}
+
+			if svcErr := h.quotaGrantService.Update(grant); svcErr != nil {
+				return nil, svcErr
+			}
+
+			return presenters.PresentQuotaGrant(grant), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Delete revokes a quota grant. The kafkas already created with the quota are left untouched
+func (h adminQuotaGrantsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateLength(&id, "id", handlers.MinRequiredFieldLength, nil),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			if _, err := h.quotaGrantService.Get(id); err != nil {
+				return nil, err
+			}
+
+			return nil, h.quotaGrantService.Delete(id)
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildQuotaGrantsKafkaConfig() *config.KafkaConfig {
+	return &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: "standard",
+						SupportedBillingModels: []config.KafkaBillingModel{
+							{ID: "standard"},
+							{ID: "enterprise"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_adminQuotaGrantsHandler_Create(t *testing.T) {
+	const quotaGrantsUrl = "/api/kafkas_mgmt/v1/admin/quota_grants"
+
+	quotaGrantService := &services.QuotaGrantServiceMock{
+		CreateFunc: func(grant *dbapi.QuotaGrant) *errors.ServiceError {
+			grant.ID = "grant-id"
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           []byte
+		service        services.QuotaGrantService
+		wantStatusCode int
+		wantGrant      *private.QuotaGrant
+	}{
+		{
+			name:           "should grant quota to an organisation",
+			body:           []byte(`{"subject_type": "organisation", "subject_id": "13640203", "instance_type_id": "standard", "billing_model_id": "enterprise", "max_allowed_instances": 10, "expiration_date": "2020-01-31 +00:00"}`),
+			service:        quotaGrantService,
+			wantStatusCode: http.StatusCreated,
+			wantGrant: &private.QuotaGrant{
+				Id:                  "grant-id",
+				Kind:                "QuotaGrant",
+				Href:                "/api/kafkas_mgmt/v1/admin/quota_grants/grant-id",
+				SubjectType:         "organisation",
+				SubjectId:           "13640203",
+				InstanceTypeId:      "standard",
+				BillingModelId:      "enterprise",
+				MaxAllowedInstances: 10,
+				ExpirationDate:      "2020-01-31 +00:00",
+				Expired:             true,
+			},
+		},
+		{
+			name:           "should return bad request when the subject type is not valid",
+			body:           []byte(`{"subject_type": "user", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "standard"}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the subject id is not set",
+			body:           []byte(`{"subject_type": "account", "instance_type_id": "standard", "billing_model_id": "standard"}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the instance type is not supported",
+			body:           []byte(`{"subject_type": "account", "subject_id": "testuser", "instance_type_id": "developer", "billing_model_id": "standard"}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the billing model is not supported by the instance type",
+			body:           []byte(`{"subject_type": "account", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "eval"}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the maximum allowed instances are negative",
+			body:           []byte(`{"subject_type": "account", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "standard", "max_allowed_instances": -1}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the expiration date is not valid",
+			body:           []byte(`{"subject_type": "account", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "standard", "expiration_date": "31/01/2020"}`),
+			service:        &services.QuotaGrantServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return conflict when the quota has already been granted",
+			body: []byte(`{"subject_type": "account", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "standard"}`),
+			service: &services.QuotaGrantServiceMock{
+				CreateFunc: func(grant *dbapi.QuotaGrant) *errors.ServiceError {
+					return errors.Conflict("account %q has already been granted quota", grant.SubjectID)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminQuotaGrantsHandler(tt.service, buildQuotaGrantsKafkaConfig())
+			req, rw := GetHandlerParams(http.MethodPost, quotaGrantsUrl, bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantGrant != nil {
+				grant := private.QuotaGrant{}
+				err := json.NewDecoder(resp.Body).Decode(&grant)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(grant).To(gomega.Equal(*tt.wantGrant))
+			}
+		})
+	}
+}
+
+func Test_adminQuotaGrantsHandler(t *testing.T) {
+	const grantID = "grant-id"
+
+	var updatedGrant *dbapi.QuotaGrant
+	quotaGrantService := &services.QuotaGrantServiceMock{
+		GetFunc: func(id string) (*dbapi.QuotaGrant, *errors.ServiceError) {
+			if id != grantID {
+				return nil, errors.NotFound("QuotaGrant with id='%s' not found", id)
+			}
+			expirationDate, _ := time.Parse("2006-01-02", "2020-01-31")
+			return &dbapi.QuotaGrant{
+				Meta:                api.Meta{ID: grantID},
+				SubjectType:         dbapi.QuotaGrantSubjectTypeAccount,
+				SubjectID:           "testuser",
+				InstanceTypeID:      "standard",
+				BillingModelID:      "standard",
+				MaxAllowedInstances: 1,
+				ExpirationDate:      &expirationDate,
+			}, nil
+		},
+		UpdateFunc: func(grant *dbapi.QuotaGrant) *errors.ServiceError {
+			updatedGrant = grant
+			return nil
+		},
+		DeleteFunc: func(id string) *errors.ServiceError {
+			return nil
+		},
+	}
+
+	type args struct {
+		method string
+		id     string
+		body   []byte
+		handle func(h *adminQuotaGrantsHandler) http.HandlerFunc
+	}
+	tests := []struct {
+		name               string
+		args               args
+		wantStatusCode     int
+		wantUpdatedGrant   bool
+		wantMaxAllowed     int
+		wantExpirationDate bool
+	}{
+		{
+			name: "should return the quota grant",
+			args: args{
+				method: http.MethodGet,
+				id:     grantID,
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return not found when the quota grant does not exist",
+			args: args{
+				method: http.MethodGet,
+				id:     "unknown-id",
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Get },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should update the maximum allowed instances and leave the expiration date untouched",
+			args: args{
+				method: http.MethodPatch,
+				id:     grantID,
+				body:   []byte(`{"max_allowed_instances": 5}`),
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Update },
+			},
+			wantStatusCode:     http.StatusOK,
+			wantUpdatedGrant:   true,
+			wantMaxAllowed:     5,
+			wantExpirationDate: true,
+		},
+		{
+			name: "should remove the expiration date when it is empty",
+			args: args{
+				method: http.MethodPatch,
+				id:     grantID,
+				body:   []byte(`{"expiration_date": ""}`),
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Update },
+			},
+			wantStatusCode:   http.StatusOK,
+			wantUpdatedGrant: true,
+			wantMaxAllowed:   1,
+		},
+		{
+			name: "should return bad request when nothing is updated",
+			args: args{
+				method: http.MethodPatch,
+				id:     grantID,
+				body:   []byte(`{}`),
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Update },
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return not found when updating a quota grant that does not exist",
+			args: args{
+				method: http.MethodPatch,
+				id:     "unknown-id",
+				body:   []byte(`{"max_allowed_instances": 5}`),
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Update },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should revoke the quota grant",
+			args: args{
+				method: http.MethodDelete,
+				id:     grantID,
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Delete },
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "should return not found when revoking a quota grant that does not exist",
+			args: args{
+				method: http.MethodDelete,
+				id:     "unknown-id",
+				handle: func(h *adminQuotaGrantsHandler) http.HandlerFunc { return h.Delete },
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			updatedGrant = nil
+			h := NewAdminQuotaGrantsHandler(quotaGrantService, buildQuotaGrantsKafkaConfig())
+			req, rw := GetHandlerParams(tt.args.method, "/quota_grants/"+tt.args.id, bytes.NewBuffer(tt.args.body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.args.id})
+			tt.args.handle(h)(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			g.Expect(updatedGrant != nil).To(gomega.Equal(tt.wantUpdatedGrant))
+			if tt.wantUpdatedGrant {
+				g.Expect(updatedGrant.MaxAllowedInstances).To(gomega.Equal(tt.wantMaxAllowed))
+				g.Expect(updatedGrant.ExpirationDate != nil).To(gomega.Equal(tt.wantExpirationDate))
+			}
+		})
+	}
+
+	t.Run("should list the quota grants", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		service := &services.QuotaGrantServiceMock{
+			ListFunc: func(query services.QuotaGrantQuery, listArgs *coreServices.ListArguments) (dbapi.QuotaGrantList, *api.PagingMeta, *errors.ServiceError) {
+				g.Expect(query).To(gomega.Equal(services.QuotaGrantQuery{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203"}))
+				return dbapi.QuotaGrantList{
+					{Meta: api.Meta{ID: grantID}, SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203"},
+				}, &api.PagingMeta{Page: 1, Size: 1, Total: 1}, nil
+			},
+		}
+		h := NewAdminQuotaGrantsHandler(service, buildQuotaGrantsKafkaConfig())
+		req, rw := GetHandlerParams(http.MethodGet, "/quota_grants?subject_type=organisation&subject_id=13640203", nil, t)
+		h.List(rw, req)
+		resp := rw.Result()
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+		list := private.QuotaGrantList{}
+		err := json.NewDecoder(resp.Body).Decode(&list)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(list.Kind).To(gomega.Equal("QuotaGrantList"))
+		g.Expect(list.Total).To(gomega.Equal(int32(1)))
+		g.Expect(list.Items).To(gomega.HaveLen(1))
+	})
+
+	t.Run("should return bad request when listing the quota grants of an unknown subject type", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		h := NewAdminQuotaGrantsHandler(&services.QuotaGrantServiceMock{}, buildQuotaGrantsKafkaConfig())
+		req, rw := GetHandlerParams(http.MethodGet, "/quota_grants?subject_type=user", nil, t)
+		h.List(rw, req)
+		resp := rw.Result()
+		defer resp.Body.Close()
+		g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusBadRequest))
+	})
+}
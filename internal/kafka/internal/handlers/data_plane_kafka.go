@@ -45,35 +45,53 @@ func (h *dataPlaneKafkaHandler) UpdateKafkaStatuses(w http.ResponseWriter, r *ht
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
 
+// GetAll returns the ManagedKafkas of the data plane cluster. When the since query parameter is set to the resource version of a
+// previous list, only the ManagedKafkas that changed since then are returned. A 304 Not Modified is returned when none changed
+// since the resource version of the since query parameter or of the If-None-Match header
 func (h *dataPlaneKafkaHandler) GetAll(w http.ResponseWriter, r *http.Request) {
 	clusterID := mux.Vars(r)["id"]
+	since := r.URL.Query().Get("since")
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateLength(&clusterID, "id", handlers.MinRequiredFieldLength, nil),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
-			managedKafkas, err := h.kafkaService.GetManagedKafkaByClusterID(clusterID)
-			if err != nil {
-				return nil, err
+			sinceResourceVersion := since
+			if sinceResourceVersion == "" {
+				sinceResourceVersion = handlers.IfNoneMatch(r)
 			}
 
-			reservedManagedKafkas, err := h.kafkaService.GenerateReservedManagedKafkasByClusterID(clusterID)
+			changes, err := h.kafkaService.GetManagedKafkaChangesByClusterID(clusterID, sinceResourceVersion)
 			if err != nil {
 				return nil, err
 			}
-			managedKafkas = append(managedKafkas, reservedManagedKafkas...)
+
+			if changes.IsEmpty() {
+				return handlers.VersionedResult{Version: changes.ResourceVersion}, nil
+			}
+
+			// the resource version of the If-None-Match header only tells whether the ManagedKafkas changed, all of them are returned when they did
+			if since == "" && changes.Incremental {
+				changes, err = h.kafkaService.GetManagedKafkaChangesByClusterID(clusterID, "")
+				if err != nil {
+					return nil, err
+				}
+			}
 
 			managedKafkaList := private.ManagedKafkaList{
-				Kind:  "ManagedKafkaList",
-				Items: []private.ManagedKafka{},
+				Kind:            "ManagedKafkaList",
+				Items:           []private.ManagedKafka{},
+				ResourceVersion: changes.ResourceVersion,
+				Incremental:     changes.Incremental,
+				RemovedIds:      changes.RemovedKafkaIDs,
 			}
 
 			managedKafkaList.Items = append(
 				managedKafkaList.Items,
-				arrays.Map(managedKafkas, func(mk v1.ManagedKafka) private.ManagedKafka { return presenters.PresentManagedKafka(&mk) })...,
+				arrays.Map(changes.ManagedKafkas, func(mk v1.ManagedKafka) private.ManagedKafka { return presenters.PresentManagedKafka(&mk) })...,
 			)
 
-			return managedKafkaList, nil
+			return handlers.VersionedResult{Result: managedKafkaList, Version: changes.ResourceVersion}, nil
 		},
 	}
 
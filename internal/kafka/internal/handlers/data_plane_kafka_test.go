@@ -87,92 +87,135 @@ func Test_UpdateUpdateKafkaStatuses(t *testing.T) {
 }
 
 func Test_GetAll(t *testing.T) {
-	type fields struct {
-		dataplaneKafkaService services.DataPlaneKafkaService
-		kafkaService          services.KafkaService
+	managedKafka := func(id string) v1.ManagedKafka {
+		return v1.ManagedKafka{
+			Id: id,
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					"bf2.org/id": id,
+				},
+			},
+		}
 	}
 
 	type args struct {
-		clusterId string
+		clusterId   string
+		since       string
+		ifNoneMatch string
 	}
 
 	tests := []struct {
-		name           string
-		args           args
-		fields         fields
-		wantStatusCode int
-		wantKafkaIDs   []string
+		name                     string
+		args                     args
+		changes                  func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError)
+		wantStatusCode           int
+		wantETag                 string
+		wantKafkaIDs             []string
+		wantRemovedIDs           []string
+		wantIncremental          bool
+		wantSinceResourceVersion []string
 	}{
 		{
 			name:           "empty cluster ID should fail validation",
 			wantStatusCode: http.StatusBadRequest,
 		},
 		{
-			name: "should fail when GetManagedKafkaByClusterID fails",
+			name: "should fail when the managed kafkas cannot be retrieved",
 			args: args{
 				clusterId: testId,
 			},
-			fields: fields{
-				kafkaService: &services.KafkaServiceMock{
-					GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *errors.ServiceError) {
-						return nil, errors.GeneralError("failed to get kafka by cluster id")
-					},
-				},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				return nil, errors.GeneralError("failed to get kafka by cluster id")
 			},
-			wantStatusCode: http.StatusInternalServerError,
+			wantStatusCode:           http.StatusInternalServerError,
+			wantSinceResourceVersion: []string{""},
 		},
 		{
-			name: "should fail when generation of reserved managed kafka instances fails",
+			name: "should successfully return ManagedKafkaList",
 			args: args{
 				clusterId: testId,
 			},
-			fields: fields{
-				kafkaService: &services.KafkaServiceMock{
-					GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *errors.ServiceError) {
-						return []v1.ManagedKafka{{Id: testId}}, nil
-					},
-					GenerateReservedManagedKafkasByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *errors.ServiceError) {
-						return nil, errors.GeneralError("test fail")
-					},
-				},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				return &services.ManagedKafkaChanges{
+					ResourceVersion: "2-1-abc",
+					ManagedKafkas:   []v1.ManagedKafka{managedKafka(testId), managedKafka("reserved-kafka-test-1")},
+				}, nil
 			},
-			wantStatusCode: http.StatusInternalServerError,
+			wantStatusCode:           http.StatusOK,
+			wantETag:                 `"2-1-abc"`,
+			wantKafkaIDs:             []string{testId, "reserved-kafka-test-1"},
+			wantSinceResourceVersion: []string{""},
 		},
 		{
-			name: "should successfully return ManagedKafkaList",
+			name: "should only return the ManagedKafkas that changed since the requested resource version",
 			args: args{
 				clusterId: testId,
+				since:     "1-1-abc",
 			},
-			fields: fields{
-				kafkaService: &services.KafkaServiceMock{
-					GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *errors.ServiceError) {
-						return []v1.ManagedKafka{
-							{
-								Id: testId,
-								ObjectMeta: metav1.ObjectMeta{
-									Annotations: map[string]string{
-										"bf2.org/id": testId,
-									},
-								},
-							},
-						}, nil
-					},
-					GenerateReservedManagedKafkasByClusterIDFunc: func(clusterID string) ([]v1.ManagedKafka, *errors.ServiceError) {
-						return []v1.ManagedKafka{
-							v1.ManagedKafka{
-								Id: "reserved-kafka-test-1",
-								ObjectMeta: metav1.ObjectMeta{
-									Annotations: map[string]string{
-										"bf2.org/id": "reserved-kafka-test-1",
-									},
-								},
-							},
-						}, nil
-					},
-				},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				return &services.ManagedKafkaChanges{
+					ResourceVersion: "2-1-abc",
+					Incremental:     true,
+					ManagedKafkas:   []v1.ManagedKafka{managedKafka(testId)},
+					RemovedKafkaIDs: []string{"removed-kafka"},
+				}, nil
 			},
-			wantStatusCode: http.StatusOK,
-			wantKafkaIDs:   []string{testId, "reserved-kafka-test-1"},
+			wantStatusCode:           http.StatusOK,
+			wantETag:                 `"2-1-abc"`,
+			wantKafkaIDs:             []string{testId},
+			wantRemovedIDs:           []string{"removed-kafka"},
+			wantIncremental:          true,
+			wantSinceResourceVersion: []string{"1-1-abc"},
+		},
+		{
+			name: "should return a 304 when nothing changed since the requested resource version",
+			args: args{
+				clusterId: testId,
+				since:     "1-1-abc",
+			},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				return &services.ManagedKafkaChanges{ResourceVersion: "2-1-abc", Incremental: true}, nil
+			},
+			wantStatusCode:           http.StatusNotModified,
+			wantETag:                 `"2-1-abc"`,
+			wantSinceResourceVersion: []string{"1-1-abc"},
+		},
+		{
+			name: "should return a 304 when nothing changed since the resource version of the If-None-Match header",
+			args: args{
+				clusterId:   testId,
+				ifNoneMatch: `"1-1-abc"`,
+			},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				return &services.ManagedKafkaChanges{ResourceVersion: "2-1-abc", Incremental: true}, nil
+			},
+			wantStatusCode:           http.StatusNotModified,
+			wantETag:                 `"2-1-abc"`,
+			wantSinceResourceVersion: []string{"1-1-abc"},
+		},
+		{
+			name: "should return all the ManagedKafkas when they changed since the resource version of the If-None-Match header",
+			args: args{
+				clusterId:   testId,
+				ifNoneMatch: `"1-1-abc"`,
+			},
+			changes: func(sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+				if sinceResourceVersion != "" {
+					return &services.ManagedKafkaChanges{
+						ResourceVersion: "2-1-abc",
+						Incremental:     true,
+						ManagedKafkas:   []v1.ManagedKafka{managedKafka(testId)},
+					}, nil
+				}
+				return &services.ManagedKafkaChanges{
+					ResourceVersion: "2-1-abc",
+					ManagedKafkas:   []v1.ManagedKafka{managedKafka(testId), managedKafka("reserved-kafka-test-1")},
+				}, nil
+			},
+			wantStatusCode:           http.StatusOK,
+			wantETag:                 `"2-1-abc"`,
+			wantKafkaIDs:             []string{testId, "reserved-kafka-test-1"},
+			wantSinceResourceVersion: []string{"1-1-abc", ""},
 		},
 	}
 
@@ -181,23 +224,44 @@ func Test_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewDataPlaneKafkaHandler(tt.fields.dataplaneKafkaService, tt.fields.kafkaService)
+			kafkaService := &services.KafkaServiceMock{
+				GetManagedKafkaChangesByClusterIDFunc: func(clusterID string, sinceResourceVersion string) (*services.ManagedKafkaChanges, *errors.ServiceError) {
+					return tt.changes(sinceResourceVersion)
+				},
+			}
+			h := NewDataPlaneKafkaHandler(nil, kafkaService)
 
-			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
+			req, rw := GetHandlerParams("GET", "/{id}?since="+tt.args.since, nil, t)
 			req = mux.SetURLVars(req, map[string]string{"id": tt.args.clusterId})
+			if tt.args.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.args.ifNoneMatch)
+			}
 
 			h.GetAll(rw, req)
 			resp := rw.Result()
-			var respBody private.ManagedKafkaList
+			defer resp.Body.Close()
 			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(resp.Header.Get("ETag")).To(gomega.Equal(tt.wantETag))
+
+			var sinceResourceVersions []string
+			for _, call := range kafkaService.GetManagedKafkaChangesByClusterIDCalls() {
+				sinceResourceVersions = append(sinceResourceVersions, call.SinceResourceVersion)
+			}
+			g.Expect(sinceResourceVersions).To(gomega.Equal(tt.wantSinceResourceVersion))
+
+			if tt.wantStatusCode == http.StatusNotModified {
+				return
+			}
+
+			var respBody private.ManagedKafkaList
 			decodeErr := json.NewDecoder(resp.Body).Decode(&respBody)
 			g.Expect(decodeErr).NotTo(gomega.HaveOccurred())
 			g.Expect(respBody.Items).Should(gomega.HaveLen(len(tt.wantKafkaIDs)))
 			for idx, managedkafka := range respBody.Items {
 				g.Expect(managedkafka.Id).To(gomega.Equal(tt.wantKafkaIDs[idx]))
 			}
-
-			resp.Body.Close()
+			g.Expect(respBody.RemovedIds).To(gomega.Equal(tt.wantRemovedIDs))
+			g.Expect(respBody.Incremental).To(gomega.Equal(tt.wantIncremental))
 		})
 	}
 }
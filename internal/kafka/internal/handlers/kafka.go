@@ -3,6 +3,8 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	config "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
@@ -21,18 +23,22 @@ type kafkaHandler struct {
 	providerConfig *config.ProviderConfig
 	authService    authorization.Authorization
 	kafkaConfig    *config.KafkaConfig
+
+	suspensionConfig *config.KafkaSuspensionConfig
 }
 
 func GetAcceptedOrderByParams() []string {
 	return []string{"bootstrap_server_host", "cloud_provider", "cluster_id", "created_at", "href", "id", "instance_type", "multi_az", "name", "organisation_id", "owner", "reauthentication_enabled", "region", "status", "updated_at", "version"}
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig,
+	suspensionConfig *config.KafkaSuspensionConfig) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
-		kafkaConfig:    kafkaConfig,
+		service:          service,
+		providerConfig:   providerConfig,
+		authService:      authService,
+		kafkaConfig:      kafkaConfig,
+		suspensionConfig: suspensionConfig,
 	}
 }
 
@@ -53,6 +59,8 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateKafkaPlan(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
 			validateKafkaBillingModel(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
 			ValidateBillingCloudAccountIdAndMarketplace(ctx, h.service, &kafkaRequestPayload),
+			ValidateKafkaMaintenanceWindow(&kafkaRequestPayload),
+			ValidateKafkaLabels(&kafkaRequestPayload),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			convKafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
@@ -121,6 +129,10 @@ func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list kafka requests: %s", err.Error())
 			}
 
+			if err := listArgs.ValidateFields(handlers.GetJSONFieldNames(public.KafkaRequest{})); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "unable to list kafka requests: %s", err.Error())
+			}
+
 			kafkaRequests, paging, err := h.service.List(ctx, listArgs)
 			if err != nil {
 				return nil, err
@@ -142,7 +154,7 @@ func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 				kafkaRequestList.Items = append(kafkaRequestList.Items, converted)
 			}
 
-			return kafkaRequestList, nil
+			return handlers.PresentListWithFields(kafkaRequestList, listArgs.Fields)
 		},
 	}
 
@@ -165,8 +177,17 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
 			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaPlanUpdate(h.kafkaConfig, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaSuspendedUpdate(h.suspensionConfig, h.kafkaConfig, kafkaRequest, &kafkaUpdateReq),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if kafkaUpdateReq.Plan != nil {
+				sizeID, _ := config.Plan(*kafkaUpdateReq.Plan).GetSizeID()
+				if resizeErr := h.service.Resize(kafkaRequest, sizeID); resizeErr != nil {
+					return nil, resizeErr
+				}
+			}
+
 			updatedNeeded := false
 			if kafkaUpdateReq.ReauthenticationEnabled != nil && kafkaRequest.ReauthenticationEnabled != *kafkaUpdateReq.ReauthenticationEnabled {
 				kafkaRequest.ReauthenticationEnabled = *kafkaUpdateReq.ReauthenticationEnabled
@@ -178,10 +199,18 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				updatedNeeded = true
 			}
 
+			if kafkaUpdateReq.MaintenanceWindow != nil {
+				if err := kafkaRequest.SetMaintenanceWindow(presenters.ConvertKafkaMaintenanceWindow(kafkaUpdateReq.MaintenanceWindow)); err != nil {
+					return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to update kafka request maintenance window")
+				}
+				updatedNeeded = true
+			}
+
 			if updatedNeeded {
 				updateErr := h.service.Updates(kafkaRequest, map[string]interface{}{
 					"reauthentication_enabled": kafkaRequest.ReauthenticationEnabled,
 					"owner":                    kafkaRequest.Owner,
+					"maintenance_window":       kafkaRequest.MaintenanceWindow,
 				})
 
 				if updateErr != nil {
@@ -189,6 +218,25 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
+			if kafkaUpdateReq.Labels != nil {
+				if labelsErr := h.service.UpdateLabels(kafkaRequest, presenters.ConvertKafkaLabels(kafkaUpdateReq.Labels)); labelsErr != nil {
+					return nil, labelsErr
+				}
+			}
+
+			if kafkaUpdateReq.Suspended != nil {
+				switch {
+				case *kafkaUpdateReq.Suspended && kafkaRequest.Status == constants.KafkaRequestStatusReady.String():
+					if suspendErr := h.service.Suspend(ctx, kafkaRequest, dbapi.KafkaSuspensionReasonUser); suspendErr != nil {
+						return nil, suspendErr
+					}
+				case !*kafkaUpdateReq.Suspended && kafkaRequest.Status == constants.KafkaRequestStatusSuspended.String():
+					if resumeErr := h.service.Resume(ctx, kafkaRequest); resumeErr != nil {
+						return nil, resumeErr
+					}
+				}
+			}
+
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
 		},
 	}
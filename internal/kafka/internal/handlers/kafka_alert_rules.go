@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type kafkaAlertRulesHandler struct {
+	kafkaService          services.KafkaService
+	kafkaAlertRuleService services.KafkaAlertRuleService
+}
+
+func NewKafkaAlertRulesHandler(kafkaService services.KafkaService, kafkaAlertRuleService services.KafkaAlertRuleService) *kafkaAlertRulesHandler {
+	return &kafkaAlertRulesHandler{
+		kafkaService:          kafkaService,
+		kafkaAlertRuleService: kafkaAlertRuleService,
+	}
+}
+
+// Create creates an alert rule for the kafka. Only the owners of the kafka are allowed to manage its alert rules
+func (h kafkaAlertRulesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var ruleRequest public.KafkaAlertRuleRequest
+	ctx := r.Context()
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &ruleRequest,
+		Validate: []handlers.Validate{
+			validateKafkaAlertRuleRequest(&ruleRequest),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			claims, err := getClaims(ctx)
+			if err != nil {
+				return nil, err
+			}
+			username, _ := claims.GetUsername()
+
+			rule, err := presenters.ConvertKafkaAlertRuleRequest(kafkaRequest.ID, ruleRequest, username)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.kafkaAlertRuleService.Create(rule); err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaAlertRule(rule)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+// List returns the alert rules of the kafka together with their latest evaluation
+func (h kafkaAlertRulesHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			rules, paging, err := h.kafkaAlertRuleService.List(kafkaRequest.ID, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			ruleList := public.KafkaAlertRuleList{
+				Kind:  "KafkaAlertRuleList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.KafkaAlertRule{},
+			}
+
+			for _, rule := range rules {
+				presentedRule, err := presenters.PresentKafkaAlertRule(rule)
+				if err != nil {
+					return nil, err
+				}
+				ruleList.Items = append(ruleList.Items, presentedRule)
+			}
+
+			return ruleList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h kafkaAlertRulesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			rule, err := h.kafkaAlertRuleService.Get(kafkaRequest.ID, mux.Vars(r)["rule_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentKafkaAlertRule(rule)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h kafkaAlertRulesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			rule, err := h.kafkaAlertRuleService.Get(kafkaRequest.ID, mux.Vars(r)["rule_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, h.kafkaAlertRuleService.Delete(kafkaRequest.ID, rule.ID)
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildKafkaAlertRulesKafkaService() *services.KafkaServiceMock {
+	return &services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			if id != "kafka-id" {
+				return nil, errors.NotFound("KafkaResource with id='%s' not found", id)
+			}
+			return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}}, nil
+		},
+	}
+}
+
+func Test_kafkaAlertRulesHandler_Create(t *testing.T) {
+	ruleService := &services.KafkaAlertRuleServiceMock{
+		CreateFunc: func(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+			rule.ID = "rule-id"
+			rule.State = dbapi.KafkaAlertRuleStateInactive
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		kafkaID        string
+		body           []byte
+		service        services.KafkaAlertRuleService
+		wantStatusCode int
+		wantRule       *public.KafkaAlertRule
+	}{
+		{
+			name:           "should create an alert rule for the kafka",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "disk almost full", "metric": "disk_usage_percentage", "threshold": 80, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service:        ruleService,
+			wantStatusCode: http.StatusCreated,
+			wantRule: &public.KafkaAlertRule{
+				Id:        "rule-id",
+				Kind:      "KafkaAlertRule",
+				Href:      "/api/kafkas_mgmt/v1/kafkas/kafka-id/alert_rules/rule-id",
+				KafkaId:   "kafka-id",
+				Name:      "disk almost full",
+				Metric:    "disk_usage_percentage",
+				Threshold: 80,
+				NotificationTargets: []public.KafkaAlertNotificationTarget{
+					{Type: "webhook", Url: "https://example.com/alerts"},
+				},
+				State: "inactive",
+			},
+		},
+		{
+			name:           "should return not found when the kafka does not belong to the user",
+			kafkaID:        "other-kafka-id",
+			body:           []byte(`{"name": "disk almost full", "metric": "disk_usage_percentage", "threshold": 80, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "should return bad request when the name is not set",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"metric": "disk_usage_percentage", "threshold": 80, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the metric is not supported",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "cpu", "metric": "cpu_usage", "threshold": 80, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the threshold is negative",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "lag", "metric": "consumer_lag", "threshold": -1, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when there is no notification target",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "lag", "metric": "consumer_lag", "threshold": 1000, "notification_targets": []}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the webhook is not an https URL",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "lag", "metric": "consumer_lag", "threshold": 1000, "notification_targets": [{"type": "webhook", "url": "http://example.com/alerts"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return bad request when the webhook targets an internal address",
+			kafkaID:        "kafka-id",
+			body:           []byte(`{"name": "lag", "metric": "consumer_lag", "threshold": 1000, "notification_targets": [{"type": "webhook", "url": "https://169.254.169.254/latest/meta-data"}]}`),
+			service:        &services.KafkaAlertRuleServiceMock{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "should return bad request when the kafka already has the maximum number of alert rules",
+			kafkaID: "kafka-id",
+			body:    []byte(`{"name": "lag", "metric": "consumer_lag", "threshold": 1000, "notification_targets": [{"type": "webhook", "url": "https://example.com/alerts"}]}`),
+			service: &services.KafkaAlertRuleServiceMock{
+				CreateFunc: func(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+					return errors.BadRequest("kafka %q already has the maximum number of %d alert rules", rule.KafkaID, 10)
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaAlertRulesHandler(buildKafkaAlertRulesKafkaService(), tt.service)
+			req, rw := GetHandlerParams(http.MethodPost, "/kafkas/"+tt.kafkaID+"/alert_rules", bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": tt.kafkaID})
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantRule != nil {
+				rule := public.KafkaAlertRule{}
+				err := json.NewDecoder(resp.Body).Decode(&rule)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(rule).To(gomega.Equal(*tt.wantRule))
+				g.Expect(ruleService.CreateCalls()[0].Rule.CreatedBy).To(gomega.Equal("test-user"))
+			}
+		})
+	}
+}
+
+func Test_kafkaAlertRulesHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name           string
+		service        *services.KafkaAlertRuleServiceMock
+		wantStatusCode int
+		wantDeleted    bool
+	}{
+		{
+			name: "should delete the alert rule of the kafka",
+			service: &services.KafkaAlertRuleServiceMock{
+				GetFunc: func(kafkaID string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+					return &dbapi.KafkaAlertRule{Meta: api.Meta{ID: id}, KafkaID: kafkaID}, nil
+				},
+				DeleteFunc: func(kafkaID string, id string) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantStatusCode: http.StatusNoContent,
+			wantDeleted:    true,
+		},
+		{
+			name: "should return not found when the kafka has no such alert rule",
+			service: &services.KafkaAlertRuleServiceMock{
+				GetFunc: func(kafkaID string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+					return nil, errors.NotFound("alert rule %q of kafka %q not found", id, kafkaID)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaAlertRulesHandler(buildKafkaAlertRulesKafkaService(), tt.service)
+			req, rw := GetHandlerParams(http.MethodDelete, "/kafkas/kafka-id/alert_rules/rule-id", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "kafka-id", "rule_id": "rule-id"})
+			h.Delete(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(len(tt.service.DeleteCalls()) > 0).To(gomega.Equal(tt.wantDeleted))
+		})
+	}
+}
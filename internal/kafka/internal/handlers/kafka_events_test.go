@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaEventsHandler_List(t *testing.T) {
+	occurredAt := time.Date(2023, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	kafkaEventService := &services.KafkaEventServiceMock{
+		ListFunc: func(kafkaID string, listArgs *coreServices.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+			return dbapi.KafkaEventList{
+				{
+					ID:              "event-2",
+					KafkaID:         kafkaID,
+					Type:            dbapi.KafkaEventTypeCondition,
+					OccurredAt:      occurredAt,
+					ClusterID:       "cluster-id",
+					ConditionType:   "Ready",
+					ConditionStatus: "False",
+					Reason:          "Error",
+					Message:         "kafka resource could not be reconciled",
+				},
+				{
+					ID:         "event-1",
+					KafkaID:    kafkaID,
+					Type:       dbapi.KafkaEventTypeStatusChange,
+					OccurredAt: occurredAt,
+					OldStatus:  "provisioning",
+					NewStatus:  "ready",
+					Actor:      "kas-fleet-manager",
+				},
+			}, &api.PagingMeta{Page: 1, Size: 2, Total: 2}, nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		kafkaService   services.KafkaService
+		wantStatusCode int
+		wantEvents     *public.KafkaEventList
+	}{
+		{
+			name: "should return the history of the kafka without the data plane details",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantEvents: &public.KafkaEventList{
+				Kind:  "KafkaEventList",
+				Page:  1,
+				Size:  2,
+				Total: 2,
+				Items: []public.KafkaEvent{
+					{
+						Id:              "event-2",
+						Kind:            "KafkaEvent",
+						Type:            "condition",
+						OccurredAt:      occurredAt,
+						ConditionType:   "Ready",
+						ConditionStatus: "False",
+						Reason:          "Error",
+					},
+					{
+						Id:         "event-1",
+						Kind:       "KafkaEvent",
+						Type:       "status_change",
+						OccurredAt: occurredAt,
+						OldStatus:  "provisioning",
+						NewStatus:  "ready",
+					},
+				},
+			},
+		},
+		{
+			name: "should return not found when the kafka does not belong to the user",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.NotFound("KafkaResource with id='%s' not found", id)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaEventsHandler(tt.kafkaService, kafkaEventService)
+			req, rw := GetHandlerParams(http.MethodGet, "/kafkas/kafka-id/events", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "kafka-id"})
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+
+			if tt.wantEvents != nil {
+				events := public.KafkaEventList{}
+				err := json.NewDecoder(resp.Body).Decode(&events)
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(events).To(gomega.Equal(*tt.wantEvents))
+			}
+		})
+	}
+}
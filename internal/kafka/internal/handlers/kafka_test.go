@@ -77,7 +77,7 @@ func Test_KafkaHandler_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, config.NewKafkaSuspensionConfig())
 			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
 			req = mux.SetURLVars(req, map[string]string{"id": id})
 			h.Get(rw, req)
@@ -145,7 +145,7 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, config.NewKafkaSuspensionConfig())
 			req, rw := GetHandlerParams("DELETE", tt.args.url, nil, t)
 			h.Delete(rw, req)
 			resp := rw.Result()
@@ -180,6 +180,13 @@ func Test_KafkaHandler_List(t *testing.T) {
 			},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "fails if an unknown field is selected",
+			args: args{
+				url: "/kafkas?fields=name,canary_service_account_client_secret",
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "fails if List in the kafka service returns an error",
 			fields: fields{
@@ -219,6 +226,25 @@ func Test_KafkaHandler_List(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "succeeds if only some fields are selected",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					ListFunc: func(ctx context.Context, listArgs *s.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
+						return dbapi.KafkaList{
+							mocks.BuildKafkaRequest(
+								mocks.WithPredefinedTestValues(),
+							),
+						}, &api.PagingMeta{}, nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				url: "/kafkas?fields=name,status",
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "fails if its unable to Present KafkaRequest due to invalid Instance type",
 			fields: fields{
@@ -238,7 +264,7 @@ func Test_KafkaHandler_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, config.NewKafkaSuspensionConfig())
 			req, rw := GetHandlerParams("GET", tt.args.url, nil, t)
 			h.List(rw, req)
 			resp := rw.Result()
@@ -344,6 +370,95 @@ func Test_KafkaHandler_Update(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "succeeds if the plan is set to a size of the instance type of the kafka",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeID string) *errors.ServiceError {
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "standard.x1"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "fails if the plan is set to a size of another instance type",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "developer.x1"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails if the kafka cannot be resized",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeID string) *errors.ServiceError {
+						return errors.TooManyKafkaInstancesReached("cluster cannot accept a bigger size")
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"plan": "standard.x1"}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "succeeds if the labels are set",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+					UpdateLabelsFunc: func(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *errors.ServiceError {
+						return nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"labels": {"env": "prod"}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "fails if the labels are not valid",
+			fields: fields{
+				service: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				kafkaConfig: &fullKafkaConfig,
+			},
+			args: args{
+				body: []byte(`{"labels": {"env": "prod env"}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -351,7 +466,7 @@ func Test_KafkaHandler_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, config.NewKafkaSuspensionConfig())
 			req, rw := GetHandlerParams("PATCH", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Update(rw, req)
@@ -604,7 +719,7 @@ func Test_KafkaHandler_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, config.NewKafkaSuspensionConfig())
 			req, rw := GetHandlerParams("CREATE", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Create(rw, req)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -25,6 +28,7 @@ import (
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 var ValidKafkaClusterNameRegexp = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
@@ -33,6 +37,9 @@ var MaxKafkaNameLength = 32
 
 var ClusterIdLength = 32
 
+// MaxKafkaLabels is the maximum number of labels of a kafka
+var MaxKafkaLabels = 20
+
 const minimunNumberOfNodesForTheKafkaMachinePool = 3
 
 func validateKafkaBillingModel(ctx context.Context, kafkaService services.KafkaService, kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
@@ -310,6 +317,34 @@ func ValidateKafkaPlan(ctx context.Context, kafkaService services.KafkaService,
 	}
 }
 
+// ValidateKafkaPlanUpdate - validate that the plan the Kafka is resized to, if any, is a size of the instance type of the Kafka
+func ValidateKafkaPlanUpdate(kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaUpdateReq.Plan == nil {
+			return nil
+		}
+
+		plan := config.Plan(*kafkaUpdateReq.Plan)
+		instanceType, err := plan.GetInstanceType()
+		if err != nil {
+			return errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance type in plan provided: %q", *kafkaUpdateReq.Plan))
+		}
+		if instanceType != kafkaRequest.InstanceType {
+			return errors.New(errors.ErrorBadRequest, fmt.Sprintf("the plan of the kafka can only be changed to another size of its instance type %q", kafkaRequest.InstanceType))
+		}
+
+		size, err := plan.GetSizeID()
+		if err != nil {
+			return errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance size in plan provided: %q", *kafkaUpdateReq.Plan))
+		}
+		if _, err := kafkaConfig.GetKafkaInstanceSize(instanceType, size); err != nil {
+			return errors.InstancePlanNotSupported("unsupported plan provided: %q", *kafkaUpdateReq.Plan)
+		}
+
+		return nil
+	}
+}
+
 func ValidateKafkaUpdateFields(kafkaUpdateRequest *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		if !(stringSet(&kafkaUpdateRequest.StrimziVersion) ||
@@ -317,8 +352,9 @@ func ValidateKafkaUpdateFields(kafkaUpdateRequest *private.KafkaUpdateRequest) h
 			stringSet(&kafkaUpdateRequest.KafkaIbpVersion) ||
 			stringSet(&kafkaUpdateRequest.DeprecatedKafkaStorageSize) ||
 			stringSet(&kafkaUpdateRequest.MaxDataRetentionSize) ||
-			shared.IsNotNil(kafkaUpdateRequest.Suspended)) {
-			return errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size or suspended to be provided")
+			shared.IsNotNil(kafkaUpdateRequest.Suspended) ||
+			shared.IsNotNil(kafkaUpdateRequest.ForceUpgrade)) {
+			return errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size, suspended or force_upgrade to be provided")
 		}
 		return nil
 	}
@@ -375,8 +411,119 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			}
 		}
 
+		if err := validateKafkaMaintenanceWindow(kafkaUpdateReq.MaintenanceWindow); err != nil {
+			return err
+		}
+
+		return validateKafkaLabels(kafkaUpdateReq.Labels)
+	}
+}
+
+// ValidateKafkaSuspendedUpdate - validate that the requested suspension or resumption of the Kafka, if any, can be carried out by its owner.
+// Suspending a suspended Kafka and resuming a Kafka that is not suspended do nothing
+func ValidateKafkaSuspendedUpdate(suspensionConfig *config.KafkaSuspensionConfig, kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaUpdateReq.Suspended == nil {
+			return nil
+		}
+
+		if !suspensionConfig.IsUserSuspendable(kafkaRequest.InstanceType) {
+			return errors.BadRequest("kafka instances of instance type %q cannot be suspended nor resumed", kafkaRequest.InstanceType)
+		}
+
+		if *kafkaUpdateReq.Suspended {
+			suspendableStates := []string{constants.KafkaRequestStatusReady.String(), constants.KafkaRequestStatusSuspending.String(), constants.KafkaRequestStatusSuspended.String()}
+			if !arrays.Contains(suspendableStates, kafkaRequest.Status) {
+				return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be suspended. Kafka instances can only be suspended in the following states: %s", kafkaRequest.Status, suspendableStates[:1])
+			}
+			return nil
+		}
+
+		switch kafkaRequest.Status {
+		case constants.KafkaRequestStatusSuspending.String():
+			return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be resumed until it is suspended", kafkaRequest.Status)
+		case constants.KafkaRequestStatusSuspended.String():
+			if !kafkaRequest.SuspensionReason.UserResumable() {
+				return errors.New(errors.ErrorValidation, "kafka instance suspended for reason %q cannot be resumed by its owner", kafkaRequest.SuspensionReason)
+			}
+			return validateKafkaNotInGracePeriod(kafkaConfig, kafkaRequest)
+		default:
+			return nil
+		}
+	}
+}
+
+// validateKafkaNotInGracePeriod checks that a suspended kafka has not entered the grace period before its expiration,
+// in which it cannot be resumed
+func validateKafkaNotInGracePeriod(kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	kafkaRequestHasExpirationSet := kafkaRequest.ExpiresAt.Valid
+	if !kafkaRequestHasExpirationSet {
+		return nil
+	}
+
+	timeNow := time.Now()
+	kafkaBillingModelConfig, err := kafkaConfig.GetBillingModelByID(kafkaRequest.InstanceType, kafkaRequest.ActualKafkaBillingModel)
+	if err != nil {
+		return errors.ToServiceError(err)
+	}
+	gracePeriodDays := kafkaBillingModelConfig.GracePeriodDays
+	durationGracePeriodDays := time.Duration(gracePeriodDays*86400) * time.Second
+	startOfGracePeriod := kafkaRequest.ExpiresAt.Time.Add(-durationGracePeriodDays)
+	isWithinOrAfterGracePeriod := timeNow.After(startOfGracePeriod)
+	if isWithinOrAfterGracePeriod {
+		return errors.New(errors.ErrorValidation, "kafka instance with a status of %q cannot be resumed due to the instance is suspended and it is within its grace period: start of grace period: %s ", kafkaRequest.Status, startOfGracePeriod)
+	}
+
+	return nil
+}
+
+// ValidateKafkaMaintenanceWindow - validate the maintenance window of the requested Kafka, if any
+func ValidateKafkaMaintenanceWindow(kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		return validateKafkaMaintenanceWindow(kafkaRequestPayload.MaintenanceWindow)
+	}
+}
+
+func validateKafkaMaintenanceWindow(window *public.KafkaMaintenanceWindow) *errors.ServiceError {
+	maintenanceWindow := presenters.ConvertKafkaMaintenanceWindow(window)
+	if maintenanceWindow == nil {
 		return nil
 	}
+	if err := maintenanceWindow.Validate(); err != nil {
+		return errors.FieldValidationError("maintenance_window is not valid: %s", err.Error())
+	}
+	return nil
+}
+
+// ValidateKafkaLabels - validate the labels of the requested Kafka, if any
+func ValidateKafkaLabels(kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		return validateKafkaLabels(kafkaRequestPayload.Labels)
+	}
+}
+
+// validateKafkaLabels returns an error for more than MaxKafkaLabels labels, or labels that are not valid k8s labels
+// i.e. whose key is not a qualified name or whose value is not a valid label value
+func validateKafkaLabels(labels map[string]string) *errors.ServiceError {
+	if len(labels) > MaxKafkaLabels {
+		return errors.FieldValidationError("labels is not valid: a Kafka instance can not have more than %d labels", MaxKafkaLabels)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			return errors.FieldValidationError("labels is not valid: invalid key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) != 0 {
+			return errors.FieldValidationError("labels is not valid: invalid value %q of key %q: %s", labels[key], key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
 }
 
 func getClaims(ctx context.Context) (auth.KFMClaims, *errors.ServiceError) {
@@ -608,3 +755,183 @@ func validateKafkaPromoteRequestFields(kafkaPromoteRequest *public.KafkaPromoteR
 		return nil
 	}
 }
+
+// validateClusterPlacementEvaluationRequest returns a validator that validates that the cloud provider, region,
+// instance type and size of the hypothetical kafka of the given cluster placement evaluation request are supported
+func validateClusterPlacementEvaluationRequest(request *private.ClusterPlacementEvaluationRequest, providerConfig *config.ProviderConfig, kafkaConfig *config.KafkaConfig) handlers.Validate {
+	return func() *errors.ServiceError {
+		supportedProviders := providerConfig.ProvidersConfig.SupportedProviders
+		provider, providerSupported := supportedProviders.GetByName(request.CloudProvider)
+		if !providerSupported {
+			return errors.ProviderNotSupported("provider %s is not supported, supported providers are: %s", request.CloudProvider, supportedProviders)
+		}
+
+		region, regionSupported := provider.Regions.GetByName(request.Region)
+		if !regionSupported {
+			return errors.RegionNotSupported("region %s is not supported for %s, supported regions are: %s", request.Region, request.CloudProvider, provider.Regions)
+		}
+
+		if !region.IsInstanceTypeSupported(config.InstanceType(request.InstanceType)) {
+			return errors.InstanceTypeNotSupported("instance type %q not supported for region %q", request.InstanceType, region.Name)
+		}
+
+		if _, err := kafkaConfig.GetKafkaInstanceSize(request.InstanceType, request.SizeId); err != nil {
+			return errors.InstancePlanNotSupported("unsupported size id %q for instance type %q", request.SizeId, request.InstanceType)
+		}
+
+		return nil
+	}
+}
+
+func validateKafkaRolloutRequest(request *private.KafkaRolloutRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.BatchSize < 1 {
+			return errors.FieldValidationError("batch_size must be greater than 0")
+		}
+
+		if request.DesiredStrimziVersion == "" && request.DesiredKafkaVersion == "" && request.DesiredKafkaIbpVersion == "" {
+			return errors.FieldValidationError("at least one of desired_strimzi_version, desired_kafka_version or desired_kafka_ibp_version must be provided")
+		}
+
+		if request.BatchPauseSeconds < 0 {
+			return errors.FieldValidationError("batch_pause_seconds must not be negative")
+		}
+
+		if request.UpgradeTimeoutSeconds < 0 {
+			return errors.FieldValidationError("upgrade_timeout_seconds must not be negative")
+		}
+
+		if request.MaxFailurePercentage < 0 || request.MaxFailurePercentage > 100 {
+			return errors.FieldValidationError("max_failure_percentage must be between 0 and 100")
+		}
+
+		return nil
+	}
+}
+
+func validateQuotaGrantSubjectType(subjectType string) *errors.ServiceError {
+	if !arrays.Contains(dbapi.QuotaGrantSubjectTypes, dbapi.QuotaGrantSubjectType(subjectType)) {
+		return errors.FieldValidationError("subject_type %q is not valid. Valid values are: %v", subjectType, dbapi.QuotaGrantSubjectTypes)
+	}
+	return nil
+}
+
+func validateQuotaGrantRequest(request *private.QuotaGrantRequest, kafkaConfig *config.KafkaConfig) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := validateQuotaGrantSubjectType(request.SubjectType); err != nil {
+			return err
+		}
+
+		if request.SubjectId == "" {
+			return errors.FieldValidationError("subject_id is required")
+		}
+
+		if _, err := kafkaConfig.GetBillingModelByID(request.InstanceTypeId, request.BillingModelId); err != nil {
+			return errors.FieldValidationError("instance_type_id %q and billing_model_id %q are not supported: %v", request.InstanceTypeId, request.BillingModelId, err)
+		}
+
+		if request.MaxAllowedInstances < 0 {
+			return errors.FieldValidationError("max_allowed_instances must not be negative")
+		}
+
+		return nil
+	}
+}
+
+func validateQuotaGrantUpdateRequest(request *private.QuotaGrantUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.MaxAllowedInstances == nil && request.ExpirationDate == nil {
+			return errors.FieldValidationError("at least one of max_allowed_instances or expiration_date must be provided")
+		}
+
+		if request.MaxAllowedInstances != nil && *request.MaxAllowedInstances < 0 {
+			return errors.FieldValidationError("max_allowed_instances must not be negative")
+		}
+
+		return nil
+	}
+}
+
+func validateAccessControlListEntryType(entryType string) *errors.ServiceError {
+	if !arrays.Contains(api.AccessControlListEntryTypes, api.AccessControlListEntryType(entryType)) {
+		return errors.FieldValidationError("type %q is not valid. Valid values are: %v", entryType, api.AccessControlListEntryTypes)
+	}
+	return nil
+}
+
+func validateAccessControlListEntryRequest(request *private.AccessControlListEntryRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := validateAccessControlListEntryType(request.Type); err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(request.Value) == "" {
+			return errors.FieldValidationError("value is required")
+		}
+
+		if !request.ExpiresAt.IsZero() && !request.ExpiresAt.After(time.Now()) {
+			return errors.FieldValidationError("expires_at must be in the future")
+		}
+
+		return nil
+	}
+}
+
+// validateKafkaOwnershipTransferRequest checks that the request either transfers a kafka or reassigns the kafkas of a previous owner
+func validateKafkaOwnershipTransferRequest(request *private.KafkaOwnershipTransferRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if strings.TrimSpace(request.Owner) == "" {
+			return errors.FieldValidationError("owner is required")
+		}
+
+		if (request.KafkaId == "") == (request.PreviousOwner == "") {
+			return errors.FieldValidationError("exactly one of kafka_id and previous_owner must be set")
+		}
+
+		if request.PreviousOrganisationId != "" && request.PreviousOwner == "" {
+			return errors.FieldValidationError("previous_organisation_id can only be set with previous_owner")
+		}
+
+		return nil
+	}
+}
+
+// maxKafkaAlertRuleNameLength is the maximum length of the names of the alert rules
+const maxKafkaAlertRuleNameLength = 64
+
+func validateKafkaAlertRuleRequest(request *public.KafkaAlertRuleRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		name := strings.TrimSpace(request.Name)
+		if name == "" {
+			return errors.FieldValidationError("name is required")
+		}
+		if len(name) > maxKafkaAlertRuleNameLength {
+			return errors.FieldValidationError("name must not be longer than %d characters", maxKafkaAlertRuleNameLength)
+		}
+
+		if !arrays.Contains(dbapi.KafkaAlertRuleMetrics, dbapi.KafkaAlertRuleMetric(request.Metric)) {
+			return errors.FieldValidationError("metric %q is not valid. Valid values are: %v", request.Metric, dbapi.KafkaAlertRuleMetrics)
+		}
+
+		if request.Threshold < 0 {
+			return errors.FieldValidationError("threshold must not be negative")
+		}
+
+		if len(request.NotificationTargets) == 0 {
+			return errors.FieldValidationError("at least one notification target is required")
+		}
+
+		for _, target := range request.NotificationTargets {
+			if target.Type != dbapi.KafkaAlertNotificationTargetTypeWebhook {
+				return errors.FieldValidationError("notification target type %q is not valid. Valid values are: [%s]", target.Type, dbapi.KafkaAlertNotificationTargetTypeWebhook)
+			}
+			// the notifications contain details of the kafka, so they are only posted over https, and they are posted by the
+			// control plane, so they must not target its internal services
+			if err := services.ValidateWebhookTargetURL(target.Url); err != nil {
+				return errors.FieldValidationError("notification target url %v", err)
+			}
+		}
+
+		return nil
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -632,6 +633,64 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 				reason:  "unable to update kafka request owner",
 			},
 		},
+		{
+			name: "do not throw an error when an empty maintenance window is passed to remove it",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					MaintenanceWindow: &public.KafkaMaintenanceWindow{},
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: false,
+			},
+		},
+		{
+			name: "throw an error when the maintenance window is not valid",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					MaintenanceWindow: &public.KafkaMaintenanceWindow{
+						DayOfWeek:     "sunday",
+						StartHour:     24,
+						DurationHours: 4,
+						Timezone:      "UTC",
+					},
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: true,
+				reason:  "Field validation failed: maintenance_window is not valid: start_hour 24 is not valid, it must be between 0 and 23",
+			},
+		},
+		{
+			name: "throw an error when the labels are not valid",
+			arg: args{
+				ctx: auth.SetTokenInContext(context.TODO(), token),
+				kafka: &dbapi.KafkaRequest{
+					Owner:          username,
+					OrganisationId: orgId,
+				},
+				kafkaUpdateRequest: public.KafkaUpdateRequest{
+					Labels: map[string]string{"env": "prod", "-team": "kafka"},
+				},
+				authService: authorization.NewMockAuthorization(),
+			},
+			want: result{
+				wantErr: true,
+				reason:  `Field validation failed: labels is not valid: invalid key "-team": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -650,6 +709,68 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 	}
 }
 
+func Test_Validation_ValidateKafkaLabels(t *testing.T) {
+	tooManyLabels := map[string]string{}
+	for i := 0; i <= MaxKafkaLabels; i++ {
+		tooManyLabels[fmt.Sprintf("label-%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "should not return an error when there are no labels",
+			labels:  nil,
+			wantErr: false,
+		},
+		{
+			name:    "should not return an error when the labels are valid",
+			labels:  map[string]string{"env": "prod", "example.com/team": "kafka_team-1", "empty": ""},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error when the prefix of a key is not a dns subdomain",
+			labels:  map[string]string{"Example.com/team": "kafka"},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when a key is too long",
+			labels:  map[string]string{strings.Repeat("a", 64): "kafka"},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when a value is too long",
+			labels:  map[string]string{"team": strings.Repeat("a", 64)},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when a value contains invalid characters",
+			labels:  map[string]string{"team": "kafka team"},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when there are too many labels",
+			labels:  tooManyLabels,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			err := ValidateKafkaLabels(&public.KafkaRequestPayload{Labels: tt.labels})()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(errors.ErrorFieldValidationError))
+			}
+		})
+	}
+}
+
 func TestValidateBillingCloudAccountIdAndMarketplace(t *testing.T) {
 	type args struct {
 		ctx                 context.Context
@@ -973,6 +1094,7 @@ func TestValidateKafkaPlan(t *testing.T) {
 }
 
 func TestValidateKafkaUpdateFields(t *testing.T) {
+	forceUpgrade := true
 	type args struct {
 		kafkaUpdateRequest *private.KafkaUpdateRequest
 	}
@@ -993,6 +1115,15 @@ func TestValidateKafkaUpdateFields(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "should return nil if only force_upgrade is provided",
+			args: args{
+				kafkaUpdateRequest: &private.KafkaUpdateRequest{
+					ForceUpgrade: &forceUpgrade,
+				},
+			},
+			want: nil,
+		},
 		{
 			name: "should return error if all fields are empty",
 			args: args{
@@ -1003,7 +1134,7 @@ func TestValidateKafkaUpdateFields(t *testing.T) {
 					DeprecatedKafkaStorageSize: "",
 				},
 			},
-			want: errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size or suspended to be provided"),
+			want: errors.FieldValidationError("failed to update Kafka Request. Expecting at least one of the following fields: strimzi_version, kafka_version, kafka_ibp_version, kafka_storage_size, max_data_retention_size, suspended or force_upgrade to be provided"),
 		},
 	}
 	for _, testcase := range tests {
@@ -1018,6 +1149,186 @@ func TestValidateKafkaUpdateFields(t *testing.T) {
 	}
 }
 
+func TestValidateKafkaPlanUpdate(t *testing.T) {
+	kafkaRequest := mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues())
+	type args struct {
+		kafkaUpdateRequest *public.KafkaUpdateRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		want *errors.ServiceError
+	}{
+		{
+			name: "should return nil if the plan is not provided",
+			args: args{
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{},
+			},
+			want: nil,
+		},
+		{
+			name: "should return nil if the plan is a size of the instance type of the kafka",
+			args: args{
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{
+					Plan: &[]string{"standard.x1"}[0],
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "should return an error if the plan is malformed",
+			args: args{
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{
+					Plan: &[]string{"standard"}[0],
+				},
+			},
+			want: errors.New(errors.ErrorBadRequest, `unable to detect instance type in plan provided: "standard"`),
+		},
+		{
+			name: "should return an error if the plan is a size of another instance type",
+			args: args{
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{
+					Plan: &[]string{"developer.x1"}[0],
+				},
+			},
+			want: errors.New(errors.ErrorBadRequest, `the plan of the kafka can only be changed to another size of its instance type "standard"`),
+		},
+		{
+			name: "should return an error if the size of the plan is not supported",
+			args: args{
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{
+					Plan: &[]string{"standard.x9"}[0],
+				},
+			},
+			want: errors.InstancePlanNotSupported(`unsupported plan provided: "standard.x9"`),
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			validateFn := ValidateKafkaPlanUpdate(&fullKafkaConfig, kafkaRequest, tt.args.kafkaUpdateRequest)
+			err := validateFn()
+			g.Expect(err).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestValidateKafkaSuspendedUpdate(t *testing.T) {
+	suspended := func(suspended bool) *public.KafkaUpdateRequest {
+		return &public.KafkaUpdateRequest{Suspended: &suspended}
+	}
+	developerKafka := func(status constants.KafkaStatus, reason dbapi.KafkaSuspensionReason) *dbapi.KafkaRequest {
+		return mocks.BuildKafkaRequest(
+			mocks.WithPredefinedTestValues(),
+			mocks.With(mocks.INSTANCE_TYPE, types.DEVELOPER.String()),
+			mocks.With(mocks.STATUS, status.String()),
+			mocks.WithSuspensionReason(reason),
+		)
+	}
+	type args struct {
+		kafkaRequest       *dbapi.KafkaRequest
+		kafkaUpdateRequest *public.KafkaUpdateRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "should return nil if suspended is not provided",
+			args: args{
+				kafkaRequest:       mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()),
+				kafkaUpdateRequest: &public.KafkaUpdateRequest{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error if the instance type of the kafka cannot be suspended by its owner",
+			args: args{
+				kafkaRequest:       mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()),
+				kafkaUpdateRequest: suspended(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return nil when suspending a ready kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusReady, dbapi.KafkaSuspensionReasonNone),
+				kafkaUpdateRequest: suspended(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return nil when suspending a suspended kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusSuspended, dbapi.KafkaSuspensionReasonAdmin),
+				kafkaUpdateRequest: suspended(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when suspending a provisioning kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusProvisioning, dbapi.KafkaSuspensionReasonNone),
+				kafkaUpdateRequest: suspended(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return nil when resuming a kafka suspended by its owner",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusSuspended, dbapi.KafkaSuspensionReasonUser),
+				kafkaUpdateRequest: suspended(false),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return nil when resuming an idle kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusSuspended, dbapi.KafkaSuspensionReasonIdle),
+				kafkaUpdateRequest: suspended(false),
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when resuming a kafka suspended by an admin",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusSuspended, dbapi.KafkaSuspensionReasonAdmin),
+				kafkaUpdateRequest: suspended(false),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when resuming a suspending kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusSuspending, dbapi.KafkaSuspensionReasonUser),
+				kafkaUpdateRequest: suspended(false),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return nil when resuming a ready kafka",
+			args: args{
+				kafkaRequest:       developerKafka(constants.KafkaRequestStatusReady, dbapi.KafkaSuspensionReasonNone),
+				kafkaUpdateRequest: suspended(false),
+			},
+			wantErr: false,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			validateFn := ValidateKafkaSuspendedUpdate(config.NewKafkaSuspensionConfig(), &fullKafkaConfig, tt.args.kafkaRequest, tt.args.kafkaUpdateRequest)
+			err := validateFn()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
 func TestValidateKafkaStorageSize(t *testing.T) {
 	type args struct {
 		kafkaRequest   *dbapi.KafkaRequest
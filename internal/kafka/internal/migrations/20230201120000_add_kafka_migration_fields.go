@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaMigrationFields() *gormigrate.Migration {
+	type KafkaMigrationStatus string
+
+	type KafkaRequest struct {
+		MigrationStatus      KafkaMigrationStatus `json:"migration_status" gorm:"index"`
+		MigrationClusterID   string               `json:"migration_cluster_id" gorm:"index"`
+		MigrationPlacementId string               `json:"migration_placement_id"`
+		MigrationRoutes      api.JSON             `json:"migration_routes"`
+		MigrationDetails     string               `json:"migration_details"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230201120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"migration_status", "migration_cluster_id", "migration_placement_id", "migration_routes", "migration_details"} {
+				err := tx.Migrator().DropColumn(&KafkaRequest{}, column)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
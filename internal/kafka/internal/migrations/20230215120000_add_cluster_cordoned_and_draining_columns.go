@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterCordonedAndDrainingColumns() *gormigrate.Migration {
+	type Cluster struct {
+		Cordoned bool `gorm:"default:false"`
+		Draining bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230215120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"cordoned", "draining"} {
+				if !tx.Migrator().HasColumn(&Cluster{}, column) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(&Cluster{}, column); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
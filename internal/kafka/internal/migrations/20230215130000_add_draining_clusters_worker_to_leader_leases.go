@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addDrainingClustersWorkerToLeaderLeases() *gormigrate.Migration {
+	const drainingClustersWorkerType = "draining_clusters"
+
+	return &gormigrate.Migration{
+		ID: "20230215130000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: drainingClustersWorkerType, Leader: api.NewID()}).Error; err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", drainingClustersWorkerType).Delete(&api.LeaderLease{}).Error
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaMaintenanceWindowFields() *gormigrate.Migration {
+	type KafkaRequest struct {
+		MaintenanceWindow api.JSON `json:"maintenance_window"`
+		ForceUpgrade      bool     `json:"force_upgrade" gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230301120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"maintenance_window", "force_upgrade"} {
+				err := tx.Migrator().DropColumn(&KafkaRequest{}, column)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaRollouts() *gormigrate.Migration {
+	type KafkaRollout struct {
+		db.Model
+		Status                 string `gorm:"index"`
+		FailedReason           string
+		InstanceType           string
+		ClusterID              string
+		StrimziVersion         string
+		DesiredStrimziVersion  string
+		DesiredKafkaVersion    string
+		DesiredKafkaIBPVersion string
+		BatchSize              int
+		BatchPauseSeconds      int
+		UpgradeTimeoutSeconds  int
+		MaxFailurePercentage   int
+		CurrentBatch           int `gorm:"default:0"`
+		NextBatchAt            *time.Time
+	}
+
+	type KafkaRolloutItem struct {
+		db.Model
+		RolloutID    string `gorm:"index"`
+		KafkaID      string `gorm:"index"`
+		Batch        int
+		Status       string
+		FailedReason string
+		StartedAt    *time.Time
+		CompletedAt  *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230315120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRollout{}, &KafkaRolloutItem{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaRolloutItem{}, &KafkaRollout{})
+		},
+	}
+}
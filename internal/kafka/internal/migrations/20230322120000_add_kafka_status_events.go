@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaStatusEvents() *gormigrate.Migration {
+	type KafkaStatusEvent struct {
+		db.Model
+		KafkaID     string `gorm:"index"`
+		OldStatus   string
+		NewStatus   string
+		Reason      string
+		Actor       string
+		OccurredAt  time.Time  `gorm:"index"`
+		PublishedAt *time.Time `gorm:"index"`
+		Attempts    int        `gorm:"default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230322120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaStatusEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaStatusEvent{})
+		},
+	}
+}
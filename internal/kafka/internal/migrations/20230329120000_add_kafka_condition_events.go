@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaConditionEvents() *gormigrate.Migration {
+	type KafkaConditionEvent struct {
+		db.Model
+		KafkaID    string `gorm:"index"`
+		ClusterID  string
+		Type       string
+		Status     string
+		Reason     string
+		Message    string
+		ObservedAt time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230329120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaConditionEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaConditionEvent{})
+		},
+	}
+}
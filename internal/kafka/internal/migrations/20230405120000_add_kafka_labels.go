@@ -0,0 +1,29 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addKafkaLabels() *gormigrate.Migration {
+	type KafkaLabel struct {
+		KafkaID string `gorm:"primaryKey;index"`
+		Key     string `gorm:"primaryKey;not null;index:idx_kafka_labels_key_value,priority:1"`
+		Value   string `gorm:"not null;index:idx_kafka_labels_key_value,priority:2"`
+	}
+
+	return db.CreateMigrationFromActions("20230405120000",
+		db.CreateTableAction(&KafkaLabel{}),
+
+		// relationship between the labels and the kafkas
+		db.ExecAction(
+			"ALTER TABLE kafka_labels ADD CONSTRAINT fk_kafka_requests_labels "+
+				"FOREIGN KEY (kafka_id) REFERENCES kafka_requests(id)",
+			"ALTER TABLE kafka_labels DROP CONSTRAINT IF EXISTS fk_kafka_requests_labels"),
+	)
+}
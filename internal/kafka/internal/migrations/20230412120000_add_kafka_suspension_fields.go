@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaSuspensionFields() *gormigrate.Migration {
+	type KafkaRequest struct {
+		SuspensionReason string       `json:"suspension_reason"`
+		ResumedAt        sql.NullTime `json:"resumed_at"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230412120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"suspension_reason", "resumed_at"} {
+				err := tx.Migrator().DropColumn(&KafkaRequest{}, column)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addDataPlaneKafkaChanges records, for each data plane cluster, the last version of the kafkas whose ManagedKafka CR may have
+// changed on it. The version of a change is the id of the transaction that made it. Every change of a kafka row, other than
+// the bump of its updated_at column, is recorded for the clusters the kafka was and is placed on, including the clusters of its
+// migration.
+// This lets the data plane only fetch the ManagedKafka CRs that changed since the version it has already seen.
+// A trigger is used so that the changes made by every writer of the kafka_requests table are recorded
+func addDataPlaneKafkaChanges() *gormigrate.Migration {
+	type DataPlaneKafkaChange struct {
+		ClusterID string `gorm:"primaryKey;index:idx_data_plane_kafka_changes_cluster_id_version,priority:1"`
+		KafkaID   string `gorm:"primaryKey"`
+		Version   int64  `gorm:"index:idx_data_plane_kafka_changes_cluster_id_version,priority:2"`
+	}
+
+	const createRecordFunction = `
+CREATE OR REPLACE FUNCTION record_data_plane_kafka_change() RETURNS TRIGGER AS $$
+DECLARE
+	change_version BIGINT;
+	old_row JSONB := '{}';
+	new_row JSONB := '{}';
+BEGIN
+	IF TG_OP <> 'INSERT' THEN
+		old_row := to_jsonb(OLD);
+	END IF;
+	IF TG_OP <> 'DELETE' THEN
+		new_row := to_jsonb(NEW);
+	END IF;
+
+	IF TG_OP = 'UPDATE' AND (old_row - 'updated_at') = (new_row - 'updated_at') THEN
+		RETURN NULL;
+	END IF;
+
+	change_version := txid_current();
+	INSERT INTO data_plane_kafka_changes (cluster_id, kafka_id, version)
+	SELECT DISTINCT cluster_id, COALESCE(new_row->>'id', old_row->>'id'), change_version
+	FROM unnest(ARRAY[old_row->>'cluster_id', old_row->>'migration_cluster_id', new_row->>'cluster_id', new_row->>'migration_cluster_id']) AS cluster_id
+	WHERE cluster_id IS NOT NULL AND cluster_id <> ''
+	ON CONFLICT (cluster_id, kafka_id) DO UPDATE SET version = EXCLUDED.version;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql`
+
+	const createRecordTrigger = `
+CREATE TRIGGER record_data_plane_kafka_change
+AFTER INSERT OR UPDATE OR DELETE ON kafka_requests
+FOR EACH ROW EXECUTE PROCEDURE record_data_plane_kafka_change()`
+
+	return &gormigrate.Migration{
+		ID: "20230419120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&DataPlaneKafkaChange{}); err != nil {
+				return err
+			}
+			for _, statement := range []string{createRecordFunction, createRecordTrigger} {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, statement := range []string{
+				"DROP TRIGGER IF EXISTS record_data_plane_kafka_change ON kafka_requests",
+				"DROP FUNCTION IF EXISTS record_data_plane_kafka_change()",
+			} {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Migrator().DropTable(&DataPlaneKafkaChange{})
+		},
+	}
+}
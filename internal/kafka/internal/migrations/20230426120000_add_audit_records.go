@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditRecords() *gormigrate.Migration {
+	type AuditRecord struct {
+		ID           string    `gorm:"primary_key"`
+		CreatedAt    time.Time `gorm:"index"`
+		UpdatedAt    time.Time
+		DeletedAt    gorm.DeletedAt `gorm:"index"`
+		Actor        string         `gorm:"index"`
+		OrgID        string
+		Roles        string `gorm:"type:jsonb"`
+		Action       string
+		Method       string
+		RequestURI   string
+		RemoteAddr   string
+		ResourceType string `gorm:"index:idx_audit_records_resource,priority:1"`
+		ResourceID   string `gorm:"index:idx_audit_records_resource,priority:2"`
+		RequestDiff  string `gorm:"type:jsonb"`
+		StatusCode   int
+		OperationID  string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230426120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&AuditRecord{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&AuditRecord{})
+		},
+	}
+}
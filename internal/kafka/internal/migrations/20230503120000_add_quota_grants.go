@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addQuotaGrants() *gormigrate.Migration {
+	type QuotaGrant struct {
+		ID                  string `gorm:"primary_key"`
+		CreatedAt           time.Time
+		UpdatedAt           time.Time
+		DeletedAt           gorm.DeletedAt `gorm:"index"`
+		SubjectType         string         `gorm:"index:idx_quota_grants_subject,priority:1"`
+		SubjectID           string         `gorm:"index:idx_quota_grants_subject,priority:2"`
+		InstanceTypeID      string
+		BillingModelID      string
+		MaxAllowedInstances int
+		ExpirationDate      *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230503120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&QuotaGrant{}); err != nil {
+				return err
+			}
+			// a subject is granted at most one quota per instance type and billing model. The revoked grants are soft deleted and are
+			// not taken into account, so that the same quota can be granted again
+			return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS uix_quota_grants_subject_instance_type_billing_model ON quota_grants
+				(subject_type, subject_id, lower(instance_type_id), lower(billing_model_id)) WHERE deleted_at IS NULL`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&QuotaGrant{})
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAccessControlListEntries() *gormigrate.Migration {
+	type AccessControlListEntry struct {
+		ID        string `gorm:"primary_key"`
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		DeletedAt gorm.DeletedAt `gorm:"index"`
+		Type      string         `gorm:"index:idx_access_control_list_entries_type_value,priority:1"`
+		Value     string         `gorm:"index:idx_access_control_list_entries_type_value,priority:2"`
+		Reason    string
+		ExpiresAt *time.Time
+		CreatedBy string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230510120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&AccessControlListEntry{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&AccessControlListEntry{})
+		},
+	}
+}
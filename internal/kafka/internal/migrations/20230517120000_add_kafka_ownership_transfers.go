@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaOwnershipTransfers() *gormigrate.Migration {
+	type KafkaOwnershipTransfer struct {
+		ID                     string `gorm:"primary_key"`
+		CreatedAt              time.Time
+		UpdatedAt              time.Time
+		DeletedAt              gorm.DeletedAt `gorm:"index"`
+		KafkaID                string         `gorm:"index"`
+		PreviousOwner          string         `gorm:"index"`
+		PreviousOrganisationID string
+		PreviousSubscriptionID string
+		Owner                  string `gorm:"index"`
+		OrganisationID         string
+		SubscriptionID         string
+		Actor                  string
+		Reason                 string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230517120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaOwnershipTransfer{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaOwnershipTransfer{})
+		},
+	}
+}
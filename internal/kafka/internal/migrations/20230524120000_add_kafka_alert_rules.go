@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaAlertRules() *gormigrate.Migration {
+	type KafkaAlertRule struct {
+		ID                  string `gorm:"primary_key"`
+		CreatedAt           time.Time
+		UpdatedAt           time.Time
+		DeletedAt           gorm.DeletedAt `gorm:"index"`
+		KafkaID             string         `gorm:"index"`
+		Name                string
+		Metric              string
+		Threshold           float64
+		NotificationTargets api.JSON `json:"notification_targets"`
+		State               string
+		StateChangedAt      *time.Time
+		Value               *float64
+		EvaluatedAt         *time.Time
+		NotifiedState       string
+		NotificationError   string
+		CreatedBy           string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230524120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaAlertRule{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaAlertRule{})
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditRecordsWebhookFields() *gormigrate.Migration {
+	type AuditRecord struct {
+		WebhookPending  bool `gorm:"index"`
+		WebhookAttempts int  `gorm:"default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230607120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&AuditRecord{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"webhook_pending", "webhook_attempts"} {
+				err := tx.Migrator().DropColumn(&AuditRecord{}, column)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -96,6 +96,28 @@ var migrations = []*gormigrate.Migration{
 	addAccessKafkasViaPrivateNetworkColumnInClustersTable(),
 	addKafkaPromoteWorkerInLeaderLeases(),
 	updateExpiresAtZeroValueFromKafkaRequests(),
+	addKafkaMigrationFields(),
+	addKafkaMigrateWorkerInLeaderLeases(),
+	addClusterCordonedAndDrainingColumns(),
+	addDrainingClustersWorkerToLeaderLeases(),
+	addKafkaMaintenanceWindowFields(),
+	addKafkaRollouts(),
+	addKafkaRolloutWorkerToLeaderLeases(),
+	addKafkaStatusEvents(),
+	addKafkaStatusEventsWorkerToLeaderLeases(),
+	addKafkaConditionEvents(),
+	addKafkaLabels(),
+	addKafkaSuspensionFields(),
+	addIdleKafkasWorkerToLeaderLeases(),
+	addDataPlaneKafkaChanges(),
+	addAuditRecords(),
+	addQuotaGrants(),
+	addAccessControlListEntries(),
+	addKafkaOwnershipTransfers(),
+	addKafkaAlertRules(),
+	addKafkaAlertRulesWorkerToLeaderLeases(),
+	addAuditRecordsWebhookFields(),
+	addAuditRecordsWebhookWorkerToLeaderLeases(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
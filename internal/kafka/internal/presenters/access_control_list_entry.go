@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func ConvertAccessControlListEntryRequest(request private.AccessControlListEntryRequest, createdBy string) *api.AccessControlListEntry {
+	entry := &api.AccessControlListEntry{
+		Type:      api.AccessControlListEntryType(request.Type),
+		Value:     request.Value,
+		Reason:    request.Reason,
+		CreatedBy: createdBy,
+	}
+
+	if !request.ExpiresAt.IsZero() {
+		expiresAt := request.ExpiresAt
+		entry.ExpiresAt = &expiresAt
+	}
+
+	return entry
+}
+
+func PresentAccessControlListEntry(entry *api.AccessControlListEntry) private.AccessControlListEntry {
+	reference := PresentReference(entry.ID, entry)
+	res := private.AccessControlListEntry{
+		Id:        reference.Id,
+		Kind:      reference.Kind,
+		Href:      reference.Href,
+		CreatedAt: entry.CreatedAt,
+		Type:      entry.Type.String(),
+		Value:     entry.Value,
+		Reason:    entry.Reason,
+		CreatedBy: entry.CreatedBy,
+		Expired:   entry.HasExpired(time.Now()),
+	}
+
+	if entry.ExpiresAt != nil {
+		res.ExpiresAt = *entry.ExpiresAt
+	}
+
+	return res
+}
@@ -0,0 +1,131 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertAccessControlListEntryRequest(t *testing.T) {
+	expiresAt := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request private.AccessControlListEntryRequest
+		want    *api.AccessControlListEntry
+	}{
+		{
+			name: "should convert a request without expiry",
+			request: private.AccessControlListEntryRequest{
+				Type:   "denied_user",
+				Value:  "testuser",
+				Reason: "abuse",
+			},
+			want: &api.AccessControlListEntry{
+				Type:      api.AccessControlListEntryTypeDeniedUser,
+				Value:     "testuser",
+				Reason:    "abuse",
+				CreatedBy: "admin",
+			},
+		},
+		{
+			name: "should convert a request with an expiry",
+			request: private.AccessControlListEntryRequest{
+				Type:      "accepted_organisation",
+				Value:     "13640203",
+				ExpiresAt: expiresAt,
+			},
+			want: &api.AccessControlListEntry{
+				Type:      api.AccessControlListEntryTypeAcceptedOrganisation,
+				Value:     "13640203",
+				ExpiresAt: &expiresAt,
+				CreatedBy: "admin",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ConvertAccessControlListEntryRequest(tt.request, "admin")).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_PresentAccessControlListEntry(t *testing.T) {
+	expired := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	notExpired := time.Now().Add(48 * time.Hour)
+
+	tests := []struct {
+		name  string
+		entry *api.AccessControlListEntry
+		want  private.AccessControlListEntry
+	}{
+		{
+			name: "should present an entry that never expires",
+			entry: &api.AccessControlListEntry{
+				Meta:      api.Meta{ID: "entry-1"},
+				Type:      api.AccessControlListEntryTypeDeniedUser,
+				Value:     "testuser",
+				Reason:    "abuse",
+				CreatedBy: "admin",
+			},
+			want: private.AccessControlListEntry{
+				Id:        "entry-1",
+				Kind:      KindAccessControlListEntry,
+				Href:      "/api/kafkas_mgmt/v1/admin/access_control_list_entries/entry-1",
+				Type:      "denied_user",
+				Value:     "testuser",
+				Reason:    "abuse",
+				CreatedBy: "admin",
+			},
+		},
+		{
+			name: "should present an entry that has expired",
+			entry: &api.AccessControlListEntry{
+				Meta:      api.Meta{ID: "entry-2"},
+				Type:      api.AccessControlListEntryTypeAcceptedOrganisation,
+				Value:     "13640203",
+				ExpiresAt: &expired,
+			},
+			want: private.AccessControlListEntry{
+				Id:        "entry-2",
+				Kind:      KindAccessControlListEntry,
+				Href:      "/api/kafkas_mgmt/v1/admin/access_control_list_entries/entry-2",
+				Type:      "accepted_organisation",
+				Value:     "13640203",
+				ExpiresAt: expired,
+				Expired:   true,
+			},
+		},
+		{
+			name: "should present an entry that has not expired yet",
+			entry: &api.AccessControlListEntry{
+				Meta:      api.Meta{ID: "entry-3"},
+				Type:      api.AccessControlListEntryTypeDeniedUser,
+				Value:     "testuser",
+				ExpiresAt: &notExpired,
+			},
+			want: private.AccessControlListEntry{
+				Id:        "entry-3",
+				Kind:      KindAccessControlListEntry,
+				Href:      "/api/kafkas_mgmt/v1/admin/access_control_list_entries/entry-3",
+				Type:      "denied_user",
+				Value:     "testuser",
+				ExpiresAt: notExpired,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentAccessControlListEntry(tt.entry)).To(gomega.Equal(tt.want))
+		})
+	}
+}
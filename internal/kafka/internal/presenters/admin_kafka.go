@@ -68,9 +68,29 @@ func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountS
 		MaxDataRetentionSize: private.SupportedKafkaSizeBytesValueItem{
 			Bytes: maxDataRetentionSizeBytes,
 		},
+		MigrationStatus:    kafkaRequest.MigrationStatus.String(),
+		MigrationClusterId: kafkaRequest.MigrationClusterID,
+		MigrationDetails:   kafkaRequest.MigrationDetails,
+		MaintenanceWindow:  presentAdminKafkaMaintenanceWindow(kafkaRequest),
+		ForceUpgrade:       kafkaRequest.ForceUpgrade,
+		Labels:             presentKafkaLabels(kafkaRequest),
+		SuspensionReason:   kafkaRequest.SuspensionReason.String(),
 	}, nil
 }
 
+func presentAdminKafkaMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest) *private.KafkaMaintenanceWindow {
+	window, err := kafkaRequest.GetMaintenanceWindow()
+	if err != nil || window == nil {
+		return nil
+	}
+	return &private.KafkaMaintenanceWindow{
+		DayOfWeek:     window.DayOfWeek,
+		StartHour:     int32(window.StartHour),
+		DurationHours: int32(window.DurationHours),
+		Timezone:      window.Timezone,
+	}
+}
+
 func GetRoutesFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) []private.KafkaAllOfRoutes {
 	var routes []private.KafkaAllOfRoutes
 	routesArray, err := kafkaRequest.GetRoutes()
@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// PresentAuditRecord presents the audit record to the administrators. A request diff that is not a JSON object is not presented
+func PresentAuditRecord(record *api.AuditRecord) private.AuditRecord {
+	var roles []string
+	if len(record.Roles) > 0 {
+		_ = json.Unmarshal(record.Roles, &roles)
+	}
+
+	var requestDiff map[string]interface{}
+	if len(record.RequestDiff) > 0 {
+		_ = json.Unmarshal(record.RequestDiff, &requestDiff)
+	}
+
+	return private.AuditRecord{
+		Id:           record.ID,
+		Kind:         KindAuditRecord,
+		Actor:        record.Actor,
+		OrgId:        record.OrgID,
+		Roles:        roles,
+		Action:       record.Action,
+		Method:       record.Method,
+		RequestUri:   record.RequestURI,
+		RemoteAddr:   record.RemoteAddr,
+		ResourceType: record.ResourceType,
+		ResourceId:   record.ResourceID,
+		RequestDiff:  requestDiff,
+		StatusCode:   int32(record.StatusCode),
+		OperationId:  record.OperationID,
+		CreatedAt:    record.CreatedAt,
+	}
+}
@@ -0,0 +1,86 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_PresentAuditRecord(t *testing.T) {
+	createdAt := time.Date(2023, 4, 26, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		record *api.AuditRecord
+		want   private.AuditRecord
+	}{
+		{
+			name: "should present the roles and the request diff of the record",
+			record: &api.AuditRecord{
+				Meta:         api.Meta{ID: "record-1", CreatedAt: createdAt},
+				Actor:        "admin-user",
+				OrgID:        "org-1",
+				Roles:        api.JSON(`["kas-fleet-manager-admin-full"]`),
+				Action:       "admin-update-kafka",
+				Method:       "PATCH",
+				RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				RemoteAddr:   "192.0.2.1:1234",
+				ResourceType: "kafkas",
+				ResourceID:   "kafka-1",
+				RequestDiff:  api.JSON(`{"suspended":true}`),
+				StatusCode:   200,
+				OperationID:  "operation-1",
+			},
+			want: private.AuditRecord{
+				Id:           "record-1",
+				Kind:         KindAuditRecord,
+				Actor:        "admin-user",
+				OrgId:        "org-1",
+				Roles:        []string{"kas-fleet-manager-admin-full"},
+				Action:       "admin-update-kafka",
+				Method:       "PATCH",
+				RequestUri:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				RemoteAddr:   "192.0.2.1:1234",
+				ResourceType: "kafkas",
+				ResourceId:   "kafka-1",
+				RequestDiff:  map[string]interface{}{"suspended": true},
+				StatusCode:   200,
+				OperationId:  "operation-1",
+				CreatedAt:    createdAt,
+			},
+		},
+		{
+			name: "should not present a request diff that is not a JSON object",
+			record: &api.AuditRecord{
+				Meta:        api.Meta{ID: "record-2", CreatedAt: createdAt},
+				Actor:       "admin-user",
+				Action:      "DELETE",
+				Method:      "DELETE",
+				RequestURI:  "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				RequestDiff: api.JSON(`["kafka-1"]`),
+				StatusCode:  404,
+			},
+			want: private.AuditRecord{
+				Id:         "record-2",
+				Kind:       KindAuditRecord,
+				Actor:      "admin-user",
+				Action:     "DELETE",
+				Method:     "DELETE",
+				RequestUri: "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				StatusCode: 404,
+				CreatedAt:  createdAt,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentAuditRecord(tt.record)).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const clusterDrainStatusKind = "ClusterDrainStatus"
+
+func PresentClusterDrainStatus(drainStatus *services.ClusterDrainStatus) private.ClusterDrainStatus {
+	counts := drainStatus.CountByReadiness()
+	res := private.ClusterDrainStatus{
+		Kind:      clusterDrainStatusKind,
+		ClusterId: drainStatus.Cluster.ClusterID,
+		Status:    drainStatus.Cluster.Status.String(),
+		Cordoned:  drainStatus.Cluster.Cordoned,
+		Draining:  drainStatus.Cluster.Draining,
+		Drained:   drainStatus.Drained(),
+		KafkaCounts: private.ClusterDrainStatusKafkaCounts{
+			Migratable: int32(counts[services.KafkaDrainReadinessMigratable]),
+			Migrating:  int32(counts[services.KafkaDrainReadinessMigrating]),
+			Deleting:   int32(counts[services.KafkaDrainReadinessDeleting]),
+			Blocked:    int32(counts[services.KafkaDrainReadinessBlocked]),
+		},
+		Items: []private.ClusterDrainKafka{},
+	}
+
+	for _, kafka := range drainStatus.Kafkas {
+		res.Items = append(res.Items, private.ClusterDrainKafka{
+			Id:              kafka.Kafka.ID,
+			Name:            kafka.Kafka.Name,
+			Status:          kafka.Kafka.Status,
+			InstanceType:    kafka.Kafka.InstanceType,
+			SizeId:          kafka.Kafka.SizeId,
+			MigrationStatus: kafka.Kafka.MigrationStatus.String(),
+			Readiness:       kafka.Readiness.String(),
+			Reason:          kafka.Reason,
+		})
+	}
+
+	return res
+}
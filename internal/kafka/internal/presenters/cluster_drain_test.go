@@ -0,0 +1,98 @@
+package presenters
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_PresentClusterDrainStatus(t *testing.T) {
+	type args struct {
+		drainStatus *services.ClusterDrainStatus
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want private.ClusterDrainStatus
+	}{
+		{
+			name: "should present a drained cluster",
+			args: args{
+				drainStatus: &services.ClusterDrainStatus{
+					Cluster: &api.Cluster{ClusterID: "cluster-1", Status: api.ClusterReady, Cordoned: true, Draining: true},
+				},
+			},
+			want: private.ClusterDrainStatus{
+				Kind:      "ClusterDrainStatus",
+				ClusterId: "cluster-1",
+				Status:    api.ClusterReady.String(),
+				Cordoned:  true,
+				Draining:  true,
+				Drained:   true,
+				Items:     []private.ClusterDrainKafka{},
+			},
+		},
+		{
+			name: "should present the kafkas still deployed on the cluster along with their readiness",
+			args: args{
+				drainStatus: &services.ClusterDrainStatus{
+					Cluster: &api.Cluster{ClusterID: "cluster-1", Status: api.ClusterReady, Cordoned: true},
+					Kafkas: []services.KafkaDrainStatus{
+						{
+							Kafka: &dbapi.KafkaRequest{
+								Meta:            api.Meta{ID: "kafka-1"},
+								Name:            "kafka-1",
+								Status:          "ready",
+								InstanceType:    "standard",
+								SizeId:          "x1",
+								MigrationStatus: dbapi.KafkaMigrationStatusProvisioning,
+							},
+							Readiness: services.KafkaDrainReadinessMigrating,
+							Reason:    `migration of the kafka is in "provisioning" status`,
+						},
+						{
+							Kafka:     &dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-2"}, Name: "kafka-2", Status: "ready", InstanceType: "developer", SizeId: "x1"},
+							Readiness: services.KafkaDrainReadinessMigratable,
+						},
+					},
+				},
+			},
+			want: private.ClusterDrainStatus{
+				Kind:      "ClusterDrainStatus",
+				ClusterId: "cluster-1",
+				Status:    api.ClusterReady.String(),
+				Cordoned:  true,
+				KafkaCounts: private.ClusterDrainStatusKafkaCounts{
+					Migratable: 1,
+					Migrating:  1,
+				},
+				Items: []private.ClusterDrainKafka{
+					{
+						Id:              "kafka-1",
+						Name:            "kafka-1",
+						Status:          "ready",
+						InstanceType:    "standard",
+						SizeId:          "x1",
+						MigrationStatus: "provisioning",
+						Readiness:       "migrating",
+						Reason:          `migration of the kafka is in "provisioning" status`,
+					},
+					{Id: "kafka-2", Name: "kafka-2", Status: "ready", InstanceType: "developer", SizeId: "x1", Readiness: "migratable"},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentClusterDrainStatus(tt.args.drainStatus)).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+const clusterPlacementEvaluationKind = "ClusterPlacementEvaluation"
+
+// ConvertClusterPlacementEvaluationRequest converts the hypothetical kafka of the given request into a kafka request.
+// When the multi AZ attribute is not provided, it is determined by the instance type as it is done on kafka creation.
+func ConvertClusterPlacementEvaluationRequest(request private.ClusterPlacementEvaluationRequest) *dbapi.KafkaRequest {
+	kafka := &dbapi.KafkaRequest{
+		CloudProvider: request.CloudProvider,
+		Region:        request.Region,
+		InstanceType:  request.InstanceType,
+		SizeId:        request.SizeId,
+		MultiAZ:       request.InstanceType == types.STANDARD.String(),
+	}
+
+	if request.MultiAz != nil {
+		kafka.MultiAZ = *request.MultiAz
+	}
+
+	return kafka
+}
+
+func PresentClusterPlacementEvaluation(evaluation *services.ClusterPlacementEvaluation) private.ClusterPlacementEvaluation {
+	res := private.ClusterPlacementEvaluation{
+		Kind:                    clusterPlacementEvaluationKind,
+		PlacementStrategy:       evaluation.PlacementStrategy,
+		RegionCapacityAvailable: evaluation.RegionCapacityAvailable,
+		Candidates:              []private.ClusterPlacementCandidate{},
+	}
+
+	if evaluation.ChosenCluster != nil {
+		res.ClusterId = evaluation.ChosenCluster.ClusterID
+	}
+
+	for _, candidate := range evaluation.Candidates {
+		streamingUnits := []private.ClusterPlacementCandidateStreamingUnits{}
+		for _, su := range candidate.StreamingUnits {
+			streamingUnits = append(streamingUnits, private.ClusterPlacementCandidateStreamingUnits{
+				InstanceType:   su.InstanceType,
+				MaxUnits:       su.MaxUnits,
+				ConsumedUnits:  su.Consumed,
+				RemainingUnits: su.Remaining(),
+			})
+		}
+
+		res.Candidates = append(res.Candidates, private.ClusterPlacementCandidate{
+			ClusterId:             candidate.Cluster.ClusterID,
+			Status:                candidate.Cluster.Status.String(),
+			ClusterType:           candidate.Cluster.ClusterType,
+			MultiAz:               candidate.Cluster.MultiAZ,
+			SupportedInstanceType: candidate.Cluster.SupportedInstanceType,
+			StreamingUnits:        streamingUnits,
+			Score:                 candidate.Score,
+			Chosen:                res.ClusterId != "" && res.ClusterId == candidate.Cluster.ClusterID,
+			RejectionReason:       candidate.RejectionReason,
+		})
+	}
+
+	return res
+}
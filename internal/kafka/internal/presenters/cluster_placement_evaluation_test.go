@@ -0,0 +1,152 @@
+package presenters
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertClusterPlacementEvaluationRequest(t *testing.T) {
+	multiAZ := true
+	singleAZ := false
+
+	type args struct {
+		request private.ClusterPlacementEvaluationRequest
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *dbapi.KafkaRequest
+	}{
+		{
+			name: "should default multi AZ to true for the standard instance type",
+			args: args{
+				request: private.ClusterPlacementEvaluationRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", SizeId: "x1"},
+			},
+			want: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", SizeId: "x1", MultiAZ: true},
+		},
+		{
+			name: "should default multi AZ to false for the developer instance type",
+			args: args{
+				request: private.ClusterPlacementEvaluationRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", SizeId: "x1"},
+			},
+			want: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", SizeId: "x1", MultiAZ: false},
+		},
+		{
+			name: "should use the multi AZ value given in the request",
+			args: args{
+				request: private.ClusterPlacementEvaluationRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", SizeId: "x1", MultiAz: &singleAZ},
+			},
+			want: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", SizeId: "x1", MultiAZ: false},
+		},
+		{
+			name: "should use the multi AZ value given in the request for the developer instance type",
+			args: args{
+				request: private.ClusterPlacementEvaluationRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", SizeId: "x1", MultiAz: &multiAZ},
+			},
+			want: &dbapi.KafkaRequest{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", SizeId: "x1", MultiAZ: true},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ConvertClusterPlacementEvaluationRequest(tt.args.request)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_PresentClusterPlacementEvaluation(t *testing.T) {
+	score := 0.5
+
+	type args struct {
+		evaluation *services.ClusterPlacementEvaluation
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want private.ClusterPlacementEvaluation
+	}{
+		{
+			name: "should present an evaluation without a chosen cluster",
+			args: args{
+				evaluation: &services.ClusterPlacementEvaluation{
+					PlacementStrategy: "spread",
+					Candidates: []services.ClusterPlacementCandidateEvaluation{
+						{
+							Cluster:         &api.Cluster{ClusterID: "cluster-1", Status: api.ClusterReady},
+							StreamingUnits:  []services.InstanceTypeStreamingUnits{},
+							RejectionReason: "not enough capacity",
+						},
+					},
+				},
+			},
+			want: private.ClusterPlacementEvaluation{
+				Kind:              "ClusterPlacementEvaluation",
+				PlacementStrategy: "spread",
+				Candidates: []private.ClusterPlacementCandidate{
+					{
+						ClusterId:       "cluster-1",
+						Status:          api.ClusterReady.String(),
+						StreamingUnits:  []private.ClusterPlacementCandidateStreamingUnits{},
+						RejectionReason: "not enough capacity",
+					},
+				},
+			},
+		},
+		{
+			name: "should present the chosen cluster and the streaming units of the candidates",
+			args: args{
+				evaluation: &services.ClusterPlacementEvaluation{
+					PlacementStrategy:       "spread",
+					RegionCapacityAvailable: true,
+					ChosenCluster:           &api.Cluster{ClusterID: "cluster-1"},
+					Candidates: []services.ClusterPlacementCandidateEvaluation{
+						{
+							Cluster: &api.Cluster{ClusterID: "cluster-1", Status: api.ClusterReady, ClusterType: api.ManagedDataPlaneClusterType.String(), MultiAZ: true, SupportedInstanceType: "standard"},
+							StreamingUnits: []services.InstanceTypeStreamingUnits{
+								{InstanceType: "standard", MaxUnits: 10, Consumed: 3},
+							},
+							Score: &score,
+						},
+					},
+				},
+			},
+			want: private.ClusterPlacementEvaluation{
+				Kind:                    "ClusterPlacementEvaluation",
+				PlacementStrategy:       "spread",
+				RegionCapacityAvailable: true,
+				ClusterId:               "cluster-1",
+				Candidates: []private.ClusterPlacementCandidate{
+					{
+						ClusterId:             "cluster-1",
+						Status:                api.ClusterReady.String(),
+						ClusterType:           api.ManagedDataPlaneClusterType.String(),
+						MultiAz:               true,
+						SupportedInstanceType: "standard",
+						StreamingUnits: []private.ClusterPlacementCandidateStreamingUnits{
+							{InstanceType: "standard", MaxUnits: 10, ConsumedUnits: 3, RemainingUnits: 7},
+						},
+						Score:  &score,
+						Chosen: true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentClusterPlacementEvaluation(tt.args.evaluation)).To(gomega.Equal(tt.want))
+		})
+	}
+}
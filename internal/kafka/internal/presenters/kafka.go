@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,9 +48,77 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 		}
 	}
 
+	if err := kafka.SetMaintenanceWindow(ConvertKafkaMaintenanceWindow(kafkaRequestPayload.MaintenanceWindow)); err != nil {
+		logger.Logger.Error(err)
+	}
+
+	kafka.Labels = ConvertKafkaLabels(kafkaRequestPayload.Labels)
+
 	return kafka
 }
 
+// ConvertKafkaLabels from payload to KafkaLabel, sorted by key. It returns nil when the payload does not define any label
+func ConvertKafkaLabels(labels map[string]string) []dbapi.KafkaLabel {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	kafkaLabels := make([]dbapi.KafkaLabel, 0, len(keys))
+	for _, key := range keys {
+		kafkaLabels = append(kafkaLabels, dbapi.KafkaLabel{Key: key, Value: labels[key]})
+	}
+	return kafkaLabels
+}
+
+// presentKafkaLabels returns the labels of the kafka request in the format returned by the API, or nil if it does not have any
+func presentKafkaLabels(kafkaRequest *dbapi.KafkaRequest) map[string]string {
+	if len(kafkaRequest.Labels) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(kafkaRequest.Labels))
+	for _, label := range kafkaRequest.Labels {
+		labels[label.Key] = label.Value
+	}
+	return labels
+}
+
+// ConvertKafkaMaintenanceWindow from payload to KafkaMaintenanceWindow. It returns nil when the payload does not define a window i.e. it is nil or empty
+func ConvertKafkaMaintenanceWindow(window *public.KafkaMaintenanceWindow) *dbapi.KafkaMaintenanceWindow {
+	if window == nil || *window == (public.KafkaMaintenanceWindow{}) {
+		return nil
+	}
+	return &dbapi.KafkaMaintenanceWindow{
+		DayOfWeek:     window.DayOfWeek,
+		StartHour:     int(window.StartHour),
+		DurationHours: int(window.DurationHours),
+		Timezone:      window.Timezone,
+	}
+}
+
+// presentKafkaMaintenanceWindow returns the maintenance window of the kafka request in the format returned by the API, or nil if it does not have one
+func presentKafkaMaintenanceWindow(kafkaRequest *dbapi.KafkaRequest) *public.KafkaMaintenanceWindow {
+	window, err := kafkaRequest.GetMaintenanceWindow()
+	if err != nil {
+		logger.Logger.Error(err)
+		return nil
+	}
+	if window == nil {
+		return nil
+	}
+	return &public.KafkaMaintenanceWindow{
+		DayOfWeek:     window.DayOfWeek,
+		StartHour:     int32(window.StartHour),
+		DurationHours: int32(window.DurationHours),
+		Timezone:      window.Timezone,
+	}
+}
+
 // PresentKafkaRequest - create KafkaRequest in an appropriate format ready to be returned by the API
 func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig) (public.KafkaRequest, *errors.ServiceError) {
 	reference := PresentReference(kafkaRequest.ID, kafkaRequest)
@@ -125,6 +194,9 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		PromotionStatus:                       kafkaRequest.PromotionStatus.String(),
 		PromotionDetails:                      kafkaRequest.PromotionDetails,
 		ClusterId:                             getClusterID(kafkaRequest),
+		MaintenanceWindow:                     presentKafkaMaintenanceWindow(kafkaRequest),
+		Labels:                                presentKafkaLabels(kafkaRequest),
+		SuspensionReason:                      kafkaRequest.SuspensionReason.String(),
 	}, nil
 }
 
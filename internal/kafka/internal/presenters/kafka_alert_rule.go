@@ -0,0 +1,68 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func ConvertKafkaAlertRuleRequest(kafkaID string, request public.KafkaAlertRuleRequest, createdBy string) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+	rule := &dbapi.KafkaAlertRule{
+		KafkaID:   kafkaID,
+		Name:      request.Name,
+		Metric:    dbapi.KafkaAlertRuleMetric(request.Metric),
+		Threshold: request.Threshold,
+		CreatedBy: createdBy,
+	}
+
+	targets := []dbapi.KafkaAlertNotificationTarget{}
+	for _, target := range request.NotificationTargets {
+		targets = append(targets, dbapi.KafkaAlertNotificationTarget{
+			Type: target.Type,
+			URL:  target.Url,
+		})
+	}
+	if err := rule.SetNotificationTargets(targets); err != nil {
+		return nil, errors.GeneralError("failed to convert the notification targets of the alert rule: %v", err)
+	}
+
+	return rule, nil
+}
+
+// PresentKafkaAlertRule presents the rule together with its latest evaluation. The rules are nested under their kafka,
+// so their href is built from the id of the kafka
+func PresentKafkaAlertRule(rule *dbapi.KafkaAlertRule) (public.KafkaAlertRule, *errors.ServiceError) {
+	targets, err := rule.GetNotificationTargets()
+	if err != nil {
+		return public.KafkaAlertRule{}, errors.GeneralError("failed to present the notification targets of alert rule %q: %v", rule.ID, err)
+	}
+
+	res := public.KafkaAlertRule{
+		Id:                  rule.ID,
+		Kind:                KindKafkaAlertRule,
+		Href:                fmt.Sprintf("%s/kafkas/%s/alert_rules/%s", BasePath, rule.KafkaID, rule.ID),
+		KafkaId:             rule.KafkaID,
+		Name:                rule.Name,
+		Metric:              rule.Metric.String(),
+		Threshold:           rule.Threshold,
+		NotificationTargets: []public.KafkaAlertNotificationTarget{},
+		State:               rule.State.String(),
+		StateChangedAt:      rule.StateChangedAt,
+		Value:               rule.Value,
+		EvaluatedAt:         rule.EvaluatedAt,
+		NotificationError:   rule.NotificationError,
+		CreatedAt:           rule.CreatedAt,
+		UpdatedAt:           rule.UpdatedAt,
+	}
+
+	for _, target := range targets {
+		res.NotificationTargets = append(res.NotificationTargets, public.KafkaAlertNotificationTarget{
+			Type: target.Type,
+			Url:  target.URL,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertKafkaAlertRuleRequest(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	rule, err := ConvertKafkaAlertRuleRequest("kafka-1", public.KafkaAlertRuleRequest{
+		Name:      "disk almost full",
+		Metric:    "disk_usage_percentage",
+		Threshold: 80,
+		NotificationTargets: []public.KafkaAlertNotificationTarget{
+			{Type: "webhook", Url: "https://example.com/alerts"},
+		},
+	}, "test-user")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(rule.KafkaID).To(gomega.Equal("kafka-1"))
+	g.Expect(rule.Metric).To(gomega.Equal(dbapi.KafkaAlertRuleMetricDiskUsagePercentage))
+	g.Expect(rule.Threshold).To(gomega.Equal(float64(80)))
+	g.Expect(rule.CreatedBy).To(gomega.Equal("test-user"))
+
+	targets, targetsErr := rule.GetNotificationTargets()
+	g.Expect(targetsErr).ToNot(gomega.HaveOccurred())
+	g.Expect(targets).To(gomega.Equal([]dbapi.KafkaAlertNotificationTarget{
+		{Type: "webhook", URL: "https://example.com/alerts"},
+	}))
+}
+
+func Test_PresentKafkaAlertRule(t *testing.T) {
+	createdAt := time.Date(2023, 5, 24, 12, 0, 0, 0, time.UTC)
+	evaluatedAt := createdAt.Add(5 * time.Minute)
+	value := float64(85)
+
+	tests := []struct {
+		name    string
+		rule    *dbapi.KafkaAlertRule
+		want    public.KafkaAlertRule
+		wantErr bool
+	}{
+		{
+			name: "should present the rule and its latest evaluation",
+			rule: &dbapi.KafkaAlertRule{
+				Meta:                api.Meta{ID: "rule-1", CreatedAt: createdAt, UpdatedAt: evaluatedAt},
+				KafkaID:             "kafka-1",
+				Name:                "disk almost full",
+				Metric:              dbapi.KafkaAlertRuleMetricDiskUsagePercentage,
+				Threshold:           80,
+				NotificationTargets: api.JSON(`[{"type":"webhook","url":"https://example.com/alerts"}]`),
+				State:               dbapi.KafkaAlertRuleStateFiring,
+				StateChangedAt:      &evaluatedAt,
+				Value:               &value,
+				EvaluatedAt:         &evaluatedAt,
+				NotifiedState:       dbapi.KafkaAlertRuleStateInactive,
+				NotificationError:   "webhook unavailable",
+				CreatedBy:           "test-user",
+			},
+			want: public.KafkaAlertRule{
+				Id:        "rule-1",
+				Kind:      KindKafkaAlertRule,
+				Href:      "/api/kafkas_mgmt/v1/kafkas/kafka-1/alert_rules/rule-1",
+				KafkaId:   "kafka-1",
+				Name:      "disk almost full",
+				Metric:    "disk_usage_percentage",
+				Threshold: 80,
+				NotificationTargets: []public.KafkaAlertNotificationTarget{
+					{Type: "webhook", Url: "https://example.com/alerts"},
+				},
+				State:             "firing",
+				StateChangedAt:    &evaluatedAt,
+				Value:             &value,
+				EvaluatedAt:       &evaluatedAt,
+				NotificationError: "webhook unavailable",
+				CreatedAt:         createdAt,
+				UpdatedAt:         evaluatedAt,
+			},
+		},
+		{
+			name: "should return an error when the notification targets cannot be read",
+			rule: &dbapi.KafkaAlertRule{
+				Meta:                api.Meta{ID: "rule-1"},
+				NotificationTargets: api.JSON(`{`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := PresentKafkaAlertRule(tt.rule)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got).To(gomega.Equal(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+// PresentKafkaEvent presents the event to the owners of the kafka. The messages of the data plane conditions,
+// the data plane cluster and the actor of the event are not shown to them
+func PresentKafkaEvent(event *dbapi.KafkaEvent) public.KafkaEvent {
+	return public.KafkaEvent{
+		Id:              event.ID,
+		Kind:            KindKafkaEvent,
+		Type:            event.Type.String(),
+		OccurredAt:      event.OccurredAt,
+		OldStatus:       event.OldStatus,
+		NewStatus:       event.NewStatus,
+		ConditionType:   event.ConditionType,
+		ConditionStatus: event.ConditionStatus,
+		Reason:          event.Reason,
+	}
+}
+
+func PresentKafkaEventAdminEndpoint(event *dbapi.KafkaEvent) private.KafkaEvent {
+	return private.KafkaEvent{
+		Id:              event.ID,
+		Kind:            KindKafkaEvent,
+		KafkaId:         event.KafkaID,
+		Type:            event.Type.String(),
+		OccurredAt:      event.OccurredAt,
+		OldStatus:       event.OldStatus,
+		NewStatus:       event.NewStatus,
+		Actor:           event.Actor,
+		ClusterId:       event.ClusterID,
+		ConditionType:   event.ConditionType,
+		ConditionStatus: event.ConditionStatus,
+		Reason:          event.Reason,
+		Message:         event.Message,
+	}
+}
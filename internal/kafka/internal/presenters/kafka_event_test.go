@@ -0,0 +1,72 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/onsi/gomega"
+)
+
+func Test_PresentKafkaEvent(t *testing.T) {
+	occurredAt := time.Date(2023, 3, 29, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event *dbapi.KafkaEvent
+		want  public.KafkaEvent
+	}{
+		{
+			name: "should not present the actor of a status transition",
+			event: &dbapi.KafkaEvent{
+				ID:         "event-1",
+				KafkaID:    "kafka-1",
+				Type:       dbapi.KafkaEventTypeStatusChange,
+				OccurredAt: occurredAt,
+				OldStatus:  "ready",
+				NewStatus:  "deprovision",
+				Actor:      "admin-user",
+			},
+			want: public.KafkaEvent{
+				Id:         "event-1",
+				Kind:       KindKafkaEvent,
+				Type:       "status_change",
+				OccurredAt: occurredAt,
+				OldStatus:  "ready",
+				NewStatus:  "deprovision",
+			},
+		},
+		{
+			name: "should not present the data plane cluster and message of a condition",
+			event: &dbapi.KafkaEvent{
+				ID:              "event-2",
+				KafkaID:         "kafka-1",
+				Type:            dbapi.KafkaEventTypeCondition,
+				OccurredAt:      occurredAt,
+				ClusterID:       "cluster-1",
+				ConditionType:   "Ready",
+				ConditionStatus: "False",
+				Reason:          "Error",
+				Message:         "kafka resource could not be reconciled",
+			},
+			want: public.KafkaEvent{
+				Id:              "event-2",
+				Kind:            KindKafkaEvent,
+				Type:            "condition",
+				OccurredAt:      occurredAt,
+				ConditionType:   "Ready",
+				ConditionStatus: "False",
+				Reason:          "Error",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentKafkaEvent(tt.event)).To(gomega.Equal(tt.want))
+		})
+	}
+}
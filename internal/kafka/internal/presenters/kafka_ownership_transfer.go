@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+// ConvertKafkaOwnershipTransferRequest converts the new owner of a kafka ownership transfer request
+func ConvertKafkaOwnershipTransferRequest(request private.KafkaOwnershipTransferRequest) dbapi.KafkaOwner {
+	return dbapi.KafkaOwner{
+		Owner:          request.Owner,
+		OrganisationID: request.OrganisationId,
+		OwnerAccountID: request.OwnerAccountId,
+	}
+}
+
+// PresentKafkaOwnershipTransfer presents the transfer to the administrators. Transfers cannot be retrieved one by one, so
+// they are presented without href
+func PresentKafkaOwnershipTransfer(transfer *dbapi.KafkaOwnershipTransfer) private.KafkaOwnershipTransfer {
+	return private.KafkaOwnershipTransfer{
+		Id:                     transfer.ID,
+		Kind:                   KindKafkaOwnershipTransfer,
+		CreatedAt:              transfer.CreatedAt,
+		KafkaId:                transfer.KafkaID,
+		PreviousOwner:          transfer.PreviousOwner,
+		PreviousOrganisationId: transfer.PreviousOrganisationID,
+		PreviousSubscriptionId: transfer.PreviousSubscriptionID,
+		Owner:                  transfer.Owner,
+		OrganisationId:         transfer.OrganisationID,
+		SubscriptionId:         transfer.SubscriptionID,
+		Actor:                  transfer.Actor,
+		Reason:                 transfer.Reason,
+	}
+}
@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertKafkaOwnershipTransferRequest(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	owner := ConvertKafkaOwnershipTransferRequest(private.KafkaOwnershipTransferRequest{
+		KafkaId:        "kafka-1",
+		Owner:          "new-owner",
+		OrganisationId: "org-2",
+		OwnerAccountId: "account-2",
+		Reason:         "the previous owner left",
+	})
+
+	g.Expect(owner).To(gomega.Equal(dbapi.KafkaOwner{
+		Owner:          "new-owner",
+		OrganisationID: "org-2",
+		OwnerAccountID: "account-2",
+	}))
+}
+
+func Test_PresentKafkaOwnershipTransfer(t *testing.T) {
+	g := gomega.NewWithT(t)
+	createdAt := time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+
+	transfer := PresentKafkaOwnershipTransfer(&dbapi.KafkaOwnershipTransfer{
+		Meta:                   api.Meta{ID: "transfer-1", CreatedAt: createdAt},
+		KafkaID:                "kafka-1",
+		PreviousOwner:          "previous-owner",
+		PreviousOrganisationID: "org-1",
+		PreviousSubscriptionID: "subscription-1",
+		Owner:                  "new-owner",
+		OrganisationID:         "org-2",
+		SubscriptionID:         "subscription-2",
+		Actor:                  "admin-user",
+		Reason:                 "the previous owner left",
+	})
+
+	g.Expect(transfer).To(gomega.Equal(private.KafkaOwnershipTransfer{
+		Id:                     "transfer-1",
+		Kind:                   KindKafkaOwnershipTransfer,
+		CreatedAt:              createdAt,
+		KafkaId:                "kafka-1",
+		PreviousOwner:          "previous-owner",
+		PreviousOrganisationId: "org-1",
+		PreviousSubscriptionId: "subscription-1",
+		Owner:                  "new-owner",
+		OrganisationId:         "org-2",
+		SubscriptionId:         "subscription-2",
+		Actor:                  "admin-user",
+		Reason:                 "the previous owner left",
+	}))
+}
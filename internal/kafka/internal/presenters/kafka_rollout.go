@@ -0,0 +1,78 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+)
+
+// DefaultKafkaRolloutUpgradeTimeoutSeconds is the upgrade timeout of a rollout when none is requested
+const DefaultKafkaRolloutUpgradeTimeoutSeconds = 3600
+
+func ConvertKafkaRolloutRequest(request private.KafkaRolloutRequest) *dbapi.KafkaRollout {
+	rollout := &dbapi.KafkaRollout{
+		InstanceType:           request.InstanceType,
+		ClusterID:              request.ClusterId,
+		StrimziVersion:         request.StrimziVersion,
+		DesiredStrimziVersion:  request.DesiredStrimziVersion,
+		DesiredKafkaVersion:    request.DesiredKafkaVersion,
+		DesiredKafkaIBPVersion: request.DesiredKafkaIbpVersion,
+		BatchSize:              int(request.BatchSize),
+		BatchPauseSeconds:      int(request.BatchPauseSeconds),
+		UpgradeTimeoutSeconds:  int(request.UpgradeTimeoutSeconds),
+		MaxFailurePercentage:   int(request.MaxFailurePercentage),
+	}
+
+	if rollout.UpgradeTimeoutSeconds == 0 {
+		rollout.UpgradeTimeoutSeconds = DefaultKafkaRolloutUpgradeTimeoutSeconds
+	}
+
+	return rollout
+}
+
+func PresentKafkaRollout(rollout *dbapi.KafkaRollout) private.KafkaRollout {
+	reference := PresentReference(rollout.ID, rollout)
+	counts := rollout.CountItemsByStatus()
+	res := private.KafkaRollout{
+		Id:                     reference.Id,
+		Kind:                   reference.Kind,
+		Href:                   reference.Href,
+		CreatedAt:              rollout.CreatedAt,
+		UpdatedAt:              rollout.UpdatedAt,
+		Status:                 rollout.Status.String(),
+		FailedReason:           rollout.FailedReason,
+		InstanceType:           rollout.InstanceType,
+		ClusterId:              rollout.ClusterID,
+		StrimziVersion:         rollout.StrimziVersion,
+		DesiredStrimziVersion:  rollout.DesiredStrimziVersion,
+		DesiredKafkaVersion:    rollout.DesiredKafkaVersion,
+		DesiredKafkaIbpVersion: rollout.DesiredKafkaIBPVersion,
+		BatchSize:              int32(rollout.BatchSize),
+		BatchPauseSeconds:      int32(rollout.BatchPauseSeconds),
+		UpgradeTimeoutSeconds:  int32(rollout.UpgradeTimeoutSeconds),
+		MaxFailurePercentage:   int32(rollout.MaxFailurePercentage),
+		BatchCount:             int32(rollout.BatchCount()),
+		CurrentBatch:           int32(rollout.CurrentBatch),
+		NextBatchAt:            rollout.NextBatchAt,
+		KafkaCounts: private.KafkaRolloutKafkaCounts{
+			Pending:   int32(counts[dbapi.KafkaRolloutItemStatusPending]),
+			Upgrading: int32(counts[dbapi.KafkaRolloutItemStatusUpgrading]),
+			Upgraded:  int32(counts[dbapi.KafkaRolloutItemStatusUpgraded]),
+			Failed:    int32(counts[dbapi.KafkaRolloutItemStatusFailed]),
+			Skipped:   int32(counts[dbapi.KafkaRolloutItemStatusSkipped]),
+		},
+		Items: []private.KafkaRolloutItem{},
+	}
+
+	for _, item := range rollout.Items {
+		res.Items = append(res.Items, private.KafkaRolloutItem{
+			KafkaId:      item.KafkaID,
+			Batch:        int32(item.Batch),
+			Status:       item.Status.String(),
+			FailedReason: item.FailedReason,
+			StartedAt:    item.StartedAt,
+			CompletedAt:  item.CompletedAt,
+		})
+	}
+
+	return res
+}
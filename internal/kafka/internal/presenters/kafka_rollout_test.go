@@ -0,0 +1,126 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertKafkaRolloutRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request private.KafkaRolloutRequest
+		want    *dbapi.KafkaRollout
+	}{
+		{
+			name: "should default the upgrade timeout",
+			request: private.KafkaRolloutRequest{
+				InstanceType:          "standard",
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             5,
+			},
+			want: &dbapi.KafkaRollout{
+				InstanceType:          "standard",
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             5,
+				UpgradeTimeoutSeconds: DefaultKafkaRolloutUpgradeTimeoutSeconds,
+			},
+		},
+		{
+			name: "should convert all the fields of the request",
+			request: private.KafkaRolloutRequest{
+				InstanceType:           "developer",
+				ClusterId:              "cluster-1",
+				StrimziVersion:         "strimzi-cluster-operator.v0.31.0-0",
+				DesiredStrimziVersion:  "strimzi-cluster-operator.v0.32.0-0",
+				DesiredKafkaVersion:    "3.3.1",
+				DesiredKafkaIbpVersion: "3.3",
+				BatchSize:              2,
+				BatchPauseSeconds:      300,
+				UpgradeTimeoutSeconds:  600,
+				MaxFailurePercentage:   10,
+			},
+			want: &dbapi.KafkaRollout{
+				InstanceType:           "developer",
+				ClusterID:              "cluster-1",
+				StrimziVersion:         "strimzi-cluster-operator.v0.31.0-0",
+				DesiredStrimziVersion:  "strimzi-cluster-operator.v0.32.0-0",
+				DesiredKafkaVersion:    "3.3.1",
+				DesiredKafkaIBPVersion: "3.3",
+				BatchSize:              2,
+				BatchPauseSeconds:      300,
+				UpgradeTimeoutSeconds:  600,
+				MaxFailurePercentage:   10,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(ConvertKafkaRolloutRequest(tt.request)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_PresentKafkaRollout(t *testing.T) {
+	startedAt := time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		rollout *dbapi.KafkaRollout
+		want    private.KafkaRollout
+	}{
+		{
+			name: "should present the progress of the rollout along with its kafkas",
+			rollout: &dbapi.KafkaRollout{
+				Meta:                  api.Meta{ID: "rollout-1"},
+				Status:                dbapi.KafkaRolloutStatusInProgress,
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             1,
+				UpgradeTimeoutSeconds: 3600,
+				CurrentBatch:          2,
+				Items: []dbapi.KafkaRolloutItem{
+					{KafkaID: "kafka-1", Batch: 1, Status: dbapi.KafkaRolloutItemStatusUpgraded, StartedAt: &startedAt, CompletedAt: &completedAt},
+					{KafkaID: "kafka-2", Batch: 2, Status: dbapi.KafkaRolloutItemStatusUpgrading, StartedAt: &completedAt},
+					{KafkaID: "kafka-3", Batch: 3, Status: dbapi.KafkaRolloutItemStatusPending},
+				},
+			},
+			want: private.KafkaRollout{
+				Id:                    "rollout-1",
+				Kind:                  KindKafkaRollout,
+				Href:                  "/api/kafkas_mgmt/v1/admin/kafka_rollouts/rollout-1",
+				Status:                "in_progress",
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             1,
+				UpgradeTimeoutSeconds: 3600,
+				BatchCount:            3,
+				CurrentBatch:          2,
+				KafkaCounts: private.KafkaRolloutKafkaCounts{
+					Pending:   1,
+					Upgrading: 1,
+					Upgraded:  1,
+				},
+				Items: []private.KafkaRolloutItem{
+					{KafkaId: "kafka-1", Batch: 1, Status: "upgraded", StartedAt: &startedAt, CompletedAt: &completedAt},
+					{KafkaId: "kafka-2", Batch: 2, Status: "upgrading", StartedAt: &completedAt},
+					{KafkaId: "kafka-3", Batch: 3, Status: "pending"},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentKafkaRollout(tt.rollout)).To(gomega.Equal(tt.want))
+		})
+	}
+}
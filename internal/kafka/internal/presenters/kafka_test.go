@@ -27,6 +27,7 @@ func TestConvertKafkaRequest(t *testing.T) {
 	reauthEnabled := true
 	reauthDisabled := false
 	clusterID := "dsdhsjdhsd"
+	maintenanceWindow := public.KafkaMaintenanceWindow{DayOfWeek: "sunday", StartHour: 2, DurationHours: 4, Timezone: "Europe/Dublin"}
 
 	tests := []struct {
 		name string
@@ -116,6 +117,53 @@ func TestConvertKafkaRequest(t *testing.T) {
 				mocks.With(mocks.DESIRED_KAFKA_BILLING_MODEL, "mybillingmodel"),
 			),
 		},
+		{
+			name: "should convert the maintenance window if provided",
+			args: args{
+				kafkaRequestPayload: *mocks.BuildKafkaRequestPayload(func(payload *public.KafkaRequestPayload) {
+					payload.MaintenanceWindow = &maintenanceWindow
+				}),
+				dbKafkaRequests: []*dbapi.KafkaRequest{},
+			},
+			want: mocks.BuildKafkaRequest(
+				mocks.With(mocks.REGION, mocks.DefaultKafkaRequestRegion),
+				mocks.With(mocks.CLOUD_PROVIDER, mocks.DefaultKafkaRequestProvider),
+				mocks.With(mocks.NAME, mocks.DefaultKafkaRequestName),
+				mocks.WithReauthenticationEnabled(reauthEnabled),
+				mocks.WithMaintenanceWindow(api.JSON(`{"day_of_week":"sunday","start_hour":2,"duration_hours":4,"timezone":"Europe/Dublin"}`)),
+			),
+		},
+		{
+			name: "should convert the labels sorted by key if provided",
+			args: args{
+				kafkaRequestPayload: *mocks.BuildKafkaRequestPayload(func(payload *public.KafkaRequestPayload) {
+					payload.Labels = map[string]string{"team": "kafka", "env": "prod"}
+				}),
+				dbKafkaRequests: []*dbapi.KafkaRequest{},
+			},
+			want: mocks.BuildKafkaRequest(
+				mocks.With(mocks.REGION, mocks.DefaultKafkaRequestRegion),
+				mocks.With(mocks.CLOUD_PROVIDER, mocks.DefaultKafkaRequestProvider),
+				mocks.With(mocks.NAME, mocks.DefaultKafkaRequestName),
+				mocks.WithReauthenticationEnabled(reauthEnabled),
+				mocks.WithLabels(dbapi.KafkaLabel{Key: "env", Value: "prod"}, dbapi.KafkaLabel{Key: "team", Value: "kafka"}),
+			),
+		},
+		{
+			name: "should not set a maintenance window when an empty one is provided",
+			args: args{
+				kafkaRequestPayload: *mocks.BuildKafkaRequestPayload(func(payload *public.KafkaRequestPayload) {
+					payload.MaintenanceWindow = &public.KafkaMaintenanceWindow{}
+				}),
+				dbKafkaRequests: []*dbapi.KafkaRequest{},
+			},
+			want: mocks.BuildKafkaRequest(
+				mocks.With(mocks.REGION, mocks.DefaultKafkaRequestRegion),
+				mocks.With(mocks.CLOUD_PROVIDER, mocks.DefaultKafkaRequestProvider),
+				mocks.With(mocks.NAME, mocks.DefaultKafkaRequestName),
+				mocks.WithReauthenticationEnabled(reauthEnabled),
+			),
+		},
 	}
 
 	for _, testcase := range tests {
@@ -223,6 +271,8 @@ func TestPresentKafkaRequest(t *testing.T) {
 					mocks.With(mocks.STORAGE_SIZE, kafkaStorageSize),
 					mocks.With(mocks.DESIRED_KAFKA_BILLING_MODEL, constants.BillingModelEnterprise.String()),
 					mocks.With(mocks.ACTUAL_KAFKA_BILLING_MODEL, constants.BillingModelEnterprise.String()),
+					mocks.WithMaintenanceWindow(api.JSON(`{"day_of_week":"sunday","start_hour":2,"duration_hours":4,"timezone":"Europe/Dublin"}`)),
+					mocks.WithLabels(dbapi.KafkaLabel{Key: "env", Value: "prod"}),
 					mocks.WithCreatedAt(nowTime),
 					mocks.WithExpiresAt(sql.NullTime{Time: nowTime.Add(time.Duration(*defaultInstanceSize.LifespanSeconds) * time.Second), Valid: true}),
 				),
@@ -242,6 +292,13 @@ func TestPresentKafkaRequest(t *testing.T) {
 				kafkaRequest.DeprecatedMaxDataRetentionPeriod = defaultInstanceSize.MaxDataRetentionPeriod
 				kafkaRequest.DeprecatedMaxConnectionAttemptsPerSec = int32(defaultInstanceSize.MaxConnectionAttemptsPerSec)
 				kafkaRequest.ClusterId = &clusterID
+				kafkaRequest.MaintenanceWindow = &public.KafkaMaintenanceWindow{
+					DayOfWeek:     "sunday",
+					StartHour:     2,
+					DurationHours: 4,
+					Timezone:      "Europe/Dublin",
+				}
+				kafkaRequest.Labels = map[string]string{"env": "prod"}
 				kafkaRequest.CreatedAt = nowTime
 				expireTime := kafkaRequest.CreatedAt.Add(time.Duration(*defaultInstanceSize.LifespanSeconds) * time.Second)
 				kafkaRequest.ExpiresAt = &expireTime
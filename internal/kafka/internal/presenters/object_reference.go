@@ -23,6 +23,20 @@ const (
 	KindServiceAccount = "ServiceAccount"
 
 	KindCluster = "Cluster"
+	// KindKafkaRollout is a string identifier for the type dbapi.KafkaRollout
+	KindKafkaRollout = "KafkaRollout"
+	// KindKafkaEvent is a string identifier for the type dbapi.KafkaEvent
+	KindKafkaEvent = "KafkaEvent"
+	// KindAuditRecord is a string identifier for the type api.AuditRecord
+	KindAuditRecord = "AuditRecord"
+	// KindQuotaGrant is a string identifier for the type dbapi.QuotaGrant
+	KindQuotaGrant = "QuotaGrant"
+	// KindAccessControlListEntry is a string identifier for the type api.AccessControlListEntry
+	KindAccessControlListEntry = "AccessControlListEntry"
+	// KindKafkaOwnershipTransfer is a string identifier for the type dbapi.KafkaOwnershipTransfer
+	KindKafkaOwnershipTransfer = "KafkaOwnershipTransfer"
+	// KindKafkaAlertRule is a string identifier for the type dbapi.KafkaAlertRule
+	KindKafkaAlertRule = "KafkaAlertRule"
 
 	BasePath = "/api/kafkas_mgmt/v1"
 )
@@ -45,6 +59,12 @@ func objectKind(i interface{}) string {
 		return KindServiceAccount
 	case api.Cluster, *api.Cluster:
 		return KindCluster
+	case dbapi.KafkaRollout, *dbapi.KafkaRollout:
+		return KindKafkaRollout
+	case dbapi.QuotaGrant, *dbapi.QuotaGrant:
+		return KindQuotaGrant
+	case api.AccessControlListEntry, *api.AccessControlListEntry:
+		return KindAccessControlListEntry
 	default:
 		return ""
 	}
@@ -60,6 +80,12 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("%s/clusters/%s", BasePath, id)
 	case api.ServiceAccount, *api.ServiceAccount:
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
+	case dbapi.KafkaRollout, *dbapi.KafkaRollout:
+		return fmt.Sprintf("%s/admin/kafka_rollouts/%s", BasePath, id)
+	case dbapi.QuotaGrant, *dbapi.QuotaGrant:
+		return fmt.Sprintf("%s/admin/quota_grants/%s", BasePath, id)
+	case api.AccessControlListEntry, *api.AccessControlListEntry:
+		return fmt.Sprintf("%s/admin/access_control_list_entries/%s", BasePath, id)
 	default:
 		return ""
 	}
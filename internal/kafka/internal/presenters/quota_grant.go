@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+)
+
+func ConvertQuotaGrantRequest(request private.QuotaGrantRequest) (*dbapi.QuotaGrant, error) {
+	grant := &dbapi.QuotaGrant{
+		SubjectType:         dbapi.QuotaGrantSubjectType(request.SubjectType),
+		SubjectID:           request.SubjectId,
+		InstanceTypeID:      request.InstanceTypeId,
+		BillingModelID:      request.BillingModelId,
+		MaxAllowedInstances: int(request.MaxAllowedInstances),
+	}
+
+	if request.ExpirationDate != "" {
+		expirationDate, err := ConvertQuotaGrantExpirationDate(request.ExpirationDate)
+		if err != nil {
+			return nil, err
+		}
+		grant.ExpirationDate = expirationDate
+	}
+
+	return grant, nil
+}
+
+// ConvertQuotaGrantExpirationDate converts an expiration date in the format of the quota management list. An empty
+// expiration date is converted to nil, i.e. the quota never expires
+func ConvertQuotaGrantExpirationDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	expirationDate, err := quota_management.ParseExpirationDate(value)
+	if err != nil {
+		return nil, err
+	}
+	t := time.Time(expirationDate)
+	return &t, nil
+}
+
+func PresentQuotaGrant(grant *dbapi.QuotaGrant) private.QuotaGrant {
+	reference := PresentReference(grant.ID, grant)
+	res := private.QuotaGrant{
+		Id:                  reference.Id,
+		Kind:                reference.Kind,
+		Href:                reference.Href,
+		CreatedAt:           grant.CreatedAt,
+		UpdatedAt:           grant.UpdatedAt,
+		SubjectType:         grant.SubjectType.String(),
+		SubjectId:           grant.SubjectID,
+		InstanceTypeId:      grant.InstanceTypeID,
+		BillingModelId:      grant.BillingModelID,
+		MaxAllowedInstances: int32(grant.MaxAllowedInstances),
+	}
+
+	if expirationDate := grant.GetExpirationDate(); expirationDate != nil {
+		res.ExpirationDate = expirationDate.String()
+		res.Expired = expirationDate.HasExpired()
+	}
+
+	return res
+}
@@ -0,0 +1,170 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_ConvertQuotaGrantRequest(t *testing.T) {
+	expirationDate := time.Date(2023, 12, 31, 0, 0, 0, 0, time.FixedZone("", 2*60*60))
+
+	tests := []struct {
+		name    string
+		request private.QuotaGrantRequest
+		want    *dbapi.QuotaGrant
+		wantErr bool
+	}{
+		{
+			name: "should convert a request without expiration date",
+			request: private.QuotaGrantRequest{
+				SubjectType:         "organisation",
+				SubjectId:           "13640203",
+				InstanceTypeId:      "standard",
+				BillingModelId:      "enterprise",
+				MaxAllowedInstances: 10,
+			},
+			want: &dbapi.QuotaGrant{
+				SubjectType:         dbapi.QuotaGrantSubjectTypeOrganisation,
+				SubjectID:           "13640203",
+				InstanceTypeID:      "standard",
+				BillingModelID:      "enterprise",
+				MaxAllowedInstances: 10,
+			},
+		},
+		{
+			name: "should parse the expiration date",
+			request: private.QuotaGrantRequest{
+				SubjectType:    "account",
+				SubjectId:      "testuser",
+				InstanceTypeId: "developer",
+				BillingModelId: "standard",
+				ExpirationDate: "2023-12-31 +02:00",
+			},
+			want: &dbapi.QuotaGrant{
+				SubjectType:    dbapi.QuotaGrantSubjectTypeAccount,
+				SubjectID:      "testuser",
+				InstanceTypeID: "developer",
+				BillingModelID: "standard",
+				ExpirationDate: &expirationDate,
+			},
+		},
+		{
+			name: "should return an error when the expiration date is not in the format of the quota management list",
+			request: private.QuotaGrantRequest{
+				SubjectType:    "account",
+				SubjectId:      "testuser",
+				InstanceTypeId: "developer",
+				BillingModelId: "standard",
+				ExpirationDate: "2023-12-31",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := ConvertQuotaGrantRequest(tt.request)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(got.ExpirationDate == nil).To(gomega.Equal(tt.want.ExpirationDate == nil))
+			if tt.want.ExpirationDate != nil {
+				g.Expect(got.ExpirationDate.Equal(*tt.want.ExpirationDate)).To(gomega.BeTrue())
+			}
+			got.ExpirationDate, tt.want.ExpirationDate = nil, nil
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_PresentQuotaGrant(t *testing.T) {
+	expired := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	notExpired := time.Now().Add(48 * time.Hour).UTC().Truncate(24 * time.Hour)
+
+	tests := []struct {
+		name  string
+		grant *dbapi.QuotaGrant
+		want  private.QuotaGrant
+	}{
+		{
+			name: "should present a quota grant that never expires",
+			grant: &dbapi.QuotaGrant{
+				Meta:                api.Meta{ID: "grant-1"},
+				SubjectType:         dbapi.QuotaGrantSubjectTypeOrganisation,
+				SubjectID:           "13640203",
+				InstanceTypeID:      "standard",
+				BillingModelID:      "enterprise",
+				MaxAllowedInstances: 10,
+			},
+			want: private.QuotaGrant{
+				Id:                  "grant-1",
+				Kind:                KindQuotaGrant,
+				Href:                "/api/kafkas_mgmt/v1/admin/quota_grants/grant-1",
+				SubjectType:         "organisation",
+				SubjectId:           "13640203",
+				InstanceTypeId:      "standard",
+				BillingModelId:      "enterprise",
+				MaxAllowedInstances: 10,
+			},
+		},
+		{
+			name: "should present a quota grant that has expired",
+			grant: &dbapi.QuotaGrant{
+				Meta:           api.Meta{ID: "grant-2"},
+				SubjectType:    dbapi.QuotaGrantSubjectTypeAccount,
+				SubjectID:      "testuser",
+				InstanceTypeID: "developer",
+				BillingModelID: "standard",
+				ExpirationDate: &expired,
+			},
+			want: private.QuotaGrant{
+				Id:             "grant-2",
+				Kind:           KindQuotaGrant,
+				Href:           "/api/kafkas_mgmt/v1/admin/quota_grants/grant-2",
+				SubjectType:    "account",
+				SubjectId:      "testuser",
+				InstanceTypeId: "developer",
+				BillingModelId: "standard",
+				ExpirationDate: "2020-01-31 +00:00",
+				Expired:        true,
+			},
+		},
+		{
+			name: "should present a quota grant that has not expired yet",
+			grant: &dbapi.QuotaGrant{
+				Meta:           api.Meta{ID: "grant-3"},
+				SubjectType:    dbapi.QuotaGrantSubjectTypeAccount,
+				SubjectID:      "testuser",
+				InstanceTypeID: "developer",
+				BillingModelID: "standard",
+				ExpirationDate: &notExpired,
+			},
+			want: private.QuotaGrant{
+				Id:             "grant-3",
+				Kind:           KindQuotaGrant,
+				Href:           "/api/kafkas_mgmt/v1/admin/quota_grants/grant-3",
+				SubjectType:    "account",
+				SubjectId:      "testuser",
+				InstanceTypeId: "developer",
+				BillingModelId: "standard",
+				ExpirationDate: notExpired.Format("2006-01-02 -07:00"),
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentQuotaGrant(tt.grant)).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -42,6 +42,8 @@ type options struct {
 	ProviderConfig *config.ProviderConfig
 	KafkaConfig    *config.KafkaConfig
 
+	KafkaSuspensionConfig *config.KafkaSuspensionConfig
+
 	AMSClient                   ocm.AMSClient
 	Kafka                       services.KafkaService
 	CloudProviders              services.CloudProvidersService
@@ -53,12 +55,22 @@ type options struct {
 	AuthService                 authorization.Authorization
 	DB                          *db.ConnectionFactory
 	ClusterPlacementStrategy    services.ClusterPlacementStrategy
+	ClusterPlacementEvaluation  services.ClusterPlacementEvaluationService
+	ClusterDrain                services.ClusterDrainService
+	KafkaRollout                services.KafkaRolloutService
+	KafkaEvent                  services.KafkaEventService
+	AuditRecord                 services.AuditRecordService
+	QuotaGrant                  services.QuotaGrantService
+	AccessControlListEntry      services.AccessControlListEntryService
+	KafkaOwnershipTransfer      services.KafkaOwnershipTransferService
+	KafkaAlertRule              services.KafkaAlertRuleService
 	ClusterService              services.ClusterService
 	ProviderFactory             clusters.ProviderFactory
 	SupportedKafkaInstanceTypes services.SupportedKafkaInstanceTypesService
 
 	AccessControlListMiddleware                       *acl.AccessControlListMiddleware
 	AccessControlListConfig                           *acl.AccessControlListConfig
+	AccessControlListReloader                         *acl.AccessControlListReloader
 	EnterpriseClusterRegistrationAccessListMiddleware *internalAcl.EnterpriseClusterRegistrationAccessListMiddleware
 	AdminRoleAuthZConfig                              *auth.AdminRoleAuthZConfig
 	KasFleetshardOperatorAddon                        services.KasFleetshardOperatorAddon
@@ -84,9 +96,11 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig, s.KafkaSuspensionConfig)
 	kafkaPromoteValidatorFactory := handlers.NewDefaultKafkaPromoteValidatorFactory(s.KafkaConfig)
 	kafkaPromoteHandler := handlers.NewKafkaPromoteHandler(s.Kafka, s.KafkaConfig, kafkaPromoteValidatorFactory)
+	kafkaEventsHandler := handlers.NewKafkaEventsHandler(s.Kafka, s.KafkaEvent)
+	kafkaAlertRulesHandler := handlers.NewKafkaAlertRulesHandler(s.Kafka, s.KafkaAlertRule)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy, s.KafkaConfig)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
@@ -98,6 +112,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
 	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL}, errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
+	auditLogMiddleware := auth.NewAuditLogMiddleware(s.AuditRecord)
 
 	// base path. Could be /api/kafkas_mgmt
 	apiRouter := mainRouter.PathPrefix(basePath).Subrouter()
@@ -137,11 +152,15 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("list-kafka", "list all kafkas").ToString()).
 		Methods(http.MethodGet)
 	apiV1KafkasRouter.Use(requireIssuer)
+	// the changes of the kafkas are recorded before checking the access of their owners, so that the denied attempts are recorded too
+	apiV1KafkasRouter.Use(auditLogMiddleware.AuditLogChanges(errors.ErrorUnauthenticated))
 	apiV1KafkasRouter.Use(requireOrgID)
 	apiV1KafkasRouter.Use(authorizeMiddleware)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
-	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).Methods(http.MethodPost)
+	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).
+		Name(logger.NewLogEvent("create-kafka", "create a kafka instance").ToString()).
+		Methods(http.MethodPost)
 	apiV1KafkasCreateRouter.Use(requireTermsAcceptance)
 
 	// /kafkas/{id}/promote
@@ -150,6 +169,26 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("promote-kafka", "promote a kafka instance").ToString()).
 		Methods(http.MethodPost)
 
+	// /kafkas/{id}/events
+	apiV1KafkasRouter.HandleFunc("/{id}/events", kafkaEventsHandler.List).
+		Name(logger.NewLogEvent("list-kafka-events", "list the status history of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+
+	// /kafkas/{id}/alert_rules
+	apiV1KafkaAlertRulesRouter := apiV1KafkasRouter.PathPrefix("/{id}/alert_rules").Subrouter()
+	apiV1KafkaAlertRulesRouter.HandleFunc("", kafkaAlertRulesHandler.List).
+		Name(logger.NewLogEvent("list-kafka-alert-rules", "list the alert rules of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaAlertRulesRouter.HandleFunc("", kafkaAlertRulesHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-alert-rule", "create an alert rule for a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaAlertRulesRouter.HandleFunc("/{rule_id}", kafkaAlertRulesHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-alert-rule", "get an alert rule of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaAlertRulesRouter.HandleFunc("/{rule_id}", kafkaAlertRulesHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-alert-rule", "delete an alert rule of a kafka instance").ToString()).
+		Methods(http.MethodDelete)
+
 	//  /kafkas/{id}/metrics
 	apiV1MetricsRouter := apiV1KafkasRouter.PathPrefix("/{id}/metrics").Subrouter()
 	apiV1MetricsRouter.HandleFunc("/query_range", metricsHandler.GetMetricsByRangeQuery).
@@ -280,8 +319,9 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig, s.ClusterService, s.KafkaConfig)
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().AdminAPISSORealm.ValidIssuerURI}, errors.ErrorNotFound))
+	// the calls are recorded before checking the roles of the callers, so that the denied attempts are recorded too
+	adminRouter.Use(auditLogMiddleware.AuditLog(errors.ErrorNotFound))
 	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(errors.ErrorNotFound))
-	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(errors.ErrorNotFound))
 	adminRouter.HandleFunc("/kafkas", adminKafkaHandler.List).
 		Name(logger.NewLogEvent("admin-list-kafkas", "[admin] list all kafkas").ToString()).
 		Methods(http.MethodGet)
@@ -294,6 +334,101 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	adminRouter.HandleFunc("/kafkas/{id}", adminKafkaHandler.Update).
 		Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).
 		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/kafkas/{id}/migrate", adminKafkaHandler.Migrate).
+		Name(logger.NewLogEvent("admin-migrate-kafka", "[admin] migrate kafka by id to another data plane cluster").ToString()).
+		Methods(http.MethodPost)
+
+	adminKafkaEventsHandler := handlers.NewAdminKafkaEventsHandler(s.Kafka, s.KafkaEvent)
+	adminRouter.HandleFunc("/kafkas/{id}/events", adminKafkaEventsHandler.List).
+		Name(logger.NewLogEvent("admin-list-kafka-events", "[admin] list the status history of kafka by id").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/cluster_placement_evaluations
+	adminClusterPlacementEvaluationHandler := handlers.NewAdminClusterPlacementEvaluationHandler(s.ClusterPlacementEvaluation, s.ProviderConfig, s.KafkaConfig)
+	adminRouter.HandleFunc("/cluster_placement_evaluations", adminClusterPlacementEvaluationHandler.Create).
+		Name(logger.NewLogEvent("admin-evaluate-cluster-placement", "[admin] evaluate the cluster placement of a kafka").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/clusters
+	adminClusterDrainHandler := handlers.NewAdminClusterDrainHandler(s.ClusterDrain)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/cordon", adminClusterDrainHandler.Cordon).
+		Name(logger.NewLogEvent("admin-cordon-cluster", "[admin] prevent new kafkas from being placed on the data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/uncordon", adminClusterDrainHandler.Uncordon).
+		Name(logger.NewLogEvent("admin-uncordon-cluster", "[admin] allow new kafkas to be placed on the data plane cluster again").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/drain", adminClusterDrainHandler.Drain).
+		Name(logger.NewLogEvent("admin-drain-cluster", "[admin] drain the data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/drain", adminClusterDrainHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-drain-status", "[admin] get the drain status of the data plane cluster").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/kafka_rollouts
+	adminKafkaRolloutHandler := handlers.NewAdminKafkaRolloutHandler(s.KafkaRollout)
+	adminRouter.HandleFunc("/kafka_rollouts", adminKafkaRolloutHandler.Create).
+		Name(logger.NewLogEvent("admin-create-kafka-rollout", "[admin] create a rollout of new versions to kafkas").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/kafka_rollouts", adminKafkaRolloutHandler.List).
+		Name(logger.NewLogEvent("admin-list-kafka-rollouts", "[admin] list all kafka rollouts").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_rollouts/{id}", adminKafkaRolloutHandler.Get).
+		Name(logger.NewLogEvent("admin-get-kafka-rollout", "[admin] get kafka rollout by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_rollouts/{id}/pause", adminKafkaRolloutHandler.Pause).
+		Name(logger.NewLogEvent("admin-pause-kafka-rollout", "[admin] pause kafka rollout by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/kafka_rollouts/{id}/resume", adminKafkaRolloutHandler.Resume).
+		Name(logger.NewLogEvent("admin-resume-kafka-rollout", "[admin] resume kafka rollout by id").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/audit_records
+	adminAuditRecordsHandler := handlers.NewAdminAuditRecordsHandler(s.AuditRecord)
+	adminRouter.HandleFunc("/audit_records", adminAuditRecordsHandler.List).
+		Name(logger.NewLogEvent("admin-list-audit-records", "[admin] list the audit records of the calls to the audited endpoints").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/quota_grants
+	adminQuotaGrantsHandler := handlers.NewAdminQuotaGrantsHandler(s.QuotaGrant, s.KafkaConfig)
+	adminRouter.HandleFunc("/quota_grants", adminQuotaGrantsHandler.List).
+		Name(logger.NewLogEvent("admin-list-quota-grants", "[admin] list the quota granted to organisations and accounts").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/quota_grants", adminQuotaGrantsHandler.Create).
+		Name(logger.NewLogEvent("admin-create-quota-grant", "[admin] grant quota to an organisation or an account").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/quota_grants/{id}", adminQuotaGrantsHandler.Get).
+		Name(logger.NewLogEvent("admin-get-quota-grant", "[admin] get quota grant by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/quota_grants/{id}", adminQuotaGrantsHandler.Update).
+		Name(logger.NewLogEvent("admin-update-quota-grant", "[admin] update quota grant by id").ToString()).
+		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/quota_grants/{id}", adminQuotaGrantsHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-quota-grant", "[admin] revoke quota grant by id").ToString()).
+		Methods(http.MethodDelete)
+
+	// /api/kafkas_mgmt/v1/admin/access_control_list_entries
+	adminAccessControlListEntriesHandler := handlers.NewAdminAccessControlListEntriesHandler(s.AccessControlListEntry, s.AccessControlListReloader)
+	adminRouter.HandleFunc("/access_control_list_entries", adminAccessControlListEntriesHandler.List).
+		Name(logger.NewLogEvent("admin-list-access-control-list-entries", "[admin] list the entries of the deny list and of the access list").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/access_control_list_entries", adminAccessControlListEntriesHandler.Create).
+		Name(logger.NewLogEvent("admin-create-access-control-list-entry", "[admin] deny a user or accept an organisation").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/access_control_list_entries/{id}", adminAccessControlListEntriesHandler.Get).
+		Name(logger.NewLogEvent("admin-get-access-control-list-entry", "[admin] get access control list entry by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/access_control_list_entries/{id}", adminAccessControlListEntriesHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-access-control-list-entry", "[admin] delete access control list entry by id").ToString()).
+		Methods(http.MethodDelete)
+
+	// /api/kafkas_mgmt/v1/admin/kafka_ownership_transfers
+	adminKafkaOwnershipTransfersHandler := handlers.NewAdminKafkaOwnershipTransfersHandler(s.KafkaOwnershipTransfer, s.Kafka)
+	adminRouter.HandleFunc("/kafka_ownership_transfers", adminKafkaOwnershipTransfersHandler.List).
+		Name(logger.NewLogEvent("admin-list-kafka-ownership-transfers", "[admin] list the transfers of kafkas to new owners").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_ownership_transfers", adminKafkaOwnershipTransfersHandler.Create).
+		Name(logger.NewLogEvent("admin-create-kafka-ownership-transfer", "[admin] transfer a kafka or the kafkas of a previous owner to a new owner").ToString()).
+		Methods(http.MethodPost)
 
 	// /api/kafkas_mgmt/v1
 	v1Metadata := api.VersionMetadata{
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+// AccessControlListEntryQuery selects access control list entries. The empty fields do not restrict the selection
+type AccessControlListEntryQuery struct {
+	Type  api.AccessControlListEntryType
+	Value string
+}
+
+//go:generate moq -out access_control_list_entries_moq.go . AccessControlListEntryService
+type AccessControlListEntryService interface {
+	// Create adds the entry. It fails with a conflict when an entry of the same type and value has not expired yet
+	Create(entry *api.AccessControlListEntry) *errors.ServiceError
+	// Get returns the entry with the given id, whether it has expired or not
+	Get(id string) (*api.AccessControlListEntry, *errors.ServiceError)
+	// List returns the entries selected by the query, the most recent first
+	List(query AccessControlListEntryQuery, listArgs *services.ListArguments) (api.AccessControlListEntryList, *api.PagingMeta, *errors.ServiceError)
+	// Delete removes the entry with the given id
+	Delete(id string) *errors.ServiceError
+	// ListActiveEntries returns the entries that have not expired yet
+	ListActiveEntries() (api.AccessControlListEntryList, *errors.ServiceError)
+}
+
+type accessControlListEntryService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ AccessControlListEntryService = &accessControlListEntryService{}
+var _ acl.AccessControlListSource = &accessControlListEntryService{}
+
+func NewAccessControlListEntryService(connectionFactory *db.ConnectionFactory) AccessControlListEntryService {
+	return &accessControlListEntryService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *accessControlListEntryService) Create(entry *api.AccessControlListEntry) *errors.ServiceError {
+	var count int64
+	if err := s.connectionFactory.New().Model(&api.AccessControlListEntry{}).
+		Where("type = ? AND value = ?", entry.Type, entry.Value).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check the %s entries of %q", entry.Type, entry.Value)
+	}
+	if count > 0 {
+		return errors.Conflict("an active %s entry already exists for %q", entry.Type, entry.Value)
+	}
+
+	entry.ID = api.NewID()
+	if err := s.connectionFactory.New().Create(entry).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to add the %s entry of %q", entry.Type, entry.Value)
+	}
+
+	return nil
+}
+
+func (s *accessControlListEntryService) Get(id string) (*api.AccessControlListEntry, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var entry api.AccessControlListEntry
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&entry).Error; err != nil {
+		return nil, services.HandleGetError("AccessControlListEntry", "id", id, err)
+	}
+
+	return &entry, nil
+}
+
+func (s *accessControlListEntryService) List(query AccessControlListEntryQuery, listArgs *services.ListArguments) (api.AccessControlListEntryList, *api.PagingMeta, *errors.ServiceError) {
+	var entries api.AccessControlListEntryList
+	dbConn := s.connectionFactory.New().Model(&api.AccessControlListEntry{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	if query.Type != "" {
+		dbConn = dbConn.Where("type = ?", query.Type)
+	}
+	if query.Value != "" {
+		dbConn = dbConn.Where("value = ?", query.Value)
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return entries, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the access control list entries")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.
+		Order("created_at DESC").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&entries).Error; err != nil {
+		return entries, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the access control list entries")
+	}
+	pagingMeta.Size = len(entries)
+
+	return entries, pagingMeta, nil
+}
+
+func (s *accessControlListEntryService) Delete(id string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("id = ?", id).Delete(&api.AccessControlListEntry{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete access control list entry %q", id)
+	}
+
+	return nil
+}
+
+func (s *accessControlListEntryService) ListActiveEntries() (api.AccessControlListEntryList, *errors.ServiceError) {
+	var entries api.AccessControlListEntryList
+	if err := s.connectionFactory.New().
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("type, value").
+		Find(&entries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the active access control list entries")
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_accessControlListEntryService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should add the entry",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "access_control_list_entries"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "access_control_list_entries"`)
+			},
+		},
+		{
+			name: "should return a conflict when an active entry already exists for the user",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "access_control_list_entries"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil))
+
+			entry := &api.AccessControlListEntry{
+				Type:   api.AccessControlListEntryTypeDeniedUser,
+				Value:  "testuser",
+				Reason: "abuse",
+			}
+			err := s.Create(entry)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(entry.ID != "").To(gomega.Equal(!tt.wantErr))
+		})
+	}
+}
+
+func Test_accessControlListEntryService_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT count(1) FROM "access_control_list_entries"`).
+		WithArgs("denied_user").
+		WithReply([]map[string]interface{}{{"count": 2}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "access_control_list_entries"`).
+		WithArgs("denied_user").
+		WithReply([]map[string]interface{}{{"id": "entry-1"}, {"id": "entry-2"}})
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+	s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil))
+
+	entries, paging, err := s.List(AccessControlListEntryQuery{Type: api.AccessControlListEntryTypeDeniedUser}, &services.ListArguments{Page: 1, Size: 100})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(paging.Total).To(gomega.Equal(2))
+	g.Expect(paging.Size).To(gomega.Equal(2))
+	g.Expect(entries).To(gomega.HaveLen(2))
+}
+
+func Test_accessControlListEntryService_ListActiveEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "should return the entries that have not expired",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "access_control_list_entries" WHERE (expires_at IS NULL OR expires_at >`).
+					WithReply([]map[string]interface{}{
+						{"id": "entry-1", "type": "denied_user", "value": "user1"},
+						{"id": "entry-2", "type": "accepted_organisation", "value": "13640203"},
+					})
+			},
+			wantLen: 2,
+		},
+		{
+			name: "should return an error when the entries cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewAccessControlListEntryService(db.NewMockConnectionFactory(nil))
+
+			entries, err := s.ListActiveEntries()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(entries).To(gomega.HaveLen(tt.wantLen))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/pkg/errors"
+)
+
+//go:generate moq -out audit_record_publisher_moq.go . AuditRecordPublisher
+type AuditRecordPublisher interface {
+	// Publish posts the audit record to the webhook. The same record may be posted more than once, e.g. when it could not be
+	// marked as posted afterwards: consumers should use the id of the record to ignore the duplicates
+	Publish(record *api.AuditRecord) error
+}
+
+func NewAuditRecordPublisher(auditRecordsConfig *config.AuditRecordsConfig) AuditRecordPublisher {
+	return &webhookAuditRecordPublisher{
+		url:    auditRecordsConfig.WebhookURL,
+		poster: newWebhookPoster(&http.Client{Timeout: auditRecordsConfig.WebhookTimeout}),
+	}
+}
+
+// AuditRecordPayload is the representation of an audit record posted to the webhook
+type AuditRecordPayload struct {
+	ID           string          `json:"id"`
+	Actor        string          `json:"actor"`
+	OrgID        string          `json:"org_id,omitempty"`
+	Roles        json.RawMessage `json:"roles,omitempty"`
+	Action       string          `json:"action"`
+	Method       string          `json:"method"`
+	RequestURI   string          `json:"request_uri"`
+	RemoteAddr   string          `json:"remote_addr,omitempty"`
+	ResourceType string          `json:"resource_type,omitempty"`
+	ResourceID   string          `json:"resource_id,omitempty"`
+	RequestDiff  json.RawMessage `json:"request_diff,omitempty"`
+	StatusCode   int             `json:"status_code"`
+	OperationID  string          `json:"operation_id,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+func newAuditRecordPayload(record *api.AuditRecord) AuditRecordPayload {
+	return AuditRecordPayload{
+		ID:           record.ID,
+		Actor:        record.Actor,
+		OrgID:        record.OrgID,
+		Roles:        json.RawMessage(record.Roles),
+		Action:       record.Action,
+		Method:       record.Method,
+		RequestURI:   record.RequestURI,
+		RemoteAddr:   record.RemoteAddr,
+		ResourceType: record.ResourceType,
+		ResourceID:   record.ResourceID,
+		RequestDiff:  json.RawMessage(record.RequestDiff),
+		StatusCode:   record.StatusCode,
+		OperationID:  record.OperationID,
+		CreatedAt:    record.CreatedAt,
+	}
+}
+
+type webhookAuditRecordPublisher struct {
+	url    string
+	poster *webhookPoster
+}
+
+var _ AuditRecordPublisher = &webhookAuditRecordPublisher{}
+
+func (p *webhookAuditRecordPublisher) Publish(record *api.AuditRecord) error {
+	if err := p.poster.post(p.url, newAuditRecordPayload(record)); err != nil {
+		return errors.Wrapf(err, "failed to post audit record %q to the webhook", record.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_webhookAuditRecordPublisher_Publish(t *testing.T) {
+	createdAt := time.Date(2023, 4, 26, 12, 0, 0, 0, time.UTC)
+	record := &api.AuditRecord{
+		Meta:         api.Meta{ID: "record-1", CreatedAt: createdAt},
+		Actor:        "admin-user",
+		Roles:        api.JSON(`["kas-fleet-manager-admin-full"]`),
+		Action:       "admin-update-kafka",
+		Method:       http.MethodPatch,
+		RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+		ResourceType: "kafkas",
+		ResourceID:   "kafka-1",
+		RequestDiff:  api.JSON(`{"suspended":true}`),
+		StatusCode:   http.StatusOK,
+	}
+
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "should post the audit record to the webhook",
+			statusCode: http.StatusNoContent,
+			wantErr:    false,
+		},
+		{
+			name:       "should return an error when the webhook does not accept the audit record",
+			statusCode: http.StatusServiceUnavailable,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var received AuditRecordPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Content-Type")).To(gomega.Equal("application/json"))
+				g.Expect(json.NewDecoder(r.Body).Decode(&received)).To(gomega.Succeed())
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			publisher := NewAuditRecordPublisher(&config.AuditRecordsConfig{
+				WebhookURL:     server.URL,
+				WebhookTimeout: time.Second,
+			})
+
+			err := publisher.Publish(record)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(received).To(gomega.Equal(AuditRecordPayload{
+				ID:           "record-1",
+				Actor:        "admin-user",
+				Roles:        json.RawMessage(`["kas-fleet-manager-admin-full"]`),
+				Action:       "admin-update-kafka",
+				Method:       http.MethodPatch,
+				RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				ResourceType: "kafkas",
+				ResourceID:   "kafka-1",
+				RequestDiff:  json.RawMessage(`{"suspended":true}`),
+				StatusCode:   http.StatusOK,
+				CreatedAt:    createdAt,
+			}))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// AuditRecordQuery selects audit records. The empty fields do not restrict the selection
+type AuditRecordQuery struct {
+	Actor        string
+	ResourceType string
+	ResourceID   string
+	// From is the time from which, included, the calls were made
+	From *time.Time
+	// To is the time until which, excluded, the calls were made
+	To *time.Time
+}
+
+//go:generate moq -out audit_records_moq.go . AuditRecordService
+type AuditRecordService interface {
+	// Record stores the audit record in the database. It does not take part in the transaction of the request, so that the calls
+	// that failed are recorded as well. When a webhook is configured, the record is left pending to be posted to it by the
+	// AuditRecordsWebhookManager, so that the webhook is not called on the request path
+	Record(ctx context.Context, record *api.AuditRecord) error
+	// List returns the audit records selected by the query, the most recent ones first
+	List(query AuditRecordQuery, listArgs *services.ListArguments) (api.AuditRecordList, *api.PagingMeta, *errors.ServiceError)
+	// ListWebhookPending returns at most limit audit records that have not been posted to the webhook yet, the oldest ones first
+	ListWebhookPending(limit int) (api.AuditRecordList, *errors.ServiceError)
+	// MarkPublished records that the audit record has been posted to the webhook so that it is not posted again
+	MarkPublished(record *api.AuditRecord) *errors.ServiceError
+	// RecordFailedAttempt records that the audit record could not be posted to the webhook
+	RecordFailedAttempt(record *api.AuditRecord) *errors.ServiceError
+}
+
+type auditRecordService struct {
+	connectionFactory  *db.ConnectionFactory
+	auditRecordsConfig *config.AuditRecordsConfig
+}
+
+var _ AuditRecordService = &auditRecordService{}
+var _ auth.AuditSink = &auditRecordService{}
+
+func NewAuditRecordService(connectionFactory *db.ConnectionFactory, auditRecordsConfig *config.AuditRecordsConfig) AuditRecordService {
+	return &auditRecordService{
+		connectionFactory:  connectionFactory,
+		auditRecordsConfig: auditRecordsConfig,
+	}
+}
+
+func (s *auditRecordService) Record(ctx context.Context, record *api.AuditRecord) error {
+	record.WebhookPending = s.auditRecordsConfig.WebhookURL != ""
+	if err := s.connectionFactory.New().Create(record).Error; err != nil {
+		return pkgerrors.Wrapf(err, "failed to store the audit record of %s %s", record.Method, record.RequestURI)
+	}
+	return nil
+}
+
+func (s *auditRecordService) List(query AuditRecordQuery, listArgs *services.ListArguments) (api.AuditRecordList, *api.PagingMeta, *errors.ServiceError) {
+	var records api.AuditRecordList
+	dbConn := s.connectionFactory.New().Model(&api.AuditRecord{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	if query.Actor != "" {
+		dbConn = dbConn.Where("actor = ?", query.Actor)
+	}
+	if query.ResourceType != "" {
+		dbConn = dbConn.Where("resource_type = ?", query.ResourceType)
+	}
+	if query.ResourceID != "" {
+		dbConn = dbConn.Where("resource_id = ?", query.ResourceID)
+	}
+	if query.From != nil {
+		dbConn = dbConn.Where("created_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		dbConn = dbConn.Where("created_at < ?", *query.To)
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return records, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the audit records")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.
+		Order("created_at desc, id").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&records).Error; err != nil {
+		return records, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the audit records")
+	}
+	pagingMeta.Size = len(records)
+
+	return records, pagingMeta, nil
+}
+
+func (s *auditRecordService) ListWebhookPending(limit int) (api.AuditRecordList, *errors.ServiceError) {
+	var records api.AuditRecordList
+	if err := s.connectionFactory.New().
+		Where("webhook_pending").
+		Order("created_at, id").
+		Limit(limit).
+		Find(&records).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the audit records pending to be posted to the webhook")
+	}
+
+	return records, nil
+}
+
+func (s *auditRecordService) MarkPublished(record *api.AuditRecord) *errors.ServiceError {
+	if err := s.connectionFactory.New().
+		Model(&api.AuditRecord{Meta: api.Meta{ID: record.ID}}).
+		Updates(map[string]interface{}{"webhook_pending": false, "webhook_attempts": record.WebhookAttempts + 1}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to mark audit record %q as posted to the webhook", record.ID)
+	}
+
+	record.WebhookPending = false
+	record.WebhookAttempts++
+	return nil
+}
+
+func (s *auditRecordService) RecordFailedAttempt(record *api.AuditRecord) *errors.ServiceError {
+	if err := s.connectionFactory.New().
+		Model(&api.AuditRecord{Meta: api.Meta{ID: record.ID}}).
+		Update("webhook_attempts", record.WebhookAttempts+1).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the attempt to post audit record %q to the webhook", record.ID)
+	}
+
+	record.WebhookAttempts++
+	return nil
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_auditRecordService_Record(t *testing.T) {
+	tests := []struct {
+		name               string
+		webhookURL         string
+		setupFn            func()
+		wantErr            bool
+		wantWebhookPending bool
+	}{
+		{
+			name: "should store the audit record",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+		},
+		{
+			name:       "should store the audit record pending to be posted when a webhook is configured",
+			webhookURL: "https://siem.example.com/hooks/audit",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantWebhookPending: true,
+		},
+		{
+			name: "should return an error when the audit record cannot be stored",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`INSERT INTO "audit_records"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			auditRecordsConfig := config.NewAuditRecordsConfig()
+			auditRecordsConfig.WebhookURL = tt.webhookURL
+			s := NewAuditRecordService(db.NewMockConnectionFactory(nil), auditRecordsConfig)
+
+			record := &api.AuditRecord{
+				Meta:       api.Meta{ID: "record-1"},
+				Actor:      "admin-user",
+				Method:     http.MethodDelete,
+				RequestURI: "/api/kafkas_mgmt/v1/admin/kafkas/kafka-1",
+				StatusCode: http.StatusAccepted,
+			}
+			err := s.Record(context.Background(), record)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(record.WebhookPending).To(gomega.Equal(tt.wantWebhookPending))
+		})
+	}
+}
+
+func Test_auditRecordService_List(t *testing.T) {
+	from := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		query     AuditRecordQuery
+		setupFn   func()
+		wantErr   bool
+		wantTotal int
+		wantIDs   []string
+	}{
+		{
+			name:  "should return the audit records selected by the query",
+			query: AuditRecordQuery{Actor: "admin-user", ResourceType: "kafkas", ResourceID: "kafka-1", From: &from},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "audit_records"`).
+					WithArgs("admin-user", "kafkas", "kafka-1", from).
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "audit_records"`).
+					WithArgs("admin-user", "kafkas", "kafka-1", from).
+					WithReply([]map[string]interface{}{{"id": "record-2"}, {"id": "record-1"}})
+			},
+			wantTotal: 2,
+			wantIDs:   []string{"record-2", "record-1"},
+		},
+		{
+			name: "should return an error when the audit records cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1)`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewAuditRecordService(db.NewMockConnectionFactory(nil), config.NewAuditRecordsConfig())
+
+			records, paging, err := s.List(tt.query, &services.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(paging.Total).To(gomega.Equal(tt.wantTotal))
+			ids := []string{}
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIDs))
+		})
+	}
+}
+
+func Test_auditRecordService_ListWebhookPending(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+		wantIDs []string
+	}{
+		{
+			name: "should return the audit records pending to be posted to the webhook",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "audit_records" WHERE webhook_pending`).
+					WithReply([]map[string]interface{}{{"id": "record-1", "webhook_pending": true}, {"id": "record-2", "webhook_pending": true}})
+			},
+			wantIDs: []string{"record-1", "record-2"},
+		},
+		{
+			name: "should return an error when the audit records cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "audit_records"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewAuditRecordService(db.NewMockConnectionFactory(nil), config.NewAuditRecordsConfig())
+
+			records, err := s.ListWebhookPending(10)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			ids := []string{}
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIDs))
+		})
+	}
+}
+
+func Test_auditRecordService_MarkPublished(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset()
+	s := NewAuditRecordService(db.NewMockConnectionFactory(nil), config.NewAuditRecordsConfig())
+
+	record := &api.AuditRecord{Meta: api.Meta{ID: "record-1"}, WebhookPending: true, WebhookAttempts: 1}
+	g.Expect(s.MarkPublished(record)).To(gomega.BeNil())
+	g.Expect(record.WebhookPending).To(gomega.BeFalse())
+	g.Expect(record.WebhookAttempts).To(gomega.Equal(2))
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// KafkaDrainReadiness tells whether a kafka is ready to leave the data plane cluster being drained
+type KafkaDrainReadiness string
+
+const (
+	// KafkaDrainReadinessMigratable - the kafka can be migrated to another data plane cluster
+	KafkaDrainReadinessMigratable KafkaDrainReadiness = "migratable"
+	// KafkaDrainReadinessMigrating - the kafka is being migrated to or from the data plane cluster
+	KafkaDrainReadinessMigrating KafkaDrainReadiness = "migrating"
+	// KafkaDrainReadinessDeleting - the kafka is being deleted from the data plane cluster
+	KafkaDrainReadinessDeleting KafkaDrainReadiness = "deleting"
+	// KafkaDrainReadinessBlocked - the kafka can neither be migrated nor is it being deleted
+	KafkaDrainReadinessBlocked KafkaDrainReadiness = "blocked"
+)
+
+var KafkaDrainReadinesses = []KafkaDrainReadiness{
+	KafkaDrainReadinessMigratable,
+	KafkaDrainReadinessMigrating,
+	KafkaDrainReadinessDeleting,
+	KafkaDrainReadinessBlocked,
+}
+
+func (r KafkaDrainReadiness) String() string {
+	return string(r)
+}
+
+//go:generate moq -out cluster_drain_moq.go . ClusterDrainService
+type ClusterDrainService interface {
+	// Cordon prevents new kafkas from being placed on the given data plane cluster. Kafkas already placed on it are not affected.
+	// When drain is true the cluster is also marked as draining, so that it is removed once all its kafkas have left it.
+	Cordon(clusterID string, drain bool) (*ClusterDrainStatus, *errors.ServiceError)
+	// Uncordon allows new kafkas to be placed on the given data plane cluster again. Any ongoing drain is cancelled.
+	Uncordon(clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+	// GetDrainStatus lists the kafkas still deployed on the given data plane cluster along with their readiness to leave it
+	GetDrainStatus(clusterID string) (*ClusterDrainStatus, *errors.ServiceError)
+}
+
+// ClusterDrainStatus holds the kafkas that are still deployed on a data plane cluster
+type ClusterDrainStatus struct {
+	Cluster *api.Cluster
+	Kafkas  []KafkaDrainStatus
+}
+
+// KafkaDrainStatus is the readiness of a single kafka to leave the data plane cluster
+type KafkaDrainStatus struct {
+	Kafka     *dbapi.KafkaRequest
+	Readiness KafkaDrainReadiness
+	// Reason explains the readiness. It is empty when the kafka is migratable
+	Reason string
+}
+
+// Drained returns true when no kafka is deployed on the cluster anymore
+func (s *ClusterDrainStatus) Drained() bool {
+	return len(s.Kafkas) == 0
+}
+
+// CountByReadiness returns the number of kafkas for each readiness, including the readinesses no kafka has
+func (s *ClusterDrainStatus) CountByReadiness() map[KafkaDrainReadiness]int {
+	counts := map[KafkaDrainReadiness]int{}
+	for _, readiness := range KafkaDrainReadinesses {
+		counts[readiness] = 0
+	}
+	for _, kafka := range s.Kafkas {
+		counts[kafka.Readiness]++
+	}
+	return counts
+}
+
+type clusterDrainService struct {
+	clusterService ClusterService
+	kafkaService   KafkaService
+}
+
+var _ ClusterDrainService = &clusterDrainService{}
+
+func NewClusterDrainService(clusterService ClusterService, kafkaService KafkaService) ClusterDrainService {
+	return &clusterDrainService{
+		clusterService: clusterService,
+		kafkaService:   kafkaService,
+	}
+}
+
+func (s *clusterDrainService) Cordon(clusterID string, drain bool) (*ClusterDrainStatus, *errors.ServiceError) {
+	return s.updateCordon(clusterID, true, drain)
+}
+
+func (s *clusterDrainService) Uncordon(clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	return s.updateCordon(clusterID, false, false)
+}
+
+func (s *clusterDrainService) updateCordon(clusterID string, cordoned bool, draining bool) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, err := s.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.clusterService.UpdateCordon(clusterID, cordoned, draining); err != nil {
+		return nil, err
+	}
+
+	cluster.Cordoned = cordoned
+	cluster.Draining = draining
+
+	return s.buildDrainStatus(cluster)
+}
+
+func (s *clusterDrainService) GetDrainStatus(clusterID string) (*ClusterDrainStatus, *errors.ServiceError) {
+	cluster, err := s.findCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildDrainStatus(cluster)
+}
+
+func (s *clusterDrainService) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := s.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil {
+		return nil, errors.NotFound("cluster with id %q not found", clusterID)
+	}
+
+	return cluster, nil
+}
+
+func (s *clusterDrainService) buildDrainStatus(cluster *api.Cluster) (*ClusterDrainStatus, *errors.ServiceError) {
+	kafkas, err := s.kafkaService.ListKafkasOnCluster(cluster.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ClusterDrainStatus{
+		Cluster: cluster,
+		Kafkas:  []KafkaDrainStatus{},
+	}
+
+	for _, kafka := range kafkas {
+		readiness, reason := kafkaDrainReadiness(kafka, cluster.ClusterID)
+		status.Kafkas = append(status.Kafkas, KafkaDrainStatus{
+			Kafka:     kafka,
+			Readiness: readiness,
+			Reason:    reason,
+		})
+	}
+
+	return status, nil
+}
+
+// kafkaDrainReadiness returns whether the given kafka is ready to leave the given data plane cluster.
+// The rules mirror the ones applied when a migration of the kafka is requested.
+func kafkaDrainReadiness(kafka *dbapi.KafkaRequest, clusterID string) (KafkaDrainReadiness, string) {
+	if kafka.ClusterID != clusterID {
+		// the kafka is only deployed on the cluster as the other cluster of its migration
+		switch kafka.MigrationStatus {
+		case dbapi.KafkaMigrationStatusSourceDeleting:
+			return KafkaDrainReadinessDeleting, fmt.Sprintf("kafka is being deleted from the cluster after its migration to cluster %q", kafka.ClusterID)
+		case dbapi.KafkaMigrationStatusFailed:
+			return KafkaDrainReadinessDeleting, "kafka is being deleted from the cluster after its failed migration"
+		default:
+			return KafkaDrainReadinessMigrating, fmt.Sprintf("kafka is being migrated to the cluster from cluster %q", kafka.ClusterID)
+		}
+	}
+
+	if arrays.Contains(kafkaDeletionStatuses, kafka.Status) {
+		return KafkaDrainReadinessDeleting, fmt.Sprintf("kafka is in %q status", kafka.Status)
+	}
+
+	if kafka.MigrationStatus.InProgress() {
+		return KafkaDrainReadinessMigrating, fmt.Sprintf("migration of the kafka is in %q status", kafka.MigrationStatus)
+	}
+
+	if kafka.MigrationClusterID != "" {
+		return KafkaDrainReadinessBlocked, fmt.Sprintf("the kafka of the previous failed migration is still being deleted from cluster %q", kafka.MigrationClusterID)
+	}
+
+	if kafka.DesiredBillingModelIsEnterprise() {
+		return KafkaDrainReadinessBlocked, "enterprise kafkas cannot be migrated, they have to be deleted"
+	}
+
+	if kafka.Status != constants.KafkaRequestStatusReady.String() {
+		return KafkaDrainReadinessBlocked, fmt.Sprintf("only kafkas in %q status can be migrated, the kafka is in %q status", constants.KafkaRequestStatusReady, kafka.Status)
+	}
+
+	return KafkaDrainReadinessMigratable, ""
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	mockkafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaDrainReadiness(t *testing.T) {
+	const clusterID = "drained-cluster"
+	const otherClusterID = "other-cluster"
+
+	tests := []struct {
+		name          string
+		kafka         *dbapi.KafkaRequest
+		wantReadiness KafkaDrainReadiness
+	}{
+		{
+			name: "a ready kafka is migratable",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+			),
+			wantReadiness: KafkaDrainReadinessMigratable,
+		},
+		{
+			name: "a kafka that is not ready is blocked",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusSuspended.String()),
+			),
+			wantReadiness: KafkaDrainReadinessBlocked,
+		},
+		{
+			name: "an enterprise kafka is blocked",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+				mockkafkas.With(mockkafkas.DESIRED_KAFKA_BILLING_MODEL, constants.BillingModelEnterprise.String()),
+			),
+			wantReadiness: KafkaDrainReadinessBlocked,
+		},
+		{
+			name: "a kafka still being deleted from the target cluster of its failed migration is blocked",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusFailed.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_CLUSTER_ID, otherClusterID),
+			),
+			wantReadiness: KafkaDrainReadinessBlocked,
+		},
+		{
+			name: "a kafka being deleted is deleting",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusDeprovision.String()),
+			),
+			wantReadiness: KafkaDrainReadinessDeleting,
+		},
+		{
+			name: "a kafka being migrated away from the cluster is migrating",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusProvisioning.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_CLUSTER_ID, otherClusterID),
+			),
+			wantReadiness: KafkaDrainReadinessMigrating,
+		},
+		{
+			name: "a kafka being migrated to the cluster is migrating",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, otherClusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusTargetReady.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_CLUSTER_ID, clusterID),
+			),
+			wantReadiness: KafkaDrainReadinessMigrating,
+		},
+		{
+			name: "a kafka migrated away from the cluster whose source is being deleted is deleting",
+			kafka: mockkafkas.BuildKafkaRequest(
+				mockkafkas.With(mockkafkas.CLUSTER_ID, otherClusterID),
+				mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusSourceDeleting.String()),
+				mockkafkas.With(mockkafkas.MIGRATION_CLUSTER_ID, clusterID),
+			),
+			wantReadiness: KafkaDrainReadinessDeleting,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			readiness, reason := kafkaDrainReadiness(tt.kafka, clusterID)
+			g.Expect(readiness).To(gomega.Equal(tt.wantReadiness))
+			if readiness == KafkaDrainReadinessMigratable {
+				g.Expect(reason).To(gomega.BeEmpty())
+			} else {
+				g.Expect(reason).ToNot(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_clusterDrainService(t *testing.T) {
+	const clusterID = "cluster-id"
+
+	readyKafka := mockkafkas.BuildKafkaRequest(
+		mockkafkas.With(mockkafkas.CLUSTER_ID, clusterID),
+		mockkafkas.With(mockkafkas.STATUS, constants.KafkaRequestStatusReady.String()),
+	)
+
+	type fields struct {
+		clusterService ClusterService
+		kafkaService   KafkaService
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		action       func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError)
+		wantErr      bool
+		wantCordoned bool
+		wantDraining bool
+		wantDrained  bool
+		wantCounts   map[KafkaDrainReadiness]int
+	}{
+		{
+			name: "should return an error when finding the cluster fails",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to find cluster")
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.GetDrainStatus(clusterID)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the cluster does not exist",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.Cordon(clusterID, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when updating the cordon of the cluster fails",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+					UpdateCordonFunc: func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+						return apiErrors.GeneralError("failed to update cluster")
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.Cordon(clusterID, false)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when listing the kafkas of the cluster fails",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+				},
+				kafkaService: &KafkaServiceMock{
+					ListKafkasOnClusterFunc: func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to list kafkas")
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.GetDrainStatus(clusterID)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should cordon the cluster without draining it",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+					UpdateCordonFunc: func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+				kafkaService: &KafkaServiceMock{
+					ListKafkasOnClusterFunc: func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+						return []*dbapi.KafkaRequest{readyKafka}, nil
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.Cordon(clusterID, false)
+			},
+			wantCordoned: true,
+			wantCounts: map[KafkaDrainReadiness]int{
+				KafkaDrainReadinessMigratable: 1,
+				KafkaDrainReadinessMigrating:  0,
+				KafkaDrainReadinessDeleting:   0,
+				KafkaDrainReadinessBlocked:    0,
+			},
+		},
+		{
+			name: "should drain the cluster",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+					UpdateCordonFunc: func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+				kafkaService: &KafkaServiceMock{
+					ListKafkasOnClusterFunc: func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+						return []*dbapi.KafkaRequest{}, nil
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.Cordon(clusterID, true)
+			},
+			wantCordoned: true,
+			wantDraining: true,
+			wantDrained:  true,
+			wantCounts: map[KafkaDrainReadiness]int{
+				KafkaDrainReadinessMigratable: 0,
+				KafkaDrainReadinessMigrating:  0,
+				KafkaDrainReadinessDeleting:   0,
+				KafkaDrainReadinessBlocked:    0,
+			},
+		},
+		{
+			name: "should uncordon the cluster",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID, Cordoned: true, Draining: true}, nil
+					},
+					UpdateCordonFunc: func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+				kafkaService: &KafkaServiceMock{
+					ListKafkasOnClusterFunc: func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+						return []*dbapi.KafkaRequest{readyKafka}, nil
+					},
+				},
+			},
+			action: func(s ClusterDrainService) (*ClusterDrainStatus, *apiErrors.ServiceError) {
+				return s.Uncordon(clusterID)
+			},
+			wantCounts: map[KafkaDrainReadiness]int{
+				KafkaDrainReadinessMigratable: 1,
+				KafkaDrainReadinessMigrating:  0,
+				KafkaDrainReadinessDeleting:   0,
+				KafkaDrainReadinessBlocked:    0,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s := NewClusterDrainService(tt.fields.clusterService, tt.fields.kafkaService)
+			status, err := tt.action(s)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(status.Cluster.Cordoned).To(gomega.Equal(tt.wantCordoned))
+			g.Expect(status.Cluster.Draining).To(gomega.Equal(tt.wantDraining))
+			g.Expect(status.Drained()).To(gomega.Equal(tt.wantDrained))
+			g.Expect(status.CountByReadiness()).To(gomega.Equal(tt.wantCounts))
+		})
+	}
+}
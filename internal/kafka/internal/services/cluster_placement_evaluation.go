@@ -0,0 +1,234 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+const (
+	firstSchedulableWithinLimitStrategyName = "first-schedulable-within-limit"
+	firstReadyClusterStrategyName           = "first-ready-cluster"
+)
+
+//go:generate moq -out cluster_placement_evaluation_moq.go . ClusterPlacementEvaluationService
+type ClusterPlacementEvaluationService interface {
+	// EvaluatePlacement evaluates every data plane cluster in the cloud provider and region of the given kafka
+	// against the configured ClusterPlacementStrategy and explains why each of them would be chosen or rejected.
+	// The kafka is not expected to exist and nothing is persisted or reserved during the evaluation.
+	EvaluatePlacement(kafka *dbapi.KafkaRequest) (*ClusterPlacementEvaluation, *errors.ServiceError)
+}
+
+// ClusterPlacementEvaluation is the result of the evaluation of the placement of a kafka
+type ClusterPlacementEvaluation struct {
+	// PlacementStrategy is the name of the placement strategy used for the instance type of the kafka
+	PlacementStrategy string
+	// RegionCapacityAvailable is false when the region limits for the instance type of the kafka have been reached
+	RegionCapacityAvailable bool
+	// ChosenCluster is the cluster where the kafka would be placed. It is nil if no cluster can receive the kafka
+	ChosenCluster *api.Cluster
+	Candidates    []ClusterPlacementCandidateEvaluation
+}
+
+// ClusterPlacementCandidateEvaluation is the evaluation of a single data plane cluster
+type ClusterPlacementCandidateEvaluation struct {
+	Cluster *api.Cluster
+	// StreamingUnits holds the streaming units capacity of the cluster for each instance type it supports
+	StreamingUnits []InstanceTypeStreamingUnits
+	// Score is only set when the placement strategy scores the clusters
+	Score *float64
+	// Eligible is true when the cluster can receive the kafka, even if another cluster is preferred by the placement strategy
+	Eligible bool
+	// RejectionReason is empty when the cluster is the one chosen by the placement strategy
+	RejectionReason string
+}
+
+type InstanceTypeStreamingUnits struct {
+	InstanceType string
+	MaxUnits     int64
+	Consumed     int64
+}
+
+func (s InstanceTypeStreamingUnits) Remaining() int64 {
+	return s.MaxUnits - s.Consumed
+}
+
+type clusterPlacementEvaluationService struct {
+	clusterService           ClusterService
+	kafkaService             KafkaService
+	clusterPlacementStrategy ClusterPlacementStrategy
+	dataplaneClusterConfig   *config.DataplaneClusterConfig
+	kafkaConfig              *config.KafkaConfig
+}
+
+var _ ClusterPlacementEvaluationService = &clusterPlacementEvaluationService{}
+
+func NewClusterPlacementEvaluationService(clusterService ClusterService, kafkaService KafkaService, clusterPlacementStrategy ClusterPlacementStrategy, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) ClusterPlacementEvaluationService {
+	return &clusterPlacementEvaluationService{
+		clusterService:           clusterService,
+		kafkaService:             kafkaService,
+		clusterPlacementStrategy: clusterPlacementStrategy,
+		dataplaneClusterConfig:   dataplaneClusterConfig,
+		kafkaConfig:              kafkaConfig,
+	}
+}
+
+func (s *clusterPlacementEvaluationService) EvaluatePlacement(kafka *dbapi.KafkaRequest) (*ClusterPlacementEvaluation, *errors.ServiceError) {
+	instanceSize, err := s.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if err != nil {
+		return nil, errors.InstancePlanNotSupported("unable to evaluate the kafka placement: %s", err.Error())
+	}
+
+	regionCapacityAvailable, svcErr := s.kafkaService.HasAvailableCapacityInRegion(kafka)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	clusters, err := s.clusterService.FindAllClusters(FindClusterCriteria{
+		Provider: kafka.CloudProvider,
+		Region:   kafka.Region,
+	})
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find data plane clusters in region %q of cloud provider %q", kafka.Region, kafka.CloudProvider)
+	}
+
+	chosenCluster, err := s.clusterPlacementStrategy.FindCluster(kafka)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to evaluate the cluster placement strategy")
+	}
+
+	strategyConfig := s.dataplaneClusterConfig.ClusterPlacementStrategyConfig.ForInstanceType(kafka.InstanceType)
+	isScoredStrategy := s.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() && strategyConfig.Strategy != config.FirstReadyPlacementStrategy
+
+	kafkaInstanceCountPerCluster := map[string]int{}
+	if s.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled() && len(clusters) > 0 {
+		clusterIDs := arrays.Map(clusters, func(c *api.Cluster) string { return c.ClusterID })
+		counts, err := s.clusterService.FindKafkaInstanceCount(clusterIDs)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count kafka instances of data plane clusters")
+		}
+		for _, count := range counts {
+			kafkaInstanceCountPerCluster[count.Clusterid] = count.Count
+		}
+	}
+
+	streamingUnitCountPerClusterList, err := s.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count consumed streaming units of data plane clusters")
+	}
+	streamingUnitCountPerClusterID := streamingUnitCountPerClusterList.StreamingUnitCountPerClusterID()
+
+	evaluation := &ClusterPlacementEvaluation{
+		PlacementStrategy:       s.placementStrategyName(strategyConfig),
+		RegionCapacityAvailable: regionCapacityAvailable,
+		ChosenCluster:           chosenCluster,
+		Candidates:              []ClusterPlacementCandidateEvaluation{},
+	}
+
+	for _, cluster := range clusters {
+		candidate := ClusterPlacementCandidateEvaluation{
+			Cluster:        cluster,
+			StreamingUnits: buildInstanceTypeStreamingUnits(cluster, streamingUnitCountPerClusterID[cluster.ClusterID]),
+		}
+
+		candidate.RejectionReason = s.rejectionReason(kafka, cluster, candidate.StreamingUnits, instanceSize, kafkaInstanceCountPerCluster[cluster.ClusterID])
+		candidate.Eligible = candidate.RejectionReason == ""
+
+		if isScoredStrategy && candidate.Eligible {
+			_, su := arrays.FindFirst(candidate.StreamingUnits, func(su InstanceTypeStreamingUnits) bool { return su.InstanceType == kafka.InstanceType })
+			score, err := scoreCluster(cluster, kafka, float64(su.Consumed+int64(instanceSize.CapacityConsumed))/float64(su.MaxUnits), strategyConfig.EffectiveWeights())
+			if err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to score cluster %q", cluster.ClusterID)
+			}
+			candidate.Score = &score
+		}
+
+		if candidate.Eligible && (chosenCluster == nil || chosenCluster.ClusterID != cluster.ClusterID) {
+			candidate.RejectionReason = fmt.Sprintf("another cluster is preferred by the %q placement strategy", evaluation.PlacementStrategy)
+		}
+
+		evaluation.Candidates = append(evaluation.Candidates, candidate)
+	}
+
+	return evaluation, nil
+}
+
+func (s *clusterPlacementEvaluationService) placementStrategyName(strategyConfig config.InstanceTypeClusterPlacementStrategyConfig) string {
+	switch {
+	case s.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		return firstSchedulableWithinLimitStrategyName
+	case s.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		return strategyConfig.Strategy.String()
+	default:
+		return firstReadyClusterStrategyName
+	}
+}
+
+// rejectionReason returns why the given cluster cannot receive the kafka. An empty string is returned if it can.
+// The rules mirror the ones applied by the ClusterPlacementStrategy of the configured scaling mode.
+func (s *clusterPlacementEvaluationService) rejectionReason(kafka *dbapi.KafkaRequest, cluster *api.Cluster, streamingUnits []InstanceTypeStreamingUnits, instanceSize *config.KafkaInstanceSize, kafkaInstanceCount int) string {
+	if cluster.Status != api.ClusterReady {
+		return fmt.Sprintf("cluster is in %q status, only clusters in %q status can receive kafkas", cluster.Status, api.ClusterReady)
+	}
+
+	if cluster.Cordoned {
+		return "cluster is cordoned and does not accept new kafkas"
+	}
+
+	if !strings.Contains(cluster.SupportedInstanceType, kafka.InstanceType) {
+		return fmt.Sprintf("cluster does not support the %q instance type, supported instance types are %q", kafka.InstanceType, cluster.SupportedInstanceType)
+	}
+
+	if kafka.MultiAZ && !cluster.MultiAZ {
+		return "cluster is single AZ while the kafka is multi AZ"
+	}
+
+	switch {
+	case s.dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
+		clusterConfig := s.dataplaneClusterConfig.ClusterConfig
+		if !clusterConfig.IsClusterSchedulable(cluster.ClusterID) {
+			return "cluster is marked as not schedulable in the data plane cluster configuration"
+		}
+		if !clusterConfig.IsNumberOfKafkaWithinClusterLimit(cluster.ClusterID, kafkaInstanceCount+instanceSize.CapacityConsumed) {
+			return fmt.Sprintf("cluster would exceed its kafka instance limit: %d streaming units consumed, %d requested", kafkaInstanceCount, instanceSize.CapacityConsumed)
+		}
+	case s.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
+		if cluster.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			return fmt.Sprintf("cluster is of %q type, only %q clusters can receive kafkas that are not enterprise", cluster.ClusterType, api.ManagedDataPlaneClusterType)
+		}
+		idx, su := arrays.FindFirst(streamingUnits, func(su InstanceTypeStreamingUnits) bool { return su.InstanceType == kafka.InstanceType })
+		if idx == -1 || su.MaxUnits <= 0 {
+			return fmt.Sprintf("cluster has no capacity information for the %q instance type", kafka.InstanceType)
+		}
+		if su.Remaining() < int64(instanceSize.CapacityConsumed) {
+			return fmt.Sprintf("cluster does not have enough remaining capacity: %d streaming units remaining, %d requested", su.Remaining(), instanceSize.CapacityConsumed)
+		}
+	}
+
+	return ""
+}
+
+func buildInstanceTypeStreamingUnits(cluster *api.Cluster, streamingUnitCounts StreamingUnitCountPerInstanceType) []InstanceTypeStreamingUnits {
+	capacityInfo := cluster.RetrieveDynamicCapacityInfo()
+	res := []InstanceTypeStreamingUnits{}
+	for _, supportedInstanceType := range strings.Split(cluster.SupportedInstanceType, ",") {
+		instanceType := strings.TrimSpace(supportedInstanceType)
+		if instanceType == "" {
+			continue
+		}
+
+		res = append(res, InstanceTypeStreamingUnits{
+			InstanceType: instanceType,
+			MaxUnits:     int64(capacityInfo[instanceType].MaxUnits),
+			Consumed:     streamingUnitCounts[types.KafkaInstanceType(instanceType)],
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	mockkafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func Test_clusterPlacementEvaluationService_EvaluatePlacement(t *testing.T) {
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: types.STANDARD.String(),
+						Sizes: []config.KafkaInstanceSize{
+							{
+								Id:               "x2",
+								CapacityConsumed: 2,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	standardCapacityInfo := func(maxUnits int) api.JSON {
+		return api.JSON([]byte(fmt.Sprintf(`{"standard":{"max_nodes":3,"max_units":%d}}`, maxUnits)))
+	}
+	readyStrimziVersions := api.JSON([]byte(`[{"version":"strimzi-cluster-operator.v0.23.0-0","ready":true}]`))
+
+	newCluster := func(clusterID string, status api.ClusterStatus, multiAZ bool, supportedInstanceType string, maxUnits int) *api.Cluster {
+		return &api.Cluster{
+			ClusterID:                clusterID,
+			Status:                   status,
+			ClusterType:              api.ManagedDataPlaneClusterType.String(),
+			MultiAZ:                  multiAZ,
+			SupportedInstanceType:    supportedInstanceType,
+			AvailableStrimziVersions: readyStrimziVersions,
+			DynamicCapacityInfo:      standardCapacityInfo(maxUnits),
+		}
+	}
+
+	cordonedCluster := newCluster("cordoned", api.ClusterReady, true, "standard", 10)
+	cordonedCluster.Cordoned = true
+
+	clusters := []*api.Cluster{
+		newCluster("chosen", api.ClusterReady, true, "standard", 10),
+		newCluster("provisioning", api.ClusterProvisioning, true, "standard", 10),
+		newCluster("developer-only", api.ClusterReady, true, "developer", 10),
+		newCluster("single-az", api.ClusterReady, false, "standard", 10),
+		newCluster("full", api.ClusterReady, true, "standard", 10),
+		newCluster("not-preferred", api.ClusterReady, true, "standard,developer", 10),
+		cordonedCluster,
+	}
+
+	consumedStreamingUnits := map[string]int64{
+		"chosen":        6,
+		"full":          9,
+		"not-preferred": 2,
+	}
+
+	clusterService := &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return clusters, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			list := KafkaStreamingUnitCountPerClusterList{}
+			for clusterID, count := range consumedStreamingUnits {
+				list = append(list, KafkaStreamingUnitCountPerCluster{ClusterId: clusterID, InstanceType: types.STANDARD.String(), Count: int32(count)})
+			}
+			return list, nil
+		},
+		FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]ResKafkaInstanceCount, error) {
+			return []ResKafkaInstanceCount{
+				{Clusterid: "full", Count: 9},
+				{Clusterid: "chosen", Count: 2},
+			}, nil
+		},
+	}
+
+	kafkaService := &KafkaServiceMock{
+		HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
+			return true, nil
+		},
+	}
+
+	clusterPlacementStrategy := &ClusterPlacementStrategyMock{
+		FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+			return clusters[0], nil
+		},
+	}
+
+	autoScalingConfig := &config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.AutoScaling,
+		ClusterPlacementStrategyConfig: config.ClusterPlacementStrategyConfig{
+			Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+				types.STANDARD.String(): {Strategy: config.BinPackPlacementStrategy},
+			},
+		},
+	}
+
+	manualScalingConfig := &config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.ManualScaling,
+		ClusterConfig: config.NewClusterConfig(config.ClusterList{
+			config.ManualCluster{ClusterId: "chosen", KafkaInstanceLimit: 10, Schedulable: true},
+			config.ManualCluster{ClusterId: "full", KafkaInstanceLimit: 10, Schedulable: true},
+			config.ManualCluster{ClusterId: "not-preferred", KafkaInstanceLimit: 10, Schedulable: false},
+		}),
+	}
+
+	standardKafka := mockkafkas.BuildKafkaRequest(
+		mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+		mockkafkas.With(mockkafkas.SIZE_ID, "x2"),
+		func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.MultiAZ = true
+		},
+	)
+
+	type fields struct {
+		clusterService           ClusterService
+		kafkaService             KafkaService
+		clusterPlacementStrategy ClusterPlacementStrategy
+		dataplaneClusterConfig   *config.DataplaneClusterConfig
+	}
+	type args struct {
+		kafka *dbapi.KafkaRequest
+	}
+	tests := []struct {
+		name                        string
+		fields                      fields
+		args                        args
+		wantErr                     bool
+		wantPlacementStrategy       string
+		wantRegionCapacityAvailable bool
+		wantChosenCluster           *api.Cluster
+		// wantRejectionReasons holds a substring of the expected rejection reason of each candidate cluster
+		wantRejectionReasons map[string]string
+		wantScoredClusters   []string
+	}{
+		{
+			name: "should return an error when the instance size of the kafka is not supported",
+			fields: fields{
+				clusterService:           clusterService,
+				kafkaService:             kafkaService,
+				clusterPlacementStrategy: clusterPlacementStrategy,
+				dataplaneClusterConfig:   autoScalingConfig,
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x10"),
+				),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when checking the region capacity fails",
+			fields: fields{
+				clusterService: clusterService,
+				kafkaService: &KafkaServiceMock{
+					HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
+						return false, apiErrors.GeneralError("test")
+					},
+				},
+				clusterPlacementStrategy: clusterPlacementStrategy,
+				dataplaneClusterConfig:   autoScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the placement strategy fails",
+			fields: fields{
+				clusterService: clusterService,
+				kafkaService:   kafkaService,
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return nil, errors.New("test")
+					},
+				},
+				dataplaneClusterConfig: autoScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when counting the consumed streaming units fails",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: clusterService.FindAllClustersFunc,
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+						return nil, errors.New("test")
+					},
+				},
+				kafkaService:             kafkaService,
+				clusterPlacementStrategy: clusterPlacementStrategy,
+				dataplaneClusterConfig:   autoScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should explain the evaluation of every cluster when dynamic scaling is enabled",
+			fields: fields{
+				clusterService:           clusterService,
+				kafkaService:             kafkaService,
+				clusterPlacementStrategy: clusterPlacementStrategy,
+				dataplaneClusterConfig:   autoScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantPlacementStrategy:       config.BinPackPlacementStrategy.String(),
+			wantRegionCapacityAvailable: true,
+			wantChosenCluster:           clusters[0],
+			wantRejectionReasons: map[string]string{
+				"chosen":         "",
+				"provisioning":   "status",
+				"developer-only": "does not support",
+				"single-az":      "single AZ",
+				"full":           "remaining capacity",
+				"not-preferred":  "another cluster is preferred",
+				"cordoned":       "cordoned",
+			},
+			wantScoredClusters: []string{"chosen", "not-preferred"},
+		},
+		{
+			name: "should explain the evaluation of every cluster when manual scaling is enabled",
+			fields: fields{
+				clusterService:           clusterService,
+				kafkaService:             kafkaService,
+				clusterPlacementStrategy: clusterPlacementStrategy,
+				dataplaneClusterConfig:   manualScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantPlacementStrategy:       "first-schedulable-within-limit",
+			wantRegionCapacityAvailable: true,
+			wantChosenCluster:           clusters[0],
+			wantRejectionReasons: map[string]string{
+				"chosen":         "",
+				"provisioning":   "status",
+				"developer-only": "does not support",
+				"single-az":      "single AZ",
+				"full":           "instance limit",
+				"not-preferred":  "not schedulable",
+				"cordoned":       "cordoned",
+			},
+		},
+		{
+			name: "should reject every cluster when no cluster is chosen and report that the region has no capacity left",
+			fields: fields{
+				clusterService: clusterService,
+				kafkaService: &KafkaServiceMock{
+					HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
+						return false, nil
+					},
+				},
+				clusterPlacementStrategy: &ClusterPlacementStrategyMock{
+					FindClusterFunc: func(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+						return nil, nil
+					},
+				},
+				dataplaneClusterConfig: autoScalingConfig,
+			},
+			args: args{
+				kafka: standardKafka,
+			},
+			wantPlacementStrategy:       config.BinPackPlacementStrategy.String(),
+			wantRegionCapacityAvailable: false,
+			wantRejectionReasons: map[string]string{
+				"chosen":         "another cluster is preferred",
+				"provisioning":   "status",
+				"developer-only": "does not support",
+				"single-az":      "single AZ",
+				"full":           "remaining capacity",
+				"not-preferred":  "another cluster is preferred",
+				"cordoned":       "cordoned",
+			},
+			wantScoredClusters: []string{"chosen", "not-preferred"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			s := NewClusterPlacementEvaluationService(tt.fields.clusterService, tt.fields.kafkaService, tt.fields.clusterPlacementStrategy, tt.fields.dataplaneClusterConfig, kafkaConfig)
+			evaluation, err := s.EvaluatePlacement(tt.args.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(evaluation.PlacementStrategy).To(gomega.Equal(tt.wantPlacementStrategy))
+			g.Expect(evaluation.RegionCapacityAvailable).To(gomega.Equal(tt.wantRegionCapacityAvailable))
+			g.Expect(evaluation.ChosenCluster).To(gomega.Equal(tt.wantChosenCluster))
+			g.Expect(evaluation.Candidates).To(gomega.HaveLen(len(tt.wantRejectionReasons)))
+			for _, candidate := range evaluation.Candidates {
+				wantRejectionReason, ok := tt.wantRejectionReasons[candidate.Cluster.ClusterID]
+				g.Expect(ok).To(gomega.BeTrue())
+				if wantRejectionReason == "" {
+					g.Expect(candidate.RejectionReason).To(gomega.BeEmpty())
+					g.Expect(candidate.Eligible).To(gomega.BeTrue())
+				} else {
+					g.Expect(candidate.RejectionReason).To(gomega.ContainSubstring(wantRejectionReason))
+				}
+				if strings.Contains(wantRejectionReason, "another cluster is preferred") {
+					g.Expect(candidate.Eligible).To(gomega.BeTrue())
+				}
+				g.Expect(candidate.Score != nil).To(gomega.Equal(arrays.Contains(tt.wantScoredClusters, candidate.Cluster.ClusterID)))
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneCluster
 	case dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
 		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService, kafkaConfig}
 	case dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
-		clusterSelection = &FirstReadyWithCapacity{clusterService, kafkaConfig}
+		clusterSelection = &HighestScoredWithCapacity{clusterService, kafkaConfig, &dataplaneClusterConfig.ClusterPlacementStrategyConfig}
 	default:
 		clusterSelection = &FirstReadyCluster{clusterService, kafkaConfig}
 	}
@@ -41,7 +41,8 @@ type findDataPlaneClusterByIdIfItHasCapacityAvailable struct {
 // Once the cluster is found, it has to match the following rules:
 // 1. The cluster has to be in ready state.
 // 2. It also also has to be in the same organization as the kafka request.
-// 3. It must have remaining capacity to receive the Kafka.
+// 3. It must not be cordoned.
+// 4. It must have remaining capacity to receive the Kafka.
 // Capacity capacity is evaluated based on the MaxUnits stored in DynamicCapacityInfo and the actual used capacity.
 func (f *findDataPlaneClusterByIdIfItHasCapacityAvailable) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
 	cluster, err := f.clusterService.FindClusterByID(kafka.ClusterID)
@@ -57,6 +58,10 @@ func (f *findDataPlaneClusterByIdIfItHasCapacityAvailable) FindCluster(kafka *db
 		return nil, apiErrors.BadRequest("cluster with id: %s is not ready to accept kafkas", kafka.ClusterID)
 	}
 
+	if cluster.Cordoned {
+		return nil, apiErrors.BadRequest("cluster with id: %s is cordoned and does not accept new kafkas", kafka.ClusterID)
+	}
+
 	kafkaSizeConsumption, sizeErr := f.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
 	if sizeErr != nil {
 		return nil, sizeErr
@@ -103,6 +108,7 @@ func (f *FirstReadyCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	cluster, err := f.ClusterService.FindCluster(criteria)
@@ -136,6 +142,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	kafkaInstanceSize, e := f.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
@@ -227,6 +234,7 @@ func (f *FirstReadyWithCapacity) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cl
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
 	}
 
 	clusters, findAllClusterErr := f.clusterService.FindAllClusters(criteria)
@@ -266,3 +274,136 @@ func (f *FirstReadyWithCapacity) isManagedClusterNotFull(cluster *api.Cluster, s
 
 	return currentStreamingUnitsUsed+instanceSize.CapacityConsumed <= int(maxStreamingUnits)
 }
+
+// HighestScoredWithCapacity finds and returns the ready cluster with remaining capacity that gets the highest score
+// according to the placement strategy configured for the instance type of the kafka.
+// When the configured strategy is "first-ready" the first ready cluster with remaining capacity is returned instead.
+type HighestScoredWithCapacity struct {
+	clusterService          ClusterService
+	kafkaConfig             *config.KafkaConfig
+	placementStrategyConfig *config.ClusterPlacementStrategyConfig
+}
+
+func (f *HighestScoredWithCapacity) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
+	strategyConfig := f.placementStrategyConfig.ForInstanceType(kafka.InstanceType)
+	if kafka.DesiredBillingModelIsEnterprise() || strategyConfig.Strategy == config.FirstReadyPlacementStrategy {
+		firstReadyWithCapacity := FirstReadyWithCapacity{
+			clusterService: f.clusterService,
+			kafkaConfig:    f.kafkaConfig,
+		}
+		return firstReadyWithCapacity.FindCluster(kafka)
+	}
+
+	candidates, err := f.scoreCandidateClusters(kafka, strategyConfig.EffectiveWeights())
+	if err != nil {
+		return nil, err
+	}
+
+	var bestCandidate *ClusterPlacementCandidate
+	for i := range candidates {
+		candidate := &candidates[i]
+		// the clusters are ordered by creation date: in case of a tie the oldest cluster wins
+		if bestCandidate == nil || candidate.Score > bestCandidate.Score {
+			bestCandidate = candidate
+		}
+	}
+
+	if bestCandidate == nil {
+		return nil, nil
+	}
+
+	return bestCandidate.Cluster, nil
+}
+
+// ClusterPlacementCandidate is a data plane cluster that has enough remaining capacity to receive a kafka, along with its score
+type ClusterPlacementCandidate struct {
+	Cluster                *api.Cluster
+	ConsumedStreamingUnits int64
+	MaxStreamingUnits      int64
+	Score                  float64
+}
+
+// scoreCandidateClusters returns the ready managed clusters that have enough remaining capacity to receive the given kafka,
+// each of them scored with the given weights
+func (f *HighestScoredWithCapacity) scoreCandidateClusters(kafka *dbapi.KafkaRequest, weights config.ClusterPlacementWeights) ([]ClusterPlacementCandidate, error) {
+	criteria := FindClusterCriteria{
+		Provider:              kafka.CloudProvider,
+		Region:                kafka.Region,
+		MultiAZ:               kafka.MultiAZ,
+		Status:                api.ClusterReady,
+		SupportedInstanceType: kafka.InstanceType,
+		ExcludeCordoned:       true,
+	}
+
+	clusters, err := f.clusterService.FindAllClusters(criteria)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find all clusters with criteria '%v'", criteria)
+	}
+
+	instanceSize, err := f.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get kafka instance size for cluster with criteria '%v'", criteria)
+	}
+
+	streamingUnitCountPerClusterList, err := f.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get count of streaming units by cluster and instance type for criteria '%v'", criteria)
+	}
+	streamingUnitCountPerClusterID := streamingUnitCountPerClusterList.StreamingUnitCountPerClusterID()
+
+	candidates := []ClusterPlacementCandidate{}
+	for _, cluster := range clusters {
+		if cluster.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			continue
+		}
+
+		capacityInfo, ok := cluster.RetrieveDynamicCapacityInfo()[kafka.InstanceType]
+		if !ok || capacityInfo.MaxUnits <= 0 {
+			continue
+		}
+
+		consumedStreamingUnits := streamingUnitCountPerClusterID[cluster.ClusterID][types.KafkaInstanceType(kafka.InstanceType)]
+		maxStreamingUnits := int64(capacityInfo.MaxUnits)
+		consumedStreamingUnitsAfterPlacement := consumedStreamingUnits + int64(instanceSize.CapacityConsumed)
+		if consumedStreamingUnitsAfterPlacement > maxStreamingUnits {
+			continue
+		}
+
+		score, err := scoreCluster(cluster, kafka, float64(consumedStreamingUnitsAfterPlacement)/float64(maxStreamingUnits), weights)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to score cluster %q", cluster.ClusterID)
+		}
+
+		candidates = append(candidates, ClusterPlacementCandidate{
+			Cluster:                cluster,
+			ConsumedStreamingUnits: consumedStreamingUnits,
+			MaxStreamingUnits:      maxStreamingUnits,
+			Score:                  score,
+		})
+	}
+
+	return candidates, nil
+}
+
+// scoreCluster computes the weighted sum of the placement criteria for the given cluster.
+// utilisation is the ratio of consumed streaming units in the cluster once the kafka is placed.
+func scoreCluster(cluster *api.Cluster, kafka *dbapi.KafkaRequest, utilisation float64, weights config.ClusterPlacementWeights) (float64, error) {
+	score := weights.Utilisation * utilisation
+
+	if weights.StrimziVersion != 0 {
+		latestStrimziVersion, err := cluster.GetLatestAvailableStrimziVersion()
+		if err != nil {
+			return 0, err
+		}
+
+		if latestStrimziVersion != nil && latestStrimziVersion.Ready {
+			score += weights.StrimziVersion
+		}
+	}
+
+	if cluster.MultiAZ == kafka.MultiAZ {
+		score += weights.MultiAZ
+	}
+
+	return score, nil
+}
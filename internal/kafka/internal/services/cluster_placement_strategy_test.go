@@ -315,8 +315,9 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 			},
 			want: nil,
 			wantErr: errors.Wrapf(errors.New("failed to find clusters"), fmt.Sprintf("failed to find all clusters with criteria '%v'", FindClusterCriteria{
-				MultiAZ: mockkafkas.BuildKafkaRequest().MultiAZ,
-				Status:  api.ClusterReady,
+				MultiAZ:         mockkafkas.BuildKafkaRequest().MultiAZ,
+				Status:          api.ClusterReady,
+				ExcludeCordoned: true,
 			})),
 		},
 		{
@@ -341,8 +342,9 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 			},
 			want: nil,
 			wantErr: errors.Wrapf(errors.New("failed to retrieve streaming unit count per region and instance type"), fmt.Sprintf("failed to get count of streaming units by cluster and instance type for criteria '%v'", FindClusterCriteria{
-				MultiAZ: mockkafkas.BuildKafkaRequest().MultiAZ,
-				Status:  api.ClusterReady,
+				MultiAZ:         mockkafkas.BuildKafkaRequest().MultiAZ,
+				Status:          api.ClusterReady,
+				ExcludeCordoned: true,
 			})),
 		},
 		{
@@ -381,6 +383,7 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 				MultiAZ:               mockkafkas.BuildKafkaRequest().MultiAZ,
 				Status:                api.ClusterReady,
 				SupportedInstanceType: "unsupported",
+				ExcludeCordoned:       true,
 			})),
 		},
 		{
@@ -637,6 +640,24 @@ func Test_findDesiredDataPlaneClusterIfItHasCapacityAvailable_FindCluster(t *tes
 			},
 			wantErr: true,
 		},
+		{
+			name: "return an error if cluster is cordoned",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{
+							OrganizationID: "some-org-id",
+							Status:         api.ClusterReady,
+							Cordoned:       true,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: buildKafkaRequest(mockkafkas.With(mockkafkas.ORGANISATION_ID, "some-org-id")),
+			},
+			wantErr: true,
+		},
 		{
 			name: "return an error if computing used streaming unit for the given cluster fails",
 			fields: fields{
@@ -810,3 +831,250 @@ func Test_findDesiredDataPlaneClusterIfItHasCapacityAvailable_FindCluster(t *tes
 		})
 	}
 }
+
+func TestHighestScoredWithCapacity_FindCluster(t *testing.T) {
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: types.STANDARD.String(),
+						Sizes: []config.KafkaInstanceSize{
+							{
+								Id:               "x1",
+								CapacityConsumed: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	readyStrimziVersions := api.JSON([]byte(`[{"version":"strimzi-cluster-operator.v0.23.0-0","ready":true}]`))
+	notReadyStrimziVersions := api.JSON([]byte(`[{"version":"strimzi-cluster-operator.v0.23.0-0","ready":false}]`))
+
+	// cluster-1 has 1 of 10 units consumed, cluster-2 has 6 of 10 units consumed and cluster-3 is full
+	clusters := []*api.Cluster{
+		{
+			ClusterID:                "cluster-1",
+			ClusterType:              api.ManagedDataPlaneClusterType.String(),
+			MultiAZ:                  true,
+			AvailableStrimziVersions: notReadyStrimziVersions,
+			DynamicCapacityInfo:      api.JSON([]byte(`{"standard":{"max_nodes":3,"max_units":10,"remaining_units":9}}`)),
+		},
+		{
+			ClusterID:                "cluster-2",
+			ClusterType:              api.ManagedDataPlaneClusterType.String(),
+			MultiAZ:                  false,
+			AvailableStrimziVersions: readyStrimziVersions,
+			DynamicCapacityInfo:      api.JSON([]byte(`{"standard":{"max_nodes":3,"max_units":10,"remaining_units":4}}`)),
+		},
+		{
+			ClusterID:                "cluster-3",
+			ClusterType:              api.ManagedDataPlaneClusterType.String(),
+			MultiAZ:                  true,
+			AvailableStrimziVersions: readyStrimziVersions,
+			DynamicCapacityInfo:      api.JSON([]byte(`{"standard":{"max_nodes":3,"max_units":10,"remaining_units":0}}`)),
+		},
+	}
+
+	consumedStreamingUnits := map[string]int64{
+		"cluster-1": 1,
+		"cluster-2": 6,
+		"cluster-3": 10,
+	}
+
+	clusterService := &ClusterServiceMock{
+		FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+			return clusters, nil
+		},
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			list := KafkaStreamingUnitCountPerClusterList{}
+			for clusterID, count := range consumedStreamingUnits {
+				list = append(list, KafkaStreamingUnitCountPerCluster{ClusterId: clusterID, InstanceType: types.STANDARD.String(), Count: int32(count)})
+			}
+			return list, nil
+		},
+	}
+
+	type fields struct {
+		clusterService          ClusterService
+		placementStrategyConfig config.ClusterPlacementStrategyConfig
+	}
+	type args struct {
+		kafka *dbapi.KafkaRequest
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *api.Cluster
+		wantErr bool
+	}{
+		{
+			name: "should return the first ready cluster with capacity when no strategy is configured for the instance type",
+			fields: fields{
+				clusterService:          clusterService,
+				placementStrategyConfig: config.NewClusterPlacementStrategyConfig(),
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want: clusters[0],
+		},
+		{
+			name: "should return the most utilised cluster with capacity for the bin-pack strategy",
+			fields: fields{
+				clusterService: clusterService,
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {Strategy: config.BinPackPlacementStrategy},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want: clusters[1],
+		},
+		{
+			name: "should return the least utilised cluster with capacity for the spread strategy",
+			fields: fields{
+				clusterService: clusterService,
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {Strategy: config.SpreadPlacementStrategy},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want: clusters[0],
+		},
+		{
+			name: "should return the highest scored cluster with capacity for the weighted strategy",
+			fields: fields{
+				clusterService: clusterService,
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {
+							Strategy: config.WeightedPlacementStrategy,
+							Weights: config.ClusterPlacementWeights{
+								Utilisation:    -1,
+								StrimziVersion: 1,
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want: clusters[1],
+		},
+		{
+			name: "should favour the cluster matching the multi AZ setting of the kafka for the weighted strategy",
+			fields: fields{
+				clusterService: clusterService,
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {
+							Strategy: config.WeightedPlacementStrategy,
+							Weights: config.ClusterPlacementWeights{
+								Utilisation: -0.1,
+								MultiAZ:     1,
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+					mockkafkas.WithMultiAZ(false),
+				),
+			},
+			want: clusters[1],
+		},
+		{
+			name: "should return nil when no cluster has enough remaining capacity",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return clusters[2:], nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: clusterService.FindStreamingUnitCountByClusterAndInstanceTypeFunc,
+				},
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {Strategy: config.SpreadPlacementStrategy},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want: nil,
+		},
+		{
+			name: "should return an error when counting the consumed streaming units fails",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindAllClustersFunc: clusterService.FindAllClustersFunc,
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+						return nil, errors.New("failed to count streaming units")
+					},
+				},
+				placementStrategyConfig: config.ClusterPlacementStrategyConfig{
+					Configuration: map[string]config.InstanceTypeClusterPlacementStrategyConfig{
+						types.STANDARD.String(): {Strategy: config.BinPackPlacementStrategy},
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			f := &HighestScoredWithCapacity{
+				clusterService:          tt.fields.clusterService,
+				kafkaConfig:             kafkaConfig,
+				placementStrategyConfig: &tt.fields.placementStrategyConfig,
+			}
+
+			got, err := f.FindCluster(tt.args.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -29,6 +29,10 @@ import (
 
 var kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane = []string{constants.KafkaRequestStatusDeleting.String()}
 
+// kafkaStatusesThatNoLongerConsumeStreamingUnits are the statuses of the kafkas whose streaming units can be given to other kafkas.
+// The suspended kafkas are scaled down by the data plane: they need to get back this capacity to be resumed
+var kafkaStatusesThatNoLongerConsumeStreamingUnits = []string{constants.KafkaRequestStatusDeleting.String(), constants.KafkaRequestStatusSuspended.String()}
+
 //go:generate moq -out clusterservice_moq.go . ClusterService
 type ClusterService interface {
 	Create(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError)
@@ -37,7 +41,12 @@ type ClusterService interface {
 	// ListEnterpriseClustersOfAnOrganization returns a list of enterprise clusters (ClusterID, AccessKafkasViaPrivateNetwork and Status fields only) which belong to organization obtained from the context
 	ListEnterpriseClustersOfAnOrganization(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError)
 	ListByStatus(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError)
+	// ListDrainingClusters returns the clusters that are being drained, whatever their status
+	ListDrainingClusters() ([]api.Cluster, *apiErrors.ServiceError)
 	UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error
+	// UpdateCordon sets the cordoned and draining flags of the cluster with the given clusterID.
+	// A draining cluster is always cordoned
+	UpdateCordon(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError
 	// Update updates a Cluster. Only fields whose value is different than the
 	// zero-value of their corresponding type will be updated
 	Update(cluster api.Cluster) *apiErrors.ServiceError
@@ -84,11 +93,12 @@ type ClusterService interface {
 	IsStrimziKafkaVersionAvailableInCluster(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error)
 	// FindStreamingUnitCountByClusterAndInstanceType returns kafka streaming unit counts per region, cloud provider, cluster id and instance type.
 	// Data Plane clusters that are in 'failed' state are not included in the response.
-	// Kafkas that are in deleting state won't be included in the count as they no longer consume resources in the data plane cluster.
+	// Kafkas that are in deleting or suspended state won't be included in the count as they no longer consume streaming units in the data plane cluster.
 	FindStreamingUnitCountByClusterAndInstanceType() (KafkaStreamingUnitCountPerClusterList, error)
 
 	// Computes the consumed streaming unit coount per instance of a given cluster.
-	// If an instance type if not contained in the returned object, it can be considered that the consumed capacity for that instance type is 0
+	// If an instance type if not contained in the returned object, it can be considered that the consumed capacity for that instance type is 0.
+	// The suspended kafkas do not consume streaming units
 	ComputeConsumedStreamingUnitCountPerInstanceType(clusterID string) (StreamingUnitCountPerInstanceType, error)
 }
 
@@ -240,6 +250,18 @@ func (c clusterService) ListByStatus(status api.ClusterStatus) ([]api.Cluster, *
 	return clusters, nil
 }
 
+func (c clusterService) ListDrainingClusters() ([]api.Cluster, *apiErrors.ServiceError) {
+	dbConn := c.connectionFactory.New()
+
+	var clusters []api.Cluster
+
+	if err := dbConn.Model(&api.Cluster{}).Where("draining = ?", true).Scan(&clusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list draining clusters")
+	}
+
+	return clusters, nil
+}
+
 func (c clusterService) Update(cluster api.Cluster) *apiErrors.ServiceError {
 	if cluster.ID == "" {
 		return apiErrors.Validation("id is undefined")
@@ -289,6 +311,27 @@ func (c clusterService) UpdateStatus(cluster api.Cluster, status api.ClusterStat
 	return nil
 }
 
+func (c clusterService) UpdateCordon(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+	if clusterID == "" {
+		return apiErrors.Validation("clusterID is undefined")
+	}
+	if draining && !cordoned {
+		return apiErrors.Validation("a draining cluster must be cordoned")
+	}
+
+	dbConn := c.connectionFactory.New()
+
+	// the flags are updated through a map so that they can be reset to false
+	if err := dbConn.Model(&api.Cluster{}).Where("cluster_id = ?", clusterID).Updates(map[string]interface{}{
+		"cordoned": cordoned,
+		"draining": draining,
+	}).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update cordon of cluster %q", clusterID)
+	}
+
+	return nil
+}
+
 type ResGroupCPRegion struct {
 	Provider string
 	Region   string
@@ -323,6 +366,8 @@ type FindClusterCriteria struct {
 	Status                api.ClusterStatus
 	SupportedInstanceType string
 	ExternalID            string
+	// ExcludeCordoned excludes the cordoned clusters, which cannot receive new kafkas
+	ExcludeCordoned bool
 }
 
 func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster, error) {
@@ -343,6 +388,10 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 		dbConn = dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
 
+	if criteria.ExcludeCordoned {
+		dbConn = dbConn.Where("cordoned = ?", false)
+	}
+
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -426,7 +475,9 @@ func (c clusterService) FindNonEmptyClusterByID(clusterID string) (*api.Cluster,
 	}
 
 	subQuery := dbConn.Select("cluster_id").Where("status != ? AND cluster_id = ?", constants.KafkaRequestStatusDeleting, clusterID).Model(dbapi.KafkaRequest{})
-	if err := dbConn.Where(clusterDetails).Where("cluster_id IN (?)", subQuery).First(cluster).Error; err != nil {
+	// kafkas being migrated to or from the cluster are also provisioned on it
+	migrationSubQuery := dbConn.Select("migration_cluster_id").Where("status != ? AND migration_cluster_id = ? AND migration_status IN (?)", constants.KafkaRequestStatusDeleting, clusterID, dbapi.KafkaMigrationStatusesWithTargetCapacity).Model(dbapi.KafkaRequest{})
+	if err := dbConn.Where(clusterDetails).Where("cluster_id IN (?) OR cluster_id IN (?)", subQuery, migrationSubQuery).First(cluster).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -480,7 +531,7 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane)
 
 	if len(clusterIDs) > 0 {
-		query = query.Where("cluster_id in (?)", clusterIDs)
+		query = query.Where("cluster_id in (?) OR (migration_cluster_id in (?) AND migration_status in (?))", clusterIDs, clusterIDs, dbapi.KafkaMigrationStatusesWithTargetCapacity)
 	}
 
 	query = query.Scan(&kafkas)
@@ -499,6 +550,10 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 			return nil, e
 		}
 		clusterIdCountMap[k.ClusterID] += kafkaInstanceSize.CapacityConsumed
+		// a kafka being migrated consumes capacity on both the source and the target clusters
+		if k.IsMigrationPeer(k.MigrationClusterID) {
+			clusterIdCountMap[k.MigrationClusterID] += kafkaInstanceSize.CapacityConsumed
+		}
 	}
 
 	// the query above won't return a count for a clusterId if that cluster doesn't have any Kafkas,
@@ -536,6 +591,10 @@ func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cl
 	if criteria.SupportedInstanceType != "" {
 		dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
+
+	if criteria.ExcludeCordoned {
+		dbConn.Where("cordoned = ?", false)
+	}
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -765,6 +824,9 @@ type KafkaStreamingUnitCountPerCluster struct {
 	MaxUnits      int32
 	Status        string
 	ClusterType   string
+	// Cordoned clusters cannot receive new kafkas: their remaining capacity is not available
+	Cordoned bool
+	Draining bool
 }
 
 func (k KafkaStreamingUnitCountPerCluster) isSame(kafkaPerRegionFromDB *KafkaPerClusterCount) bool {
@@ -798,6 +860,21 @@ func (kafkaStreamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterLis
 	return 0
 }
 
+// StreamingUnitCountPerClusterID indexes the consumed streaming units of the list by cluster id and instance type
+func (kafkaStreamingUnitCountPerClusterList KafkaStreamingUnitCountPerClusterList) StreamingUnitCountPerClusterID() map[string]StreamingUnitCountPerInstanceType {
+	streamingUnitCountPerClusterID := map[string]StreamingUnitCountPerInstanceType{}
+	for _, kafkaStreamingUnitCountPerCluster := range kafkaStreamingUnitCountPerClusterList {
+		streamingUnitCounts, ok := streamingUnitCountPerClusterID[kafkaStreamingUnitCountPerCluster.ClusterId]
+		if !ok {
+			streamingUnitCounts = StreamingUnitCountPerInstanceType{}
+			streamingUnitCountPerClusterID[kafkaStreamingUnitCountPerCluster.ClusterId] = streamingUnitCounts
+		}
+		streamingUnitCounts[kafkaTypes.KafkaInstanceType(kafkaStreamingUnitCountPerCluster.InstanceType)] += int64(kafkaStreamingUnitCountPerCluster.Count)
+	}
+
+	return streamingUnitCountPerClusterID
+}
+
 // KafkaPerClusterCount is a struct used to query the database using a "group by" clause
 type KafkaPerClusterCount struct {
 	Region        string
@@ -817,6 +894,8 @@ type ClusterSelection struct {
 	DynamicCapacityInfo   api.JSON
 	Status                string
 	ClusterType           string
+	Cordoned              bool
+	Draining              bool
 }
 
 func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (KafkaStreamingUnitCountPerClusterList, error) {
@@ -862,6 +941,8 @@ func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (Kafka
 				MaxUnits:      maxUnits,
 				Status:        clusterSelection.Status,
 				ClusterType:   clusterSelection.ClusterType,
+				Cordoned:      clusterSelection.Cordoned,
+				Draining:      clusterSelection.Draining,
 			})
 		}
 	}
@@ -871,11 +952,23 @@ func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (Kafka
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).
 		Select("cloud_provider, region, count(1) as Count, size_id, cluster_id, instance_type").
 		Group("size_id, cluster_id, cloud_provider, region, instance_type").
-		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane).
+		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeStreamingUnits).
 		Scan(&kafkasPerCluster).Error; err != nil {
 		return nil, errors.Wrap(err, "failed to perform count query on kafkas table")
 	}
 
+	// kafkas being migrated also consume capacity on the other cluster of the migration
+	var migratingKafkasPerCluster []*KafkaPerClusterCount
+	if err := c.connectionFactory.New().Model(&dbapi.KafkaRequest{}).
+		Select("cloud_provider, region, count(1) as Count, size_id, migration_cluster_id as cluster_id, instance_type").
+		Group("size_id, migration_cluster_id, cloud_provider, region, instance_type").
+		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeStreamingUnits).
+		Where("migration_cluster_id != '' AND migration_status in (?)", dbapi.KafkaMigrationStatusesWithTargetCapacity).
+		Scan(&migratingKafkasPerCluster).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to perform count query of migrating kafkas on kafkas table")
+	}
+	kafkasPerCluster = append(kafkasPerCluster, migratingKafkasPerCluster...)
+
 	for _, kafkaCountPerCluster := range kafkasPerCluster {
 		instSize, err := c.kafkaConfig.GetKafkaInstanceSize(kafkaCountPerCluster.InstanceType, kafkaCountPerCluster.SizeId)
 		if err != nil {
@@ -906,8 +999,8 @@ func (c *clusterService) ComputeConsumedStreamingUnitCountPerInstanceType(cluste
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).
 		Select("size_id, instance_type, count(1) as Count").
 		Group("size_id, instance_type").
-		Where("cluster_id = ?", clusterID).
-		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeResourcesInTheDataPlane).
+		Where("cluster_id = ? OR (migration_cluster_id = ? AND migration_status in (?))", clusterID, clusterID, dbapi.KafkaMigrationStatusesWithTargetCapacity).
+		Where("status not in (?)", kafkaStatusesThatNoLongerConsumeStreamingUnits).
 		Scan(&sizeCountsPerInstanceType).Error; err != nil {
 		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get count of sizes of a cluster")
 	}
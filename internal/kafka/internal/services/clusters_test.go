@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
@@ -779,6 +781,166 @@ func Test_UpdateStatus(t *testing.T) {
 	}
 }
 
+func Test_clusterService_UpdateCordon(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+	type args struct {
+		clusterID string
+		cordoned  bool
+		draining  bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name: "error when cluster id is undefined",
+			args: args{
+				cordoned: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when a cluster is draining without being cordoned",
+			args: args{
+				clusterID: testID,
+				draining:  true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail: database returns an error",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+				cordoned:  true,
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithExecException()
+			},
+		},
+		{
+			name: "successful cordon and drain update",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+				cordoned:  true,
+				draining:  true,
+			},
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "cordoned"=$1,"draining"=$2,"updated_at"=$3 WHERE cluster_id = $4`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "successful uncordon update",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testID,
+			},
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "clusters" SET "cordoned"=$1,"draining"=$2,"updated_at"=$3 WHERE cluster_id = $4`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &clusterService{
+				connectionFactory: tt.fields.connectionFactory,
+			}
+			err := k.UpdateCordon(tt.args.clusterID, tt.args.cordoned, tt.args.draining)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateCordon() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func Test_clusterService_ListDrainingClusters(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		want    []api.Cluster
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should return an error when the query fails",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQueryException().WithExecException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return the draining clusters",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "clusters" WHERE draining = $1`).WithReply([]map[string]interface{}{
+					{
+						"cluster_id": "test01",
+						"cordoned":   true,
+						"draining":   true,
+					},
+				})
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			want: []api.Cluster{
+				{
+					ClusterID: "test01",
+					Cordoned:  true,
+					Draining:  true,
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			c := clusterService{
+				connectionFactory: tt.fields.connectionFactory,
+			}
+			got, err := c.ListDrainingClusters()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func Test_RegisterClusterJob(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -1154,7 +1316,7 @@ func Test_Cluster_FindNonEmptyClusterByID(t *testing.T) {
 			want: &api.Cluster{ClusterID: testClusterID, Meta: api.Meta{CreatedAt: now, UpdatedAt: now, DeletedAt: gorm.DeletedAt{Valid: true}}},
 			setupFn: func() {
 				mockedResponse := []map[string]interface{}{{"cluster_id": testClusterID, "created_at": now, "updated_at": now, "deleted_at": gorm.DeletedAt{Valid: true}.Time}}
-				query := `SELECT * FROM "clusters" WHERE "clusters"."cluster_id" = $1 AND cluster_id IN (SELECT "cluster_id" FROM "kafka_requests" WHERE (status != $2 AND cluster_id = $3) AND "kafka_requests"."deleted_at" IS NULL) AND "clusters"."deleted_at" IS NULL ORDER BY "clusters"."id" LIMIT 1%`
+				query := `SELECT * FROM "clusters" WHERE "clusters"."cluster_id" = $1 AND (cluster_id IN (SELECT "cluster_id" FROM "kafka_requests" WHERE (status != $2 AND cluster_id = $3) AND "kafka_requests"."deleted_at" IS NULL) OR cluster_id IN (SELECT "migration_cluster_id" FROM "kafka_requests" WHERE (status != $4 AND migration_cluster_id = $5 AND migration_status IN ($6,$7,$8,$9)) AND "kafka_requests"."deleted_at" IS NULL)) AND "clusters"."deleted_at" IS NULL ORDER BY "clusters"."id" LIMIT 1%`
 				mocket.Catcher.Reset().NewMock().WithQuery(query).WithReply(mockedResponse)
 			},
 		},
@@ -2854,6 +3016,10 @@ func Test_clusterService_FindStreamingUnitCountByClusterAndInstanceType(t *testi
 					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, cluster_id, instance_type FROM "kafka_requests"`).
 					WithReply([]map[string]interface{}{})
 
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, migration_cluster_id as cluster_id, instance_type FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{})
+
 				mocket.Catcher.NewMock().
 					WithQuery(`SELECT * FROM "clusters"`).
 					WithReply([]map[string]interface{}{})
@@ -2900,6 +3066,20 @@ func Test_clusterService_FindStreamingUnitCountByClusterAndInstanceType(t *testi
 					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, cluster_id, instance_type FROM "kafka_requests"`).
 					WithReply(counters)
 
+				// a kafka being migrated to the first cluster
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT cloud_provider, region, count(1) as Count, size_id, migration_cluster_id as cluster_id, instance_type FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{
+							"region":         "us-east-1",
+							"instance_type":  "standard",
+							"cluster_id":     testClusterID1,
+							"cloud_provider": testKafkaRequestProvider,
+							"Count":          1,
+							"SizeId":         "x2",
+						},
+					})
+
 				mocket.Catcher.NewMock().
 					WithQuery(`SELECT * FROM "clusters"`).
 					WithReply([]map[string]interface{}{
@@ -2933,7 +3113,7 @@ func Test_clusterService_FindStreamingUnitCountByClusterAndInstanceType(t *testi
 					Region:        "us-east-1",
 					InstanceType:  "standard",
 					ClusterId:     testClusterID1,
-					Count:         12,
+					Count:         14,
 					MaxUnits:      20,
 					CloudProvider: "aws",
 				},
@@ -3111,6 +3291,35 @@ func Test_clusterService_ComputeConsumedStreamingUnitCountPerInstanceType(t *tes
 				kafkaTypes.STANDARD:  12,
 			},
 		},
+		{
+			name: "should not count the streaming units of the deleting and suspended kafkas",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			wantErr: false,
+			setupFunc: func() {
+				counters := []map[string]interface{}{
+					{
+						"instance_type": "developer",
+						"Count":         3,
+						"SizeId":        "x1",
+					},
+				}
+				mocket.Catcher.Reset().
+					NewMock().
+					WithQuery(`SELECT size_id, instance_type, count(1) as Count FROM "kafka_requests"`).
+					WithArgs("some-cluster-id", "some-cluster-id",
+						dbapi.KafkaMigrationStatusProvisioning.String(), dbapi.KafkaMigrationStatusTargetReady.String(), dbapi.KafkaMigrationStatusSourceDeleting.String(), dbapi.KafkaMigrationStatusFailed.String(),
+						constants.KafkaRequestStatusDeleting.String(), constants.KafkaRequestStatusSuspended.String()).
+					WithReply(counters)
+
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			want: StreamingUnitCountPerInstanceType{
+				kafkaTypes.DEVELOPER: 3,
+				kafkaTypes.STANDARD:  0,
+			},
+		},
 	}
 
 	for _, testcase := range tests {
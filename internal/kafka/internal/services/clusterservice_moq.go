@@ -94,6 +94,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError) {
 //				panic("mock out the ListByStatus method")
 //			},
+//			ListDrainingClustersFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+//				panic("mock out the ListDrainingClusters method")
+//			},
 //			ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError) {
 //				panic("mock out the ListEnterpriseClustersOfAnOrganization method")
 //			},
@@ -112,6 +115,9 @@ var _ ClusterService = &ClusterServiceMock{}
 //			UpdateFunc: func(cluster api.Cluster) *apiErrors.ServiceError {
 //				panic("mock out the Update method")
 //			},
+//			UpdateCordonFunc: func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+//				panic("mock out the UpdateCordon method")
+//			},
 //			UpdateMultiClusterStatusFunc: func(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError {
 //				panic("mock out the UpdateMultiClusterStatus method")
 //			},
@@ -197,6 +203,9 @@ type ClusterServiceMock struct {
 	// ListByStatusFunc mocks the ListByStatus method.
 	ListByStatusFunc func(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError)
 
+	// ListDrainingClustersFunc mocks the ListDrainingClusters method.
+	ListDrainingClustersFunc func() ([]api.Cluster, *apiErrors.ServiceError)
+
 	// ListEnterpriseClustersOfAnOrganizationFunc mocks the ListEnterpriseClustersOfAnOrganization method.
 	ListEnterpriseClustersOfAnOrganizationFunc func(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError)
 
@@ -215,6 +224,9 @@ type ClusterServiceMock struct {
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(cluster api.Cluster) *apiErrors.ServiceError
 
+	// UpdateCordonFunc mocks the UpdateCordon method.
+	UpdateCordonFunc func(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError
+
 	// UpdateMultiClusterStatusFunc mocks the UpdateMultiClusterStatus method.
 	UpdateMultiClusterStatusFunc func(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError
 
@@ -355,6 +367,9 @@ type ClusterServiceMock struct {
 			// State is the state argument value.
 			State api.ClusterStatus
 		}
+		// ListDrainingClusters holds details about calls to the ListDrainingClusters method.
+		ListDrainingClusters []struct {
+		}
 		// ListEnterpriseClustersOfAnOrganization holds details about calls to the ListEnterpriseClustersOfAnOrganization method.
 		ListEnterpriseClustersOfAnOrganization []struct {
 			// Ctx is the ctx argument value.
@@ -389,6 +404,15 @@ type ClusterServiceMock struct {
 			// Cluster is the cluster argument value.
 			Cluster api.Cluster
 		}
+		// UpdateCordon holds details about calls to the UpdateCordon method.
+		UpdateCordon []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// Cordoned is the cordoned argument value.
+			Cordoned bool
+			// Draining is the draining argument value.
+			Draining bool
+		}
 		// UpdateMultiClusterStatus holds details about calls to the UpdateMultiClusterStatus method.
 		UpdateMultiClusterStatus []struct {
 			// ClusterIDs is the clusterIDs argument value.
@@ -428,12 +452,14 @@ type ClusterServiceMock struct {
 	lockInstallStrimzi                                   sync.RWMutex
 	lockIsStrimziKafkaVersionAvailableInCluster          sync.RWMutex
 	lockListByStatus                                     sync.RWMutex
+	lockListDrainingClusters                             sync.RWMutex
 	lockListEnterpriseClustersOfAnOrganization           sync.RWMutex
 	lockListGroupByProviderAndRegion                     sync.RWMutex
 	lockListNonEnterpriseClusterIDs                      sync.RWMutex
 	lockRegisterClusterJob                               sync.RWMutex
 	lockRemoveResources                                  sync.RWMutex
 	lockUpdate                                           sync.RWMutex
+	lockUpdateCordon                                     sync.RWMutex
 	lockUpdateMultiClusterStatus                         sync.RWMutex
 	lockUpdateStatus                                     sync.RWMutex
 }
@@ -1229,6 +1255,33 @@ func (mock *ClusterServiceMock) ListByStatusCalls() []struct {
 	return calls
 }
 
+// ListDrainingClusters calls ListDrainingClustersFunc.
+func (mock *ClusterServiceMock) ListDrainingClusters() ([]api.Cluster, *apiErrors.ServiceError) {
+	if mock.ListDrainingClustersFunc == nil {
+		panic("ClusterServiceMock.ListDrainingClustersFunc: method is nil but ClusterService.ListDrainingClusters was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListDrainingClusters.Lock()
+	mock.calls.ListDrainingClusters = append(mock.calls.ListDrainingClusters, callInfo)
+	mock.lockListDrainingClusters.Unlock()
+	return mock.ListDrainingClustersFunc()
+}
+
+// ListDrainingClustersCalls gets all the calls that were made to ListDrainingClusters.
+// Check the length with:
+//
+//	len(mockedClusterService.ListDrainingClustersCalls())
+func (mock *ClusterServiceMock) ListDrainingClustersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListDrainingClusters.RLock()
+	calls = mock.calls.ListDrainingClusters
+	mock.lockListDrainingClusters.RUnlock()
+	return calls
+}
+
 // ListEnterpriseClustersOfAnOrganization calls ListEnterpriseClustersOfAnOrganizationFunc.
 func (mock *ClusterServiceMock) ListEnterpriseClustersOfAnOrganization(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError) {
 	if mock.ListEnterpriseClustersOfAnOrganizationFunc == nil {
@@ -1428,6 +1481,46 @@ func (mock *ClusterServiceMock) UpdateCalls() []struct {
 	return calls
 }
 
+// UpdateCordon calls UpdateCordonFunc.
+func (mock *ClusterServiceMock) UpdateCordon(clusterID string, cordoned bool, draining bool) *apiErrors.ServiceError {
+	if mock.UpdateCordonFunc == nil {
+		panic("ClusterServiceMock.UpdateCordonFunc: method is nil but ClusterService.UpdateCordon was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+		Cordoned  bool
+		Draining  bool
+	}{
+		ClusterID: clusterID,
+		Cordoned:  cordoned,
+		Draining:  draining,
+	}
+	mock.lockUpdateCordon.Lock()
+	mock.calls.UpdateCordon = append(mock.calls.UpdateCordon, callInfo)
+	mock.lockUpdateCordon.Unlock()
+	return mock.UpdateCordonFunc(clusterID, cordoned, draining)
+}
+
+// UpdateCordonCalls gets all the calls that were made to UpdateCordon.
+// Check the length with:
+//
+//	len(mockedClusterService.UpdateCordonCalls())
+func (mock *ClusterServiceMock) UpdateCordonCalls() []struct {
+	ClusterID string
+	Cordoned  bool
+	Draining  bool
+} {
+	var calls []struct {
+		ClusterID string
+		Cordoned  bool
+		Draining  bool
+	}
+	mock.lockUpdateCordon.RLock()
+	calls = mock.calls.UpdateCordon
+	mock.lockUpdateCordon.RUnlock()
+	return calls
+}
+
 // UpdateMultiClusterStatus calls UpdateMultiClusterStatusFunc.
 func (mock *ClusterServiceMock) UpdateMultiClusterStatus(clusterIDs []string, status api.ClusterStatus) *apiErrors.ServiceError {
 	if mock.UpdateMultiClusterStatusFunc == nil {
@@ -55,16 +55,18 @@ type DataPlaneKafkaService interface {
 }
 
 type dataPlaneKafkaService struct {
-	kafkaService   KafkaService
-	clusterService ClusterService
-	kafkaConfig    *config.KafkaConfig
+	kafkaService      KafkaService
+	clusterService    ClusterService
+	kafkaConfig       *config.KafkaConfig
+	kafkaEventService KafkaEventService
 }
 
-func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
+func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig, kafkaEventSrv KafkaEventService) *dataPlaneKafkaService {
 	return &dataPlaneKafkaService{
-		kafkaService:   kafkaSrv,
-		clusterService: clusterSrv,
-		kafkaConfig:    kafkaConfig,
+		kafkaService:      kafkaSrv,
+		clusterService:    clusterSrv,
+		kafkaConfig:       kafkaConfig,
+		kafkaEventService: kafkaEventSrv,
 	}
 }
 
@@ -147,11 +149,18 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 		glog.Error(errors.Wrapf(getErr, "failed to get kafka request by kafka ID %q", ks.KafkaClusterId))
 		return
 	}
+	if kafka.ClusterID != cluster.ClusterID && kafka.IsMigrationPeer(cluster.ClusterID) {
+		d.recordKafkaConditions(kafka, ks, cluster, log)
+		d.processKafkaMigrationPeerDeployment(kafka, ks, cluster, log)
+		return
+	}
 	if kafka.ClusterID != cluster.ClusterID {
 		log.Warningf("kafka with ID %q does not match cluster's ClusterID. kafka ClusterID = %q, cluster's ClusterID = %q", kafka.ID, kafka.ClusterID, cluster.ClusterID)
 		return
 	}
 
+	d.recordKafkaConditions(kafka, ks, cluster, log)
+
 	// Notes on state transitions
 	//  - 'suspending' state can only be set by an admin user from a 'ready' state via the /admin/kafkas/ endpoint.
 	//     This must only transition to 'resuming', 'suspended' or 'deprovision'.
@@ -219,6 +228,14 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 	}
 }
 
+// recordKafkaConditions keeps the conditions reported by the data plane in the history of the kafka.
+// Failing to record them must not prevent the status of the kafka from being updated, so the error is only logged
+func (d *dataPlaneKafkaService) recordKafkaConditions(kafka *dbapi.KafkaRequest, ks *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster, log logger.UHCLogger) {
+	if err := d.kafkaEventService.RecordConditions(kafka.ID, cluster.ClusterID, ks.Conditions); err != nil {
+		log.Error(errors.Wrapf(err, "failed to record the conditions of kafka %q", kafka.ID))
+	}
+}
+
 func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if !kafka.RoutesCreated {
 		logger.Logger.V(10).Infof("routes for kafka %q are not created", kafka.ID)
@@ -316,6 +333,13 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 
 	}
 
+	// a forced upgrade is over once the kafka runs its desired versions, further upgrades have to wait for the maintenance window again
+	if kafka.ForceUpgrade && !kafka.HasPendingUpgrade() {
+		logger.Logger.Infof("Forced upgrade of Kafka ID %q has completed", kafka.ID)
+		kafka.ForceUpgrade = false
+		needsUpdate = true
+	}
+
 	if needsUpdate {
 		versionFields := map[string]interface{}{
 			"actual_strimzi_version":   kafka.ActualStrimziVersion,
@@ -324,6 +348,7 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 			"strimzi_upgrading":        kafka.StrimziUpgrading,
 			"kafka_upgrading":          kafka.KafkaUpgrading,
 			"kafka_ibp_upgrading":      kafka.KafkaIBPUpgrading,
+			"force_upgrade":            kafka.ForceUpgrade,
 		}
 
 		if err := d.kafkaService.Updates(kafka, versionFields); err != nil {
@@ -425,6 +450,80 @@ func (d *dataPlaneKafkaService) checkKafkaRequestCurrentStatus(kafka *dbapi.Kafk
 	return matchStatus, nil
 }
 
+// processKafkaMigrationPeerDeployment handles the status of a kafka reported by the other data plane cluster of its migration.
+// The status of the kafka itself is only driven by the cluster it is assigned to, the one reported by the
+// migration cluster only drives the migration
+func (d *dataPlaneKafkaService) processKafkaMigrationPeerDeployment(kafka *dbapi.KafkaRequest, ks *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster, log logger.UHCLogger) {
+	var e *serviceError.ServiceError
+	s := d.getManagedKafkaStatus(ks)
+	switch kafka.MigrationStatus {
+	case dbapi.KafkaMigrationStatusProvisioning, dbapi.KafkaMigrationStatusTargetReady:
+		switch s {
+		case statusReady:
+			e = d.persistKafkaMigrationRoutes(kafka, ks, cluster)
+			if e == nil && kafka.MigrationRoutes != nil && kafka.MigrationStatus == dbapi.KafkaMigrationStatusProvisioning {
+				log.Infof("kafka %q is ready on the target cluster %q of its migration", kafka.ID, cluster.ClusterID)
+				e = d.kafkaService.Updates(kafka, map[string]interface{}{
+					"migration_status": dbapi.KafkaMigrationStatusTargetReady,
+				})
+			}
+		case statusInstalling:
+			e = d.persistKafkaMigrationRoutes(kafka, ks, cluster)
+		case statusError, statusRejected, statusRejectedClusterFull:
+			reason := fmt.Sprintf("kafka was rejected by the target cluster %q", cluster.ClusterID)
+			if readyCondition, ok := ks.GetReadyCondition(); ok && readyCondition.Message != "" {
+				reason = fmt.Sprintf("kafka failed on the target cluster %q: %s", cluster.ClusterID, readyCondition.Message)
+			}
+			log.Infof("migration of kafka %q failed: %s", kafka.ID, reason)
+			e = d.kafkaService.Updates(kafka, map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusFailed,
+				"migration_details": reason,
+			})
+		}
+	case dbapi.KafkaMigrationStatusSourceDeleting, dbapi.KafkaMigrationStatusFailed:
+		if s == statusDeleted || s == statusRejected || s == statusRejectedClusterFull {
+			values := map[string]interface{}{
+				"migration_cluster_id":   "",
+				"migration_placement_id": "",
+				"migration_routes":       nil,
+			}
+			if kafka.MigrationStatus == dbapi.KafkaMigrationStatusSourceDeleting {
+				log.Infof("kafka %q has been migrated from cluster %q to cluster %q", kafka.ID, cluster.ClusterID, kafka.ClusterID)
+				values["migration_status"] = dbapi.KafkaMigrationStatusNoMigration
+				values["migration_details"] = fmt.Sprintf("kafka migrated from cluster %q to cluster %q", cluster.ClusterID, kafka.ClusterID)
+			}
+			e = d.kafkaService.Updates(kafka, values)
+		}
+	}
+
+	if e != nil {
+		log.Error(errors.Wrapf(e, "Error updating migration of kafka %q reported by cluster %q", ks.KafkaClusterId, cluster.ClusterID))
+	}
+}
+
+// stores the routes reported by the target data plane cluster of a migration to the database if not already persisted
+func (d *dataPlaneKafkaService) persistKafkaMigrationRoutes(kafka *dbapi.KafkaRequest, kafkaStatus *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) *serviceError.ServiceError {
+	if kafka.MigrationRoutes != nil || len(kafkaStatus.Routes) < 1 {
+		return nil
+	}
+
+	logger.Logger.Infof("store routes information of kafka %q on the target cluster %q of its migration", kafka.ID, cluster.ClusterID)
+	routes, err := d.buildKafkaRoutesFromStatus(kafka, kafkaStatus, cluster)
+	if err != nil {
+		return err
+	}
+
+	if err := kafka.SetMigrationRoutes(routes); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set migration routes for kafka %q", kafka.ID)
+	}
+
+	if err := d.kafkaService.Updates(kafka, map[string]interface{}{"migration_routes": kafka.MigrationRoutes}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update migration routes for kafka %q", kafka.ID)
+	}
+
+	return nil
+}
+
 // stores routes reported by data plane to the database if not already persisted
 func (d *dataPlaneKafkaService) persistKafkaRoutes(kafka *dbapi.KafkaRequest, kafkaStatus *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) *serviceError.ServiceError {
 	if kafka.Routes != nil {
@@ -438,18 +537,9 @@ func (d *dataPlaneKafkaService) persistKafkaRoutes(kafka *dbapi.KafkaRequest, ka
 	}
 
 	logger.Logger.Infof("store routes information for kafka %q", kafka.ID)
-	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
+	routes, err := d.buildKafkaRoutesFromStatus(kafka, kafkaStatus, cluster)
 	if err != nil {
-		return serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for ClusterID %q", cluster.ClusterID)
-	}
-
-	routesInRequest := kafkaStatus.Routes
-	var routes []dbapi.DataPlaneKafkaRoute
-
-	var routesErr error
-	baseClusterDomain := strings.TrimPrefix(clusterDNS, fmt.Sprintf("%s.", constants.DefaultIngressDnsNamePrefix))
-	if routes, routesErr = d.buildKafkaRoutes(routesInRequest, kafka, baseClusterDomain); routesErr != nil {
-		return serviceError.NewWithCause(serviceError.ErrorBadRequest, routesErr, "routes are not valid")
+		return err
 	}
 
 	if err := kafka.SetRoutes(routes); err != nil {
@@ -463,6 +553,22 @@ func (d *dataPlaneKafkaService) persistKafkaRoutes(kafka *dbapi.KafkaRequest, ka
 	return nil
 }
 
+// buildKafkaRoutesFromStatus builds the routes of the kafka from the ones reported by the given data plane cluster
+func (d *dataPlaneKafkaService) buildKafkaRoutesFromStatus(kafka *dbapi.KafkaRequest, kafkaStatus *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) ([]dbapi.DataPlaneKafkaRoute, *serviceError.ServiceError) {
+	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
+	if err != nil {
+		return nil, serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for ClusterID %q", cluster.ClusterID)
+	}
+
+	baseClusterDomain := strings.TrimPrefix(clusterDNS, fmt.Sprintf("%s.", constants.DefaultIngressDnsNamePrefix))
+	routes, routesErr := d.buildKafkaRoutes(kafkaStatus.Routes, kafka, baseClusterDomain)
+	if routesErr != nil {
+		return nil, serviceError.NewWithCause(serviceError.ErrorBadRequest, routesErr, "routes are not valid")
+	}
+
+	return routes, nil
+}
+
 func (d *dataPlaneKafkaService) getManagedKafkaStatus(status *dbapi.DataPlaneKafkaStatus) managedKafkaStatus {
 	for _, c := range status.Conditions {
 		if strings.EqualFold(c.Type, "Ready") {
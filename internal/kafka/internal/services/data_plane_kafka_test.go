@@ -723,7 +723,11 @@ func Test_dataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 				"rejected":  0,
 				"suspended": 0,
 			}
-			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, &config.KafkaConfig{}, &KafkaEventServiceMock{
+				RecordConditionsFunc: func(kafkaID, clusterID string, conditions []dbapi.DataPlaneKafkaStatusCondition) *errors.ServiceError {
+					return nil
+				},
+			})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.args.clusterId, tt.args.status)
 			g.Expect(err).To(gomega.Equal(tt.want))
 			g.Expect(counter).To(gomega.Equal(tt.expectCounters))
@@ -739,6 +743,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 		strimziUpgrading      bool
 		kafkaUpgrading        bool
 		kafkaIBPUpgrading     bool
+		forceUpgrade          bool
 	}
 
 	tests := []struct {
@@ -777,6 +782,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 						v.strimziUpgrading = kafkaRequest.StrimziUpgrading
 						v.kafkaUpgrading = kafkaRequest.KafkaUpgrading
 						v.kafkaIBPUpgrading = kafkaRequest.KafkaIBPUpgrading
+						v.forceUpgrade = kafkaRequest.ForceUpgrade
 						return nil
 					},
 					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
@@ -842,6 +848,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 						v.strimziUpgrading = kafkaRequest.StrimziUpgrading
 						v.kafkaUpgrading = kafkaRequest.KafkaUpgrading
 						v.kafkaIBPUpgrading = kafkaRequest.KafkaIBPUpgrading
+						v.forceUpgrade = kafkaRequest.ForceUpgrade
 						return nil
 					},
 					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
@@ -900,6 +907,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 						v.strimziUpgrading = kafkaRequest.StrimziUpgrading
 						v.kafkaUpgrading = kafkaRequest.KafkaUpgrading
 						v.kafkaIBPUpgrading = kafkaRequest.KafkaIBPUpgrading
+						v.forceUpgrade = kafkaRequest.ForceUpgrade
 						return nil
 					},
 					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
@@ -959,6 +967,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 						v.strimziUpgrading = kafkaRequest.StrimziUpgrading
 						v.kafkaUpgrading = kafkaRequest.KafkaUpgrading
 						v.kafkaIBPUpgrading = kafkaRequest.KafkaIBPUpgrading
+						v.forceUpgrade = kafkaRequest.ForceUpgrade
 						return nil
 					},
 					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
@@ -994,6 +1003,74 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 				kafkaIBPUpgrading:     true,
 			},
 		},
+		{
+			name: "should reset the forced upgrade once the kafka runs its desired versions",
+			clusterService: &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: "test-cluster-id"}, nil
+				},
+			},
+			kafkaService: func(v *versions) KafkaService {
+				return &KafkaServiceMock{
+					GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{
+							ClusterID:              "test-cluster-id",
+							Status:                 constants.KafkaRequestStatusReady.String(),
+							Routes:                 []byte("[{'domain':'test.example.com', 'router':'test.example.com'}]"),
+							RoutesCreated:          true,
+							ActualKafkaVersion:     "kafka-1",
+							ActualStrimziVersion:   "strimzi-1",
+							ActualKafkaIBPVersion:  "kafka-ibp-1",
+							DesiredKafkaVersion:    "kafka-2",
+							DesiredStrimziVersion:  "strimzi-1",
+							DesiredKafkaIBPVersion: "kafka-ibp-1",
+							KafkaUpgrading:         true,
+							ForceUpgrade:           true,
+						}, nil
+					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
+						v.actualKafkaVersion = kafkaRequest.ActualKafkaVersion
+						v.actualKafkaIBPVersion = kafkaRequest.ActualKafkaIBPVersion
+						v.actualStrimziVersion = kafkaRequest.ActualStrimziVersion
+						v.strimziUpgrading = kafkaRequest.StrimziUpgrading
+						v.kafkaUpgrading = kafkaRequest.KafkaUpgrading
+						v.kafkaIBPUpgrading = kafkaRequest.KafkaIBPUpgrading
+						v.forceUpgrade = kafkaRequest.ForceUpgrade
+						return nil
+					},
+					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
+						return true, nil
+					},
+					DeleteFunc: func(in1 *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+				}
+			},
+			clusterId: "test-cluster-id",
+			status: []*dbapi.DataPlaneKafkaStatus{
+				{
+					Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+						{
+							Type:   "Ready",
+							Status: "True",
+						},
+					},
+					KafkaVersion:    "kafka-2",
+					StrimziVersion:  "strimzi-1",
+					KafkaIBPVersion: "kafka-ibp-1",
+				},
+			},
+			wantErr: false,
+			expectedVersions: versions{
+				actualKafkaVersion:    "kafka-2",
+				actualStrimziVersion:  "strimzi-1",
+				actualKafkaIBPVersion: "kafka-ibp-1",
+				strimziUpgrading:      false,
+				kafkaUpgrading:        false,
+				kafkaIBPUpgrading:     false,
+				forceUpgrade:          false,
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -1002,7 +1079,11 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			v := versions{}
-			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{}, &KafkaEventServiceMock{
+				RecordConditionsFunc: func(kafkaID, clusterID string, conditions []dbapi.DataPlaneKafkaStatusCondition) *errors.ServiceError {
+					return nil
+				},
+			})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterId, tt.status)
 			if err != nil && !tt.wantErr {
 				t.Errorf("unexpected error %v", err)
@@ -1736,3 +1817,159 @@ func Test_dataPlaneKafkaService_processReservedKafkaDeployment(t *testing.T) {
 		})
 	}
 }
+
+func Test_dataPlaneKafkaService_UpdateDataPlaneKafkaService_MigrationPeer(t *testing.T) {
+	sourceClusterID := "source-cluster-id"
+	targetClusterID := "target-cluster-id"
+	bootstrapServer := "test.kafka.example.com"
+	clusterDNS := "target.example.com"
+
+	migratingKafka := func(migrationStatus dbapi.KafkaMigrationStatus, migrationRoutes api.JSON) *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:                api.Meta{ID: "test-kafka-id"},
+			ClusterID:           sourceClusterID,
+			Status:              constants.KafkaRequestStatusReady.String(),
+			BootstrapServerHost: bootstrapServer,
+			Routes:              api.JSON(`[{"Domain":"test.kafka.example.com","Router":"router.source.example.com"}]`),
+			RoutesCreated:       true,
+			MigrationStatus:     migrationStatus,
+			MigrationClusterID:  targetClusterID,
+			MigrationRoutes:     migrationRoutes,
+		}
+	}
+
+	readyStatus := &dbapi.DataPlaneKafkaStatus{
+		KafkaClusterId: "test-kafka-id",
+		Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+			{Type: "Ready", Status: "True"},
+		},
+		Routes: []dbapi.DataPlaneKafkaRouteRequest{
+			{Name: "bootstrap", Prefix: "", Router: fmt.Sprintf("router.%s", clusterDNS)},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		kafka      *dbapi.KafkaRequest
+		clusterID  string
+		status     *dbapi.DataPlaneKafkaStatus
+		wantValues []map[string]interface{}
+	}{
+		{
+			name:      "should store the routes of the kafka on the target cluster and mark the target as ready",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusProvisioning, nil),
+			clusterID: targetClusterID,
+			status:    readyStatus,
+			wantValues: []map[string]interface{}{
+				{"migration_routes": api.JSON(`[{"Domain":"test.kafka.example.com","Router":"router.target.example.com"}]`)},
+				{"migration_status": dbapi.KafkaMigrationStatusTargetReady},
+			},
+		},
+		{
+			name:      "should not update the kafka when the target is ready and its routes are already stored",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusTargetReady, api.JSON(`[{"Domain":"test.kafka.example.com","Router":"router.target.example.com"}]`)),
+			clusterID: targetClusterID,
+			status:    readyStatus,
+		},
+		{
+			name:      "should fail the migration when the kafka fails on the target cluster",
+			kafka:     migratingKafka(dbapi.KafkaMigrationStatusProvisioning, nil),
+			clusterID: targetClusterID,
+			status: &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: "test-kafka-id",
+				Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+					{Type: "Ready", Status: "False", Reason: "Error", Message: "insufficient resources"},
+				},
+			},
+			wantValues: []map[string]interface{}{
+				{
+					"migration_status":  dbapi.KafkaMigrationStatusFailed,
+					"migration_details": `kafka failed on the target cluster "target-cluster-id": insufficient resources`,
+				},
+			},
+		},
+		{
+			name: "should complete the migration once the kafka is deleted from the source cluster",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := migratingKafka(dbapi.KafkaMigrationStatusSourceDeleting, nil)
+				kafka.ClusterID, kafka.MigrationClusterID = targetClusterID, sourceClusterID
+				return kafka
+			}(),
+			clusterID: sourceClusterID,
+			status: &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: "test-kafka-id",
+				Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+					{Type: "Ready", Status: "False", Reason: "Deleted"},
+				},
+			},
+			wantValues: []map[string]interface{}{
+				{
+					"migration_cluster_id":   "",
+					"migration_placement_id": "",
+					"migration_routes":       nil,
+					"migration_status":       dbapi.KafkaMigrationStatusNoMigration,
+					"migration_details":      `kafka migrated from cluster "source-cluster-id" to cluster "target-cluster-id"`,
+				},
+			},
+		},
+		{
+			name: "should release the target cluster once the kafka of a failed migration is deleted from it",
+			kafka: func() *dbapi.KafkaRequest {
+				kafka := migratingKafka(dbapi.KafkaMigrationStatusFailed, nil)
+				kafka.MigrationDetails = "insufficient resources"
+				return kafka
+			}(),
+			clusterID: targetClusterID,
+			status: &dbapi.DataPlaneKafkaStatus{
+				KafkaClusterId: "test-kafka-id",
+				Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+					{Type: "Ready", Status: "False", Reason: "Deleted"},
+				},
+			},
+			wantValues: []map[string]interface{}{
+				{
+					"migration_cluster_id":   "",
+					"migration_placement_id": "",
+					"migration_routes":       nil,
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &KafkaServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return tt.kafka, nil
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+			}
+			clusterService := &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID}, nil
+				},
+				GetClusterDNSFunc: func(clusterID string) (string, *errors.ServiceError) {
+					return clusterDNS, nil
+				},
+			}
+
+			s := NewDataPlaneKafkaService(kafkaService, clusterService, &config.KafkaConfig{}, &KafkaEventServiceMock{
+				RecordConditionsFunc: func(kafkaID, clusterID string, conditions []dbapi.DataPlaneKafkaStatusCondition) *errors.ServiceError {
+					return nil
+				},
+			})
+			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterID, []*dbapi.DataPlaneKafkaStatus{tt.status})
+			g.Expect(err).To(gomega.BeNil())
+
+			calls := kafkaService.UpdatesCalls()
+			g.Expect(calls).To(gomega.HaveLen(len(tt.wantValues)))
+			for i, values := range tt.wantValues {
+				g.Expect(calls[i].Values).To(gomega.Equal(values))
+			}
+		})
+	}
+}
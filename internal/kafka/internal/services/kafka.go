@@ -10,10 +10,10 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
@@ -28,18 +28,18 @@ import (
 	"github.com/golang/glog"
 
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 )
 
 var kafkaDeletionStatuses = []string{constants.KafkaRequestStatusDeleting.String(), constants.KafkaRequestStatusDeprovision.String()}
+var kafkaSuspensionStatuses = []string{constants.KafkaRequestStatusSuspending.String(), constants.KafkaRequestStatusSuspended.String()}
 var kafkaManagedCRStatuses = []string{
 	constants.KafkaRequestStatusProvisioning.String(),
 	constants.KafkaRequestStatusDeprovision.String(),
@@ -59,14 +59,15 @@ func (a KafkaRoutesAction) String() string {
 const (
 	KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
 	KafkaRoutesActionDelete KafkaRoutesAction = "DELETE"
+	// KafkaRoutesActionUpsert creates the records or updates them if they already exist, e.g. when a kafka is migrated to another cluster
+	KafkaRoutesActionUpsert KafkaRoutesAction = "UPSERT"
 )
 
 const CanaryServiceAccountPrefix = "canary"
 
-type CNameRecordStatus struct {
-	Id     *string
-	Status *string
-}
+// kafkaLabelValueQuery selects the value of a label of the kafka with the key bound to its placeholder, so that the kafkas
+// can be searched by label e.g. with `labels.env = 'prod'`
+const kafkaLabelValueQuery = "(SELECT kafka_labels.value FROM kafka_labels WHERE kafka_labels.kafka_id = kafka_requests.id AND kafka_labels.key = ?)"
 
 //go:generate moq -out kafkaservice_moq.go . KafkaService
 type KafkaService interface {
@@ -87,7 +88,19 @@ type KafkaService interface {
 	// Lists all kafkas. As this returns all Kafka requests without need for authentication, this should only be used for internal purposes
 	ListAll() (dbapi.KafkaList, *errors.ServiceError)
 	ListKafkasToBePromoted() ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// ListKafkasToBeMigrated returns the kafkas whose migration to another data plane cluster has to be progressed by the
+	// fleet manager, i.e. the ones whose migration is pending or whose kafka is ready on the target cluster
+	ListKafkasToBeMigrated() ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// ListKafkasOnCluster returns the kafkas deployed on the given data plane cluster, including the ones being migrated to
+	// or from it
+	ListKafkasOnCluster(clusterID string) ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// GetManagedKafkaByClusterID returns the managed kafkas to be reconciled by the given data plane cluster.
+	// Kafkas being migrated to or from the cluster are returned with the placement id they have on the cluster
 	GetManagedKafkaByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError)
+	// GetManagedKafkaChangesByClusterID returns the changes of the managed kafkas, including the reserved ones, of the given data
+	// plane cluster since the given resource version of them. All the managed kafkas are returned when the resource version
+	// is empty or can no longer be used to compute the changes
+	GetManagedKafkaChangesByClusterID(clusterID string, sinceResourceVersion string) (*ManagedKafkaChanges, *errors.ServiceError)
 	// GenerateReservedManagedKafkasByClusterID returns a list of reserved managed
 	// kafkas for a given clusterID. The number of generated reserved managed
 	// kafkas in the cluster is the sum of the specified number of reserved
@@ -111,8 +124,23 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
-	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	// Resize changes the size of the kafka to another size of its instance type. The data plane cluster of the kafka
+	// must have the remaining capacity needed by the new size, and the quota it needs is reserved before the kafka is updated.
+	// The limits of the new size are sent to the data plane cluster with the ManagedKafka of the kafka
+	Resize(kafkaRequest *dbapi.KafkaRequest, sizeID string) *errors.ServiceError
+	// Suspend starts the suspension of the kafka for the given reason. The status of the kafka is set to suspending until the
+	// data plane cluster reports that it has been suspended. The kafka is only suspended while it still has the status it was
+	// read with, so that a kafka whose status changed in the meantime, e.g. a kafka being deleted, is not overwritten
+	Suspend(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError
+	// Resume starts the resumption of a suspending or suspended kafka. A suspended kafka no longer consumes the capacity of
+	// its data plane cluster: the cluster must have the remaining capacity needed by its size for it to be resumed
+	Resume(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// UpdateLabels replaces the labels of the kafka with the given ones
+	UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *errors.ServiceError
+	// ChangeKafkaCNAMErecords applies the action to the CNAME records of the routes of the kafka with the configured DNS provider
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*KafkaDNSChange, *errors.ServiceError)
+	// GetCNAMERecordStatus returns the change of the CNAME records of the kafka tracked by its RoutesCreationId
+	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*KafkaDNSChange, error)
 	AssignInstanceType(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError)
 	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
@@ -142,25 +170,23 @@ type kafkaService struct {
 	clusterService           ClusterService
 	keycloakService          sso.KeycloakService
 	kafkaConfig              *config.KafkaConfig
-	awsConfig                *config.AWSConfig
+	dnsProvider              KafkaDNSProvider
 	quotaServiceFactory      QuotaServiceFactory
 	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
 	authService              authorization.Authorization
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	providerConfig           *config.ProviderConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
 }
 
-func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
+func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, dnsProvider KafkaDNSProvider, quotaServiceFactory QuotaServiceFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
 	return &kafkaService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
 		keycloakService:          keycloakService,
 		kafkaConfig:              kafkaConfig,
-		awsConfig:                awsConfig,
+		dnsProvider:              dnsProvider,
 		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
 		authService:              authorizationService,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		providerConfig:           providerConfig,
@@ -454,7 +480,14 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 	// the API is restarted this time changing the --quota-type flag to quota-management-list, when kafka A is deleted at this point,
 	// we want to use the correct quota to perform the deletion.
 	kafkaRequest.QuotaType = k.kafkaConfig.Quota.Type
-	if err := dbConn.Create(kafkaRequest).Error; err != nil {
+	if err := dbConn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(kafkaRequest).Error; err != nil {
+			return err
+		}
+
+		transition := kafkaStatusTransition{status: kafkaRequest.Status, actor: kafkaRequest.Owner}
+		return tx.Create(newKafkaStatusEvent(kafkaRequest.ID, "", transition, timeNow)).Error
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create kafka request") //hide the db error to http caller
 	}
 
@@ -568,6 +601,45 @@ func (k *kafkaService) ListKafkasToBePromoted() ([]*dbapi.KafkaRequest, *errors.
 	return kafkas, nil
 }
 
+func (k *kafkaService) ListKafkasToBeMigrated() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+
+	migrationStatuses := []dbapi.KafkaMigrationStatus{
+		dbapi.KafkaMigrationStatusPending,
+		dbapi.KafkaMigrationStatusTargetReady,
+	}
+
+	var kafkas []*dbapi.KafkaRequest
+
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).
+		Where("migration_status in ?", migrationStatuses).
+		Where("status not in ?", kafkaDeletionStatuses).
+		Scan(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafkas to be migrated")
+	}
+
+	return kafkas, nil
+}
+
+func (k *kafkaService) ListKafkasOnCluster(clusterID string) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	if clusterID == "" {
+		return nil, errors.Validation("clusterID is undefined")
+	}
+
+	dbConn := k.connectionFactory.New()
+
+	var kafkas []*dbapi.KafkaRequest
+
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).
+		Where("cluster_id = ? OR migration_cluster_id = ?", clusterID, clusterID).
+		Order("created_at asc").
+		Scan(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafkas on cluster %q", clusterID)
+	}
+
+	return kafkas, nil
+}
+
 func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
 	if id == "" {
 		return nil, errors.Validation("id is undefined")
@@ -599,7 +671,7 @@ func (k *kafkaService) Get(ctx context.Context, id string) (*dbapi.KafkaRequest,
 	}
 
 	var kafkaRequest dbapi.KafkaRequest
-	if err := dbConn.First(&kafkaRequest).Error; err != nil {
+	if err := dbConn.Preload("Labels").First(&kafkaRequest).Error; err != nil {
 		resourceTypeStr := "KafkaResource"
 		if user != "" {
 			resourceTypeStr = fmt.Sprintf("%s for user %s", resourceTypeStr, user)
@@ -654,7 +726,8 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 
 	deprovisionStatus := constants.KafkaRequestStatusDeprovision
 
-	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
+	actor, _ := claims.GetUsername()
+	if executed, err := k.updateStatus(id, deprovisionStatus, actor); executed {
 		if err != nil {
 			return services.HandleGetError("KafkaResource", "id", id, err)
 		}
@@ -666,21 +739,27 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 }
 
 func (k *kafkaService) DeprovisionKafkaForUsers(users []string) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(&dbapi.KafkaRequest{}).
-		Where("owner IN (?)", users).
-		Where("status NOT IN (?)", kafkaDeletionStatuses).
-		Update("status", constants.KafkaRequestStatusDeprovision)
+	transition := kafkaStatusTransition{
+		scope: func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.Where("owner IN (?)", users).Where("status NOT IN (?)", kafkaDeletionStatuses)
+		},
+		status: constants.KafkaRequestStatusDeprovision.String(),
+		reason: "the owner of the kafka is not allowed to use the service",
+	}
 
-	err := dbConn.Error
+	rowsAffected, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&dbapi.KafkaRequest{}).
+			Scopes(transition.scope).
+			Update("status", constants.KafkaRequestStatusDeprovision)
+	})
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision kafka requests for users")
 	}
 
-	if dbConn.RowsAffected >= 1 {
-		glog.Infof("%v kafkas are now deprovisioning for users %v", dbConn.RowsAffected, users)
+	if rowsAffected >= 1 {
+		glog.Infof("%v kafkas are now deprovisioning for users %v", rowsAffected, users)
 		var counter int64 = 0
-		for ; counter < dbConn.RowsAffected; counter++ {
+		for ; counter < rowsAffected; counter++ {
 			metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
 			metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationDeprovision)
 		}
@@ -726,16 +805,25 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 
 	if len(kafkasToDeprovisionIDs) > 0 {
 		glog.V(10).Infof("Kafka IDs to mark with status %s: %+v", constants.KafkaRequestStatusDeprovision, kafkasToDeprovisionIDs)
-		db = dbConn.Where("id IN (?)", kafkasToDeprovisionIDs).
-			Updates(map[string]interface{}{"status": constants.KafkaRequestStatusDeprovision})
-		err = db.Error
+		transition := kafkaStatusTransition{
+			scope: func(dbConn *gorm.DB) *gorm.DB {
+				return dbConn.Where("id IN (?)", kafkasToDeprovisionIDs)
+			},
+			status: constants.KafkaRequestStatusDeprovision.String(),
+			reason: "the kafka has expired",
+		}
+		rowsAffected, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+			return tx.Model(&dbapi.KafkaRequest{}).
+				Scopes(transition.scope).
+				Updates(map[string]interface{}{"status": constants.KafkaRequestStatusDeprovision})
+		})
 		if err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision expired kafkas")
 		}
-		if db.RowsAffected >= 1 {
-			glog.Infof("%v expired kafka_request's have had their status updated to deprovisioning", db.RowsAffected)
+		if rowsAffected >= 1 {
+			glog.Infof("%v expired kafka_request's have had their status updated to deprovisioning", rowsAffected)
 			var counter int64 = 0
-			for ; counter < db.RowsAffected; counter++ {
+			for ; counter < rowsAffected; counter++ {
 				metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
 				metrics.IncreaseKafkaSuccessOperationsCountMetric(constants.KafkaOperationDeprovision)
 			}
@@ -779,8 +867,13 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 		}
 	}
 
-	// soft delete the kafka request
-	if err := dbConn.Delete(kafkaRequest).Error; err != nil {
+	// delete the labels of the kafka request, which are not soft deleted, along with the soft deletion of the kafka request
+	if err := dbConn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("kafka_id = ?", kafkaRequest.ID).Delete(&dbapi.KafkaLabel{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(kafkaRequest).Error
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to delete kafka request with id %s", kafkaRequest.ID)
 	}
 
@@ -836,7 +929,7 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchDbQuery, err := coreServices.NewQueryParserWithLabels("labels", kafkaLabelValueQuery).Parse(listArgs.Search)
 		if err != nil {
 			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list kafka requests: %s", err.Error())
 		}
@@ -850,7 +943,7 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 
 	// Set the order by arguments if any
 	for _, orderByArg := range listArgs.OrderBy {
-		dbConn = dbConn.Order(orderByArg)
+		dbConn = dbConn.Order(kafkaOrderByColumn(orderByArg))
 	}
 
 	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
@@ -862,19 +955,91 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 	}
 	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
+	// only read the columns the selected fields are presented from
+	if len(listArgs.Fields) > 0 {
+		dbConn = dbConn.Select(kafkaSelectColumns(listArgs.Fields))
+	}
+
 	// execute query
-	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
+	if err := dbConn.Preload("Labels").Find(&kafkaRequestList).Error; err != nil {
 		return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
 	return kafkaRequestList, pagingMeta, nil
 }
 
+// kafkaOrderByColumns maps the fields of a kafka, as returned by the API, to the columns they are ordered by
+// when they are not stored in a column of the same name
+var kafkaOrderByColumns = map[string]string{
+	"href":    "id",
+	"version": "actual_kafka_version",
+}
+
+// kafkaOrderByColumn returns the order by clause of the kafka_requests table for the validated order by argument of the API
+func kafkaOrderByColumn(orderByArg string) string {
+	keywords := strings.Fields(orderByArg)
+	if len(keywords) == 0 {
+		return orderByArg
+	}
+	if column, ok := kafkaOrderByColumns[strings.ToLower(keywords[0])]; ok {
+		keywords[0] = column
+	}
+	return strings.Join(keywords, " ")
+}
+
+// kafkaPresentedColumns are always selected when only some of the fields of the kafkas are listed: the labels are loaded
+// by id, and the kafkas cannot be presented without their storage size, organisation and instance size
+var kafkaPresentedColumns = []string{"id", "kafka_storage_size", "organisation_id", "instance_type", "size_id"}
+
+// kafkaFieldColumns maps the fields of a kafka, as returned by the API, to the columns they are presented from when they
+// are not stored in a column of the same name. The fields computed from the presented columns or the configuration have none
+var kafkaFieldColumns = map[string][]string{
+	"kind":                            {},
+	"href":                            {},
+	"browser_url":                     {},
+	"labels":                          {},
+	"account_number":                  {},
+	"version":                         {"actual_kafka_version"},
+	"billing_model":                   {"actual_kafka_billing_model"},
+	"max_data_retention_size":         {},
+	"cluster_id":                      {"cluster_id", "desired_kafka_billing_model"},
+	"instance_type_name":              {},
+	"ingress_throughput_per_sec":      {},
+	"egress_throughput_per_sec":       {},
+	"total_max_connections":           {},
+	"max_partitions":                  {},
+	"max_data_retention_period":       {},
+	"max_connection_attempts_per_sec": {},
+}
+
+// kafkaSelectColumns returns the columns of the kafka_requests table to select to present the validated fields of the API
+func kafkaSelectColumns(fields []string) []string {
+	columns := append([]string{}, kafkaPresentedColumns...)
+	for _, field := range fields {
+		fieldColumns, ok := kafkaFieldColumns[field]
+		if !ok {
+			fieldColumns = []string{field}
+		}
+		for _, column := range fieldColumns {
+			if !arrays.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
 func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New().
-		Where("cluster_id = ?", clusterID).
-		Where("status IN (?)", kafkaManagedCRStatuses).
-		Where("bootstrap_server_host != ''")
+	return k.getManagedKafkasByClusterID(clusterID)
+}
+
+// getManagedKafkasByClusterID returns the managed kafkas to be reconciled by the given data plane cluster, restricted to the
+// kafkas with the given ids when there are some
+func (k *kafkaService) getManagedKafkasByClusterID(clusterID string, kafkaIDs ...string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
+	dbConn := managedKafkasByClusterIDScope(k.connectionFactory.New(), clusterID)
+	if len(kafkaIDs) > 0 {
+		dbConn = dbConn.Where("id IN (?)", kafkaIDs)
+	}
 
 	var kafkaRequestList dbapi.KafkaList
 	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
@@ -888,12 +1053,31 @@ func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedka
 		if err != nil {
 			return nil, err
 		}
+
+		// during a migration the kafka is also provisioned on the other cluster of the migration, with its own placement id.
+		// It is deleted from the source cluster once the DNS records pointing to the target cluster are in sync,
+		// and from the target cluster if the migration failed
+		if kafkaRequest.ClusterID != clusterID && kafkaRequest.IsMigrationPeer(clusterID) {
+			mk.Annotations["bf2.org/placementId"] = kafkaRequest.MigrationPlacementId
+			mk.Spec.Deleted = mk.Spec.Deleted ||
+				(kafkaRequest.MigrationStatus == dbapi.KafkaMigrationStatusSourceDeleting && kafkaRequest.RoutesCreated) ||
+				kafkaRequest.MigrationStatus == dbapi.KafkaMigrationStatusFailed
+		}
+
 		res = append(res, *mk)
 	}
 
 	return res, nil
 }
 
+// managedKafkasByClusterIDScope selects the kafkas that have a ManagedKafka CR on the given data plane cluster
+func managedKafkasByClusterIDScope(dbConn *gorm.DB, clusterID string) *gorm.DB {
+	return dbConn.
+		Where("cluster_id = ? OR (migration_cluster_id = ? AND migration_status IN (?))", clusterID, clusterID, dbapi.KafkaMigrationStatusesWithTargetCapacity).
+		Where("status IN (?)", kafkaManagedCRStatuses).
+		Where("bootstrap_server_host != ''")
+}
+
 func (k *kafkaService) GenerateReservedManagedKafkasByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError) {
 	reservedKafkas := []managedkafka.ManagedKafka{}
 	cluster, svcErr := k.clusterService.FindClusterByID(clusterID)
@@ -940,29 +1124,280 @@ func (k *kafkaService) GenerateReservedManagedKafkasByClusterID(clusterID string
 }
 
 func (k *kafkaService) Update(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
-
-	if err := dbConn.Updates(kafkaRequest).Error; err != nil {
+	transition := kafkaStatusTransition{
+		scope:  kafkaNotUnderDeletionScope(kafkaRequest.ID),
+		status: kafkaRequest.Status,
+		reason: kafkaStatusTransitionReason(kafkaRequest.Status, kafkaRequest.FailedReason),
+	}
+
+	if _, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		// the associations, e.g. the labels, are not saved: they are only changed through their own methods, such as UpdateLabels,
+		// so that a kafka loaded before they changed does not put back their previous values
+		return tx.Model(kafkaRequest).
+			Omit(clause.Associations).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(kafkaRequest)
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
 	return nil
 }
 
+func (k *kafkaService) UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *errors.ServiceError {
+	for i := range labels {
+		labels[i].KafkaID = kafkaRequest.ID
+	}
+
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("kafka_id = ?", kafkaRequest.ID).Delete(&dbapi.KafkaLabel{}).Error; err != nil {
+			return err
+		}
+		if len(labels) == 0 {
+			return nil
+		}
+		return tx.Create(&labels).Error
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the labels of kafka %q", kafkaRequest.ID)
+	}
+
+	kafkaRequest.Labels = labels
+	return nil
+}
+
 func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
+	transition := kafkaStatusTransition{scope: kafkaNotUnderDeletionScope(kafkaRequest.ID)}
+	if status, ok := fields["status"]; ok {
+		transition.status = fmt.Sprint(status)
+		failedReason := kafkaRequest.FailedReason
+		if reason, ok := fields["failed_reason"]; ok {
+			failedReason = fmt.Sprint(reason)
+		}
+		transition.reason = kafkaStatusTransitionReason(transition.status, failedReason)
+	}
 
-	if err := dbConn.Updates(fields).Error; err != nil {
+	if _, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(kafkaRequest).
+			Omit(clause.Associations).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(fields)
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
 	return nil
 }
 
+func (k *kafkaService) Resize(kafkaRequest *dbapi.KafkaRequest, sizeID string) *errors.ServiceError {
+	if kafkaRequest.SizeId == sizeID {
+		return nil
+	}
+
+	if kafkaRequest.Status != constants.KafkaRequestStatusReady.String() {
+		return errors.BadRequest("kafka %q cannot be resized while its status is %q", kafkaRequest.ID, kafkaRequest.Status)
+	}
+
+	currentSize, err := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to resize kafka %q", kafkaRequest.ID)
+	}
+
+	newSize, err := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, sizeID)
+	if err != nil {
+		return errors.InstancePlanNotSupported("unsupported size id %q for instance type %q", sizeID, kafkaRequest.InstanceType)
+	}
+
+	// prevents the capacity and the quota from being consumed by kafkas registered at the same time
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if additionalStreamingUnits := newSize.CapacityConsumed - currentSize.CapacityConsumed; additionalStreamingUnits > 0 {
+		if capacityErr := k.checkClusterCapacity(kafkaRequest, int64(additionalStreamingUnits)); capacityErr != nil {
+			return capacityErr
+		}
+	}
+
+	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(kafkaRequest.QuotaType))
+	if factoryErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
+	}
+
+	subscriptionID, quotaErr := quotaService.ReserveQuotaForResize(kafkaRequest, sizeID)
+	if quotaErr != nil {
+		return quotaErr
+	}
+
+	previous := *kafkaRequest
+	kafkaRequest.SizeId = sizeID
+	kafkaRequest.SubscriptionId = subscriptionID
+	// the storage of a kafka cannot be shrunk: it is only increased to the one of the new size
+	if storageIncreased, _ := isStorageSizeIncreased(kafkaRequest.KafkaStorageSize, newSize.MaxDataRetentionSize); storageIncreased {
+		kafkaRequest.KafkaStorageSize = newSize.MaxDataRetentionSize.String()
+	}
+
+	if updateErr := k.Updates(kafkaRequest, map[string]interface{}{
+		"size_id":            kafkaRequest.SizeId,
+		"subscription_id":    kafkaRequest.SubscriptionId,
+		"kafka_storage_size": kafkaRequest.KafkaStorageSize,
+	}); updateErr != nil {
+		// the quota reserved for the new size is no longer needed
+		if subscriptionID != "" && subscriptionID != previous.SubscriptionId {
+			if deleteErr := quotaService.DeleteQuota(subscriptionID); deleteErr != nil {
+				logger.Logger.Errorf("failed to delete the quota %q reserved for the resize of kafka %q: %v", subscriptionID, kafkaRequest.ID, deleteErr)
+			}
+		}
+		kafkaRequest.SizeId = previous.SizeId
+		kafkaRequest.SubscriptionId = previous.SubscriptionId
+		kafkaRequest.KafkaStorageSize = previous.KafkaStorageSize
+		return updateErr
+	}
+
+	return nil
+}
+
+// checkClusterCapacity checks that the data plane cluster of the kafka has the remaining capacity needed by the
+// given additional streaming units
+func (k *kafkaService) checkClusterCapacity(kafkaRequest *dbapi.KafkaRequest, additionalStreamingUnits int64) *errors.ServiceError {
+	cluster, err := k.clusterService.FindClusterByID(kafkaRequest.ClusterID)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to check the capacity of the data plane cluster of kafka %q", kafkaRequest.ID)
+	}
+	if cluster == nil {
+		return errors.GeneralError("unable to check the capacity of the data plane cluster of kafka %q: cluster %q not found", kafkaRequest.ID, kafkaRequest.ClusterID)
+	}
+
+	capacityInfo, ok := cluster.RetrieveDynamicCapacityInfo()[kafkaRequest.InstanceType]
+	if !ok {
+		return errors.TooManyKafkaInstancesReached("cluster %q cannot accept %d more streaming units of instance type %q at this moment", cluster.ClusterID, additionalStreamingUnits, kafkaRequest.InstanceType)
+	}
+
+	streamingUnitCounts, countErr := k.clusterService.ComputeConsumedStreamingUnitCountPerInstanceType(cluster.ClusterID)
+	if countErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, countErr, "unable to check the capacity of the data plane cluster of kafka %q", kafkaRequest.ID)
+	}
+
+	consumedStreamingUnits := streamingUnitCounts[types.KafkaInstanceType(kafkaRequest.InstanceType)]
+	if consumedStreamingUnits+additionalStreamingUnits > int64(capacityInfo.MaxUnits) {
+		return errors.TooManyKafkaInstancesReached("cluster %q cannot accept %d more streaming units of instance type %q at this moment", cluster.ClusterID, additionalStreamingUnits, kafkaRequest.InstanceType)
+	}
+
+	return nil
+}
+
+func (k *kafkaService) Suspend(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+	currentStatus := kafkaRequest.Status
+	transition := kafkaStatusTransition{
+		scope: func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.Where("id = ?", kafkaRequest.ID).Where("status = ?", currentStatus)
+		},
+		status: constants.KafkaRequestStatusSuspending.String(),
+		reason: reason.String(),
+		actor:  kafkaStatusTransitionActor(ctx),
+	}
+
+	// the kafka request is only updated once the kafka has been suspended in the database
+	rowsAffected, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+			Where("status = ?", currentStatus). // the kafka may have been deleted or its status changed in the meantime
+			Updates(map[string]interface{}{
+				"status":            transition.status,
+				"suspension_reason": reason,
+			})
+	})
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to suspend kafka %q", kafkaRequest.ID)
+	}
+	if rowsAffected == 0 {
+		return errors.BadRequest("kafka %q cannot be suspended as its status is no longer %q", kafkaRequest.ID, currentStatus)
+	}
+
+	kafkaRequest.Status = transition.status
+	kafkaRequest.SuspensionReason = reason
+
+	return nil
+}
+
+func (k *kafkaService) Resume(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	size, err := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to resume kafka %q", kafkaRequest.ID)
+	}
+
+	// prevents the capacity released by the kafka from being consumed by kafkas registered at the same time
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// a kafka that is still suspending has not released its capacity yet
+	if kafkaRequest.Status == constants.KafkaRequestStatusSuspended.String() {
+		if capacityErr := k.checkClusterCapacity(kafkaRequest, int64(size.CapacityConsumed)); capacityErr != nil {
+			return capacityErr
+		}
+	}
+
+	transition := kafkaStatusTransition{
+		scope: func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.Where("id = ?", kafkaRequest.ID).Where("status IN (?)", kafkaSuspensionStatuses)
+		},
+		status: constants.KafkaRequestStatusResuming.String(),
+		actor:  kafkaStatusTransitionActor(ctx),
+	}
+
+	resumedAt := sql.NullTime{Time: time.Now(), Valid: true}
+	rowsAffected, updateErr := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(kafkaRequest).
+			Where("status IN (?)", kafkaSuspensionStatuses). // the kafka may have been deleted or resumed in the meantime
+			Updates(map[string]interface{}{
+				"status":            transition.status,
+				"suspension_reason": dbapi.KafkaSuspensionReasonNone,
+				"resumed_at":        resumedAt,
+			})
+	})
+	if updateErr != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, updateErr, "failed to resume kafka %q", kafkaRequest.ID)
+	}
+	if rowsAffected == 0 {
+		return errors.BadRequest("kafka %q cannot be resumed as it is no longer suspended", kafkaRequest.ID)
+	}
+
+	kafkaRequest.Status = transition.status
+	kafkaRequest.SuspensionReason = dbapi.KafkaSuspensionReasonNone
+	kafkaRequest.ResumedAt = resumedAt
+
+	return nil
+}
+
+// kafkaStatusTransitionActor returns the username of the user of the request, or the fleet manager itself when there is none
+func kafkaStatusTransitionActor(ctx context.Context) string {
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		if username, _ := claims.GetUsername(); username != "" {
+			return username
+		}
+	}
+	return dbapi.KafkaStatusEventActorSystem
+}
+
+// isStorageSizeIncreased returns whether the given storage size is bigger than the current storage size
+func isStorageSizeIncreased(currentStorageSize string, storageSize config.Quantity) (bool, error) {
+	current, err := resource.ParseQuantity(currentStorageSize)
+	if err != nil {
+		return false, err
+	}
+
+	requested, err := storageSize.ToK8Quantity()
+	if err != nil {
+		return false, err
+	}
+
+	return requested.Cmp(current) > 0, nil
+}
+
+// kafkaNotUnderDeletionScope selects the kafka with the given id when it is not under deletion
+func kafkaNotUnderDeletionScope(id string) func(dbConn *gorm.DB) *gorm.DB {
+	return func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.Where("id = ?", id).Where("status not IN (?)", kafkaDeletionStatuses)
+	}
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "user not authenticated")
@@ -975,12 +1410,25 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 		"desired_kafka_version":     kafkaRequest.DesiredKafkaVersion,
 		"desired_kafka_ibp_version": kafkaRequest.DesiredKafkaIBPVersion,
 		"status":                    kafkaRequest.Status,
+		"force_upgrade":             kafkaRequest.ForceUpgrade,
+		"suspension_reason":         kafkaRequest.SuspensionReason,
+		"resumed_at":                kafkaRequest.ResumedAt,
 	}
 
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest)
+	transition := kafkaStatusTransition{
+		scope: func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.Where("id = ?", kafkaRequest.ID)
+		},
+		status: kafkaRequest.Status,
+		reason: kafkaStatusTransitionReason(kafkaRequest.Status, kafkaRequest.FailedReason),
+	}
+	if claims, err := auth.GetClaimsFromContext(ctx); err == nil {
+		transition.actor, _ = claims.GetUsername()
+	}
 
-	if err := dbConn.Updates(updatableFields).Error; err != nil {
+	if _, err := updateWithStatusEvents(k.connectionFactory.New(), transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(kafkaRequest).Updates(updatableFields)
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka")
 	}
 
@@ -988,6 +1436,10 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 }
 
 func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
+	return k.updateStatus(id, status, dbapi.KafkaStatusEventActorSystem)
+}
+
+func (k *kafkaService) updateStatus(id string, status constants.KafkaStatus, actor string) (bool, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 
 	if kafka, err := k.GetByID(id); err != nil {
@@ -1004,68 +1456,54 @@ func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bo
 		}
 	}
 
-	if err := dbConn.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status).Error; err != nil {
+	transition := kafkaStatusTransition{
+		scope: func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.Where("id = ?", id)
+		},
+		status: status.String(),
+		actor:  actor,
+	}
+
+	if _, err := updateWithStatusEvents(dbConn, transition, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status)
+	}); err != nil {
 		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka status")
 	}
 
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*KafkaDNSChange, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, action)
-
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
+	change, err := k.dnsProvider.ChangeRecords(kafkaRequest, routes, action)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to change the CNAME records of kafka %q with the %q DNS provider", kafkaRequest.ID, k.dnsProvider.Name())
 	}
 
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
-	}
-
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create domain record sets")
-	}
-
-	return changeRecordsOutput, nil
+	return &KafkaDNSChange{
+		ID:     formatKafkaDNSChangeID(k.dnsProvider.Name(), change.ID),
+		InSync: change.InSync,
+	}, nil
 }
 
-func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
-	}
-
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
+func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*KafkaDNSChange, error) {
+	providerName, changeID := parseKafkaDNSChangeID(kafkaRequest.RoutesCreationId)
+	if providerName != k.dnsProvider.Name() {
+		return nil, errors.GeneralError("the CNAME records of kafka %q have been changed with the %q DNS provider but the %q DNS provider is configured", kafkaRequest.ID, providerName, k.dnsProvider.Name())
 	}
 
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+	change, err := k.dnsProvider.GetChange(kafkaRequest, changeID)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get status of Route53 change batch request with ID %q", kafkaRequest.RoutesCreationId)
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the status of the change %q of the CNAME records of kafka %q", kafkaRequest.RoutesCreationId, kafkaRequest.ID)
 	}
 
-	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
+	return &KafkaDNSChange{
+		ID:     kafkaRequest.RoutesCreationId,
+		InSync: change.InSync,
 	}, nil
 }
 
@@ -1170,13 +1608,9 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 			Endpoint: managedkafka.EndpointSpec{
 				BootstrapServerHost: kafkaRequest.BootstrapServerHost,
 			},
-			Versions: managedkafka.VersionsSpec{
-				Kafka:    kafkaRequest.DesiredKafkaVersion,
-				Strimzi:  kafkaRequest.DesiredStrimziVersion,
-				KafkaIBP: kafkaRequest.DesiredKafkaIBPVersion,
-			},
-			Deleted: kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
-			Owners:  buildKafkaOwner(kafkaRequest, kafkaConfig),
+			Versions: buildManagedKafkaVersions(kafkaRequest, time.Now()),
+			Deleted:  kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
+			Owners:   buildKafkaOwner(kafkaRequest, kafkaConfig),
 		},
 		Status: managedkafka.ManagedKafkaStatus{},
 	}
@@ -1222,6 +1656,33 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 	return managedKafkaCR, nil
 }
 
+// buildManagedKafkaVersions returns the versions of the kafka instance to be reconciled by the data plane.
+// Changes of the desired versions are held back, by keeping the actual versions, until the kafka instance can be upgraded
+// i.e. its maintenance window is open or an upgrade is forced.
+// Versions which have not been reported by the data plane yet and upgrades which are already in progress are not held back.
+func buildManagedKafkaVersions(kafkaRequest *dbapi.KafkaRequest, now time.Time) managedkafka.VersionsSpec {
+	if kafkaRequest.CanBeUpgradedAt(now) {
+		return managedkafka.VersionsSpec{
+			Kafka:    kafkaRequest.DesiredKafkaVersion,
+			Strimzi:  kafkaRequest.DesiredStrimziVersion,
+			KafkaIBP: kafkaRequest.DesiredKafkaIBPVersion,
+		}
+	}
+
+	heldBackVersion := func(desired string, actual string, upgrading bool) string {
+		if actual == "" || upgrading {
+			return desired
+		}
+		return actual
+	}
+
+	return managedkafka.VersionsSpec{
+		Kafka:    heldBackVersion(kafkaRequest.DesiredKafkaVersion, kafkaRequest.ActualKafkaVersion, kafkaRequest.KafkaUpgrading),
+		Strimzi:  heldBackVersion(kafkaRequest.DesiredStrimziVersion, kafkaRequest.ActualStrimziVersion, kafkaRequest.StrimziUpgrading),
+		KafkaIBP: heldBackVersion(kafkaRequest.DesiredKafkaIBPVersion, kafkaRequest.ActualKafkaIBPVersion, kafkaRequest.KafkaIBPUpgrading),
+	}
+}
+
 // buildReservedManagedKafkaCR builds a Reserved Managed Kafka CR.
 // The ID, K8s object ID, K8s namespace and PlacementID are all set to
 // the provided kafkaID.
@@ -1295,41 +1756,6 @@ func buildKafkaOwner(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.Kafka
 	}
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) *route53.ChangeBatch {
-	var changes []*route53.Change
-	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action KafkaRoutesAction) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	actionStr := action.String()
-	resourceRecordChange := &route53.Change{
-		Action: &actionStr,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
-	}
-
-	return resourceRecordChange
-}
-
 func (k *kafkaService) AssignBootstrapServerHost(kafkaRequest *dbapi.KafkaRequest) error {
 	truncatedKafkaIdentifier := buildTruncateKafkaIdentifier(kafkaRequest)
 	truncatedKafkaIdentifier, replaceErr := replaceHostSpecialChar(truncatedKafkaIdentifier)
@@ -1354,28 +1780,6 @@ func (k *kafkaService) AssignBootstrapServerHost(kafkaRequest *dbapi.KafkaReques
 	return nil
 }
 
-// getRoute53RegionFromKafkaRequest calculates the AWS region to be used for
-// Route53 from the kafka request. It calculates its value using the
-// cloud provider specified in the kafka request.
-// Route53 is a global service which means that in most of the cases
-// the region specified is only used to access a regional endpoint in AWS.
-// There are some parts of the Route53 functionality that are regional.
-// For what we perform which is create hosted zones and entries in them
-// that is a global functionality.
-// See: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/disaster-recovery-resiliency.html
-// If at some point we end up needing Route53 regional functionalities this
-// mechanism should be reevaluated
-func (k *kafkaService) getRoute53RegionFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) (string, error) {
-	switch kafkaRequest.CloudProvider {
-	case cloudproviders.AWS.String():
-		return aws.DefaultAWSRoute53Region, nil
-	case cloudproviders.GCP.String():
-		return aws.DefaultGCPRoute53Region, nil
-	default:
-		return "", errors.GeneralError("unknown cloud provider: %q", kafkaRequest.CloudProvider)
-	}
-}
-
 func (k *kafkaService) IsQuotaEntitlementActive(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(k.kafkaConfig.Quota.Type))
 	if factoryErr != nil {
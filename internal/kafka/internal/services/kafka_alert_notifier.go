@@ -0,0 +1,97 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	// KafkaAlertStatusFiring is the status of the alerts delivered when a rule starts firing
+	KafkaAlertStatusFiring = "firing"
+	// KafkaAlertStatusResolved is the status of the alerts delivered when a rule stops firing
+	KafkaAlertStatusResolved = "resolved"
+)
+
+//go:generate moq -out kafka_alert_notifier_moq.go . KafkaAlertNotifier
+type KafkaAlertNotifier interface {
+	// Notify delivers the state of the alert rule to all its notification targets. The same alert may be delivered more than
+	// once, e.g. when one of the targets could not be reached: consumers should use the rule id and the changed_at time of the
+	// alert to ignore the duplicates
+	Notify(kafkaRequest *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) error
+}
+
+func NewKafkaAlertNotifier(alertRulesConfig *config.KafkaAlertRulesConfig) KafkaAlertNotifier {
+	// the alerts are posted to the URLs given by the owners of the kafkas, which must not reach the internal services
+	return &webhookKafkaAlertNotifier{
+		poster: newWebhookPoster(newWebhookTargetsClient(alertRulesConfig.WebhookTimeout)),
+	}
+}
+
+// KafkaAlertPayload is the representation of an alert posted to the webhooks
+type KafkaAlertPayload struct {
+	RuleID    string    `json:"rule_id"`
+	RuleName  string    `json:"rule_name"`
+	KafkaID   string    `json:"kafka_id"`
+	KafkaName string    `json:"kafka_name"`
+	Metric    string    `json:"metric"`
+	Threshold float64   `json:"threshold"`
+	Value     *float64  `json:"value,omitempty"`
+	Status    string    `json:"status"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func newKafkaAlertPayload(kafkaRequest *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) KafkaAlertPayload {
+	payload := KafkaAlertPayload{
+		RuleID:    rule.ID,
+		RuleName:  rule.Name,
+		KafkaID:   kafkaRequest.ID,
+		KafkaName: kafkaRequest.Name,
+		Metric:    rule.Metric.String(),
+		Threshold: rule.Threshold,
+		Value:     rule.Value,
+		Status:    KafkaAlertStatusResolved,
+	}
+	if rule.State == dbapi.KafkaAlertRuleStateFiring {
+		payload.Status = KafkaAlertStatusFiring
+	}
+	if rule.StateChangedAt != nil {
+		payload.ChangedAt = *rule.StateChangedAt
+	}
+	return payload
+}
+
+type webhookKafkaAlertNotifier struct {
+	poster *webhookPoster
+}
+
+var _ KafkaAlertNotifier = &webhookKafkaAlertNotifier{}
+
+func (n *webhookKafkaAlertNotifier) Notify(kafkaRequest *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) error {
+	targets, err := rule.GetNotificationTargets()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the notification targets of alert rule %q", rule.ID)
+	}
+
+	payload := newKafkaAlertPayload(kafkaRequest, rule)
+
+	// all the targets are tried, so that a target that cannot be reached does not hold back the others
+	var failedTargets []string
+	for _, target := range targets {
+		if target.Type != dbapi.KafkaAlertNotificationTargetTypeWebhook {
+			continue
+		}
+		if err := n.poster.post(target.URL, payload); err != nil {
+			failedTargets = append(failedTargets, errors.Wrapf(err, "failed to post the alert to %q", target.URL).Error())
+		}
+	}
+
+	if len(failedTargets) > 0 {
+		return fmt.Errorf("failed to deliver the alert of rule %q to %d of its %d notification targets: %v", rule.ID, len(failedTargets), len(targets), failedTargets)
+	}
+
+	return nil
+}
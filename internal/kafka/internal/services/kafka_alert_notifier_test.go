@@ -0,0 +1,125 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_webhookKafkaAlertNotifier_Notify(t *testing.T) {
+	changedAt := time.Date(2023, 5, 24, 12, 0, 0, 0, time.UTC)
+	value := 92.5
+	kafkaRequest := &dbapi.KafkaRequest{
+		Meta: api.Meta{ID: "kafka-1"},
+		Name: "my-kafka",
+	}
+
+	tests := []struct {
+		name       string
+		state      dbapi.KafkaAlertRuleState
+		statusCode int
+		wantStatus string
+		wantErr    bool
+	}{
+		{
+			name:       "should post the firing alert to the webhooks",
+			state:      dbapi.KafkaAlertRuleStateFiring,
+			statusCode: http.StatusOK,
+			wantStatus: KafkaAlertStatusFiring,
+			wantErr:    false,
+		},
+		{
+			name:       "should post the resolved alert to the webhooks",
+			state:      dbapi.KafkaAlertRuleStateInactive,
+			statusCode: http.StatusNoContent,
+			wantStatus: KafkaAlertStatusResolved,
+			wantErr:    false,
+		},
+		{
+			name:       "should return an error when a webhook does not accept the alert",
+			state:      dbapi.KafkaAlertRuleStateFiring,
+			statusCode: http.StatusServiceUnavailable,
+			wantStatus: KafkaAlertStatusFiring,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var received []KafkaAlertPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload KafkaAlertPayload
+				g.Expect(r.Header.Get("Content-Type")).To(gomega.Equal("application/json"))
+				g.Expect(json.NewDecoder(r.Body).Decode(&payload)).To(gomega.Succeed())
+				received = append(received, payload)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			rule := &dbapi.KafkaAlertRule{
+				Meta:           api.Meta{ID: "rule-1"},
+				KafkaID:        "kafka-1",
+				Name:           "disk almost full",
+				Metric:         dbapi.KafkaAlertRuleMetricDiskUsagePercentage,
+				Threshold:      90,
+				State:          tt.state,
+				StateChangedAt: &changedAt,
+				Value:          &value,
+			}
+			g.Expect(rule.SetNotificationTargets([]dbapi.KafkaAlertNotificationTarget{
+				{Type: dbapi.KafkaAlertNotificationTargetTypeWebhook, URL: server.URL + "/first"},
+				{Type: dbapi.KafkaAlertNotificationTargetTypeWebhook, URL: server.URL + "/second"},
+			})).To(gomega.Succeed())
+
+			// the test server listens on a loopback address, which the client of NewKafkaAlertNotifier refuses to connect to
+			notifier := &webhookKafkaAlertNotifier{poster: newWebhookPoster(server.Client())}
+			err := notifier.Notify(kafkaRequest, rule)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+
+			// the alert is posted to all the targets, even when one of them does not accept it
+			g.Expect(received).To(gomega.HaveLen(2))
+			g.Expect(received[0]).To(gomega.Equal(KafkaAlertPayload{
+				RuleID:    "rule-1",
+				RuleName:  "disk almost full",
+				KafkaID:   "kafka-1",
+				KafkaName: "my-kafka",
+				Metric:    "disk_usage_percentage",
+				Threshold: 90,
+				Value:     &value,
+				Status:    tt.wantStatus,
+				ChangedAt: changedAt,
+			}))
+		})
+	}
+}
+
+func Test_NewKafkaAlertNotifier_RefusesInternalAddresses(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	called := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	rule := &dbapi.KafkaAlertRule{Meta: api.Meta{ID: "rule-1"}, State: dbapi.KafkaAlertRuleStateFiring}
+	g.Expect(rule.SetNotificationTargets([]dbapi.KafkaAlertNotificationTarget{
+		{Type: dbapi.KafkaAlertNotificationTargetTypeWebhook, URL: server.URL},
+	})).To(gomega.Succeed())
+
+	notifier := NewKafkaAlertNotifier(&config.KafkaAlertRulesConfig{WebhookTimeout: time.Second})
+	err := notifier.Notify(&dbapi.KafkaRequest{Meta: api.Meta{ID: "kafka-1"}}, rule)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(err.Error()).To(gomega.ContainSubstring("not allowed"))
+	g.Expect(called).To(gomega.BeFalse())
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+//go:generate moq -out kafka_alert_rules_moq.go . KafkaAlertRuleService
+type KafkaAlertRuleService interface {
+	// Create creates the alert rule. It fails with a bad request when the kafka already has the maximum number of alert rules
+	Create(rule *dbapi.KafkaAlertRule) *errors.ServiceError
+	// Get returns the alert rule of the kafka with the given id
+	Get(kafkaID string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError)
+	// List returns the alert rules of the kafka, the oldest first
+	List(kafkaID string, listArgs *services.ListArguments) (dbapi.KafkaAlertRuleList, *api.PagingMeta, *errors.ServiceError)
+	// Delete deletes the alert rule of the kafka with the given id
+	Delete(kafkaID string, id string) *errors.ServiceError
+	// ListDueForEvaluation returns the alert rules of the ready kafkas that have not been evaluated since the given time, or whose
+	// last state change has not been delivered to their notification targets yet
+	ListDueForEvaluation(evaluatedBefore time.Time) (dbapi.KafkaAlertRuleList, *errors.ServiceError)
+	// UpdateEvaluation stores the result of the evaluation of the alert rule and of the delivery of its notifications
+	UpdateEvaluation(rule *dbapi.KafkaAlertRule) *errors.ServiceError
+	// DeleteOrphans deletes the alert rules of the kafkas that have been deleted
+	DeleteOrphans() *errors.ServiceError
+}
+
+type kafkaAlertRuleService struct {
+	connectionFactory *db.ConnectionFactory
+	alertRulesConfig  *config.KafkaAlertRulesConfig
+}
+
+var _ KafkaAlertRuleService = &kafkaAlertRuleService{}
+
+func NewKafkaAlertRuleService(connectionFactory *db.ConnectionFactory, alertRulesConfig *config.KafkaAlertRulesConfig) KafkaAlertRuleService {
+	return &kafkaAlertRuleService{
+		connectionFactory: connectionFactory,
+		alertRulesConfig:  alertRulesConfig,
+	}
+}
+
+func (s *kafkaAlertRuleService) Create(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaAlertRule{}).
+		Where("kafka_id = ?", rule.KafkaID).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the alert rules of kafka %q", rule.KafkaID)
+	}
+	if count >= int64(s.alertRulesConfig.MaxRulesPerKafka) {
+		return errors.BadRequest("kafka %q already has the maximum number of %d alert rules", rule.KafkaID, s.alertRulesConfig.MaxRulesPerKafka)
+	}
+
+	rule.ID = api.NewID()
+	rule.State = dbapi.KafkaAlertRuleStateInactive
+	rule.NotifiedState = dbapi.KafkaAlertRuleStateInactive
+	if err := s.connectionFactory.New().Create(rule).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the alert rule %q of kafka %q", rule.Name, rule.KafkaID)
+	}
+
+	return nil
+}
+
+func (s *kafkaAlertRuleService) Get(kafkaID string, id string) (*dbapi.KafkaAlertRule, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var rule dbapi.KafkaAlertRule
+	if err := s.connectionFactory.New().Where("kafka_id = ? AND id = ?", kafkaID, id).First(&rule).Error; err != nil {
+		return nil, services.HandleGetError("KafkaAlertRule", "id", id, err)
+	}
+
+	return &rule, nil
+}
+
+func (s *kafkaAlertRuleService) List(kafkaID string, listArgs *services.ListArguments) (dbapi.KafkaAlertRuleList, *api.PagingMeta, *errors.ServiceError) {
+	var rules dbapi.KafkaAlertRuleList
+	dbConn := s.connectionFactory.New().Model(&dbapi.KafkaAlertRule{}).Where("kafka_id = ?", kafkaID)
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return rules, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the alert rules of kafka %q", kafkaID)
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.
+		Order("created_at").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&rules).Error; err != nil {
+		return rules, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the alert rules of kafka %q", kafkaID)
+	}
+	pagingMeta.Size = len(rules)
+
+	return rules, pagingMeta, nil
+}
+
+func (s *kafkaAlertRuleService) Delete(kafkaID string, id string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("kafka_id = ? AND id = ?", kafkaID, id).Delete(&dbapi.KafkaAlertRule{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete alert rule %q of kafka %q", id, kafkaID)
+	}
+
+	return nil
+}
+
+func (s *kafkaAlertRuleService) ListDueForEvaluation(evaluatedBefore time.Time) (dbapi.KafkaAlertRuleList, *errors.ServiceError) {
+	var rules dbapi.KafkaAlertRuleList
+	if err := s.connectionFactory.New().
+		Joins("JOIN kafka_requests ON kafka_requests.id = kafka_alert_rules.kafka_id AND kafka_requests.deleted_at IS NULL").
+		Where("kafka_requests.status = ?", constants.KafkaRequestStatusReady.String()).
+		Where("kafka_alert_rules.evaluated_at IS NULL OR kafka_alert_rules.evaluated_at < ? OR kafka_alert_rules.state <> kafka_alert_rules.notified_state", evaluatedBefore).
+		Order("kafka_alert_rules.kafka_id, kafka_alert_rules.created_at").
+		Find(&rules).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the alert rules due for evaluation")
+	}
+
+	return rules, nil
+}
+
+func (s *kafkaAlertRuleService) UpdateEvaluation(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+	// the rule may have been deleted while it was evaluated: it is not brought back by the update
+	if err := s.connectionFactory.New().Model(rule).
+		Select("state", "state_changed_at", "value", "evaluated_at", "notified_state", "notification_error").
+		Updates(rule).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the evaluation of alert rule %q", rule.ID)
+	}
+
+	return nil
+}
+
+func (s *kafkaAlertRuleService) DeleteOrphans() *errors.ServiceError {
+	if err := s.connectionFactory.New().
+		Where("kafka_id NOT IN (?)", s.connectionFactory.New().Model(&dbapi.KafkaRequest{}).Select("id")).
+		Delete(&dbapi.KafkaAlertRule{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the alert rules of the deleted kafkas")
+	}
+
+	return nil
+}
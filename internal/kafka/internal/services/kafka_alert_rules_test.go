@@ -0,0 +1,140 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaAlertRuleService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should create the alert rule as inactive",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "kafka_alert_rules"`).
+					WithArgs("kafka-1").
+					WithReply([]map[string]interface{}{{"count": 9}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_alert_rules"`)
+			},
+		},
+		{
+			name: "should return an error when the kafka already has the maximum number of alert rules",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "kafka_alert_rules"`).
+					WithArgs("kafka-1").
+					WithReply([]map[string]interface{}{{"count": 10}})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewKafkaAlertRuleService(db.NewMockConnectionFactory(nil), config.NewKafkaAlertRulesConfig())
+
+			rule := &dbapi.KafkaAlertRule{
+				KafkaID:   "kafka-1",
+				Name:      "disk almost full",
+				Metric:    dbapi.KafkaAlertRuleMetricDiskUsagePercentage,
+				Threshold: 90,
+			}
+			err := s.Create(rule)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(rule.ID).ToNot(gomega.BeEmpty())
+				g.Expect(rule.State).To(gomega.Equal(dbapi.KafkaAlertRuleStateInactive))
+				g.Expect(rule.HasPendingNotifications()).To(gomega.BeFalse())
+			}
+		})
+	}
+}
+
+func Test_kafkaAlertRuleService_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT count(1) FROM "kafka_alert_rules"`).
+		WithArgs("kafka-1").
+		WithReply([]map[string]interface{}{{"count": 2}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "kafka_alert_rules"`).
+		WithArgs("kafka-1").
+		WithReply([]map[string]interface{}{{"id": "rule-1"}, {"id": "rule-2"}})
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+	s := NewKafkaAlertRuleService(db.NewMockConnectionFactory(nil), config.NewKafkaAlertRulesConfig())
+
+	rules, paging, err := s.List("kafka-1", &services.ListArguments{Page: 1, Size: 100})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(paging.Total).To(gomega.Equal(2))
+	g.Expect(paging.Size).To(gomega.Equal(2))
+	g.Expect(rules).To(gomega.HaveLen(2))
+}
+
+func Test_kafkaAlertRuleService_ListDueForEvaluation(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "should return the alert rules of the ready kafkas due for evaluation",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`JOIN kafka_requests ON kafka_requests.id = kafka_alert_rules.kafka_id`).
+					WithReply([]map[string]interface{}{
+						{"id": "rule-1", "kafka_id": "kafka-1"},
+						{"id": "rule-2", "kafka_id": "kafka-2"},
+					})
+			},
+			wantLen: 2,
+		},
+		{
+			name: "should return an error when the alert rules cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewKafkaAlertRuleService(db.NewMockConnectionFactory(nil), config.NewKafkaAlertRulesConfig())
+
+			rules, err := s.ListDueForEvaluation(time.Now().Add(-5 * time.Minute))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(rules).To(gomega.HaveLen(tt.wantLen))
+		})
+	}
+}
+
+func Test_kafkaAlertRuleService_DeleteOrphans(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`UPDATE "kafka_alert_rules" SET "deleted_at"=`).
+		WithRowsNum(1)
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+	s := NewKafkaAlertRuleService(db.NewMockConnectionFactory(nil), config.NewKafkaAlertRulesConfig())
+
+	g.Expect(s.DeleteOrphans()).To(gomega.BeNil())
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// kafkaCNAMERecordTTL is the TTL, in seconds, of the CNAME records of the kafkas
+const kafkaCNAMERecordTTL = 300
+
+// KafkaDNSChange is a change of the CNAME records of a kafka made by a DNS provider
+type KafkaDNSChange struct {
+	// ID identifies the change. The ids returned by the KafkaService are prefixed by the name of the provider
+	// that made the change, e.g. "rfc2136:cgd4s0b6t3ik1nbudl9g", so that they can be stored in the RoutesCreationId of the kafka
+	ID string
+	// InSync is true once the change has been applied by the DNS servers
+	InSync bool
+}
+
+//go:generate moq -out kafka_dns_moq.go . KafkaDNSProvider
+type KafkaDNSProvider interface {
+	// Name returns the name of the provider, as set in the configuration
+	Name() string
+	// ChangeRecords applies the action to the CNAME records of the given routes of the kafka
+	ChangeRecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error)
+	// GetChange returns the change of the CNAME records of the kafka with the given id, as returned by ChangeRecords
+	GetChange(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error)
+}
+
+// NewKafkaDNSProvider returns the DNS provider selected by the configuration
+func NewKafkaDNSProvider(dnsConfig *config.KafkaDNSConfig, kafkaConfig *config.KafkaConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) KafkaDNSProvider {
+	switch dnsConfig.Provider {
+	case config.KafkaDNSRFC2136Provider:
+		return newRFC2136KafkaDNSProvider(dnsConfig, kafkaConfig)
+	case config.KafkaDNSNoopProvider:
+		return &noopKafkaDNSProvider{}
+	default:
+		return &route53KafkaDNSProvider{
+			kafkaConfig:      kafkaConfig,
+			awsConfig:        awsConfig,
+			awsClientFactory: awsClientFactory,
+		}
+	}
+}
+
+// formatKafkaDNSChangeID prefixes the id of a change by the name of the provider that made it
+func formatKafkaDNSChangeID(providerName string, changeID string) string {
+	return fmt.Sprintf("%s:%s", providerName, changeID)
+}
+
+// parseKafkaDNSChangeID returns the name of the provider that made the change and the id of the change in this provider.
+// The changes made before the DNS providers were introduced are not prefixed: they were all made by Route53
+func parseKafkaDNSChangeID(id string) (string, string) {
+	providerName, changeID, found := strings.Cut(id, ":")
+	if !found || !arrays.Contains(config.KafkaDNSProviders, providerName) {
+		return config.KafkaDNSRoute53Provider, id
+	}
+	return providerName, changeID
+}
+
+type route53KafkaDNSProvider struct {
+	kafkaConfig      *config.KafkaConfig
+	awsConfig        *config.AWSConfig
+	awsClientFactory aws.ClientFactory
+}
+
+var _ KafkaDNSProvider = &route53KafkaDNSProvider{}
+
+func (p *route53KafkaDNSProvider) Name() string {
+	return config.KafkaDNSRoute53Provider
+}
+
+func (p *route53KafkaDNSProvider) ChangeRecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error) {
+	awsClient, err := p.newClient(kafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(p.kafkaConfig.KafkaDomainName, buildKafkaClusterCNAMESRecordBatch(routes, action))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create domain record sets")
+	}
+
+	return newRoute53KafkaDNSChange(changeRecordsOutput.ChangeInfo), nil
+}
+
+func (p *route53KafkaDNSProvider) GetChange(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error) {
+	awsClient, err := p.newClient(kafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	changeOutput, err := awsClient.GetChange(changeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get status of Route53 change batch request with ID %q", changeID)
+	}
+
+	return newRoute53KafkaDNSChange(changeOutput.ChangeInfo), nil
+}
+
+func (p *route53KafkaDNSProvider) newClient(kafkaRequest *dbapi.KafkaRequest) (aws.AWSClient, error) {
+	awsConfig := aws.Config{
+		AccessKeyID:     p.awsConfig.Route53AccessKey,
+		SecretAccessKey: p.awsConfig.Route53SecretAccessKey,
+	}
+
+	route53Region, err := getRoute53RegionFromKafkaRequest(kafkaRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting route 53 region from kafka request")
+	}
+
+	awsClient, err := p.awsClientFactory.NewClient(awsConfig, route53Region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	return awsClient, nil
+}
+
+func newRoute53KafkaDNSChange(changeInfo *route53.ChangeInfo) *KafkaDNSChange {
+	return &KafkaDNSChange{
+		ID:     *changeInfo.Id,
+		InSync: *changeInfo.Status == route53.ChangeStatusInsync,
+	}
+}
+
+// getRoute53RegionFromKafkaRequest calculates the AWS region to be used for
+// Route53 from the kafka request. It calculates its value using the
+// cloud provider specified in the kafka request.
+// Route53 is a global service which means that in most of the cases
+// the region specified is only used to access a regional endpoint in AWS.
+// There are some parts of the Route53 functionality that are regional.
+// For what we perform which is create hosted zones and entries in them
+// that is a global functionality.
+// See: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/disaster-recovery-resiliency.html
+// If at some point we end up needing Route53 regional functionalities this
+// mechanism should be reevaluated
+func getRoute53RegionFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) (string, error) {
+	switch kafkaRequest.CloudProvider {
+	case cloudproviders.AWS.String():
+		return aws.DefaultAWSRoute53Region, nil
+	case cloudproviders.GCP.String():
+		return aws.DefaultGCPRoute53Region, nil
+	default:
+		return "", errors.Errorf("unknown cloud provider: %q", kafkaRequest.CloudProvider)
+	}
+}
+
+func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) *route53.ChangeBatch {
+	var changes []*route53.Change
+	for _, r := range routes {
+		c := buildResourceRecordChange(r.Domain, r.Router, action)
+		changes = append(changes, c)
+	}
+	recordChangeBatch := &route53.ChangeBatch{
+		Changes: changes,
+	}
+
+	return recordChangeBatch
+}
+
+func buildResourceRecordChange(recordName string, clusterIngress string, action KafkaRoutesAction) *route53.Change {
+	recordType := "CNAME"
+	recordTTL := int64(kafkaCNAMERecordTTL)
+
+	actionStr := action.String()
+	resourceRecordChange := &route53.Change{
+		Action: &actionStr,
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name: &recordName,
+			Type: &recordType,
+			TTL:  &recordTTL,
+			ResourceRecords: []*route53.ResourceRecord{
+				{
+					Value: &clusterIngress,
+				},
+			},
+		},
+	}
+
+	return resourceRecordChange
+}
+
+// noopKafkaDNSProvider leaves the CNAME records of the kafkas to be managed outside of the fleet manager
+type noopKafkaDNSProvider struct{}
+
+var _ KafkaDNSProvider = &noopKafkaDNSProvider{}
+
+func (p *noopKafkaDNSProvider) Name() string {
+	return config.KafkaDNSNoopProvider
+}
+
+func (p *noopKafkaDNSProvider) ChangeRecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error) {
+	glog.Infof("skipping %s of the CNAME records of kafka %q: they are not managed by the fleet manager", action, kafkaRequest.ID)
+	return &KafkaDNSChange{ID: api.NewID(), InSync: true}, nil
+}
+
+func (p *noopKafkaDNSProvider) GetChange(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error) {
+	return &KafkaDNSChange{ID: changeID, InSync: true}, nil
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 hmac-sha1 is one of the TSIG algorithms still supported by the DNS servers
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// dnsUpdateOpCode is the operation code of the dynamic updates, see https://www.rfc-editor.org/rfc/rfc2136#section-1
+	dnsUpdateOpCode dnsmessage.OpCode = 5
+	// dnsTypeTSIG is the type of the records signing the messages, see https://www.rfc-editor.org/rfc/rfc8945#section-4.2
+	dnsTypeTSIG dnsmessage.Type = 250
+	// tsigFudge is the number of seconds the servers accept between the signing of a message and its reception
+	tsigFudge = 300
+)
+
+var rfc2136TSIGHashes = map[string]func() hash.Hash{
+	"hmac-sha1":   sha1.New,
+	"hmac-sha256": sha256.New,
+	"hmac-sha512": sha512.New,
+}
+
+// rfc2136RCodeNames are the names of the response codes specific to the dynamic updates, see https://www.rfc-editor.org/rfc/rfc2136#section-2.2
+var rfc2136RCodeNames = map[dnsmessage.RCode]string{
+	6:  "YXDomain",
+	7:  "YXRRSet",
+	8:  "NXRRSet",
+	9:  "NotAuth",
+	10: "NotZone",
+}
+
+// rfc2136KafkaDNSProvider manages the CNAME records of the kafkas with RFC 2136 dynamic updates sent to the primary server of the zone.
+// The server applies an update before answering it, so the changes are in sync as soon as they are made
+type rfc2136KafkaDNSProvider struct {
+	server        string
+	transport     string
+	timeout       time.Duration
+	zone          string
+	tsigKeyName   string
+	tsigAlgorithm string
+	tsigSecret    []byte
+}
+
+var _ KafkaDNSProvider = &rfc2136KafkaDNSProvider{}
+
+func newRFC2136KafkaDNSProvider(dnsConfig *config.KafkaDNSConfig, kafkaConfig *config.KafkaConfig) *rfc2136KafkaDNSProvider {
+	zone := dnsConfig.RFC2136Zone
+	if zone == "" {
+		zone = kafkaConfig.KafkaDomainName
+	}
+
+	// the secret has been validated with the configuration
+	tsigSecret, _ := base64.StdEncoding.DecodeString(dnsConfig.RFC2136TSIGSecret)
+
+	return &rfc2136KafkaDNSProvider{
+		server:        dnsConfig.RFC2136Server,
+		transport:     dnsConfig.RFC2136Transport,
+		timeout:       dnsConfig.RFC2136Timeout,
+		zone:          zone,
+		tsigKeyName:   dnsConfig.RFC2136TSIGKeyName,
+		tsigAlgorithm: dnsConfig.RFC2136TSIGAlgorithm,
+		tsigSecret:    tsigSecret,
+	}
+}
+
+func (p *rfc2136KafkaDNSProvider) Name() string {
+	return config.KafkaDNSRFC2136Provider
+}
+
+func (p *rfc2136KafkaDNSProvider) ChangeRecords(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error) {
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the id of the dynamic update")
+	}
+	messageID := binary.BigEndian.Uint16(id[:])
+
+	update, err := p.buildUpdate(messageID, routes, action)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build the dynamic update of the CNAME records of kafka %q", kafkaRequest.ID)
+	}
+
+	if p.tsigKeyName != "" {
+		update = p.sign(update, messageID, time.Now())
+	}
+
+	if err := p.send(update, messageID); err != nil {
+		return nil, errors.Wrapf(err, "failed to send the dynamic update of the CNAME records of kafka %q to %q", kafkaRequest.ID, p.server)
+	}
+
+	return &KafkaDNSChange{ID: api.NewID(), InSync: true}, nil
+}
+
+func (p *rfc2136KafkaDNSProvider) GetChange(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error) {
+	return &KafkaDNSChange{ID: changeID, InSync: true}, nil
+}
+
+// buildUpdate builds the dynamic update applying the action to the CNAME records of the routes.
+// The creation of a record replaces the record of the same name, if any, so that the updates can be retried
+func (p *rfc2136KafkaDNSProvider) buildUpdate(messageID uint16, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) ([]byte, error) {
+	zone, err := dnsmessage.NewName(fqdn(p.zone))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid zone %q", p.zone)
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: messageID, OpCode: dnsUpdateOpCode})
+
+	// the zone section of an update has the format of the question section
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := builder.Question(dnsmessage.Question{Name: zone, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, err
+	}
+
+	// the update section of an update has the format of the authority section
+	if err := builder.StartAuthorities(); err != nil {
+		return nil, err
+	}
+	for _, route := range routes {
+		name, err := dnsmessage.NewName(fqdn(route.Domain))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid domain %q", route.Domain)
+		}
+
+		if action != KafkaRoutesActionCreate {
+			// deletes the CNAME record of the name whatever its target, see https://www.rfc-editor.org/rfc/rfc2136#section-2.5.2
+			if err := builder.UnknownResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassANY}, dnsmessage.UnknownResource{Type: dnsmessage.TypeCNAME}); err != nil {
+				return nil, err
+			}
+		}
+
+		if action != KafkaRoutesActionDelete {
+			target, err := dnsmessage.NewName(fqdn(route.Router))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid router %q", route.Router)
+			}
+			if err := builder.CNAMEResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: kafkaCNAMERecordTTL}, dnsmessage.CNAMEResource{CNAME: target}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return builder.Finish()
+}
+
+// sign appends the TSIG record signing the message, see https://www.rfc-editor.org/rfc/rfc8945#section-4.3
+func (p *rfc2136KafkaDNSProvider) sign(message []byte, messageID uint16, timeSigned time.Time) []byte {
+	keyName := packDNSName(p.tsigKeyName)
+	algorithm := packDNSName(p.tsigAlgorithm)
+	timeAndFudge := binary.BigEndian.AppendUint16(nil, uint16(timeSigned.Unix()>>32))
+	timeAndFudge = binary.BigEndian.AppendUint32(timeAndFudge, uint32(timeSigned.Unix()))
+	timeAndFudge = binary.BigEndian.AppendUint16(timeAndFudge, tsigFudge)
+
+	mac := hmac.New(rfc2136TSIGHashes[p.tsigAlgorithm], p.tsigSecret)
+	mac.Write(message)
+	mac.Write(keyName)
+	mac.Write(binary.BigEndian.AppendUint16(nil, uint16(dnsmessage.ClassANY)))
+	mac.Write(binary.BigEndian.AppendUint32(nil, 0)) // TTL
+	mac.Write(algorithm)
+	mac.Write(timeAndFudge)
+	mac.Write(binary.BigEndian.AppendUint32(nil, 0)) // error and other len
+	signature := mac.Sum(nil)
+
+	rdata := append(algorithm, timeAndFudge...)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(signature)))
+	rdata = append(rdata, signature...)
+	rdata = binary.BigEndian.AppendUint16(rdata, messageID)
+	rdata = binary.BigEndian.AppendUint32(rdata, 0) // error and other len
+
+	signed := append(message, keyName...)
+	signed = binary.BigEndian.AppendUint16(signed, uint16(dnsTypeTSIG))
+	signed = binary.BigEndian.AppendUint16(signed, uint16(dnsmessage.ClassANY))
+	signed = binary.BigEndian.AppendUint32(signed, 0) // TTL
+	signed = binary.BigEndian.AppendUint16(signed, uint16(len(rdata)))
+	signed = append(signed, rdata...)
+
+	// the TSIG record is the last record of the additional section
+	additionalCount := binary.BigEndian.Uint16(signed[10:12])
+	binary.BigEndian.PutUint16(signed[10:12], additionalCount+1)
+
+	return signed
+}
+
+// send sends the update to the server and checks that it has been applied.
+// The messages sent over TCP are prefixed by their length, see https://www.rfc-editor.org/rfc/rfc1035#section-4.2.2
+func (p *rfc2136KafkaDNSProvider) send(update []byte, messageID uint16) error {
+	conn, err := net.DialTimeout(p.transport, p.server, p.timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return err
+	}
+
+	var response []byte
+	if p.transport == "tcp" {
+		if _, err := conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(update))), update...)); err != nil {
+			return err
+		}
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return err
+		}
+		response = make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, response); err != nil {
+			return err
+		}
+	} else {
+		if _, err := conn.Write(update); err != nil {
+			return err
+		}
+		response = make([]byte, 65535)
+		n, err := conn.Read(response)
+		if err != nil {
+			return err
+		}
+		response = response[:n]
+	}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(response)
+	if err != nil {
+		return errors.Wrap(err, "invalid response")
+	}
+	if header.ID != messageID || !header.Response {
+		return fmt.Errorf("unexpected response with id %d", header.ID)
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		rcode, ok := rfc2136RCodeNames[header.RCode]
+		if !ok {
+			rcode = header.RCode.String()
+		}
+		return fmt.Errorf("the update has been rejected with response code %s", rcode)
+	}
+
+	return nil
+}
+
+// fqdn returns the fully qualified form of the domain name
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// packDNSName returns the uncompressed canonical wire format of the domain name, see https://www.rfc-editor.org/rfc/rfc4034#section-6.2
+func packDNSName(name string) []byte {
+	var packed []byte
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".") {
+		packed = append(packed, byte(len(label)))
+		packed = append(packed, label...)
+	}
+	return append(packed, 0)
+}
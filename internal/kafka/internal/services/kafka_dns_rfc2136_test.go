@@ -0,0 +1,245 @@
+package services
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testRFC2136Update is the content of a dynamic update received by the test DNS server
+type testRFC2136Update struct {
+	zone    string
+	changes []string
+	tsigKey string
+}
+
+// startTestRFC2136Server starts a DNS server answering the dynamic updates with the given response code.
+// It returns the address of the server and the updates it received
+func startTestRFC2136Server(t *testing.T, transport string, rcode dnsmessage.RCode) (string, chan testRFC2136Update) {
+	updates := make(chan testRFC2136Update, 1)
+	answer := func(request []byte) []byte {
+		update, header := parseTestRFC2136Update(t, request)
+		updates <- update
+		builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: header.ID, Response: true, OpCode: header.OpCode, RCode: rcode})
+		response, err := builder.Finish()
+		if err != nil {
+			t.Error(err)
+		}
+		return response
+	}
+
+	if transport == "tcp" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { listener.Close() })
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			var length [2]byte
+			if _, err := io.ReadFull(conn, length[:]); err != nil {
+				t.Error(err)
+				return
+			}
+			request := make([]byte, binary.BigEndian.Uint16(length[:]))
+			if _, err := io.ReadFull(conn, request); err != nil {
+				t.Error(err)
+				return
+			}
+			response := answer(request)
+			_, _ = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(response))), response...))
+		}()
+		return listener.Addr().String(), updates
+	}
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		request := make([]byte, 65535)
+		n, addr, err := conn.ReadFrom(request)
+		if err != nil {
+			return
+		}
+		_, _ = conn.WriteTo(answer(request[:n]), addr)
+	}()
+	return conn.LocalAddr().String(), updates
+}
+
+func parseTestRFC2136Update(t *testing.T, request []byte) (testRFC2136Update, dnsmessage.Header) {
+	var update testRFC2136Update
+	var parser dnsmessage.Parser
+	header, err := parser.Start(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zone, err := parser.Question()
+	if err != nil {
+		t.Fatal(err)
+	}
+	update.zone = zone.Name.String()
+	if err := parser.SkipAllQuestions(); err != nil {
+		t.Fatal(err)
+	}
+	if err := parser.SkipAllAnswers(); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		resourceHeader, err := parser.AuthorityHeader()
+		if err == dnsmessage.ErrSectionDone {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resourceHeader.Class == dnsmessage.ClassANY {
+			update.changes = append(update.changes, "delete "+resourceHeader.Name.String())
+			if err := parser.SkipAuthority(); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		cname, err := parser.CNAMEResource()
+		if err != nil {
+			t.Fatal(err)
+		}
+		update.changes = append(update.changes, "add "+resourceHeader.Name.String()+" "+cname.CNAME.String())
+	}
+	if tsig, err := parser.AdditionalHeader(); err == nil && tsig.Type == dnsTypeTSIG {
+		update.tsigKey = tsig.Name.String()
+	}
+	return update, header
+}
+
+func Test_rfc2136KafkaDNSProvider_ChangeRecords(t *testing.T) {
+	routes := []dbapi.DataPlaneKafkaRoute{
+		{Domain: "test-kafka-id.kafka.example.com", Router: "router.test-cluster.example.com"},
+		{Domain: "admin-server-test-kafka-id.kafka.example.com", Router: "router.test-cluster.example.com"},
+	}
+
+	tests := []struct {
+		name        string
+		transport   string
+		tsigKeyName string
+		action      KafkaRoutesAction
+		rcode       dnsmessage.RCode
+		wantChanges []string
+		wantTSIGKey string
+		wantErr     bool
+	}{
+		{
+			name:      "should add the CNAME records of the kafka",
+			transport: "udp",
+			action:    KafkaRoutesActionCreate,
+			wantChanges: []string{
+				"add test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+				"add admin-server-test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+			},
+		},
+		{
+			name:      "should replace the CNAME records of the kafka",
+			transport: "tcp",
+			action:    KafkaRoutesActionUpsert,
+			wantChanges: []string{
+				"delete test-kafka-id.kafka.example.com.",
+				"add test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+				"delete admin-server-test-kafka-id.kafka.example.com.",
+				"add admin-server-test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+			},
+		},
+		{
+			name:        "should sign the deletion of the CNAME records of the kafka",
+			transport:   "udp",
+			tsigKeyName: "kas-fleet-manager",
+			action:      KafkaRoutesActionDelete,
+			wantChanges: []string{
+				"delete test-kafka-id.kafka.example.com.",
+				"delete admin-server-test-kafka-id.kafka.example.com.",
+			},
+			wantTSIGKey: "kas-fleet-manager.",
+		},
+		{
+			name:      "should return an error when the server rejects the update",
+			transport: "udp",
+			action:    KafkaRoutesActionCreate,
+			rcode:     9,
+			wantChanges: []string{
+				"add test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+				"add admin-server-test-kafka-id.kafka.example.com. router.test-cluster.example.com.",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			server, updates := startTestRFC2136Server(t, tt.transport, tt.rcode)
+			provider := &rfc2136KafkaDNSProvider{
+				server:        server,
+				transport:     tt.transport,
+				timeout:       5 * time.Second,
+				zone:          "kafka.example.com",
+				tsigKeyName:   tt.tsigKeyName,
+				tsigAlgorithm: "hmac-sha256",
+				tsigSecret:    []byte("secret"),
+			}
+
+			change, err := provider.ChangeRecords(&dbapi.KafkaRequest{}, routes, tt.action)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(change.ID).ToNot(gomega.BeEmpty())
+				g.Expect(change.InSync).To(gomega.BeTrue())
+			}
+
+			update := <-updates
+			g.Expect(update.zone).To(gomega.Equal("kafka.example.com."))
+			g.Expect(update.changes).To(gomega.Equal(tt.wantChanges))
+			g.Expect(update.tsigKey).To(gomega.Equal(tt.wantTSIGKey))
+		})
+	}
+}
+
+func Test_rfc2136KafkaDNSProvider_sign(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	provider := &rfc2136KafkaDNSProvider{
+		zone:          "kafka.example.com",
+		tsigKeyName:   "kas-fleet-manager",
+		tsigAlgorithm: "hmac-sha256",
+		tsigSecret:    []byte("secret"),
+	}
+	update, err := provider.buildUpdate(42, nil, KafkaRoutesActionCreate)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	signed := provider.sign(update, 42, time.Unix(1679486400, 0))
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(signed)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(header.ID).To(gomega.Equal(uint16(42)))
+	g.Expect(parser.SkipAllQuestions()).To(gomega.Succeed())
+	g.Expect(parser.SkipAllAnswers()).To(gomega.Succeed())
+	g.Expect(parser.SkipAllAuthorities()).To(gomega.Succeed())
+	tsig, err := parser.AdditionalHeader()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(tsig.Name.String()).To(gomega.Equal("kas-fleet-manager."))
+	g.Expect(tsig.Type).To(gomega.Equal(dnsTypeTSIG))
+	g.Expect(tsig.Class).To(gomega.Equal(dnsmessage.ClassANY))
+	// algorithm name, time signed, fudge, mac size, sha256 mac, original id, error and other len
+	g.Expect(int(tsig.Length)).To(gomega.Equal(len(packDNSName("hmac-sha256")) + 6 + 2 + 2 + 32 + 2 + 2 + 2))
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/onsi/gomega"
+	goerrors "github.com/pkg/errors"
+)
+
+func Test_NewKafkaDNSProvider(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	dnsConfig := config.NewKafkaDNSConfig()
+	kafkaConfig := &config.KafkaConfig{KafkaDomainName: "kafka.example.com"}
+	g.Expect(NewKafkaDNSProvider(dnsConfig, kafkaConfig, config.NewAWSConfig(), &aws.MockClientFactory{})).To(gomega.BeAssignableToTypeOf(&route53KafkaDNSProvider{}))
+
+	dnsConfig.Provider = config.KafkaDNSNoopProvider
+	g.Expect(NewKafkaDNSProvider(dnsConfig, kafkaConfig, config.NewAWSConfig(), &aws.MockClientFactory{})).To(gomega.BeAssignableToTypeOf(&noopKafkaDNSProvider{}))
+
+	dnsConfig.Provider = config.KafkaDNSRFC2136Provider
+	dnsConfig.RFC2136Server = "127.0.0.1:53"
+	dnsConfig.RFC2136TSIGKeyName = "kas-fleet-manager"
+	dnsConfig.RFC2136TSIGSecret = "c2VjcmV0"
+	provider := NewKafkaDNSProvider(dnsConfig, kafkaConfig, config.NewAWSConfig(), &aws.MockClientFactory{})
+	g.Expect(provider).To(gomega.BeAssignableToTypeOf(&rfc2136KafkaDNSProvider{}))
+	g.Expect(provider.(*rfc2136KafkaDNSProvider).zone).To(gomega.Equal("kafka.example.com"))
+	g.Expect(provider.(*rfc2136KafkaDNSProvider).tsigSecret).To(gomega.Equal([]byte("secret")))
+}
+
+func Test_parseKafkaDNSChangeID(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		wantProviderName string
+		wantChangeID     string
+	}{
+		{
+			name:             "should return the provider that made the change",
+			id:               "rfc2136:cgd4s0b6t3ik1nbudl9g",
+			wantProviderName: config.KafkaDNSRFC2136Provider,
+			wantChangeID:     "cgd4s0b6t3ik1nbudl9g",
+		},
+		{
+			name:             "should keep the separators of the id of the change in the provider",
+			id:               "route53:/change/C2682N5HXP0BZ4",
+			wantProviderName: config.KafkaDNSRoute53Provider,
+			wantChangeID:     "/change/C2682N5HXP0BZ4",
+		},
+		{
+			name:             "should return route53 for the changes that are not prefixed",
+			id:               "/change/C2682N5HXP0BZ4",
+			wantProviderName: config.KafkaDNSRoute53Provider,
+			wantChangeID:     "/change/C2682N5HXP0BZ4",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			providerName, changeID := parseKafkaDNSChangeID(tt.id)
+			g.Expect(providerName).To(gomega.Equal(tt.wantProviderName))
+			g.Expect(changeID).To(gomega.Equal(tt.wantChangeID))
+		})
+	}
+}
+
+func Test_route53KafkaDNSProvider_ChangeRecords(t *testing.T) {
+	changeID := "/change/C2682N5HXP0BZ4"
+	pending := route53.ChangeStatusPending
+
+	routes := []dbapi.DataPlaneKafkaRoute{
+		{Domain: "test-kafka-id.example.com", Router: "test-kafka-id.rhcloud.com"},
+	}
+
+	tests := []struct {
+		name         string
+		awsClient    aws.AWSClient
+		kafkaRequest *dbapi.KafkaRequest
+		action       KafkaRoutesAction
+		want         *KafkaDNSChange
+		wantErr      bool
+	}{
+		{
+			name: "should create CNAMEs for kafka",
+			awsClient: &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					if len(recordChangeBatch.Changes) != 1 {
+						return nil, goerrors.Errorf("number of record changes should be 1")
+					}
+					if *recordChangeBatch.Changes[0].Action != "CREATE" {
+						return nil, goerrors.Errorf("the action of the record change is not CREATE")
+					}
+					return &route53.ChangeResourceRecordSetsOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: &changeID, Status: &pending},
+					}, nil
+				},
+			},
+			kafkaRequest: &dbapi.KafkaRequest{CloudProvider: cloudproviders.AWS.String()},
+			action:       KafkaRoutesActionCreate,
+			want:         &KafkaDNSChange{ID: changeID, InSync: false},
+		},
+		{
+			name: "should delete CNAMEs for kafka",
+			awsClient: &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					if *recordChangeBatch.Changes[0].Action != "DELETE" {
+						return nil, goerrors.Errorf("the action of the record change is not DELETE")
+					}
+					return &route53.ChangeResourceRecordSetsOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: &changeID, Status: &pending},
+					}, nil
+				},
+			},
+			kafkaRequest: &dbapi.KafkaRequest{CloudProvider: cloudproviders.GCP.String()},
+			action:       KafkaRoutesActionDelete,
+			want:         &KafkaDNSChange{ID: changeID, InSync: false},
+		},
+		{
+			name:         "should return error for an unknown cloud provider",
+			awsClient:    &aws.AWSClientMock{},
+			kafkaRequest: &dbapi.KafkaRequest{CloudProvider: "anunknowncloudprovider"},
+			action:       KafkaRoutesActionCreate,
+			wantErr:      true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := &route53KafkaDNSProvider{
+				kafkaConfig:      &config.KafkaConfig{KafkaDomainName: "rhcloud.com"},
+				awsConfig:        config.NewAWSConfig(),
+				awsClientFactory: aws.NewMockClientFactory(tt.awsClient),
+			}
+			got, err := provider.ChangeRecords(tt.kafkaRequest, routes, tt.action)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_route53KafkaDNSProvider_GetChange(t *testing.T) {
+	changeID := "/change/C2682N5HXP0BZ4"
+	insync := route53.ChangeStatusInsync
+
+	tests := []struct {
+		name      string
+		awsClient aws.AWSClient
+		want      *KafkaDNSChange
+		wantErr   bool
+	}{
+		{
+			name: "should get the change",
+			awsClient: &aws.AWSClientMock{
+				GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+					return &route53.GetChangeOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: &changeId, Status: &insync},
+					}, nil
+				},
+			},
+			want: &KafkaDNSChange{ID: changeID, InSync: true},
+		},
+		{
+			name: "should return error when it fails to get the change",
+			awsClient: &aws.AWSClientMock{
+				GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+					return nil, goerrors.Errorf("throttled")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := &route53KafkaDNSProvider{
+				kafkaConfig:      &config.KafkaConfig{KafkaDomainName: "rhcloud.com"},
+				awsConfig:        config.NewAWSConfig(),
+				awsClientFactory: aws.NewMockClientFactory(tt.awsClient),
+			}
+			got, err := provider.GetChange(&dbapi.KafkaRequest{CloudProvider: cloudproviders.AWS.String()}, changeID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_getRoute53RegionFromKafkaRequest(t *testing.T) {
+
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Route53 region is correctly returned for Kafka instances in AWS",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "anotherregion",
+					CloudProvider: cloudproviders.AWS.String(),
+				},
+			},
+			want:    aws.DefaultAWSRoute53Region,
+			wantErr: false,
+		},
+		{
+			name: "Route53 region is correctly returned for Kafka instances in GCP",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "anotherregiontwo",
+					CloudProvider: cloudproviders.GCP.String(),
+				},
+			},
+			want:    aws.DefaultGCPRoute53Region,
+			wantErr: false,
+		},
+		{
+			name: "An error is returned if the Kafka instance has an unknown cloud provider",
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "us-east-1",
+					CloudProvider: "anunknowncloudprovider",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			res, err := getRoute53RegionFromKafkaRequest(tt.args.kafkaRequest)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(res).To(gomega.Equal(tt.want))
+		})
+	}
+}
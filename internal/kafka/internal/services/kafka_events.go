@@ -0,0 +1,124 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+// kafkaEventsQuery merges the status transitions and the data plane conditions of a kafka into a single history
+const kafkaEventsQuery = `SELECT id, kafka_id, ? AS type, occurred_at, old_status, new_status, actor,
+'' AS cluster_id, '' AS condition_type, '' AS condition_status, reason, '' AS message
+FROM kafka_status_events WHERE kafka_id = ? AND deleted_at IS NULL
+UNION ALL
+SELECT id, kafka_id, ? AS type, observed_at AS occurred_at, '' AS old_status, '' AS new_status, '' AS actor,
+cluster_id, type AS condition_type, status AS condition_status, reason, message
+FROM kafka_condition_events WHERE kafka_id = ? AND deleted_at IS NULL`
+
+//go:generate moq -out kafka_events_moq.go . KafkaEventService
+type KafkaEventService interface {
+	// RecordConditions records the conditions reported by the data plane cluster for the kafka.
+	// Only the conditions that changed since they were last reported by the data plane cluster are recorded
+	RecordConditions(kafkaID string, clusterID string, conditions []dbapi.DataPlaneKafkaStatusCondition) *errors.ServiceError
+	// List returns the history of the kafka, the most recent events first
+	List(kafkaID string, listArgs *services.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError)
+}
+
+type kafkaEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ KafkaEventService = &kafkaEventService{}
+
+func NewKafkaEventService(connectionFactory *db.ConnectionFactory) KafkaEventService {
+	return &kafkaEventService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaEventService) RecordConditions(kafkaID string, clusterID string, conditions []dbapi.DataPlaneKafkaStatusCondition) *errors.ServiceError {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	var latestConditions []*dbapi.KafkaConditionEvent
+	if err := s.connectionFactory.New().
+		Select("DISTINCT ON (type) *").
+		Where("kafka_id = ?", kafkaID).
+		Where("cluster_id = ?", clusterID).
+		Order("type, observed_at desc").
+		Find(&latestConditions).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the latest conditions of kafka %q", kafkaID)
+	}
+
+	latestConditionsByType := map[string]*dbapi.KafkaConditionEvent{}
+	for _, latestCondition := range latestConditions {
+		latestConditionsByType[latestCondition.Type] = latestCondition
+	}
+
+	now := time.Now()
+	events := []*dbapi.KafkaConditionEvent{}
+	for _, condition := range conditions {
+		if latestCondition, ok := latestConditionsByType[condition.Type]; ok && latestCondition.Matches(condition) {
+			continue
+		}
+
+		events = append(events, &dbapi.KafkaConditionEvent{
+			Meta:       api.Meta{ID: api.NewID()},
+			KafkaID:    kafkaID,
+			ClusterID:  clusterID,
+			Type:       condition.Type,
+			Status:     condition.Status,
+			Reason:     condition.Reason,
+			Message:    condition.Message,
+			ObservedAt: now,
+		})
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err := s.connectionFactory.New().Create(&events).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the conditions of kafka %q", kafkaID)
+	}
+
+	return nil
+}
+
+func (s *kafkaEventService) List(kafkaID string, listArgs *services.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+	var events dbapi.KafkaEventList
+	dbConn := s.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn = dbConn.Table("(?) AS kafka_events", dbConn.Raw(kafkaEventsQuery,
+		dbapi.KafkaEventTypeStatusChange, kafkaID,
+		dbapi.KafkaEventTypeCondition, kafkaID))
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return events, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the events of kafka %q", kafkaID)
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = dbConn.
+		Order("occurred_at desc, id").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&events).Error; err != nil {
+		return events, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the events of kafka %q", kafkaID)
+	}
+
+	return events, pagingMeta, nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaEventService_RecordConditions(t *testing.T) {
+	readyCondition := dbapi.DataPlaneKafkaStatusCondition{Type: "Ready", Status: "True"}
+
+	tests := []struct {
+		name       string
+		conditions []dbapi.DataPlaneKafkaStatusCondition
+		setupFn    func()
+		wantErr    bool
+		wantInsert bool
+	}{
+		{
+			name:       "should not record anything when no condition is reported",
+			conditions: []dbapi.DataPlaneKafkaStatusCondition{},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQueryException().WithExecException()
+			},
+		},
+		{
+			name:       "should record the conditions reported for the first time",
+			conditions: []dbapi.DataPlaneKafkaStatusCondition{readyCondition},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+			},
+			wantInsert: true,
+		},
+		{
+			name:       "should not record the conditions that did not change",
+			conditions: []dbapi.DataPlaneKafkaStatusCondition{readyCondition},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT DISTINCT ON (type) *`).
+					WithReply([]map[string]interface{}{{"id": "event-1", "type": "Ready", "status": "True"}})
+			},
+		},
+		{
+			name: "should record the conditions that changed",
+			conditions: []dbapi.DataPlaneKafkaStatusCondition{
+				{Type: "Ready", Status: "False", Reason: "Error", Message: "kafka resource could not be reconciled"},
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT DISTINCT ON (type) *`).
+					WithReply([]map[string]interface{}{{"id": "event-1", "type": "Ready", "status": "True"}})
+			},
+			wantInsert: true,
+		},
+		{
+			name:       "should return an error when the latest conditions cannot be retrieved",
+			conditions: []dbapi.DataPlaneKafkaStatusCondition{readyCondition},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT DISTINCT ON (type) *`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_condition_events"`)
+			s := NewKafkaEventService(db.NewMockConnectionFactory(nil))
+
+			err := s.RecordConditions("kafka-1", "cluster-1", tt.conditions)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(insert.Triggered).To(gomega.Equal(tt.wantInsert))
+		})
+	}
+}
+
+func Test_kafkaEventService_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantErr   bool
+		wantTotal int
+		wantIDs   []string
+	}{
+		{
+			name: "should return the status transitions and the conditions of the kafka",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1)`).WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`FROM kafka_status_events`).
+					WithReply([]map[string]interface{}{
+						{"id": "event-2", "kafka_id": "kafka-1", "type": "condition", "condition_type": "Ready", "condition_status": "True"},
+						{"id": "event-1", "kafka_id": "kafka-1", "type": "status_change", "old_status": "provisioning", "new_status": "ready"},
+					})
+			},
+			wantTotal: 2,
+			wantIDs:   []string{"event-2", "event-1"},
+		},
+		{
+			name: "should return an error when the events cannot be counted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1)`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewKafkaEventService(db.NewMockConnectionFactory(nil))
+
+			events, paging, err := s.List("kafka-1", &services.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(paging.Total).To(gomega.Equal(tt.wantTotal))
+			ids := []string{}
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			g.Expect(ids).To(gomega.Equal(tt.wantIDs))
+		})
+	}
+}
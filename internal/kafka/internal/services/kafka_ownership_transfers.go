@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+// kafkaTransferableStatuses are the statuses a kafka can be transferred in: the quota of a kafka that is being created or
+// whose status is changing could be reserved or released while it is transferred
+var kafkaTransferableStatuses = []string{constants.KafkaRequestStatusReady.String(), constants.KafkaRequestStatusSuspended.String()}
+
+// KafkaOwnershipTransferQuery selects kafka ownership transfers. The empty fields do not restrict the selection
+type KafkaOwnershipTransferQuery struct {
+	KafkaID string
+	// Owner selects the transfers from or to the owner
+	Owner string
+	// OrganisationID selects the transfers from or to the organisation
+	OrganisationID string
+}
+
+//go:generate moq -out kafka_ownership_transfers_moq.go . KafkaOwnershipTransferService
+type KafkaOwnershipTransferService interface {
+	// Transfer transfers the kafka to the new owner and records the transfer. When the new owner belongs to another
+	// organisation, the quota of the kafka is reserved in that organisation before the quota reserved in the previous one
+	// is deleted
+	Transfer(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, newOwner dbapi.KafkaOwner, reason string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// ReassignOwner transfers all the kafkas of the owner that can be transferred, in the given organisation when it is not empty,
+	// to the new owner. The kafkas in the other statuses, e.g. the failed ones, are left to the owner. It stops at the first kafka
+	// that fails to be transferred: the kafkas transferred so far are left to the new owner, so that the reassignment can be
+	// retried once the error is fixed
+	ReassignOwner(ctx context.Context, owner string, organisationID string, newOwner dbapi.KafkaOwner, reason string) (dbapi.KafkaOwnershipTransferList, *errors.ServiceError)
+	// List returns the kafka ownership transfers selected by the query, the most recent first
+	List(query KafkaOwnershipTransferQuery, listArgs *services.ListArguments) (dbapi.KafkaOwnershipTransferList, *api.PagingMeta, *errors.ServiceError)
+}
+
+type kafkaOwnershipTransferService struct {
+	connectionFactory   *db.ConnectionFactory
+	quotaServiceFactory QuotaServiceFactory
+	authService         authorization.Authorization
+}
+
+var _ KafkaOwnershipTransferService = &kafkaOwnershipTransferService{}
+
+func NewKafkaOwnershipTransferService(connectionFactory *db.ConnectionFactory, quotaServiceFactory QuotaServiceFactory, authService authorization.Authorization) KafkaOwnershipTransferService {
+	return &kafkaOwnershipTransferService{
+		connectionFactory:   connectionFactory,
+		quotaServiceFactory: quotaServiceFactory,
+		authService:         authService,
+	}
+}
+
+func (s *kafkaOwnershipTransferService) Transfer(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, newOwner dbapi.KafkaOwner, reason string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	if newOwner.OrganisationID == "" {
+		newOwner.OrganisationID = kafkaRequest.OrganisationId
+	}
+
+	if kafkaRequest.Owner == newOwner.Owner && kafkaRequest.OrganisationId == newOwner.OrganisationID {
+		return nil, errors.BadRequest("kafka %q is already owned by %q in organisation %q", kafkaRequest.ID, newOwner.Owner, newOwner.OrganisationID)
+	}
+
+	if !arrays.Contains(kafkaTransferableStatuses, kafkaRequest.Status) {
+		return nil, errors.BadRequest("kafka %q cannot be transferred while its status is %q", kafkaRequest.ID, kafkaRequest.Status)
+	}
+
+	userValid, authErr := s.authService.CheckUserValid(newOwner.Owner, newOwner.OrganisationID)
+	if authErr != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, authErr, "unable to check the new owner of kafka %q", kafkaRequest.ID)
+	}
+	if !userValid {
+		return nil, errors.BadRequest("user %q does not belong in organisation %q", newOwner.Owner, newOwner.OrganisationID)
+	}
+
+	transfer := &dbapi.KafkaOwnershipTransfer{
+		Meta:                   api.Meta{ID: api.NewID()},
+		KafkaID:                kafkaRequest.ID,
+		PreviousOwner:          kafkaRequest.Owner,
+		PreviousOrganisationID: kafkaRequest.OrganisationId,
+		PreviousSubscriptionID: kafkaRequest.SubscriptionId,
+		Owner:                  newOwner.Owner,
+		OrganisationID:         newOwner.OrganisationID,
+		SubscriptionID:         kafkaRequest.SubscriptionId,
+		Actor:                  kafkaStatusTransitionActor(ctx),
+		Reason:                 reason,
+	}
+
+	// the quota of an organisation is shared by its users: it only has to be reserved again when the organisation changes
+	var quotaService QuotaService
+	organisationChanged := kafkaRequest.OrganisationId != newOwner.OrganisationID
+	if organisationChanged {
+		var factoryErr *errors.ServiceError
+		quotaService, factoryErr = s.quotaServiceFactory.GetQuotaService(api.QuotaType(kafkaRequest.QuotaType))
+		if factoryErr != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, factoryErr, "unable to check quota")
+		}
+
+		transferredKafka := *kafkaRequest
+		transferredKafka.Owner = newOwner.Owner
+		transferredKafka.OrganisationId = newOwner.OrganisationID
+		// the billing model of a kafka does not change when it is transferred
+		transferredKafka.DesiredKafkaBillingModel = kafkaRequest.ActualKafkaBillingModel
+
+		subscriptionID, quotaErr := quotaService.ReserveQuota(&transferredKafka)
+		if quotaErr != nil {
+			return nil, errors.NewWithCause(quotaErr.Code, quotaErr, "unable to reserve the quota of kafka %q in organisation %q", kafkaRequest.ID, newOwner.OrganisationID)
+		}
+		transfer.SubscriptionID = subscriptionID
+	}
+
+	fields := map[string]interface{}{
+		"owner":           transfer.Owner,
+		"organisation_id": transfer.OrganisationID,
+		"subscription_id": transfer.SubscriptionID,
+	}
+	if newOwner.OwnerAccountID != "" {
+		fields["owner_account_id"] = newOwner.OwnerAccountID
+	}
+
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// the kafka must not have been transferred, deleted or changed status in the meantime
+		res := tx.Model(&dbapi.KafkaRequest{}).
+			Where("id = ? AND owner = ? AND organisation_id = ?", kafkaRequest.ID, transfer.PreviousOwner, transfer.PreviousOrganisationID).
+			Where("status IN (?)", kafkaTransferableStatuses).
+			Updates(fields)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(transfer).Error
+	})
+	if err != nil {
+		// the quota reserved in the new organisation is no longer needed
+		if organisationChanged && transfer.SubscriptionID != "" && transfer.SubscriptionID != transfer.PreviousSubscriptionID {
+			if deleteErr := quotaService.DeleteQuota(transfer.SubscriptionID); deleteErr != nil {
+				logger.Logger.Errorf("failed to delete the quota %q reserved for the transfer of kafka %q: %v", transfer.SubscriptionID, kafkaRequest.ID, deleteErr)
+			}
+		}
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Conflict("kafka %q has changed while it was being transferred", kafkaRequest.ID)
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to transfer kafka %q", kafkaRequest.ID)
+	}
+
+	if organisationChanged && transfer.PreviousSubscriptionID != "" && transfer.PreviousSubscriptionID != transfer.SubscriptionID {
+		// the kafka is transferred already: the quota that could not be deleted is only logged to be cleaned up
+		if deleteErr := quotaService.DeleteQuota(transfer.PreviousSubscriptionID); deleteErr != nil {
+			logger.Logger.Errorf("failed to delete the quota %q of kafka %q in its previous organisation %q: %v", transfer.PreviousSubscriptionID, kafkaRequest.ID, transfer.PreviousOrganisationID, deleteErr)
+		}
+	}
+
+	kafkaRequest.Owner = transfer.Owner
+	kafkaRequest.OrganisationId = transfer.OrganisationID
+	kafkaRequest.SubscriptionId = transfer.SubscriptionID
+	if newOwner.OwnerAccountID != "" {
+		kafkaRequest.OwnerAccountId = newOwner.OwnerAccountID
+	}
+
+	return transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) ReassignOwner(ctx context.Context, owner string, organisationID string, newOwner dbapi.KafkaOwner, reason string) (dbapi.KafkaOwnershipTransferList, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New().
+		Where("owner = ?", owner).
+		Where("status IN (?)", kafkaTransferableStatuses)
+	if organisationID != "" {
+		dbConn = dbConn.Where("organisation_id = ?", organisationID)
+	}
+
+	var kafkas dbapi.KafkaList
+	if err := dbConn.Order("created_at").Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the kafkas of %q", owner)
+	}
+
+	transfers := dbapi.KafkaOwnershipTransferList{}
+	for _, kafka := range kafkas {
+		transfer, err := s.Transfer(ctx, kafka, newOwner, reason)
+		if err != nil {
+			return transfers, errors.NewWithCause(err.Code, err, "%d of the %d kafkas of %q have been transferred", len(transfers), len(kafkas), owner)
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+func (s *kafkaOwnershipTransferService) List(query KafkaOwnershipTransferQuery, listArgs *services.ListArguments) (dbapi.KafkaOwnershipTransferList, *api.PagingMeta, *errors.ServiceError) {
+	var transfers dbapi.KafkaOwnershipTransferList
+	dbConn := s.connectionFactory.New().Model(&dbapi.KafkaOwnershipTransfer{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	if query.KafkaID != "" {
+		dbConn = dbConn.Where("kafka_id = ?", query.KafkaID)
+	}
+	if query.Owner != "" {
+		dbConn = dbConn.Where("previous_owner = ? OR owner = ?", query.Owner, query.Owner)
+	}
+	if query.OrganisationID != "" {
+		dbConn = dbConn.Where("previous_organisation_id = ? OR organisation_id = ?", query.OrganisationID, query.OrganisationID)
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return transfers, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the kafka ownership transfers")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.
+		Order("created_at DESC").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&transfers).Error; err != nil {
+		return transfers, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the kafka ownership transfers")
+	}
+	pagingMeta.Size = len(transfers)
+
+	return transfers, pagingMeta, nil
+}
@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaOwnershipTransferService_Transfer(t *testing.T) {
+	buildTransferredKafka := func() *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:                    api.Meta{ID: "kafka-id"},
+			Owner:                   "departed-user",
+			OrganisationId:          "old-org",
+			SubscriptionId:          "old-subscription",
+			QuotaType:               api.AMSQuotaType.String(),
+			ActualKafkaBillingModel: "standard",
+			Status:                  constants.KafkaRequestStatusReady.String(),
+		}
+	}
+
+	type args struct {
+		modifyFn func(kafkaRequest *dbapi.KafkaRequest)
+		newOwner dbapi.KafkaOwner
+	}
+	tests := []struct {
+		name               string
+		args               args
+		setupFn            func()
+		quotaService       *QuotaServiceMock
+		authService        authorization.Authorization
+		wantErr            *errors.ServiceError
+		wantSubscriptionID string
+		wantReserved       int
+		wantDeleted        []string
+		wantOrganisationID string
+		wantOwnerAccountID string
+	}{
+		{
+			name: "should transfer the kafka within its organisation without reserving quota",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "new-user"},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_ownership_transfers"`)
+			},
+			quotaService:       &QuotaServiceMock{},
+			wantSubscriptionID: "old-subscription",
+			wantOrganisationID: "old-org",
+		},
+		{
+			name: "should reserve the quota in the new organisation and delete the quota of the previous one",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "new-user", OrganisationID: "new-org", OwnerAccountID: "new-account"},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_ownership_transfers"`)
+			},
+			quotaService: &QuotaServiceMock{
+				ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+					return "new-subscription", nil
+				},
+				DeleteQuotaFunc: func(subscriptionId string) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantSubscriptionID: "new-subscription",
+			wantReserved:       1,
+			wantDeleted:        []string{"old-subscription"},
+			wantOrganisationID: "new-org",
+			wantOwnerAccountID: "new-account",
+		},
+		{
+			name: "should not transfer the kafka when the quota cannot be reserved in the new organisation",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "new-user", OrganisationID: "new-org"},
+			},
+			setupFn: func() {},
+			quotaService: &QuotaServiceMock{
+				ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+					return "", errors.InsufficientQuotaError("insufficient quota")
+				},
+			},
+			wantErr:      errors.InsufficientQuotaError("insufficient quota"),
+			wantReserved: 1,
+		},
+		{
+			name: "should delete the quota reserved in the new organisation when the kafka has changed in the meantime",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "new-user", OrganisationID: "new-org"},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(0)
+			},
+			quotaService: &QuotaServiceMock{
+				ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+					return "new-subscription", nil
+				},
+				DeleteQuotaFunc: func(subscriptionId string) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantErr:      errors.Conflict("kafka has changed"),
+			wantReserved: 1,
+			wantDeleted:  []string{"new-subscription"},
+		},
+		{
+			name: "should return bad request when the kafka is already owned by the new owner",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "departed-user", OrganisationID: "old-org"},
+			},
+			setupFn:      func() {},
+			quotaService: &QuotaServiceMock{},
+			wantErr:      errors.BadRequest("already owned"),
+		},
+		{
+			name: "should return bad request when the kafka is not ready nor suspended",
+			args: args{
+				modifyFn: func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.Status = constants.KafkaRequestStatusProvisioning.String()
+				},
+				newOwner: dbapi.KafkaOwner{Owner: "new-user"},
+			},
+			setupFn:      func() {},
+			quotaService: &QuotaServiceMock{},
+			wantErr:      errors.BadRequest("cannot be transferred"),
+		},
+		{
+			name: "should return bad request when the new owner does not belong in the organisation",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "unknown-user", OrganisationID: "new-org"},
+			},
+			setupFn:      func() {},
+			quotaService: &QuotaServiceMock{},
+			authService: &authorization.AuthorizationMock{
+				CheckUserValidFunc: func(username string, orgId string) (bool, error) {
+					return false, nil
+				},
+			},
+			wantErr: errors.BadRequest("user does not belong in the organisation"),
+		},
+		{
+			name: "should return an error when the new owner cannot be checked",
+			args: args{
+				newOwner: dbapi.KafkaOwner{Owner: "new-user"},
+			},
+			setupFn:      func() {},
+			quotaService: &QuotaServiceMock{},
+			authService: &authorization.AuthorizationMock{
+				CheckUserValidFunc: func(username string, orgId string) (bool, error) {
+					return false, errors.GeneralError("test")
+				},
+			},
+			wantErr: errors.GeneralError("unable to check the new owner"),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+			quotaServiceFactory := &QuotaServiceFactoryMock{
+				GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+					return tt.quotaService, nil
+				},
+			}
+			authService := tt.authService
+			if authService == nil {
+				authService = authorization.NewMockAuthorization()
+			}
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), quotaServiceFactory, authService)
+
+			kafkaRequest := buildTransferredKafka()
+			if tt.args.modifyFn != nil {
+				tt.args.modifyFn(kafkaRequest)
+			}
+			transfer, err := s.Transfer(context.Background(), kafkaRequest, tt.args.newOwner, "offboarding")
+			g.Expect(tt.quotaService.ReserveQuotaCalls()).To(gomega.HaveLen(tt.wantReserved))
+			var deleted []string
+			for _, call := range tt.quotaService.DeleteQuotaCalls() {
+				deleted = append(deleted, call.SubscriptionId)
+			}
+			g.Expect(deleted).To(gomega.Equal(tt.wantDeleted))
+
+			if tt.wantErr != nil {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErr.Code))
+				g.Expect(kafkaRequest.Owner).To(gomega.Equal("departed-user"))
+				return
+			}
+
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(transfer.PreviousOwner).To(gomega.Equal("departed-user"))
+			g.Expect(transfer.PreviousOrganisationID).To(gomega.Equal("old-org"))
+			g.Expect(transfer.PreviousSubscriptionID).To(gomega.Equal("old-subscription"))
+			g.Expect(transfer.Owner).To(gomega.Equal("new-user"))
+			g.Expect(transfer.OrganisationID).To(gomega.Equal(tt.wantOrganisationID))
+			g.Expect(transfer.SubscriptionID).To(gomega.Equal(tt.wantSubscriptionID))
+			g.Expect(transfer.Reason).To(gomega.Equal("offboarding"))
+			g.Expect(kafkaRequest.Owner).To(gomega.Equal("new-user"))
+			g.Expect(kafkaRequest.OrganisationId).To(gomega.Equal(tt.wantOrganisationID))
+			g.Expect(kafkaRequest.SubscriptionId).To(gomega.Equal(tt.wantSubscriptionID))
+			g.Expect(kafkaRequest.OwnerAccountId).To(gomega.Equal(tt.wantOwnerAccountID))
+		})
+	}
+}
+
+func Test_kafkaOwnershipTransferService_ReassignOwner(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupFn         func()
+		wantErr         bool
+		wantTransferred []string
+	}{
+		{
+			name: "should transfer all the kafkas of the owner",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithArgs("departed-user", constants.KafkaRequestStatusReady.String(), constants.KafkaRequestStatusSuspended.String()).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "owner": "departed-user", "organisation_id": "org", "status": constants.KafkaRequestStatusReady.String()},
+						{"id": "kafka-2", "owner": "departed-user", "organisation_id": "org", "status": constants.KafkaRequestStatusSuspended.String()},
+					})
+			},
+			wantTransferred: []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name: "should only select the kafkas of the owner that can be transferred",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithArgs("departed-user", constants.KafkaRequestStatusReady.String(), constants.KafkaRequestStatusSuspended.String()).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-2", "owner": "departed-user", "organisation_id": "org", "status": constants.KafkaRequestStatusReady.String()},
+					})
+				// the failed kafka must not be selected, as it would stop the reassignment of the following ones
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "owner": "departed-user", "organisation_id": "org", "status": constants.KafkaRequestStatusFailed.String()},
+						{"id": "kafka-2", "owner": "departed-user", "organisation_id": "org", "status": constants.KafkaRequestStatusReady.String()},
+					})
+			},
+			wantTransferred: []string{"kafka-2"},
+		},
+		{
+			name: "should return an error when the kafkas of the owner cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_ownership_transfers"`)
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), &QuotaServiceFactoryMock{}, authorization.NewMockAuthorization())
+			transfers, err := s.ReassignOwner(context.Background(), "departed-user", "", dbapi.KafkaOwner{Owner: "new-user"}, "offboarding")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			transferred := []string{}
+			for _, transfer := range transfers {
+				transferred = append(transferred, transfer.KafkaID)
+			}
+			g.Expect(transferred).To(gomega.Equal(tt.wantTransferred))
+		})
+	}
+}
+
+func Test_kafkaOwnershipTransferService_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT count(1) FROM "kafka_ownership_transfers"`).
+		WithArgs("departed-user", "departed-user").
+		WithReply([]map[string]interface{}{{"count": 2}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "kafka_ownership_transfers"`).
+		WithArgs("departed-user", "departed-user").
+		WithReply([]map[string]interface{}{{"id": "transfer-1"}, {"id": "transfer-2"}})
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+	s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), &QuotaServiceFactoryMock{}, authorization.NewMockAuthorization())
+
+	transfers, paging, err := s.List(KafkaOwnershipTransferQuery{Owner: "departed-user"}, &services.ListArguments{Page: 1, Size: 100})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(paging.Total).To(gomega.Equal(2))
+	g.Expect(transfers).To(gomega.HaveLen(2))
+}
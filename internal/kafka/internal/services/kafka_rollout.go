@@ -0,0 +1,226 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate moq -out kafka_rollout_moq.go . KafkaRolloutService
+type KafkaRolloutService interface {
+	// Create selects the kafkas to be upgraded by the rollout, assigns them to its batches and persists the rollout along with them.
+	// Only ready kafkas that do not run the target versions yet and that are not part of another active rollout are selected.
+	// The selected kafkas are locked until the rollout is persisted, so that concurrent rollouts cannot select the same kafkas
+	Create(rollout *dbapi.KafkaRollout) *errors.ServiceError
+	// Get returns the rollout with the given id along with its kafkas
+	Get(id string) (*dbapi.KafkaRollout, *errors.ServiceError)
+	// List returns the rollouts along with their kafkas, the most recent ones first
+	List(listArgs *services.ListArguments) (dbapi.KafkaRolloutList, *api.PagingMeta, *errors.ServiceError)
+	// ListActive returns the rollouts in progress or paused along with their kafkas. The kafkas being upgraded by a paused
+	// rollout keep being tracked, only no new batch is started
+	ListActive() (dbapi.KafkaRolloutList, *errors.ServiceError)
+	// Pause stops the rollout from starting new batches. The kafkas of the current batch keep being upgraded
+	Pause(id string) (*dbapi.KafkaRollout, *errors.ServiceError)
+	// Resume lets a paused rollout start new batches again
+	Resume(id string) (*dbapi.KafkaRollout, *errors.ServiceError)
+	// Update updates the given fields of the rollout
+	Update(rollout *dbapi.KafkaRollout, fields map[string]interface{}) *errors.ServiceError
+	// UpdateItem updates the given fields of a kafka of a rollout
+	UpdateItem(item *dbapi.KafkaRolloutItem, fields map[string]interface{}) *errors.ServiceError
+}
+
+type kafkaRolloutService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ KafkaRolloutService = &kafkaRolloutService{}
+
+func NewKafkaRolloutService(connectionFactory *db.ConnectionFactory) KafkaRolloutService {
+	return &kafkaRolloutService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaRolloutService) Create(rollout *dbapi.KafkaRollout) *errors.ServiceError {
+	rollout.ID = api.NewID()
+	rollout.Status = dbapi.KafkaRolloutStatusInProgress
+	rollout.CurrentBatch = 0
+	rollout.Items = []dbapi.KafkaRolloutItem{}
+
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// lock the kafkas matching the selector first: a concurrent creation selecting any of them waits for this rollout
+		// to be persisted, and its selection below then excludes the kafkas of this rollout
+		if err := s.selectedKafkas(tx, rollout).Select("id").Order("created_at").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&[]*dbapi.KafkaRequest{}).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to lock the kafkas of the rollout")
+		}
+
+		var kafkas []*dbapi.KafkaRequest
+		if err := s.selectedKafkas(tx, rollout).
+			Where("id NOT IN (?)", tx.
+				Table("kafka_rollout_items").
+				Select("kafka_rollout_items.kafka_id").
+				Joins("JOIN kafka_rollouts ON kafka_rollouts.id = kafka_rollout_items.rollout_id AND kafka_rollouts.deleted_at IS NULL").
+				Where("kafka_rollout_items.deleted_at IS NULL").
+				Where("kafka_rollouts.status IN ?", dbapi.KafkaRolloutStatusesActive).
+				Where("kafka_rollout_items.status IN ?", []dbapi.KafkaRolloutItemStatus{dbapi.KafkaRolloutItemStatusPending, dbapi.KafkaRolloutItemStatusUpgrading})).
+			Order("created_at").Find(&kafkas).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to select the kafkas of the rollout")
+		}
+
+		for _, kafka := range kafkas {
+			if rollout.IsUpgradedBy(kafka) {
+				continue
+			}
+			rollout.Items = append(rollout.Items, dbapi.KafkaRolloutItem{
+				Meta:      api.Meta{ID: api.NewID()},
+				RolloutID: rollout.ID,
+				KafkaID:   kafka.ID,
+				Batch:     len(rollout.Items)/rollout.BatchSize + 1,
+				Status:    dbapi.KafkaRolloutItemStatusPending,
+			})
+		}
+
+		if len(rollout.Items) == 0 {
+			return errors.BadRequest("no kafka to be upgraded matches the selector of the rollout")
+		}
+
+		if err := tx.Create(rollout).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the rollout")
+		}
+
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
+	}
+
+	return nil
+}
+
+// selectedKafkas returns the query of the ready kafkas matching the selector of the rollout
+func (s *kafkaRolloutService) selectedKafkas(tx *gorm.DB, rollout *dbapi.KafkaRollout) *gorm.DB {
+	dbConn := tx.Model(&dbapi.KafkaRequest{}).
+		Where("status = ?", constants.KafkaRequestStatusReady.String())
+
+	if rollout.InstanceType != "" {
+		dbConn = dbConn.Where("instance_type = ?", rollout.InstanceType)
+	}
+	if rollout.ClusterID != "" {
+		dbConn = dbConn.Where("cluster_id = ?", rollout.ClusterID)
+	}
+	if rollout.StrimziVersion != "" {
+		dbConn = dbConn.Where("actual_strimzi_version = ?", rollout.StrimziVersion)
+	}
+
+	return dbConn
+}
+
+func (s *kafkaRolloutService) Get(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var rollout dbapi.KafkaRollout
+	if err := s.withItems(s.connectionFactory.New()).Where("id = ?", id).First(&rollout).Error; err != nil {
+		return nil, services.HandleGetError("KafkaRollout", "id", id, err)
+	}
+
+	return &rollout, nil
+}
+
+func (s *kafkaRolloutService) List(listArgs *services.ListArguments) (dbapi.KafkaRolloutList, *api.PagingMeta, *errors.ServiceError) {
+	var rollouts dbapi.KafkaRolloutList
+	dbConn := s.connectionFactory.New()
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&rollouts).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	dbConn = s.withItems(dbConn).
+		Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size)
+
+	if err := dbConn.Find(&rollouts).Error; err != nil {
+		return rollouts, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list rollouts")
+	}
+
+	return rollouts, pagingMeta, nil
+}
+
+func (s *kafkaRolloutService) ListActive() (dbapi.KafkaRolloutList, *errors.ServiceError) {
+	var rollouts dbapi.KafkaRolloutList
+	if err := s.withItems(s.connectionFactory.New()).
+		Where("status IN ?", dbapi.KafkaRolloutStatusesActive).
+		Order("created_at").
+		Find(&rollouts).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the active rollouts")
+	}
+
+	return rollouts, nil
+}
+
+func (s *kafkaRolloutService) Pause(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+	return s.updateStatus(id, dbapi.KafkaRolloutStatusInProgress, dbapi.KafkaRolloutStatusPaused)
+}
+
+func (s *kafkaRolloutService) Resume(id string) (*dbapi.KafkaRollout, *errors.ServiceError) {
+	return s.updateStatus(id, dbapi.KafkaRolloutStatusPaused, dbapi.KafkaRolloutStatusInProgress)
+}
+
+func (s *kafkaRolloutService) updateStatus(id string, from dbapi.KafkaRolloutStatus, to dbapi.KafkaRolloutStatus) (*dbapi.KafkaRollout, *errors.ServiceError) {
+	rollout, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rollout.Status == to {
+		return rollout, nil
+	}
+
+	if rollout.Status != from {
+		return nil, errors.BadRequest("rollout %q is in %q status, only rollouts in %q status can be moved to %q status", id, rollout.Status, from, to)
+	}
+
+	if err := s.Update(rollout, map[string]interface{}{"status": to}); err != nil {
+		return nil, err
+	}
+	rollout.Status = to
+
+	return rollout, nil
+}
+
+func (s *kafkaRolloutService) Update(rollout *dbapi.KafkaRollout, fields map[string]interface{}) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaRollout{Meta: api.Meta{ID: rollout.ID}}).Updates(fields).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update rollout %q", rollout.ID)
+	}
+
+	return nil
+}
+
+func (s *kafkaRolloutService) UpdateItem(item *dbapi.KafkaRolloutItem, fields map[string]interface{}) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaRolloutItem{Meta: api.Meta{ID: item.ID}}).Updates(fields).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka %q of rollout %q", item.KafkaID, item.RolloutID)
+	}
+
+	return nil
+}
+
+func (s *kafkaRolloutService) withItems(dbConn *gorm.DB) *gorm.DB {
+	return dbConn.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("batch, created_at")
+	})
+}
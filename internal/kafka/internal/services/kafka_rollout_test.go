@@ -0,0 +1,154 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaRolloutService_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		rollout       *dbapi.KafkaRollout
+		setupFn       func()
+		wantErr       bool
+		wantItemCount int
+		wantBatches   []int
+	}{
+		{
+			name: "should return an error when the kafkas cannot be selected",
+			rollout: &dbapi.KafkaRollout{
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             1,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the kafkas matching the selector cannot be locked",
+			rollout: &dbapi.KafkaRollout{
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             1,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id" FROM "kafka_requests"`).WithQueryException()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.31.0-0"},
+					})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_rollouts"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_rollout_items"`)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when no kafka has to be upgraded",
+			rollout: &dbapi.KafkaRollout{
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             1,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.32.0-0"},
+					})
+			},
+			wantErr: true,
+		},
+		{
+			name: "should assign the kafkas that have to be upgraded to batches of the given size",
+			rollout: &dbapi.KafkaRollout{
+				DesiredStrimziVersion: "strimzi-cluster-operator.v0.32.0-0",
+				BatchSize:             2,
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{
+						{"id": "kafka-1", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.31.0-0"},
+						{"id": "kafka-2", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.32.0-0"},
+						{"id": "kafka-3", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.31.0-0"},
+						{"id": "kafka-4", "status": "ready", "actual_strimzi_version": "strimzi-cluster-operator.v0.31.0-0"},
+					})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_rollouts"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_rollout_items"`)
+			},
+			wantItemCount: 3,
+			wantBatches:   []int{1, 1, 2},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := &kafkaRolloutService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+
+			err := s.Create(tt.rollout)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(tt.rollout.ID).ToNot(gomega.BeEmpty())
+			g.Expect(tt.rollout.Status).To(gomega.Equal(dbapi.KafkaRolloutStatusInProgress))
+			g.Expect(tt.rollout.Items).To(gomega.HaveLen(tt.wantItemCount))
+			for i, item := range tt.rollout.Items {
+				g.Expect(item.RolloutID).To(gomega.Equal(tt.rollout.ID))
+				g.Expect(item.Status).To(gomega.Equal(dbapi.KafkaRolloutItemStatusPending))
+				g.Expect(item.Batch).To(gomega.Equal(tt.wantBatches[i]))
+			}
+		})
+	}
+}
+
+func Test_kafkaRolloutService_Pause(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     dbapi.KafkaRolloutStatus
+		wantErr    bool
+		wantStatus dbapi.KafkaRolloutStatus
+	}{
+		{
+			name:       "should pause a rollout in progress",
+			status:     dbapi.KafkaRolloutStatusInProgress,
+			wantStatus: dbapi.KafkaRolloutStatusPaused,
+		},
+		{
+			name:       "should not fail when the rollout is already paused",
+			status:     dbapi.KafkaRolloutStatusPaused,
+			wantStatus: dbapi.KafkaRolloutStatusPaused,
+		},
+		{
+			name:    "should return an error when the rollout has completed",
+			status:  dbapi.KafkaRolloutStatusCompleted,
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_rollouts"`).
+				WithReply([]map[string]interface{}{{"id": "rollout-1", "status": tt.status.String()}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_rollouts"`)
+			s := &kafkaRolloutService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+
+			rollout, err := s.Pause("rollout-1")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(rollout.Status).To(gomega.Equal(tt.wantStatus))
+			}
+		})
+	}
+}
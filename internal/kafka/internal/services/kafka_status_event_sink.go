@@ -0,0 +1,83 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+//go:generate moq -out kafka_status_event_sink_moq.go . KafkaStatusEventSink
+type KafkaStatusEventSink interface {
+	// Publish publishes the event. The same event may be published more than once, e.g. when the event could not be marked
+	// as published afterwards: consumers should use the id of the event to ignore the duplicates
+	Publish(event *dbapi.KafkaStatusEvent) error
+}
+
+// NewKafkaStatusEventSink returns the sink selected by the configuration
+func NewKafkaStatusEventSink(statusEventsConfig *config.KafkaStatusEventsConfig) KafkaStatusEventSink {
+	if statusEventsConfig.Sink == config.KafkaStatusEventsWebhookSink {
+		return &webhookKafkaStatusEventSink{
+			url:    statusEventsConfig.WebhookURL,
+			poster: newWebhookPoster(&http.Client{Timeout: statusEventsConfig.WebhookTimeout}),
+		}
+	}
+
+	return &logKafkaStatusEventSink{}
+}
+
+// KafkaStatusEventPayload is the representation of an event published to the sinks
+type KafkaStatusEventPayload struct {
+	ID         string    `json:"id"`
+	KafkaID    string    `json:"kafka_id"`
+	OldStatus  string    `json:"old_status,omitempty"`
+	NewStatus  string    `json:"new_status"`
+	Reason     string    `json:"reason,omitempty"`
+	Actor      string    `json:"actor"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func newKafkaStatusEventPayload(event *dbapi.KafkaStatusEvent) KafkaStatusEventPayload {
+	return KafkaStatusEventPayload{
+		ID:         event.ID,
+		KafkaID:    event.KafkaID,
+		OldStatus:  event.OldStatus,
+		NewStatus:  event.NewStatus,
+		Reason:     event.Reason,
+		Actor:      event.Actor,
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+type logKafkaStatusEventSink struct{}
+
+var _ KafkaStatusEventSink = &logKafkaStatusEventSink{}
+
+func (s *logKafkaStatusEventSink) Publish(event *dbapi.KafkaStatusEvent) error {
+	payload, err := json.Marshal(newKafkaStatusEventPayload(event))
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal kafka status event %q", event.ID)
+	}
+
+	glog.Infof("kafka status event: %s", payload)
+	return nil
+}
+
+type webhookKafkaStatusEventSink struct {
+	url    string
+	poster *webhookPoster
+}
+
+var _ KafkaStatusEventSink = &webhookKafkaStatusEventSink{}
+
+func (s *webhookKafkaStatusEventSink) Publish(event *dbapi.KafkaStatusEvent) error {
+	if err := s.poster.post(s.url, newKafkaStatusEventPayload(event)); err != nil {
+		return errors.Wrapf(err, "failed to post kafka status event %q to the webhook", event.ID)
+	}
+
+	return nil
+}
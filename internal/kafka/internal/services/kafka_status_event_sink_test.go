@@ -0,0 +1,88 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_NewKafkaStatusEventSink(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	statusEventsConfig := config.NewKafkaStatusEventsConfig()
+	g.Expect(NewKafkaStatusEventSink(statusEventsConfig)).To(gomega.BeAssignableToTypeOf(&logKafkaStatusEventSink{}))
+
+	statusEventsConfig.Sink = config.KafkaStatusEventsWebhookSink
+	statusEventsConfig.WebhookURL = "https://portal.example.com/hooks/kafkas"
+	g.Expect(NewKafkaStatusEventSink(statusEventsConfig)).To(gomega.BeAssignableToTypeOf(&webhookKafkaStatusEventSink{}))
+}
+
+func Test_webhookKafkaStatusEventSink_Publish(t *testing.T) {
+	occurredAt := time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC)
+	event := &dbapi.KafkaStatusEvent{
+		Meta:       api.Meta{ID: "event-1"},
+		KafkaID:    "kafka-1",
+		OldStatus:  "provisioning",
+		NewStatus:  "failed",
+		Reason:     "kafka creation timed out",
+		Actor:      dbapi.KafkaStatusEventActorSystem,
+		OccurredAt: occurredAt,
+	}
+
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "should post the event to the webhook",
+			statusCode: http.StatusNoContent,
+			wantErr:    false,
+		},
+		{
+			name:       "should return an error when the webhook does not accept the event",
+			statusCode: http.StatusServiceUnavailable,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var received KafkaStatusEventPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Header.Get("Content-Type")).To(gomega.Equal("application/json"))
+				g.Expect(json.NewDecoder(r.Body).Decode(&received)).To(gomega.Succeed())
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			sink := NewKafkaStatusEventSink(&config.KafkaStatusEventsConfig{
+				Sink:           config.KafkaStatusEventsWebhookSink,
+				WebhookURL:     server.URL,
+				WebhookTimeout: time.Second,
+			})
+
+			err := sink.Publish(event)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(received).To(gomega.Equal(KafkaStatusEventPayload{
+				ID:         "event-1",
+				KafkaID:    "kafka-1",
+				OldStatus:  "provisioning",
+				NewStatus:  "failed",
+				Reason:     "kafka creation timed out",
+				Actor:      "kas-fleet-manager",
+				OccurredAt: occurredAt,
+			}))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate moq -out kafka_status_events_moq.go . KafkaStatusEventService
+type KafkaStatusEventService interface {
+	// ListUnpublished returns at most limit events that have not been published yet, the oldest ones first. The events that
+	// failed to be published maxAttempts times are dead-lettered: they are not returned anymore
+	ListUnpublished(limit int, maxAttempts int) (dbapi.KafkaStatusEventList, *errors.ServiceError)
+	// MarkPublished records that the event has been published to the sink so that it is not published again
+	MarkPublished(event *dbapi.KafkaStatusEvent) *errors.ServiceError
+	// RecordFailedAttempt records that the event could not be published to the sink
+	RecordFailedAttempt(event *dbapi.KafkaStatusEvent) *errors.ServiceError
+}
+
+type kafkaStatusEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ KafkaStatusEventService = &kafkaStatusEventService{}
+
+func NewKafkaStatusEventService(connectionFactory *db.ConnectionFactory) KafkaStatusEventService {
+	return &kafkaStatusEventService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaStatusEventService) ListUnpublished(limit int, maxAttempts int) (dbapi.KafkaStatusEventList, *errors.ServiceError) {
+	var events dbapi.KafkaStatusEventList
+	if err := s.connectionFactory.New().
+		Where("published_at IS NULL AND attempts < ?", maxAttempts).
+		Order("occurred_at, created_at").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list unpublished kafka status events")
+	}
+
+	return events, nil
+}
+
+func (s *kafkaStatusEventService) MarkPublished(event *dbapi.KafkaStatusEvent) *errors.ServiceError {
+	now := time.Now()
+	if err := s.connectionFactory.New().
+		Model(&dbapi.KafkaStatusEvent{Meta: api.Meta{ID: event.ID}}).
+		Updates(map[string]interface{}{"published_at": now, "attempts": event.Attempts + 1}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to mark kafka status event %q as published", event.ID)
+	}
+
+	event.PublishedAt = &now
+	event.Attempts++
+	return nil
+}
+
+func (s *kafkaStatusEventService) RecordFailedAttempt(event *dbapi.KafkaStatusEvent) *errors.ServiceError {
+	if err := s.connectionFactory.New().
+		Model(&dbapi.KafkaStatusEvent{Meta: api.Meta{ID: event.ID}}).
+		Update("attempts", event.Attempts+1).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record the publishing attempt of kafka status event %q", event.ID)
+	}
+
+	event.Attempts++
+	return nil
+}
+
+// kafkaStatusTransition is the transition of the kafkas selected by scope to status
+type kafkaStatusTransition struct {
+	scope  func(dbConn *gorm.DB) *gorm.DB
+	status string
+	reason string
+	actor  string
+}
+
+// updateWithStatusEvents runs the update and records a status event for each of the kafkas selected by the scope of the transition
+// whose status is changed by it, in the same transaction. The selected kafkas are locked until the transaction ends so that their
+// previous status cannot change in the meantime.
+// The update is run on its own when the transition has no status. It returns the number of rows affected by the update
+func updateWithStatusEvents(dbConn *gorm.DB, transition kafkaStatusTransition, update func(tx *gorm.DB) *gorm.DB) (int64, error) {
+	if transition.status == "" {
+		res := update(dbConn)
+		return res.RowsAffected, res.Error
+	}
+
+	var rowsAffected int64
+	err := dbConn.Transaction(func(tx *gorm.DB) error {
+		var kafkas []*dbapi.KafkaRequest
+		if err := tx.Model(&dbapi.KafkaRequest{}).
+			Scopes(transition.scope).
+			Select("id", "status").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&kafkas).Error; err != nil {
+			return err
+		}
+
+		res := update(tx)
+		if res.Error != nil {
+			return res.Error
+		}
+		rowsAffected = res.RowsAffected
+
+		now := time.Now()
+		events := dbapi.KafkaStatusEventList{}
+		for _, kafka := range kafkas {
+			if kafka.Status == transition.status {
+				continue
+			}
+			events = append(events, newKafkaStatusEvent(kafka.ID, kafka.Status, transition, now))
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		return tx.Create(&events).Error
+	})
+
+	return rowsAffected, err
+}
+
+func newKafkaStatusEvent(kafkaID string, oldStatus string, transition kafkaStatusTransition, occurredAt time.Time) *dbapi.KafkaStatusEvent {
+	actor := transition.actor
+	if actor == "" {
+		actor = dbapi.KafkaStatusEventActorSystem
+	}
+
+	return &dbapi.KafkaStatusEvent{
+		Meta:       api.Meta{ID: api.NewID()},
+		KafkaID:    kafkaID,
+		OldStatus:  oldStatus,
+		NewStatus:  transition.status,
+		Reason:     transition.reason,
+		Actor:      actor,
+		OccurredAt: occurredAt,
+	}
+}
+
+// kafkaStatusTransitionReason returns the reason of the transition of a kafka to the given status.
+// Only the transitions to the failed status have one
+func kafkaStatusTransitionReason(status string, failedReason string) string {
+	if status != constants.KafkaRequestStatusFailed.String() {
+		return ""
+	}
+	return failedReason
+}
@@ -11,7 +11,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
@@ -22,7 +21,6 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -324,13 +322,19 @@ func Test_kafkaService_Get(t *testing.T) {
 				ctx: authenticatedCtx,
 				id:  testID,
 			},
-			want: buildKafkaRequest(nil),
+			want: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Labels = []dbapi.KafkaLabel{{KafkaID: testID, Key: "env", Value: "prod"}}
+			}),
 			setupFn: func() {
 				mocket.Catcher.Reset().
 					NewMock().
 					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND owner = $2`).
 					WithArgs(testID, testUser).
 					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT * FROM "kafka_labels" WHERE "kafka_labels"."kafka_id" = $1`).
+					WithArgs(testID).
+					WithReply([]map[string]interface{}{{"kafka_id": testID, "key": "env", "value": "prod"}})
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -496,6 +500,7 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 			},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			wantErr: false,
@@ -551,6 +556,7 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 			},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			wantErr:                 false,
@@ -626,7 +632,6 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				clusterService:    tt.fields.clusterService,
 				keycloakService:   tt.fields.keycloakService,
 				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			if err := k.PrepareKafkaRequest(tt.args.kafkaRequest); (err != nil) != tt.wantErr {
@@ -721,7 +726,6 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
 			if (err != nil) != tt.wantErr {
@@ -776,7 +780,8 @@ func Test_kafkaService_Delete(t *testing.T) {
 				}),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -802,7 +807,8 @@ func Test_kafkaService_Delete(t *testing.T) {
 				}),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -880,10 +886,33 @@ func Test_kafkaService_Delete(t *testing.T) {
 				}),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
+		{
+			name: "fail to delete kafka request: error when deleting its labels",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				keycloakService: &sso.KeycloakServiceMock{
+					GetConfigFunc: func() *keycloak.KeycloakConfig {
+						return &keycloak.KeycloakConfig{}
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.ID = testID
+				}),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels"`).WithExecException()
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
+			},
+			wantErr: true,
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase
@@ -897,7 +926,6 @@ func Test_kafkaService_Delete(t *testing.T) {
 				clusterService:    tt.fields.clusterService,
 				keycloakService:   tt.fields.keycloakService,
 				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Delete(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -1025,6 +1053,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1085,6 +1114,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.OrganisationId = "org-id"
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQuery(``)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 
@@ -1139,6 +1169,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1182,6 +1213,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1284,6 +1316,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.OrganisationId = "org-id"
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1441,6 +1474,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1487,6 +1521,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1530,6 +1565,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1650,6 +1686,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			error: errorCheck{
@@ -1670,7 +1707,6 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				connectionFactory:        tt.fields.connectionFactory,
 				clusterService:           tt.fields.clusterService,
 				kafkaConfig:              &tt.fields.kafkaConfig,
-				awsConfig:                config.NewAWSConfig(),
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
@@ -2042,6 +2078,48 @@ func Test_kafkaService_List(t *testing.T) {
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
+		{
+			name: "success: list only the columns of the selected fields",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				ctx: authenticatedAdminCtx,
+				listArgs: &services.ListArguments{
+					Page:   1,
+					Size:   100,
+					Fields: []string{"name", "version"},
+				},
+			},
+			want: want{
+				kafkaList: dbapi.KafkaList{
+					&dbapi.KafkaRequest{
+						Name:               "dummy-cluster-name",
+						ActualKafkaVersion: "3.3.1",
+						KafkaStorageSize:   "1000Gi",
+					},
+				},
+				pagingMeta: &api.PagingMeta{
+					Page:  1,
+					Size:  1,
+					Total: 1,
+				},
+			},
+			wantErr: false,
+			setupFn: func(kafkaList dbapi.KafkaList) {
+				mocket.Catcher.Reset()
+
+				totalCountResponse := []map[string]interface{}{{"count": len(kafkaList)}}
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "kafka_requests"`).WithReply(totalCountResponse)
+
+				query := fmt.Sprintf(`SELECT "id","kafka_storage_size","organisation_id","instance_type","size_id","name","actual_kafka_version" FROM "%s"`, kafkaRequestTableName)
+				response := []map[string]interface{}{
+					{"id": "", "kafka_storage_size": "1000Gi", "organisation_id": "", "instance_type": "", "size_id": "", "name": "dummy-cluster-name", "actual_kafka_version": "3.3.1"},
+				}
+				mocket.Catcher.NewMock().WithQuery(query).WithReply(response)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
 		{
 			name: "fail: user credentials not available in context",
 			fields: fields{
@@ -2108,7 +2186,6 @@ func Test_kafkaService_List(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, pagingMeta, err := k.List(tt.args.ctx, tt.args.listArgs)
@@ -2213,7 +2290,6 @@ func Test_kafkaService_ListAll(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, err := k.ListAll()
@@ -2282,7 +2358,6 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			got, err := k.ListByStatus(tt.args.status)
 			if (err != nil) != tt.wantErr {
@@ -2294,6 +2369,76 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_ListKafkasOnCluster(t *testing.T) {
+	type fields struct {
+		connectionFactory *db.ConnectionFactory
+	}
+	type args struct {
+		clusterID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*dbapi.KafkaRequest
+		wantErr bool
+		setupFn func()
+	}{
+		{
+			name:    "fail when the cluster id is undefined",
+			wantErr: true,
+		},
+		{
+			name: "fail when database returns an error",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testClusterID,
+			},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery("SELECT").WithQueryException()
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "success",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			},
+			args: args{
+				clusterID: testClusterID,
+			},
+			want: []*dbapi.KafkaRequest{buildKafkaRequest(nil)},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT * FROM "kafka_requests" WHERE (cluster_id = $1 OR migration_cluster_id = $2)`).
+					WithArgs(testClusterID, testClusterID).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			k := &kafkaService{
+				connectionFactory: tt.fields.connectionFactory,
+			}
+			got, err := k.ListKafkasOnCluster(tt.args.clusterID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func Test_kafkaService_UpdateStatus(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2352,6 +2497,9 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 			wantExecuted: true,
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants.KafkaRequestStatusDeprovision.String()}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().
 					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).
 					WithArgs(testID).
@@ -2379,6 +2527,9 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 						kafkaRequest.Status = constants.KafkaRequestStatusPreparing.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants.KafkaRequestStatusDeprovision.String()}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			args: args{
@@ -2395,7 +2546,6 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			executed, err := k.UpdateStatus(tt.args.id, tt.args.status)
 			if executed != tt.wantExecuted {
@@ -2449,6 +2599,7 @@ func Test_kafkaService_Update(t *testing.T) {
 			},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 		},
@@ -2462,7 +2613,6 @@ func Test_kafkaService_Update(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Update(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -2473,6 +2623,28 @@ func Test_kafkaService_Update(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_Update_DoesNotRestoreRemovedLabels(t *testing.T) {
+	g := gomega.NewWithT(t)
+	k := kafkaService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		kafkaConfig:       config.NewKafkaConfig(),
+	}
+	// a kafka loaded along with its labels, e.g. by the fleetshard status updates, before they are removed
+	staleKafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.Labels = []dbapi.KafkaLabel{{KafkaID: testID, Key: "env", Value: "prod"}}
+	})
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+	g.Expect(k.UpdateLabels(buildKafkaRequest(nil), nil)).To(gomega.BeNil())
+
+	mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+	mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
+	insertLabelsMock := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_labels"`)
+	g.Expect(k.Update(staleKafkaRequest)).To(gomega.BeNil())
+	g.Expect(k.Updates(staleKafkaRequest, map[string]interface{}{"owner": "new-owner"})).To(gomega.BeNil())
+	g.Expect(insertLabelsMock.Triggered).To(gomega.BeFalse())
+}
+
 func Test_kafkaService_Updates(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2512,6 +2684,7 @@ func Test_kafkaService_Updates(t *testing.T) {
 			},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 		},
@@ -2525,7 +2698,6 @@ func Test_kafkaService_Updates(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Updates(tt.args.kafkaRequest, map[string]interface{}{
 				"id":    "idsds",
@@ -2539,6 +2711,367 @@ func Test_kafkaService_Updates(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_UpdateLabels(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     []dbapi.KafkaLabel
+		wantLabels []dbapi.KafkaLabel
+		wantErr    bool
+		setupFn    func()
+	}{
+		{
+			name:    "fail when the labels can not be deleted",
+			labels:  []dbapi.KafkaLabel{{Key: "env", Value: "prod"}},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels"`).WithExecException()
+			},
+		},
+		{
+			name:    "fail when the labels can not be inserted",
+			labels:  []dbapi.KafkaLabel{{Key: "env", Value: "prod"}},
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_labels"`).WithExecException()
+			},
+		},
+		{
+			name:       "success replacing the labels of the kafka",
+			labels:     []dbapi.KafkaLabel{{Key: "env", Value: "prod"}},
+			wantLabels: []dbapi.KafkaLabel{{KafkaID: testID, Key: "env", Value: "prod"}},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_labels" ("kafka_id","key","value") VALUES ($1,$2,$3)`).WithArgs(testID, "env", "prod")
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+		},
+		{
+			name:       "success removing all the labels of the kafka",
+			labels:     nil,
+			wantLabels: nil,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`DELETE FROM "kafka_labels" WHERE kafka_id = $1`).WithArgs(testID)
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			kafkaRequest := buildKafkaRequest(nil)
+			err := k.UpdateLabels(kafkaRequest, tt.labels)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(kafkaRequest.Labels).To(gomega.Equal(tt.wantLabels))
+			}
+		})
+	}
+}
+
+func Test_kafkaService_Resize(t *testing.T) {
+	x2 := supportedKafkaSizeStandard[0]
+	x2.Id = "x2"
+	x2.QuotaConsumed = 2
+	x2.CapacityConsumed = 2
+	x2.MaxDataRetentionSize = "200Gi"
+	kafkaConfig := &config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id:                     types.STANDARD.String(),
+						DisplayName:            "Standard",
+						SupportedBillingModels: testSupportedKafkaBillingModelsStandard,
+						Sizes:                  append([]config.KafkaInstanceSize{x2}, supportedKafkaSizeStandard...),
+					},
+				},
+			},
+		},
+	}
+
+	buildCluster := func(maxUnits int) *api.Cluster {
+		return &api.Cluster{
+			ClusterID:           testClusterID,
+			DynamicCapacityInfo: api.JSON([]byte(fmt.Sprintf(`{"standard":{"max_nodes":3,"max_units":%d}}`, maxUnits))),
+		}
+	}
+	buildClusterService := func(maxUnits int, consumedStreamingUnits int64) *ClusterServiceMock {
+		return &ClusterServiceMock{
+			FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+				return buildCluster(maxUnits), nil
+			},
+			ComputeConsumedStreamingUnitCountPerInstanceTypeFunc: func(clusterID string) (StreamingUnitCountPerInstanceType, error) {
+				return StreamingUnitCountPerInstanceType{types.STANDARD: consumedStreamingUnits}, nil
+			},
+		}
+	}
+	var deletedQuotas []string
+	buildQuotaServiceFactory := func(quotaErr *errors.ServiceError) *QuotaServiceFactoryMock {
+		return &QuotaServiceFactoryMock{
+			GetQuotaServiceFunc: func(quoataType api.QuotaType) (QuotaService, *errors.ServiceError) {
+				return &QuotaServiceMock{
+					ReserveQuotaForResizeFunc: func(kafka *dbapi.KafkaRequest, sizeID string) (string, *errors.ServiceError) {
+						if quotaErr != nil {
+							return "", quotaErr
+						}
+						return "subscription-id", nil
+					},
+					DeleteQuotaFunc: func(subscriptionId string) *errors.ServiceError {
+						deletedQuotas = append(deletedQuotas, subscriptionId)
+						return nil
+					},
+				}, nil
+			},
+		}
+	}
+	buildReadyKafka := func(sizeID string, storageSize string) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.InstanceType = types.STANDARD.String()
+			kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+			kafkaRequest.SizeId = sizeID
+			kafkaRequest.KafkaStorageSize = storageSize
+		})
+	}
+
+	type fields struct {
+		clusterService      ClusterService
+		quotaServiceFactory QuotaServiceFactory
+	}
+	type args struct {
+		kafkaRequest *dbapi.KafkaRequest
+		sizeID       string
+	}
+	tests := []struct {
+		name              string
+		fields            fields
+		args              args
+		updateFails       bool
+		wantErr           bool
+		wantErrCode       errors.ServiceErrorCode
+		wantUpdate        bool
+		wantSizeID        string
+		wantStorageSize   string
+		wantDeletedQuotas []string
+	}{
+		{
+			name: "should do nothing when the kafka already has the size",
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x1",
+			},
+			wantSizeID:      "x1",
+			wantStorageSize: "100Gi",
+		},
+		{
+			name: "should return an error when the kafka is not ready",
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.InstanceType = types.STANDARD.String()
+					kafkaRequest.Status = constants.KafkaRequestStatusProvisioning.String()
+				}),
+				sizeID: "x2",
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name: "should return an error when the size is not a size of the instance type of the kafka",
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x3",
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorInstancePlanNotSupported,
+		},
+		{
+			name: "should return an error when the data plane cluster does not have the capacity for the new size",
+			fields: fields{
+				clusterService: buildClusterService(5, 5),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x2",
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorTooManyKafkaInstancesReached,
+		},
+		{
+			name: "should return an error when the quota for the new size cannot be reserved",
+			fields: fields{
+				clusterService:      buildClusterService(5, 3),
+				quotaServiceFactory: buildQuotaServiceFactory(errors.InsufficientQuotaError("insufficient quota")),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x2",
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorInsufficientQuota,
+		},
+		{
+			name: "should resize the kafka to a bigger size and increase its storage",
+			fields: fields{
+				clusterService:      buildClusterService(5, 3),
+				quotaServiceFactory: buildQuotaServiceFactory(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x2",
+			},
+			wantUpdate:      true,
+			wantSizeID:      "x2",
+			wantStorageSize: "200Gi",
+		},
+		{
+			name: "should resize the kafka to a smaller size without checking the capacity of the cluster nor shrinking its storage",
+			fields: fields{
+				clusterService:      &ClusterServiceMock{},
+				quotaServiceFactory: buildQuotaServiceFactory(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafka("x2", "200Gi"),
+				sizeID:       "x1",
+			},
+			wantUpdate:      true,
+			wantSizeID:      "x1",
+			wantStorageSize: "200Gi",
+		},
+		{
+			name: "should delete the quota reserved for the new size when the kafka cannot be updated",
+			fields: fields{
+				clusterService:      buildClusterService(5, 3),
+				quotaServiceFactory: buildQuotaServiceFactory(nil),
+			},
+			args: args{
+				kafkaRequest: buildReadyKafka("x1", "100Gi"),
+				sizeID:       "x2",
+			},
+			updateFails:       true,
+			wantErr:           true,
+			wantErrCode:       errors.ErrorGeneral,
+			wantSizeID:        "x1",
+			wantStorageSize:   "100Gi",
+			wantDeletedQuotas: []string{"subscription-id"},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			mocket.Catcher.Reset()
+			updateMock := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "kafka_storage_size"=$1,"size_id"=$2,"subscription_id"=$3`)
+			if tt.updateFails {
+				updateMock.WithExecException()
+			}
+			mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
+			deletedQuotas = nil
+
+			k := kafkaService{
+				connectionFactory:   db.NewMockConnectionFactory(nil),
+				clusterService:      tt.fields.clusterService,
+				quotaServiceFactory: tt.fields.quotaServiceFactory,
+				kafkaConfig:         kafkaConfig,
+			}
+			err := k.Resize(tt.args.kafkaRequest, tt.args.sizeID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(deletedQuotas).To(gomega.Equal(tt.wantDeletedQuotas))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+				if tt.updateFails {
+					g.Expect(tt.args.kafkaRequest.SizeId).To(gomega.Equal(tt.wantSizeID))
+					g.Expect(tt.args.kafkaRequest.KafkaStorageSize).To(gomega.Equal(tt.wantStorageSize))
+				}
+				return
+			}
+			g.Expect(updateMock.Triggered).To(gomega.Equal(tt.wantUpdate))
+			g.Expect(tt.args.kafkaRequest.SizeId).To(gomega.Equal(tt.wantSizeID))
+			g.Expect(tt.args.kafkaRequest.KafkaStorageSize).To(gomega.Equal(tt.wantStorageSize))
+		})
+	}
+}
+
+func Test_kafkaService_Suspend(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupFn     func()
+		wantErr     bool
+		wantErrCode errors.ServiceErrorCode
+		wantStatus  string
+	}{
+		{
+			name: "should start the suspension of the kafka",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE id = $1 AND status = $2`).
+					WithArgs(testID, constants.KafkaRequestStatusReady.String()).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants.KafkaRequestStatusReady.String()}})
+				mocket.Catcher.NewMock().
+					WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"suspension_reason"=$2,"updated_at"=$3 WHERE status = $4`).
+					WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			wantStatus: constants.KafkaRequestStatusSuspending.String(),
+		},
+		{
+			name: "should not suspend a kafka whose status changed since it was read",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE id = $1 AND status = $2`).
+					WithArgs(testID, constants.KafkaRequestStatusReady.String()).
+					WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().
+					WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"suspension_reason"=$2,"updated_at"=$3 WHERE status = $4`).
+					WithRowsNum(0)
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorBadRequest,
+			wantStatus:  constants.KafkaRequestStatusReady.String(),
+		},
+		{
+			name: "should return an error when the kafka cannot be updated",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).WithExecException()
+			},
+			wantErr:     true,
+			wantErrCode: errors.ErrorGeneral,
+			wantStatus:  constants.KafkaRequestStatusReady.String(),
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			kafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = constants.KafkaRequestStatusReady.String()
+			})
+
+			err := k.Suspend(context.Background(), kafkaRequest, dbapi.KafkaSuspensionReasonIdle)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantErrCode))
+			}
+			g.Expect(kafkaRequest.Status).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
+
 func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2575,6 +3108,7 @@ func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 			args:    args{users: []string{"user"}},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -2644,9 +3178,13 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 				mocket.Catcher.Reset().NewMock().
 					WithQuery(`SELECT * FROM "kafka_requests" WHERE status NOT IN ($1,$2) AND expires_at IS NOT NULL`).
 					WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize, "expires_at": &expiredTime}})
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE id IN ($1)`).
+					WithReply([]map[string]interface{}{{"id": "kafkainstance1", "status": constants.KafkaRequestStatusReady.String()}})
 				mocket.Catcher.NewMock().
 					WithArgs(constants.KafkaRequestStatusDeprovision.String(), dbTime, "kafkainstance1").
 					WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_status_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -2808,7 +3346,7 @@ func Test_KafkaService_CountByStatus(t *testing.T) {
 
 func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 	type fields struct {
-		awsClient aws.AWSClient
+		dnsProvider KafkaDNSProvider
 	}
 
 	type args struct {
@@ -2816,91 +3354,69 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 		action       KafkaRoutesAction
 	}
 
+	kafkaRequest := &dbapi.KafkaRequest{
+		Meta: api.Meta{
+			ID: "test-kafka-id",
+		},
+		Name:          "test-kafka-cname",
+		Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
+		Region:        testKafkaRequestRegion,
+		CloudProvider: cloudproviders.AWS.String(),
+	}
+
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
+		want    *KafkaDNSChange
 		wantErr bool
 	}{
 		{
-			name: "should create CNAMEs for kafka",
+			name: "should change the CNAMEs of the kafka with the DNS provider and prefix the id of the change by its name",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
+				dnsProvider: &KafkaDNSProviderMock{
+					NameFunc: func() string {
+						return config.KafkaDNSRFC2136Provider
+					},
+					ChangeRecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error) {
+						if len(routes) != 1 || routes[0].Domain != "test-kafka-id.example.com" {
+							return nil, goerrors.Errorf("unexpected routes %v", routes)
 						}
-						if *recordChangeBatch.Changes[0].Action != "CREATE" {
-							return nil, goerrors.Errorf("the action of the record change is not CREATE")
+						if action != KafkaRoutesActionDelete {
+							return nil, goerrors.Errorf("the action of the record change is not DELETE")
 						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
+						return &KafkaDNSChange{ID: "change-1", InSync: true}, nil
 					},
 				},
 			},
 			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Meta: api.Meta{
-						ID: "test-kafka-id",
-					},
-					Name:          "test-kafka-cname",
-					Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
-					Region:        testKafkaRequestRegion,
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-				action: KafkaRoutesActionCreate,
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionDelete,
 			},
+			want: &KafkaDNSChange{ID: "rfc2136:change-1", InSync: true},
 		},
 		{
-			name: "should delete CNAMEs for kafka",
+			name: "should return error if the DNS provider fails to change the CNAMEs",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
-						}
-						if *recordChangeBatch.Changes[0].Action != "DELETE" {
-							return nil, goerrors.Errorf("the action of the record change is not DELETE")
-						}
-						return nil, nil
+				dnsProvider: &KafkaDNSProviderMock{
+					NameFunc: func() string {
+						return config.KafkaDNSRoute53Provider
 					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
+					ChangeRecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) (*KafkaDNSChange, error) {
+						return nil, goerrors.Errorf("throttled")
 					},
 				},
 			},
 			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Meta: api.Meta{
-						ID: "test-kafka-id",
-					},
-					Name:          "test-kafka-cname",
-					Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
-					Region:        testKafkaRequestRegion,
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-				action: KafkaRoutesActionDelete,
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionCreate,
 			},
+			wantErr: true,
 		},
 		{
 			name: "should return error if it fails to get routes",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
-						}
-						if *recordChangeBatch.Changes[0].Action != "CREATE" {
-							return nil, goerrors.Errorf("the action of the record change is not CREATE")
-						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
-					},
-				},
+				dnsProvider: &KafkaDNSProviderMock{},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
@@ -2920,21 +3436,14 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 		tt := testcase
 
 		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
 			kafkaService := &kafkaService{
-				awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
-				awsConfig: &config.AWSConfig{
-					Route53AccessKey:       "test-route-53-key",
-					Route53SecretAccessKey: "test-route-53-secret-key",
-				},
-				kafkaConfig: &config.KafkaConfig{
-					KafkaDomainName: "rhcloud.com",
-				},
+				dnsProvider: tt.fields.dnsProvider,
 			}
 
-			_, err := kafkaService.ChangeKafkaCNAMErecords(tt.args.kafkaRequest, tt.args.action)
-			if err != nil && !tt.wantErr {
-				t.Errorf("unexpected error for ChangeKafkaCNAMErecords %v", err)
-			}
+			got, err := kafkaService.ChangeKafkaCNAMErecords(tt.args.kafkaRequest, tt.args.action)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
 		})
 	}
 
@@ -4025,18 +4534,21 @@ func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 
 func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 	type fields struct {
-		awsConfig        *config.AWSConfig
-		awsClientFactory aws.ClientFactory
+		dnsProvider KafkaDNSProvider
 	}
 
-	CNAME_Id := "CNAME_Id"
-	CNAME_Status := "CNAME_Status"
-	awsConfig := &config.AWSConfig{
-		AccountID:              "AccountID",
-		AccessKey:              "AccessKey",
-		SecretAccessKey:        "SecretAccessKey",
-		Route53AccessKey:       "Route53AccessKey",
-		Route53SecretAccessKey: "Route53SecretAccessKey",
+	route53Provider := func(status string) KafkaDNSProvider {
+		return &KafkaDNSProviderMock{
+			NameFunc: func() string {
+				return config.KafkaDNSRoute53Provider
+			},
+			GetChangeFunc: func(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error) {
+				if changeID != "/change/C2682N5HXP0BZ4" {
+					return nil, goerrors.Errorf("unexpected change id %q", changeID)
+				}
+				return &KafkaDNSChange{ID: changeID, InSync: status == "INSYNC"}, nil
+			},
+		}
 	}
 
 	type args struct {
@@ -4046,49 +4558,68 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    *CNameRecordStatus
+		want    *KafkaDNSChange
 		wantErr bool
 	}{
 		{
 			name: "should get the CNAME record Status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
-						return &route53.GetChangeOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &CNAME_Id,
-								Status: &CNAME_Status,
-							},
-						}, nil
-					},
-				}),
+				dnsProvider: route53Provider("INSYNC"),
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: cloudproviders.AWS.String(),
+					RoutesCreationId: "route53:/change/C2682N5HXP0BZ4",
 				},
 			},
-			want: &CNameRecordStatus{
-				Id:     &CNAME_Id,
-				Status: &CNAME_Status,
+			want: &KafkaDNSChange{
+				ID:     "route53:/change/C2682N5HXP0BZ4",
+				InSync: true,
 			},
 			wantErr: false,
 		},
+		{
+			name: "should get the CNAME record Status of a change made before the DNS providers were introduced",
+			fields: fields{
+				dnsProvider: route53Provider("PENDING"),
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					RoutesCreationId: "/change/C2682N5HXP0BZ4",
+				},
+			},
+			want: &KafkaDNSChange{
+				ID:     "/change/C2682N5HXP0BZ4",
+				InSync: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return error when the change was made by another DNS provider",
+			fields: fields{
+				dnsProvider: route53Provider("INSYNC"),
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					RoutesCreationId: "rfc2136:cgd4s0b6t3ik1nbudl9g",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should return error when it fails to get CNAME status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+				dnsProvider: &KafkaDNSProviderMock{
+					NameFunc: func() string {
+						return config.KafkaDNSRoute53Provider
+					},
+					GetChangeFunc: func(kafkaRequest *dbapi.KafkaRequest, changeID string) (*KafkaDNSChange, error) {
 						return nil, errors.GeneralError("unable to CNAME record status")
 					},
-				}),
+				},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
-					Region: "us-east-1",
+					RoutesCreationId: "route53:/change/C2682N5HXP0BZ4",
 				},
 			},
 			wantErr: true,
@@ -4100,8 +4631,7 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			k := &kafkaService{
-				awsConfig:        tt.fields.awsConfig,
-				awsClientFactory: tt.fields.awsClientFactory,
+				dnsProvider: tt.fields.dnsProvider,
 			}
 			got, err := k.GetCNAMERecordStatus(tt.args.kafkaRequest)
 			g.Expect(got).To(gomega.Equal(tt.want))
@@ -4117,9 +4647,8 @@ func Test_NewKafkaService(t *testing.T) {
 		keycloakService          sso.KafkaKeycloakService
 		kafkaConfig              *config.KafkaConfig
 		dataplaneClusterConfig   *config.DataplaneClusterConfig
-		awsConfig                *config.AWSConfig
+		dnsProvider              KafkaDNSProvider
 		quotaServiceFactory      QuotaServiceFactory
-		awsClientFactory         aws.ClientFactory
 		authorizationService     authorization.Authorization
 		providerConfig           *config.ProviderConfig
 		clusterPlacementStrategy ClusterPlacementStrategy
@@ -4137,9 +4666,8 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:          &sso.KeycloakServiceMock{},
 				kafkaConfig:              &config.KafkaConfig{},
 				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
+				dnsProvider:              &KafkaDNSProviderMock{},
 				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
 				providerConfig:           &config.ProviderConfig{},
 				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
 			},
@@ -4149,9 +4677,8 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:          &sso.KeycloakServiceMock{},
 				kafkaConfig:              &config.KafkaConfig{},
 				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
+				dnsProvider:              &KafkaDNSProviderMock{},
 				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
 				providerConfig:           &config.ProviderConfig{},
 				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
 			},
@@ -4161,7 +4688,7 @@ func Test_NewKafkaService(t *testing.T) {
 	for _, testcase := range tests {
 		g := gomega.NewWithT(t)
 		tt := testcase
-		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.awsConfig, tt.args.quotaServiceFactory, tt.args.awsClientFactory, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy)).To(gomega.Equal(tt.want))
+		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.dnsProvider, tt.args.quotaServiceFactory, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy)).To(gomega.Equal(tt.want))
 	}
 }
 
@@ -4281,49 +4808,67 @@ func Test_kafkaService_AssignBootstrapServerHost(t *testing.T) {
 	}
 }
 
-func Test_kafkaService_getRoute53RegionFromKafkaRequest(t *testing.T) {
-
-	type args struct {
-		kafkaRequest *dbapi.KafkaRequest
+func Test_buildManagedKafkaVersions(t *testing.T) {
+	now := time.Date(2023, 3, 8, 12, 0, 0, 0, time.UTC)
+	closedWindow := api.JSON(`{"day_of_week":"sunday","start_hour":0,"duration_hours":4,"timezone":"UTC"}`)
+	openWindow := api.JSON(`{"day_of_week":"wednesday","start_hour":10,"duration_hours":4,"timezone":"UTC"}`)
+	kafkaWithPendingUpgrade := func(window api.JSON) *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			MaintenanceWindow:      window,
+			DesiredKafkaVersion:    "kafka-2",
+			DesiredStrimziVersion:  "strimzi-2",
+			DesiredKafkaIBPVersion: "kafka-ibp-2",
+			ActualKafkaVersion:     "kafka-1",
+			ActualStrimziVersion:   "strimzi-1",
+			ActualKafkaIBPVersion:  "kafka-ibp-1",
+		}
 	}
+	desiredVersions := managedkafka.VersionsSpec{Kafka: "kafka-2", Strimzi: "strimzi-2", KafkaIBP: "kafka-ibp-2"}
 
 	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
+		name         string
+		kafkaRequest func() *dbapi.KafkaRequest
+		want         managedkafka.VersionsSpec
 	}{
 		{
-			name: "Route53 region is correctly returned for Kafka instances in AWS",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregion",
-					CloudProvider: cloudproviders.AWS.String(),
-				},
+			name: "should return the desired versions when the kafka has no maintenance window",
+			kafkaRequest: func() *dbapi.KafkaRequest {
+				return kafkaWithPendingUpgrade(nil)
 			},
-			want:    aws.DefaultAWSRoute53Region,
-			wantErr: false,
+			want: desiredVersions,
 		},
 		{
-			name: "Route53 region is correctly returned for Kafka instances in GCP",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregiontwo",
-					CloudProvider: cloudproviders.GCP.String(),
-				},
+			name: "should return the desired versions when the maintenance window is open",
+			kafkaRequest: func() *dbapi.KafkaRequest {
+				return kafkaWithPendingUpgrade(openWindow)
 			},
-			want:    aws.DefaultGCPRoute53Region,
-			wantErr: false,
+			want: desiredVersions,
 		},
 		{
-			name: "An error is returned if the Kafka instance has an unknown cloud provider",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: "anunknowncloudprovider",
-				},
+			name: "should hold back the actual versions when the maintenance window is closed",
+			kafkaRequest: func() *dbapi.KafkaRequest {
+				return kafkaWithPendingUpgrade(closedWindow)
 			},
-			wantErr: true,
+			want: managedkafka.VersionsSpec{Kafka: "kafka-1", Strimzi: "strimzi-1", KafkaIBP: "kafka-ibp-1"},
+		},
+		{
+			name: "should return the desired versions when the upgrade is forced outside of the maintenance window",
+			kafkaRequest: func() *dbapi.KafkaRequest {
+				k := kafkaWithPendingUpgrade(closedWindow)
+				k.ForceUpgrade = true
+				return k
+			},
+			want: desiredVersions,
+		},
+		{
+			name: "should not hold back unreported versions and upgrades in progress when the maintenance window is closed",
+			kafkaRequest: func() *dbapi.KafkaRequest {
+				k := kafkaWithPendingUpgrade(closedWindow)
+				k.ActualKafkaIBPVersion = ""
+				k.StrimziUpgrading = true
+				return k
+			},
+			want: managedkafka.VersionsSpec{Kafka: "kafka-1", Strimzi: "strimzi-2", KafkaIBP: "kafka-ibp-2"},
 		},
 	}
 
@@ -4331,10 +4876,71 @@ func Test_kafkaService_getRoute53RegionFromKafkaRequest(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			k := &kafkaService{}
-			res, err := k.getRoute53RegionFromKafkaRequest(tt.args.kafkaRequest)
-			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
-			g.Expect(res).To(gomega.Equal(tt.want))
+			g.Expect(buildManagedKafkaVersions(tt.kafkaRequest(), now)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaOrderByColumn(t *testing.T) {
+	tests := []struct {
+		name       string
+		orderByArg string
+		want       string
+	}{
+		{
+			name:       "should keep the fields stored in a column of the same name",
+			orderByArg: "name asc",
+			want:       "name asc",
+		},
+		{
+			name:       "should order by the column storing the field",
+			orderByArg: "version  desc",
+			want:       "actual_kafka_version desc",
+		},
+		{
+			name:       "should order by the id of the kafka for its href",
+			orderByArg: "href",
+			want:       "id",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(kafkaOrderByColumn(tt.orderByArg)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaSelectColumns(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+		want   []string
+	}{
+		{
+			name:   "should select the fields stored in a column of the same name",
+			fields: []string{"name", "status"},
+			want:   []string{"id", "kafka_storage_size", "organisation_id", "instance_type", "size_id", "name", "status"},
+		},
+		{
+			name:   "should select the columns the fields are presented from",
+			fields: []string{"version", "cluster_id", "max_partitions", "total_max_connections"},
+			want:   []string{"id", "kafka_storage_size", "organisation_id", "instance_type", "size_id", "actual_kafka_version", "cluster_id", "desired_kafka_billing_model"},
+		},
+		{
+			name:   "should not select a column for the fields computed without one",
+			fields: []string{"href", "kind", "browser_url", "labels", "max_data_retention_size"},
+			want:   []string{"id", "kafka_storage_size", "organisation_id", "instance_type", "size_id"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(kafkaSelectColumns(tt.fields)).To(gomega.Equal(tt.want))
 		})
 	}
 }
@@ -5,7 +5,6 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	kafkaTypes "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
@@ -32,7 +31,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //			AssignInstanceTypeFunc: func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *apiErrors.ServiceError) {
 //				panic("mock out the AssignInstanceType method")
 //			},
-//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *apiErrors.ServiceError) {
+//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*KafkaDNSChange, *apiErrors.ServiceError) {
 //				panic("mock out the ChangeKafkaCNAMErecords method")
 //			},
 //			CountByStatusFunc: func(status []constants.KafkaStatus) ([]KafkaStatusCount, error) {
@@ -59,12 +58,15 @@ var _ KafkaService = &KafkaServiceMock{}
 //			GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *apiErrors.ServiceError) {
 //				panic("mock out the GetByID method")
 //			},
-//			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+//			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*KafkaDNSChange, error) {
 //				panic("mock out the GetCNAMERecordStatus method")
 //			},
 //			GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]managedkafka.ManagedKafka, *apiErrors.ServiceError) {
 //				panic("mock out the GetManagedKafkaByClusterID method")
 //			},
+//			GetManagedKafkaChangesByClusterIDFunc: func(clusterID string, sinceResourceVersion string) (*ManagedKafkaChanges, *apiErrors.ServiceError) {
+//				panic("mock out the GetManagedKafkaChangesByClusterID method")
+//			},
 //			HasAvailableCapacityInRegionFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
 //				panic("mock out the HasAvailableCapacityInRegion method")
 //			},
@@ -83,6 +85,12 @@ var _ KafkaService = &KafkaServiceMock{}
 //			ListComponentVersionsFunc: func() ([]KafkaComponentVersions, error) {
 //				panic("mock out the ListComponentVersions method")
 //			},
+//			ListKafkasOnClusterFunc: func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+//				panic("mock out the ListKafkasOnCluster method")
+//			},
+//			ListKafkasToBeMigratedFunc: func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+//				panic("mock out the ListKafkasToBeMigrated method")
+//			},
 //			ListKafkasToBePromotedFunc: func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
 //				panic("mock out the ListKafkasToBePromoted method")
 //			},
@@ -98,9 +106,21 @@ var _ KafkaService = &KafkaServiceMock{}
 //			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 //				panic("mock out the RegisterKafkaJob method")
 //			},
+//			ResizeFunc: func(kafkaRequest *dbapi.KafkaRequest, sizeID string) *apiErrors.ServiceError {
+//				panic("mock out the Resize method")
+//			},
+//			ResumeFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+//				panic("mock out the Resume method")
+//			},
+//			SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *apiErrors.ServiceError {
+//				panic("mock out the Suspend method")
+//			},
 //			UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 //				panic("mock out the Update method")
 //			},
+//			UpdateLabelsFunc: func(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *apiErrors.ServiceError {
+//				panic("mock out the UpdateLabels method")
+//			},
 //			UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError) {
 //				panic("mock out the UpdateStatus method")
 //			},
@@ -127,7 +147,7 @@ type KafkaServiceMock struct {
 	AssignInstanceTypeFunc func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *apiErrors.ServiceError)
 
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *apiErrors.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*KafkaDNSChange, *apiErrors.ServiceError)
 
 	// CountByStatusFunc mocks the CountByStatus method.
 	CountByStatusFunc func(status []constants.KafkaStatus) ([]KafkaStatusCount, error)
@@ -154,11 +174,14 @@ type KafkaServiceMock struct {
 	GetByIDFunc func(id string) (*dbapi.KafkaRequest, *apiErrors.ServiceError)
 
 	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
-	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*KafkaDNSChange, error)
 
 	// GetManagedKafkaByClusterIDFunc mocks the GetManagedKafkaByClusterID method.
 	GetManagedKafkaByClusterIDFunc func(clusterID string) ([]managedkafka.ManagedKafka, *apiErrors.ServiceError)
 
+	// GetManagedKafkaChangesByClusterIDFunc mocks the GetManagedKafkaChangesByClusterID method.
+	GetManagedKafkaChangesByClusterIDFunc func(clusterID string, sinceResourceVersion string) (*ManagedKafkaChanges, *apiErrors.ServiceError)
+
 	// HasAvailableCapacityInRegionFunc mocks the HasAvailableCapacityInRegion method.
 	HasAvailableCapacityInRegionFunc func(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError)
 
@@ -177,6 +200,12 @@ type KafkaServiceMock struct {
 	// ListComponentVersionsFunc mocks the ListComponentVersions method.
 	ListComponentVersionsFunc func() ([]KafkaComponentVersions, error)
 
+	// ListKafkasOnClusterFunc mocks the ListKafkasOnCluster method.
+	ListKafkasOnClusterFunc func(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError)
+
+	// ListKafkasToBeMigratedFunc mocks the ListKafkasToBeMigrated method.
+	ListKafkasToBeMigratedFunc func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError)
+
 	// ListKafkasToBePromotedFunc mocks the ListKafkasToBePromoted method.
 	ListKafkasToBePromotedFunc func() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError)
 
@@ -192,9 +221,21 @@ type KafkaServiceMock struct {
 	// RegisterKafkaJobFunc mocks the RegisterKafkaJob method.
 	RegisterKafkaJobFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
 
+	// ResizeFunc mocks the Resize method.
+	ResizeFunc func(kafkaRequest *dbapi.KafkaRequest, sizeID string) *apiErrors.ServiceError
+
+	// ResumeFunc mocks the Resume method.
+	ResumeFunc func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
+
+	// SuspendFunc mocks the Suspend method.
+	SuspendFunc func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *apiErrors.ServiceError
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError
 
+	// UpdateLabelsFunc mocks the UpdateLabels method.
+	UpdateLabelsFunc func(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *apiErrors.ServiceError
+
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError)
 
@@ -278,6 +319,13 @@ type KafkaServiceMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// GetManagedKafkaChangesByClusterID holds details about calls to the GetManagedKafkaChangesByClusterID method.
+		GetManagedKafkaChangesByClusterID []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// SinceResourceVersion is the sinceResourceVersion argument value.
+			SinceResourceVersion string
+		}
 		// HasAvailableCapacityInRegion holds details about calls to the HasAvailableCapacityInRegion method.
 		HasAvailableCapacityInRegion []struct {
 			// KafkaRequest is the kafkaRequest argument value.
@@ -306,6 +354,14 @@ type KafkaServiceMock struct {
 		// ListComponentVersions holds details about calls to the ListComponentVersions method.
 		ListComponentVersions []struct {
 		}
+		// ListKafkasOnCluster holds details about calls to the ListKafkasOnCluster method.
+		ListKafkasOnCluster []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// ListKafkasToBeMigrated holds details about calls to the ListKafkasToBeMigrated method.
+		ListKafkasToBeMigrated []struct {
+		}
 		// ListKafkasToBePromoted holds details about calls to the ListKafkasToBePromoted method.
 		ListKafkasToBePromoted []struct {
 		}
@@ -329,11 +385,41 @@ type KafkaServiceMock struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// Resize holds details about calls to the Resize method.
+		Resize []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// SizeID is the sizeID argument value.
+			SizeID string
+		}
+		// Resume holds details about calls to the Resume method.
+		Resume []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+		}
+		// Suspend holds details about calls to the Suspend method.
+		Suspend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Reason is the reason argument value.
+			Reason dbapi.KafkaSuspensionReason
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// UpdateLabels holds details about calls to the UpdateLabels method.
+		UpdateLabels []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Labels is the labels argument value.
+			Labels []dbapi.KafkaLabel
+		}
 		// UpdateStatus holds details about calls to the UpdateStatus method.
 		UpdateStatus []struct {
 			// ID is the id argument value.
@@ -382,18 +468,25 @@ type KafkaServiceMock struct {
 	lockGetByID                                  sync.RWMutex
 	lockGetCNAMERecordStatus                     sync.RWMutex
 	lockGetManagedKafkaByClusterID               sync.RWMutex
+	lockGetManagedKafkaChangesByClusterID        sync.RWMutex
 	lockHasAvailableCapacityInRegion             sync.RWMutex
 	lockIsQuotaEntitlementActive                 sync.RWMutex
 	lockList                                     sync.RWMutex
 	lockListAll                                  sync.RWMutex
 	lockListByStatus                             sync.RWMutex
 	lockListComponentVersions                    sync.RWMutex
+	lockListKafkasOnCluster                      sync.RWMutex
+	lockListKafkasToBeMigrated                   sync.RWMutex
 	lockListKafkasToBePromoted                   sync.RWMutex
 	lockListKafkasWithRoutesNotCreated           sync.RWMutex
 	lockPrepareKafkaRequest                      sync.RWMutex
 	lockRegisterKafkaDeprovisionJob              sync.RWMutex
 	lockRegisterKafkaJob                         sync.RWMutex
+	lockResize                                   sync.RWMutex
+	lockResume                                   sync.RWMutex
+	lockSuspend                                  sync.RWMutex
 	lockUpdate                                   sync.RWMutex
+	lockUpdateLabels                             sync.RWMutex
 	lockUpdateStatus                             sync.RWMutex
 	lockUpdates                                  sync.RWMutex
 	lockValidateBillingAccount                   sync.RWMutex
@@ -469,7 +562,7 @@ func (mock *KafkaServiceMock) AssignInstanceTypeCalls() []struct {
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *apiErrors.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*KafkaDNSChange, *apiErrors.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -760,7 +853,7 @@ func (mock *KafkaServiceMock) GetByIDCalls() []struct {
 }
 
 // GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
-func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*KafkaDNSChange, error) {
 	if mock.GetCNAMERecordStatusFunc == nil {
 		panic("KafkaServiceMock.GetCNAMERecordStatusFunc: method is nil but KafkaService.GetCNAMERecordStatus was just called")
 	}
@@ -823,6 +916,42 @@ func (mock *KafkaServiceMock) GetManagedKafkaByClusterIDCalls() []struct {
 	return calls
 }
 
+// GetManagedKafkaChangesByClusterID calls GetManagedKafkaChangesByClusterIDFunc.
+func (mock *KafkaServiceMock) GetManagedKafkaChangesByClusterID(clusterID string, sinceResourceVersion string) (*ManagedKafkaChanges, *apiErrors.ServiceError) {
+	if mock.GetManagedKafkaChangesByClusterIDFunc == nil {
+		panic("KafkaServiceMock.GetManagedKafkaChangesByClusterIDFunc: method is nil but KafkaService.GetManagedKafkaChangesByClusterID was just called")
+	}
+	callInfo := struct {
+		ClusterID            string
+		SinceResourceVersion string
+	}{
+		ClusterID:            clusterID,
+		SinceResourceVersion: sinceResourceVersion,
+	}
+	mock.lockGetManagedKafkaChangesByClusterID.Lock()
+	mock.calls.GetManagedKafkaChangesByClusterID = append(mock.calls.GetManagedKafkaChangesByClusterID, callInfo)
+	mock.lockGetManagedKafkaChangesByClusterID.Unlock()
+	return mock.GetManagedKafkaChangesByClusterIDFunc(clusterID, sinceResourceVersion)
+}
+
+// GetManagedKafkaChangesByClusterIDCalls gets all the calls that were made to GetManagedKafkaChangesByClusterID.
+// Check the length with:
+//
+//	len(mockedKafkaService.GetManagedKafkaChangesByClusterIDCalls())
+func (mock *KafkaServiceMock) GetManagedKafkaChangesByClusterIDCalls() []struct {
+	ClusterID            string
+	SinceResourceVersion string
+} {
+	var calls []struct {
+		ClusterID            string
+		SinceResourceVersion string
+	}
+	mock.lockGetManagedKafkaChangesByClusterID.RLock()
+	calls = mock.calls.GetManagedKafkaChangesByClusterID
+	mock.lockGetManagedKafkaChangesByClusterID.RUnlock()
+	return calls
+}
+
 // HasAvailableCapacityInRegion calls HasAvailableCapacityInRegionFunc.
 func (mock *KafkaServiceMock) HasAvailableCapacityInRegion(kafkaRequest *dbapi.KafkaRequest) (bool, *apiErrors.ServiceError) {
 	if mock.HasAvailableCapacityInRegionFunc == nil {
@@ -1009,6 +1138,65 @@ func (mock *KafkaServiceMock) ListComponentVersionsCalls() []struct {
 	return calls
 }
 
+// ListKafkasOnCluster calls ListKafkasOnClusterFunc.
+func (mock *KafkaServiceMock) ListKafkasOnCluster(clusterID string) ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+	if mock.ListKafkasOnClusterFunc == nil {
+		panic("KafkaServiceMock.ListKafkasOnClusterFunc: method is nil but KafkaService.ListKafkasOnCluster was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockListKafkasOnCluster.Lock()
+	mock.calls.ListKafkasOnCluster = append(mock.calls.ListKafkasOnCluster, callInfo)
+	mock.lockListKafkasOnCluster.Unlock()
+	return mock.ListKafkasOnClusterFunc(clusterID)
+}
+
+// ListKafkasOnClusterCalls gets all the calls that were made to ListKafkasOnCluster.
+// Check the length with:
+//
+//	len(mockedKafkaService.ListKafkasOnClusterCalls())
+func (mock *KafkaServiceMock) ListKafkasOnClusterCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockListKafkasOnCluster.RLock()
+	calls = mock.calls.ListKafkasOnCluster
+	mock.lockListKafkasOnCluster.RUnlock()
+	return calls
+}
+
+// ListKafkasToBeMigrated calls ListKafkasToBeMigratedFunc.
+func (mock *KafkaServiceMock) ListKafkasToBeMigrated() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
+	if mock.ListKafkasToBeMigratedFunc == nil {
+		panic("KafkaServiceMock.ListKafkasToBeMigratedFunc: method is nil but KafkaService.ListKafkasToBeMigrated was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListKafkasToBeMigrated.Lock()
+	mock.calls.ListKafkasToBeMigrated = append(mock.calls.ListKafkasToBeMigrated, callInfo)
+	mock.lockListKafkasToBeMigrated.Unlock()
+	return mock.ListKafkasToBeMigratedFunc()
+}
+
+// ListKafkasToBeMigratedCalls gets all the calls that were made to ListKafkasToBeMigrated.
+// Check the length with:
+//
+//	len(mockedKafkaService.ListKafkasToBeMigratedCalls())
+func (mock *KafkaServiceMock) ListKafkasToBeMigratedCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListKafkasToBeMigrated.RLock()
+	calls = mock.calls.ListKafkasToBeMigrated
+	mock.lockListKafkasToBeMigrated.RUnlock()
+	return calls
+}
+
 // ListKafkasToBePromoted calls ListKafkasToBePromotedFunc.
 func (mock *KafkaServiceMock) ListKafkasToBePromoted() ([]*dbapi.KafkaRequest, *apiErrors.ServiceError) {
 	if mock.ListKafkasToBePromotedFunc == nil {
@@ -1163,6 +1351,118 @@ func (mock *KafkaServiceMock) RegisterKafkaJobCalls() []struct {
 	return calls
 }
 
+// Resize calls ResizeFunc.
+func (mock *KafkaServiceMock) Resize(kafkaRequest *dbapi.KafkaRequest, sizeID string) *apiErrors.ServiceError {
+	if mock.ResizeFunc == nil {
+		panic("KafkaServiceMock.ResizeFunc: method is nil but KafkaService.Resize was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeID       string
+	}{
+		KafkaRequest: kafkaRequest,
+		SizeID:       sizeID,
+	}
+	mock.lockResize.Lock()
+	mock.calls.Resize = append(mock.calls.Resize, callInfo)
+	mock.lockResize.Unlock()
+	return mock.ResizeFunc(kafkaRequest, sizeID)
+}
+
+// ResizeCalls gets all the calls that were made to Resize.
+// Check the length with:
+//
+//	len(mockedKafkaService.ResizeCalls())
+func (mock *KafkaServiceMock) ResizeCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	SizeID       string
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		SizeID       string
+	}
+	mock.lockResize.RLock()
+	calls = mock.calls.Resize
+	mock.lockResize.RUnlock()
+	return calls
+}
+
+// Resume calls ResumeFunc.
+func (mock *KafkaServiceMock) Resume(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
+	if mock.ResumeFunc == nil {
+		panic("KafkaServiceMock.ResumeFunc: method is nil but KafkaService.Resume was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		KafkaRequest *dbapi.KafkaRequest
+	}{
+		Ctx:          ctx,
+		KafkaRequest: kafkaRequest,
+	}
+	mock.lockResume.Lock()
+	mock.calls.Resume = append(mock.calls.Resume, callInfo)
+	mock.lockResume.Unlock()
+	return mock.ResumeFunc(ctx, kafkaRequest)
+}
+
+// ResumeCalls gets all the calls that were made to Resume.
+// Check the length with:
+//
+//	len(mockedKafkaService.ResumeCalls())
+func (mock *KafkaServiceMock) ResumeCalls() []struct {
+	Ctx          context.Context
+	KafkaRequest *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		Ctx          context.Context
+		KafkaRequest *dbapi.KafkaRequest
+	}
+	mock.lockResume.RLock()
+	calls = mock.calls.Resume
+	mock.lockResume.RUnlock()
+	return calls
+}
+
+// Suspend calls SuspendFunc.
+func (mock *KafkaServiceMock) Suspend(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *apiErrors.ServiceError {
+	if mock.SuspendFunc == nil {
+		panic("KafkaServiceMock.SuspendFunc: method is nil but KafkaService.Suspend was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		KafkaRequest *dbapi.KafkaRequest
+		Reason       dbapi.KafkaSuspensionReason
+	}{
+		Ctx:          ctx,
+		KafkaRequest: kafkaRequest,
+		Reason:       reason,
+	}
+	mock.lockSuspend.Lock()
+	mock.calls.Suspend = append(mock.calls.Suspend, callInfo)
+	mock.lockSuspend.Unlock()
+	return mock.SuspendFunc(ctx, kafkaRequest, reason)
+}
+
+// SuspendCalls gets all the calls that were made to Suspend.
+// Check the length with:
+//
+//	len(mockedKafkaService.SuspendCalls())
+func (mock *KafkaServiceMock) SuspendCalls() []struct {
+	Ctx          context.Context
+	KafkaRequest *dbapi.KafkaRequest
+	Reason       dbapi.KafkaSuspensionReason
+} {
+	var calls []struct {
+		Ctx          context.Context
+		KafkaRequest *dbapi.KafkaRequest
+		Reason       dbapi.KafkaSuspensionReason
+	}
+	mock.lockSuspend.RLock()
+	calls = mock.calls.Suspend
+	mock.lockSuspend.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *KafkaServiceMock) Update(kafkaRequest *dbapi.KafkaRequest) *apiErrors.ServiceError {
 	if mock.UpdateFunc == nil {
@@ -1195,6 +1495,42 @@ func (mock *KafkaServiceMock) UpdateCalls() []struct {
 	return calls
 }
 
+// UpdateLabels calls UpdateLabelsFunc.
+func (mock *KafkaServiceMock) UpdateLabels(kafkaRequest *dbapi.KafkaRequest, labels []dbapi.KafkaLabel) *apiErrors.ServiceError {
+	if mock.UpdateLabelsFunc == nil {
+		panic("KafkaServiceMock.UpdateLabelsFunc: method is nil but KafkaService.UpdateLabels was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Labels       []dbapi.KafkaLabel
+	}{
+		KafkaRequest: kafkaRequest,
+		Labels:       labels,
+	}
+	mock.lockUpdateLabels.Lock()
+	mock.calls.UpdateLabels = append(mock.calls.UpdateLabels, callInfo)
+	mock.lockUpdateLabels.Unlock()
+	return mock.UpdateLabelsFunc(kafkaRequest, labels)
+}
+
+// UpdateLabelsCalls gets all the calls that were made to UpdateLabels.
+// Check the length with:
+//
+//	len(mockedKafkaService.UpdateLabelsCalls())
+func (mock *KafkaServiceMock) UpdateLabelsCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Labels       []dbapi.KafkaLabel
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Labels       []dbapi.KafkaLabel
+	}
+	mock.lockUpdateLabels.RLock()
+	calls = mock.calls.UpdateLabels
+	mock.lockUpdateLabels.RUnlock()
+	return calls
+}
+
 // UpdateStatus calls UpdateStatusFunc.
 func (mock *KafkaServiceMock) UpdateStatus(id string, status constants.KafkaStatus) (bool, *apiErrors.ServiceError) {
 	if mock.UpdateStatusFunc == nil {
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// ManagedKafkaChanges are the changes of the managed kafkas of a data plane cluster since a resource version of them
+type ManagedKafkaChanges struct {
+	// ResourceVersion is the resource version of the managed kafkas of the data plane cluster once the changes are applied
+	ResourceVersion string
+	// Incremental is whether only the managed kafkas that changed are returned. Otherwise, all the managed kafkas of the data plane
+	// cluster are returned and the ones that are not returned have to be removed from it
+	Incremental bool
+	// ManagedKafkas are the added and modified managed kafkas, or all of them when the changes are not incremental
+	ManagedKafkas []managedkafka.ManagedKafka
+	// RemovedKafkaIDs are the ids of the kafkas whose managed kafka has been removed from the data plane cluster. It may contain
+	// the ids of kafkas whose managed kafka has never been returned, e.g. the ones that were unassigned before being provisioned
+	RemovedKafkaIDs []string
+}
+
+// IsEmpty returns whether the managed kafkas of the data plane cluster did not change since the resource version
+func (c *ManagedKafkaChanges) IsEmpty() bool {
+	return c.Incremental && len(c.ManagedKafkas) == 0 && len(c.RemovedKafkaIDs) == 0
+}
+
+// managedKafkasResourceVersion identifies the managed kafkas of a data plane cluster returned at a given time
+type managedKafkasResourceVersion struct {
+	// txID is the id of the oldest transaction that was in progress when the managed kafkas were listed. The changes of the kafkas
+	// recorded by this transaction and the later ones may not have been seen
+	txID int64
+	// listedAt is when the managed kafkas were listed. The versions of the kafkas whose upgrade is held back until their maintenance
+	// window depend on it
+	listedAt time.Time
+	// digest of everything but the kafkas the managed kafkas are built from
+	digest string
+}
+
+func (v managedKafkasResourceVersion) String() string {
+	return fmt.Sprintf("%d-%d-%s", v.txID, v.listedAt.Unix(), v.digest)
+}
+
+// parseManagedKafkasResourceVersion parses a resource version returned by managedKafkasResourceVersion.String. It returns
+// false when the resource version is empty or malformed
+func parseManagedKafkasResourceVersion(resourceVersion string) (managedKafkasResourceVersion, bool) {
+	parts := strings.SplitN(resourceVersion, "-", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return managedKafkasResourceVersion{}, false
+	}
+
+	txID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return managedKafkasResourceVersion{}, false
+	}
+	listedAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return managedKafkasResourceVersion{}, false
+	}
+
+	return managedKafkasResourceVersion{txID: txID, listedAt: time.Unix(listedAt, 0), digest: parts[2]}, true
+}
+
+func (k *kafkaService) GetManagedKafkaChangesByClusterID(clusterID string, sinceResourceVersion string) (*ManagedKafkaChanges, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+
+	// taken before listing the kafkas, so that the changes committed while they are listed are returned again by the next call
+	current := managedKafkasResourceVersion{listedAt: time.Now()}
+	if err := dbConn.Raw("SELECT txid_snapshot_xmin(txid_current_snapshot())").Scan(&current.txID).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the resource version of the managed kafkas of cluster %q", clusterID)
+	}
+
+	reservedManagedKafkas, svcErr := k.GenerateReservedManagedKafkasByClusterID(clusterID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	current.digest, svcErr = k.managedKafkasDigest(reservedManagedKafkas)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	changes := &ManagedKafkaChanges{ResourceVersion: current.String()}
+
+	since, ok := parseManagedKafkasResourceVersion(sinceResourceVersion)
+	if !ok || since.digest != current.digest || since.txID > current.txID {
+		managedKafkas, svcErr := k.getManagedKafkasByClusterID(clusterID)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		changes.ManagedKafkas = append(managedKafkas, reservedManagedKafkas...)
+		return changes, nil
+	}
+
+	changes.Incremental = true
+
+	var changedKafkaIDs []string
+	if err := dbConn.Table("data_plane_kafka_changes").
+		Where("cluster_id = ? AND version >= ?", clusterID, since.txID).
+		Pluck("kafka_id", &changedKafkaIDs).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the changed managed kafkas of cluster %q", clusterID)
+	}
+
+	heldBackKafkaIDs, svcErr := k.listKafkasWithUpgradeWindowChange(clusterID, since.listedAt, current.listedAt)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	for _, kafkaID := range heldBackKafkaIDs {
+		if !arrays.Contains(changedKafkaIDs, kafkaID) {
+			changedKafkaIDs = append(changedKafkaIDs, kafkaID)
+		}
+	}
+
+	if len(changedKafkaIDs) == 0 {
+		return changes, nil
+	}
+
+	changes.ManagedKafkas, svcErr = k.getManagedKafkasByClusterID(clusterID, changedKafkaIDs...)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	for _, kafkaID := range changedKafkaIDs {
+		if idx, _ := arrays.FindFirst(changes.ManagedKafkas, func(mk managedkafka.ManagedKafka) bool { return mk.Id == kafkaID }); idx < 0 {
+			changes.RemovedKafkaIDs = append(changes.RemovedKafkaIDs, kafkaID)
+		}
+	}
+
+	return changes, nil
+}
+
+// listKafkasWithUpgradeWindowChange returns the ids of the kafkas of the data plane cluster whose upgrade is held back until their
+// maintenance window, and whose window opened or closed between the given times. Their managed kafka changes without them
+// being updated
+func (k *kafkaService) listKafkasWithUpgradeWindowChange(clusterID string, from time.Time, to time.Time) ([]string, *errors.ServiceError) {
+	var kafkas dbapi.KafkaList
+	if err := managedKafkasByClusterIDScope(k.connectionFactory.New(), clusterID).
+		Where("maintenance_window IS NOT NULL AND force_upgrade = ?", false).
+		Where("desired_kafka_version != actual_kafka_version OR desired_strimzi_version != actual_strimzi_version OR desired_kafka_ibp_version != actual_kafka_ibp_version").
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the kafkas with a pending upgrade of cluster %q", clusterID)
+	}
+
+	var kafkaIDs []string
+	for _, kafka := range kafkas {
+		if kafka.CanBeUpgradedAt(from) != kafka.CanBeUpgradedAt(to) {
+			kafkaIDs = append(kafkaIDs, kafka.ID)
+		}
+	}
+	return kafkaIDs, nil
+}
+
+// managedKafkasDigest digests everything but the kafkas the managed kafkas of a data plane cluster are built from: its reserved
+// managed kafkas and the configuration of the fleet manager. The configuration is captured by the managed kafkas of sample kafkas
+// of every instance size. A change of the digest invalidates all the managed kafkas previously returned
+func (k *kafkaService) managedKafkasDigest(reservedManagedKafkas []managedkafka.ManagedKafka) (string, *errors.ServiceError) {
+	var sampleManagedKafkas []*managedkafka.ManagedKafka
+	for _, instanceType := range k.kafkaConfig.SupportedInstanceTypes.Configuration.SupportedKafkaInstanceTypes {
+		for _, size := range instanceType.Sizes {
+			mk, svcErr := buildManagedKafkaCR(&dbapi.KafkaRequest{InstanceType: instanceType.Id, SizeId: size.Id}, k.kafkaConfig, k.keycloakService)
+			if svcErr != nil {
+				return "", svcErr
+			}
+			sampleManagedKafkas = append(sampleManagedKafkas, mk)
+		}
+	}
+
+	content, err := json.Marshal([]interface{}{reservedManagedKafkas, sampleManagedKafkas})
+	if err != nil {
+		return "", errors.NewWithCause(errors.ErrorGeneral, err, "unable to digest the managed kafkas")
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/converters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_parseManagedKafkasResourceVersion(t *testing.T) {
+	listedAt := time.Unix(1681900000, 0)
+
+	tests := []struct {
+		name            string
+		resourceVersion string
+		want            managedKafkasResourceVersion
+		wantOk          bool
+	}{
+		{
+			name:            "should parse a resource version",
+			resourceVersion: managedKafkasResourceVersion{txID: 42, listedAt: listedAt, digest: "0123456789abcdef"}.String(),
+			want:            managedKafkasResourceVersion{txID: 42, listedAt: listedAt, digest: "0123456789abcdef"},
+			wantOk:          true,
+		},
+		{
+			name:            "should not parse an empty resource version",
+			resourceVersion: "",
+			wantOk:          false,
+		},
+		{
+			name:            "should not parse a resource version without digest",
+			resourceVersion: "42-1681900000-",
+			wantOk:          false,
+		},
+		{
+			name:            "should not parse a resource version with a malformed transaction id",
+			resourceVersion: "txid-1681900000-0123456789abcdef",
+			wantOk:          false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, ok := parseManagedKafkasResourceVersion(tt.resourceVersion)
+			g.Expect(ok).To(gomega.Equal(tt.wantOk))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaService_GetManagedKafkaChangesByClusterID(t *testing.T) {
+	newKafkaService := func() *kafkaService {
+		return &kafkaService{
+			connectionFactory: db.NewMockConnectionFactory(nil),
+			kafkaConfig: &config.KafkaConfig{
+				SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+			},
+			keycloakService: &sso.KeycloakServiceMock{
+				GetConfigFunc: func() *keycloak.KeycloakConfig {
+					return &keycloak.KeycloakConfig{}
+				},
+				GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+					return &keycloak.KeycloakRealmConfig{}
+				},
+			},
+			// no reserved managed kafka is generated for the clusters that are not ready
+			clusterService: &ClusterServiceMock{
+				FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+					return &api.Cluster{ClusterID: clusterID, Status: api.ClusterProvisioning}, nil
+				},
+			},
+		}
+	}
+
+	digest, digestErr := newKafkaService().managedKafkasDigest([]managedkafka.ManagedKafka{})
+	if digestErr != nil {
+		t.Fatalf("unexpected error: %v", digestErr)
+	}
+	since := managedKafkasResourceVersion{txID: 40, listedAt: time.Now().Add(-time.Minute), digest: digest}
+
+	buildKafka := func(id string) *dbapi.KafkaRequest {
+		return &dbapi.KafkaRequest{
+			Meta:                api.Meta{ID: id},
+			ClusterID:           testClusterID,
+			InstanceType:        "developer",
+			SizeId:              "x1",
+			Status:              constants.KafkaRequestStatusReady.String(),
+			BootstrapServerHost: "bootstrap",
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		sinceResourceVersion string
+		setupFn              func()
+		wantIncremental      bool
+		wantKafkaIDs         []string
+		wantRemovedKafkaIDs  []string
+	}{
+		{
+			name:                 "should return all the managed kafkas when there is no resource version",
+			sinceResourceVersion: "",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply(converters.ConvertKafkaRequestList(dbapi.KafkaList{buildKafka("kafka-1"), buildKafka("kafka-2")}))
+			},
+			wantIncremental: false,
+			wantKafkaIDs:    []string{"kafka-1", "kafka-2"},
+		},
+		{
+			name:                 "should return all the managed kafkas when the digest of the resource version changed",
+			sinceResourceVersion: managedKafkasResourceVersion{txID: since.txID, listedAt: since.listedAt, digest: "0123456789abcdef"}.String(),
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply(converters.ConvertKafkaRequestList(dbapi.KafkaList{buildKafka("kafka-1")}))
+			},
+			wantIncremental: false,
+			wantKafkaIDs:    []string{"kafka-1"},
+		},
+		{
+			name:                 "should only return the managed kafkas that changed since the resource version",
+			sinceResourceVersion: since.String(),
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "data_plane_kafka_changes" WHERE cluster_id = $1 AND version >= $2`).
+					WithArgs(testClusterID, since.txID).
+					WithReply([]map[string]interface{}{{"kafka_id": "kafka-1"}, {"kafka_id": "kafka-2"}})
+				mocket.Catcher.NewMock().WithQuery("maintenance_window IS NOT NULL").WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).
+					WithReply(converters.ConvertKafkaRequestList(dbapi.KafkaList{buildKafka("kafka-1")}))
+			},
+			wantIncremental:     true,
+			wantKafkaIDs:        []string{"kafka-1"},
+			wantRemovedKafkaIDs: []string{"kafka-2"},
+		},
+		{
+			name:                 "should not return any managed kafka when none changed since the resource version",
+			sinceResourceVersion: since.String(),
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "data_plane_kafka_changes"`).WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().WithQuery("maintenance_window IS NOT NULL").WithReply([]map[string]interface{}{})
+			},
+			wantIncremental: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery("txid_snapshot_xmin").WithReply([]map[string]interface{}{{"txid_snapshot_xmin": 42}})
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+			changes, err := newKafkaService().GetManagedKafkaChangesByClusterID(testClusterID, tt.sinceResourceVersion)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			resourceVersion, ok := parseManagedKafkasResourceVersion(changes.ResourceVersion)
+			g.Expect(ok).To(gomega.BeTrue())
+			g.Expect(resourceVersion.txID).To(gomega.Equal(int64(42)))
+			g.Expect(resourceVersion.digest).To(gomega.Equal(digest))
+
+			g.Expect(changes.Incremental).To(gomega.Equal(tt.wantIncremental))
+			var kafkaIDs []string
+			for _, mk := range changes.ManagedKafkas {
+				kafkaIDs = append(kafkaIDs, mk.Id)
+			}
+			g.Expect(kafkaIDs).To(gomega.Equal(tt.wantKafkaIDs))
+			g.Expect(changes.RemovedKafkaIDs).To(gomega.Equal(tt.wantRemovedKafkaIDs))
+			g.Expect(changes.IsEmpty()).To(gomega.Equal(tt.wantIncremental && len(tt.wantKafkaIDs) == 0 && len(tt.wantRemovedKafkaIDs) == 0))
+		})
+	}
+}
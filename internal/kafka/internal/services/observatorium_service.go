@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
@@ -25,6 +27,10 @@ func NewObservatoriumService(observatorium *observatorium.Client, kafkaService K
 type ObservatoriumService interface {
 	GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error)
 	GetMetricsByKafkaId(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *errors.ServiceError)
+	// GetKafkaTraffic returns the produce and consume traffic of the kafka during the given period
+	GetKafkaTraffic(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *errors.ServiceError)
+	// GetKafkaMetrics returns the metrics of the kafka without checking the permissions of the caller, e.g. to evaluate its alert rules
+	GetKafkaMetrics(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError
 }
 
 func (obs observatoriumService) GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error) {
@@ -44,3 +50,20 @@ func (obs observatoriumService) GetMetricsByKafkaId(ctx context.Context, kafkasM
 
 	return kafkaRequest.ID, nil
 }
+
+func (obs observatoriumService) GetKafkaTraffic(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *errors.ServiceError) {
+	traffic, err := obs.observatorium.Service.GetKafkaTraffic(kafkaRequest.Namespace, period)
+	if err != nil {
+		return traffic, errors.NewWithCause(errors.ErrorGeneral, err, "failed to retrieve the traffic of kafka %q", kafkaRequest.ID)
+	}
+
+	return traffic, nil
+}
+
+func (obs observatoriumService) GetKafkaMetrics(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+	if err := obs.observatorium.Service.GetMetrics(kafkaMetrics, kafkaRequest.Namespace, &query); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to retrieve the metrics of kafka %q", kafkaRequest.ID)
+	}
+
+	return nil
+}
@@ -5,9 +5,11 @@ package services
 
 import (
 	"context"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"sync"
+	"time"
 )
 
 // Ensure, that ObservatoriumServiceMock does implement ObservatoriumService.
@@ -20,9 +22,15 @@ var _ ObservatoriumService = &ObservatoriumServiceMock{}
 //
 //		// make and configure a mocked ObservatoriumService
 //		mockedObservatoriumService := &ObservatoriumServiceMock{
+//			GetKafkaMetricsFunc: func(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *apiErrors.ServiceError {
+//				panic("mock out the GetKafkaMetrics method")
+//			},
 //			GetKafkaStateFunc: func(name string, namespaceName string) (observatorium.KafkaState, error) {
 //				panic("mock out the GetKafkaState method")
 //			},
+//			GetKafkaTrafficFunc: func(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *apiErrors.ServiceError) {
+//				panic("mock out the GetKafkaTraffic method")
+//			},
 //			GetMetricsByKafkaIdFunc: func(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *apiErrors.ServiceError) {
 //				panic("mock out the GetMetricsByKafkaId method")
 //			},
@@ -33,14 +41,29 @@ var _ ObservatoriumService = &ObservatoriumServiceMock{}
 //
 //	}
 type ObservatoriumServiceMock struct {
+	// GetKafkaMetricsFunc mocks the GetKafkaMetrics method.
+	GetKafkaMetricsFunc func(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *apiErrors.ServiceError
+
 	// GetKafkaStateFunc mocks the GetKafkaState method.
 	GetKafkaStateFunc func(name string, namespaceName string) (observatorium.KafkaState, error)
 
+	// GetKafkaTrafficFunc mocks the GetKafkaTraffic method.
+	GetKafkaTrafficFunc func(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *apiErrors.ServiceError)
+
 	// GetMetricsByKafkaIdFunc mocks the GetMetricsByKafkaId method.
 	GetMetricsByKafkaIdFunc func(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *apiErrors.ServiceError)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// GetKafkaMetrics holds details about calls to the GetKafkaMetrics method.
+		GetKafkaMetrics []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// KafkaMetrics is the kafkaMetrics argument value.
+			KafkaMetrics *observatorium.KafkaMetrics
+			// Query is the query argument value.
+			Query observatorium.MetricsReqParams
+		}
 		// GetKafkaState holds details about calls to the GetKafkaState method.
 		GetKafkaState []struct {
 			// Name is the name argument value.
@@ -48,6 +71,13 @@ type ObservatoriumServiceMock struct {
 			// NamespaceName is the namespaceName argument value.
 			NamespaceName string
 		}
+		// GetKafkaTraffic holds details about calls to the GetKafkaTraffic method.
+		GetKafkaTraffic []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Period is the period argument value.
+			Period time.Duration
+		}
 		// GetMetricsByKafkaId holds details about calls to the GetMetricsByKafkaId method.
 		GetMetricsByKafkaId []struct {
 			// Ctx is the ctx argument value.
@@ -60,10 +90,52 @@ type ObservatoriumServiceMock struct {
 			Query observatorium.MetricsReqParams
 		}
 	}
+	lockGetKafkaMetrics     sync.RWMutex
 	lockGetKafkaState       sync.RWMutex
+	lockGetKafkaTraffic     sync.RWMutex
 	lockGetMetricsByKafkaId sync.RWMutex
 }
 
+// GetKafkaMetrics calls GetKafkaMetricsFunc.
+func (mock *ObservatoriumServiceMock) GetKafkaMetrics(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *apiErrors.ServiceError {
+	if mock.GetKafkaMetricsFunc == nil {
+		panic("ObservatoriumServiceMock.GetKafkaMetricsFunc: method is nil but ObservatoriumService.GetKafkaMetrics was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		KafkaMetrics *observatorium.KafkaMetrics
+		Query        observatorium.MetricsReqParams
+	}{
+		KafkaRequest: kafkaRequest,
+		KafkaMetrics: kafkaMetrics,
+		Query:        query,
+	}
+	mock.lockGetKafkaMetrics.Lock()
+	mock.calls.GetKafkaMetrics = append(mock.calls.GetKafkaMetrics, callInfo)
+	mock.lockGetKafkaMetrics.Unlock()
+	return mock.GetKafkaMetricsFunc(kafkaRequest, kafkaMetrics, query)
+}
+
+// GetKafkaMetricsCalls gets all the calls that were made to GetKafkaMetrics.
+// Check the length with:
+//
+//	len(mockedObservatoriumService.GetKafkaMetricsCalls())
+func (mock *ObservatoriumServiceMock) GetKafkaMetricsCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	KafkaMetrics *observatorium.KafkaMetrics
+	Query        observatorium.MetricsReqParams
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		KafkaMetrics *observatorium.KafkaMetrics
+		Query        observatorium.MetricsReqParams
+	}
+	mock.lockGetKafkaMetrics.RLock()
+	calls = mock.calls.GetKafkaMetrics
+	mock.lockGetKafkaMetrics.RUnlock()
+	return calls
+}
+
 // GetKafkaState calls GetKafkaStateFunc.
 func (mock *ObservatoriumServiceMock) GetKafkaState(name string, namespaceName string) (observatorium.KafkaState, error) {
 	if mock.GetKafkaStateFunc == nil {
@@ -100,6 +172,42 @@ func (mock *ObservatoriumServiceMock) GetKafkaStateCalls() []struct {
 	return calls
 }
 
+// GetKafkaTraffic calls GetKafkaTrafficFunc.
+func (mock *ObservatoriumServiceMock) GetKafkaTraffic(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *apiErrors.ServiceError) {
+	if mock.GetKafkaTrafficFunc == nil {
+		panic("ObservatoriumServiceMock.GetKafkaTrafficFunc: method is nil but ObservatoriumService.GetKafkaTraffic was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Period       time.Duration
+	}{
+		KafkaRequest: kafkaRequest,
+		Period:       period,
+	}
+	mock.lockGetKafkaTraffic.Lock()
+	mock.calls.GetKafkaTraffic = append(mock.calls.GetKafkaTraffic, callInfo)
+	mock.lockGetKafkaTraffic.Unlock()
+	return mock.GetKafkaTrafficFunc(kafkaRequest, period)
+}
+
+// GetKafkaTrafficCalls gets all the calls that were made to GetKafkaTraffic.
+// Check the length with:
+//
+//	len(mockedObservatoriumService.GetKafkaTrafficCalls())
+func (mock *ObservatoriumServiceMock) GetKafkaTrafficCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Period       time.Duration
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Period       time.Duration
+	}
+	mock.lockGetKafkaTraffic.RLock()
+	calls = mock.calls.GetKafkaTraffic
+	mock.lockGetKafkaTraffic.RUnlock()
+	return calls
+}
+
 // GetMetricsByKafkaId calls GetMetricsByKafkaIdFunc.
 func (mock *ObservatoriumServiceMock) GetMetricsByKafkaId(ctx context.Context, csMetrics *observatorium.KafkaMetrics, id string, query observatorium.MetricsReqParams) (string, *apiErrors.ServiceError) {
 	if mock.GetMetricsByKafkaIdFunc == nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -215,3 +216,18 @@ func Test_observatoriumService_GetMetricsByKafkaId(t *testing.T) {
 		})
 	}
 }
+
+func Test_observatoriumService_GetKafkaTraffic(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	client, err := observatorium.NewClientMock(&observatorium.Configuration{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	obs := observatoriumService{
+		observatorium: client,
+	}
+	traffic, getErr := obs.GetKafkaTraffic(&dbapi.KafkaRequest{Namespace: "kafkaRequestNamespace"}, 24*time.Hour)
+	g.Expect(getErr).To(gomega.BeNil())
+	g.Expect(traffic.Reported).To(gomega.BeTrue())
+	g.Expect(traffic.Bytes).To(gomega.BeNumerically(">", 0))
+}
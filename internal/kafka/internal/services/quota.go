@@ -16,6 +16,10 @@ type QuotaService interface {
 	// ReserveQuotaIfNotAlreadyReserved reserves a quota for the specified request if the desired quota
 	// has not been already reserved. Returns the id of the newly reserved quota or the id of the existing one
 	ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError)
+	// ReserveQuotaForResize reserves the quota needed by the kafka once resized to the given size of its instance type.
+	// Only the difference between the new size and the current size of the kafka has to be available.
+	// Returns the id of the subscription of the kafka
+	ReserveQuotaForResize(kafka *dbapi.KafkaRequest, sizeID string) (string, *errors.ServiceError)
 	// DeleteQuota deletes a reserved quota
 	DeleteQuota(subscriptionId string) *errors.ServiceError
 	// DeleteQuotaForBillingModel deletes a reserved quota only if it is related to the specified billing model, otherwise exits with no error
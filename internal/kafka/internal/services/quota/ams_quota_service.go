@@ -348,6 +348,18 @@ func (q amsQuotaService) ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequ
 	return q.ReserveQuota(kafka)
 }
 
+// ReserveQuotaForResize requests the cluster authorization of the kafka again, with the quota consumed by the given size.
+// The cluster id of the authorization is the one of the existing subscription of the kafka: AMS updates the resources
+// reserved by this subscription, so that only the difference between the two sizes has to be available
+func (q amsQuotaService) ReserveQuotaForResize(kafka *dbapi.KafkaRequest, sizeID string) (string, *errors.ServiceError) {
+	resizedKafka := *kafka
+	resizedKafka.SizeId = sizeID
+	// the billing model of a kafka does not change when it is resized
+	resizedKafka.DesiredKafkaBillingModel = kafka.ActualKafkaBillingModel
+
+	return q.ReserveQuota(&resizedKafka)
+}
+
 func (q amsQuotaService) DeleteQuota(subscriptionID string) *errors.ServiceError {
 	if subscriptionID == "" {
 		return nil
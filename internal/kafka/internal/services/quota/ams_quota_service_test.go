@@ -629,7 +629,7 @@ func Test_AMSGetBillingModel(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, tt.fields.kafkaConfig)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, tt.fields.kafkaConfig)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 
 			kafkaBillingModel, billingModel, err := quotaService.(*amsQuotaService).getBillingModel(&tt.args.request)
@@ -778,7 +778,7 @@ func Test_AMSValidateBillingAccount(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, tt.fields.kafkaConfig)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, tt.fields.kafkaConfig)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			// TODO: add a test value for billing model
 			err := quotaService.ValidateBillingAccount(tt.args.orgId, types.STANDARD, "", tt.args.billingAccountId, tt.args.marketplace)
@@ -963,7 +963,7 @@ func Test_AMSCheckQuota(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, tt.fields.kafkaConfig)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, tt.fields.kafkaConfig)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Meta: api.Meta{
@@ -1166,7 +1166,7 @@ func Test_AMSReserveQuotaIfNotAlreadyReserved(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, &tt.fields.kafkaConfig)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, &tt.fields.kafkaConfig)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 
 			_, err := quotaService.ReserveQuotaIfNotAlreadyReserved(kafka)
@@ -1762,7 +1762,7 @@ func Test_AMSReserveQuota(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, tt.fields.kafkaConfig)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, tt.fields.kafkaConfig)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Meta: api.Meta{
@@ -1796,6 +1796,70 @@ func Test_AMSReserveQuota(t *testing.T) {
 	}
 }
 
+func Test_AMSReserveQuotaForResize(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	standard := test.NewAMSTestKafkaSupportedInstanceTypesConfig().Configuration.SupportedKafkaInstanceTypes[0]
+	x2 := standard.Sizes[0]
+	x2.Id = "x2"
+	x2.QuotaConsumed = 2
+	standard.Sizes = []config.KafkaInstanceSize{standard.Sizes[0], x2}
+	kafkaConfig := &config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{standard},
+			},
+		},
+	}
+
+	ocmClient := &ocm.ClientMock{
+		ClusterAuthorizationFunc: func(cb *v1.ClusterAuthorizationRequest) (*v1.ClusterAuthorizationResponse, error) {
+			sub := v1.SubscriptionBuilder{}
+			sub.ID("1234")
+			sub.Status("Active")
+			ca, _ := v1.NewClusterAuthorizationResponse().Allowed(true).Subscription(&sub).Build()
+			return ca, nil
+		},
+		GetOrganisationIdFromExternalIdFunc: func(externalId string) (string, error) {
+			return fmt.Sprintf("fake-org-id-%s", externalId), nil
+		},
+		GetQuotaCostsForProductFunc: func(organizationID, resourceName, product string) ([]*v1.QuotaCost, error) {
+			rrbq1 := v1.NewRelatedResource().BillingModel(string(v1.BillingModelStandard)).Product(string(ocm.RHOSAKProduct)).ResourceName(resourceName).Cost(1)
+			qcb, err := v1.NewQuotaCost().Allowed(3).Consumed(1).OrganizationID(organizationID).RelatedResources(rrbq1).Build()
+			if err != nil {
+				panic("unexpected error")
+			}
+			return []*v1.QuotaCost{qcb}, nil
+		},
+	}
+
+	factory := NewDefaultQuotaServiceFactory(ocmClient, nil, nil, nil, kafkaConfig)
+	quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
+	kafka := &dbapi.KafkaRequest{
+		Meta: api.Meta{
+			ID: "12231",
+		},
+		Owner:                   "testUser",
+		SizeId:                  "x1",
+		InstanceType:            types.STANDARD.String(),
+		ActualKafkaBillingModel: "standard",
+	}
+	subID, err := quotaService.ReserveQuotaForResize(kafka, "x2")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(subID).To(gomega.Equal("1234"))
+	// the kafka is only resized once the quota has been reserved
+	g.Expect(kafka.SizeId).To(gomega.Equal("x1"))
+
+	// the authorization is requested again for the cluster id of the subscription of the kafka, with the quota consumed by the new size
+	clusterAuthorizationCalls := ocmClient.ClusterAuthorizationCalls()
+	g.Expect(clusterAuthorizationCalls).To(gomega.HaveLen(1))
+	g.Expect(clusterAuthorizationCalls[0].Cb.ClusterID()).To(gomega.Equal("12231"))
+	clusterAuthorizationResources := clusterAuthorizationCalls[0].Cb.Resources()
+	g.Expect(clusterAuthorizationResources).To(gomega.HaveLen(1))
+	g.Expect(clusterAuthorizationResources[0].Count()).To(gomega.Equal(2))
+}
+
 func Test_Delete_Quota(t *testing.T) {
 	var amsDefaultKafkaConf = config.KafkaConfig{
 		Quota:                  config.NewKafkaQuotaConfig(),
@@ -1852,7 +1916,7 @@ func Test_Delete_Quota(t *testing.T) {
 	for _, testcase := range tests {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
-			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, &amsDefaultKafkaConf)
+			factory := NewDefaultQuotaServiceFactory(tt.fields.ocmClient, nil, nil, nil, &amsDefaultKafkaConf)
 			quotaService, _ := factory.GetQuotaService(api.AMSQuotaType)
 			err := quotaService.DeleteQuota(tt.args.subscriptionId)
 			if (err != nil) != tt.wantErr {
@@ -2036,7 +2100,7 @@ func Test_amsQuotaService_CheckIfQuotaIsDefinedForInstanceType(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil, &amsDefaultKafkaConf)
+			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.ocmClient, nil, nil, nil, &amsDefaultKafkaConf)
 			quotaService, _ := quotaServiceFactory.GetQuotaService(api.AMSQuotaType)
 
 			// FIXME: fix when implementing support for KAFKA BILLING MODELS
@@ -2319,7 +2383,7 @@ func Test_amsQuotaService_IsQuotaEntitlementActive(t *testing.T) {
 		tt := testcase
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.fields.amsClient, nil, nil, &tt.fields.kafkaConfig)
+			quotaServiceFactory := NewDefaultQuotaServiceFactory(tt.fields.amsClient, nil, nil, nil, &tt.fields.kafkaConfig)
 			quotaService, _ := quotaServiceFactory.GetQuotaService(api.AMSQuotaType)
 
 			got, err := quotaService.IsQuotaEntitlementActive(tt.args.kafka)
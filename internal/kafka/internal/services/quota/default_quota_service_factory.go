@@ -19,11 +19,17 @@ func NewDefaultQuotaServiceFactory(
 	amsClient ocm.AMSClient,
 	connectionFactory *db.ConnectionFactory,
 	quotaManagementListConfig *quota_management.QuotaManagementListConfig,
+	quotaGrantService services.QuotaGrantService,
 	kafkaConfig *config.KafkaConfig,
 ) services.QuotaServiceFactory {
 	quotaServiceContainer := map[api.QuotaType]services.QuotaService{
-		api.AMSQuotaType:                 &amsQuotaService{amsClient: amsClient, kafkaConfig: kafkaConfig},
-		api.QuotaManagementListQuotaType: &QuotaManagementListService{connectionFactory: connectionFactory, quotaManagementList: quotaManagementListConfig, kafkaConfig: kafkaConfig},
+		api.AMSQuotaType: &amsQuotaService{amsClient: amsClient, kafkaConfig: kafkaConfig},
+		api.QuotaManagementListQuotaType: &QuotaManagementListService{
+			connectionFactory:   connectionFactory,
+			quotaManagementList: quotaManagementListConfig,
+			quotaGrantService:   quotaGrantService,
+			kafkaConfig:         kafkaConfig,
+		},
 	}
 	return &DefaultQuotaServiceFactory{quotaServiceContainer: quotaServiceContainer}
 }
@@ -24,6 +24,7 @@ const (
 type QuotaManagementListService struct {
 	connectionFactory   *db.ConnectionFactory
 	quotaManagementList *quota_management.QuotaManagementListConfig
+	quotaGrantService   services.QuotaGrantService
 	kafkaConfig         *config.KafkaConfig
 }
 
@@ -44,16 +45,21 @@ func (q QuotaManagementListService) ValidateBillingAccount(organisationId string
 
 // CheckIfQuotaIsDefinedForInstanceType - returns if there is any quota configuration for the given instanceType/billingAccount pair (either organization or service account)
 func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(username string, organisationId string, instanceType types.KafkaInstanceType, kafkaBillingModel config.KafkaBillingModel) (bool, *errors.ServiceError) {
+	quotaList, err := q.quotaGrantService.GetQuotaManagementList()
+	if err != nil {
+		return false, err
+	}
+
 	orgId := organisationId
 	var account quota_management.Account
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	org, orgFound := quotaList.Organisations.GetById(orgId)
 	userIsRegistered := false
 	serviceAccountIsRegistered := false
 
 	if orgFound && org.IsUserRegistered(username) {
 		userIsRegistered = true
 	} else {
-		account, serviceAccountIsRegistered = q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		account, serviceAccountIsRegistered = quotaList.ServiceAccounts.GetByUsername(username)
 	}
 
 	// if the user is registered, check that he has quota defined for the desired instance type
@@ -76,6 +82,24 @@ func (q QuotaManagementListService) CheckIfQuotaIsDefinedForInstanceType(usernam
 
 // ReserveQuota - tries to reserve the quota for the received kafka request
 func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (string, *errors.ServiceError) {
+	return q.reserveQuota(kafka, "")
+}
+
+// ReserveQuotaForResize - checks that the streaming units of the kafka once resized, added to the ones of the other kafkas
+// counted against the same quota, are within the limits of the quota
+func (q QuotaManagementListService) ReserveQuotaForResize(kafka *dbapi.KafkaRequest, sizeID string) (string, *errors.ServiceError) {
+	resizedKafka := *kafka
+	resizedKafka.SizeId = sizeID
+	// the billing model of a kafka does not change when it is resized
+	resizedKafka.DesiredKafkaBillingModel = kafka.ActualKafkaBillingModel
+
+	// the kafka is already counted with its new size: its current size must not be counted
+	return q.reserveQuota(&resizedKafka, kafka.ID)
+}
+
+// reserveQuota - tries to reserve the quota for the received kafka request. The streaming units of the kafka with the
+// given excluded id, if any, are not counted against the quota
+func (q QuotaManagementListService) reserveQuota(kafka *dbapi.KafkaRequest, excludedKafkaID string) (string, *errors.ServiceError) {
 	billingModelID, err := q.detectBillingModel(kafka)
 	if err != nil {
 		return "", err
@@ -90,18 +114,23 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 		return "", nil
 	}
 
+	quotaList, err := q.quotaGrantService.GetQuotaManagementList()
+	if err != nil {
+		return "", err
+	}
+
 	username := kafka.Owner
 	orgId := kafka.OrganisationId
 	var quotaManagementListItem quota_management.QuotaManagementListItem
 	message := fmt.Sprintf("user '%s' has reached a maximum number of %d allowed streaming units", username, quota_management.GetDefaultMaxAllowedInstances())
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(orgId)
+	org, orgFound := quotaList.Organisations.GetById(orgId)
 	filterByOrg := false
 	if orgFound && org.IsUserRegistered(username) {
 		quotaManagementListItem = org
 		message = fmt.Sprintf("organization '%s' has reached a maximum number of %d allowed streaming units", orgId, org.GetMaxAllowedInstances(kafka.InstanceType, kafka.DesiredKafkaBillingModel))
 		filterByOrg = true
 	} else {
-		user, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		user, userFound := quotaList.ServiceAccounts.GetByUsername(username)
 		if userFound {
 			quotaManagementListItem = user
 			message = fmt.Sprintf("user '%s' has reached a maximum number of %d allowed streaming units", username, user.GetMaxAllowedInstances(kafka.InstanceType, kafka.DesiredKafkaBillingModel))
@@ -123,6 +152,10 @@ func (q QuotaManagementListService) ReserveQuota(kafka *dbapi.KafkaRequest) (str
 		dbConn = dbConn.Where("owner = ?", username)
 	}
 
+	if excludedKafkaID != "" {
+		dbConn = dbConn.Where("id <> ?", excludedKafkaID)
+	}
+
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).
 		Scan(&kafkas).Error; err != nil {
 		return "", errors.GeneralError(errMessage)
@@ -186,14 +219,19 @@ func (q QuotaManagementListService) detectBillingModel(kafka *dbapi.KafkaRequest
 		return billingModelStandard, nil
 	}
 
+	quotaList, err := q.quotaGrantService.GetQuotaManagementList()
+	if err != nil {
+		return "", err
+	}
+
 	var grantedQuota []quota_management.Quota
 
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(kafka.OrganisationId)
+	org, orgFound := quotaList.Organisations.GetById(kafka.OrganisationId)
 	username := kafka.Owner
 	if orgFound {
 		grantedQuota = org.GetGrantedQuota()
 	} else {
-		user, userFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(username)
+		user, userFound := quotaList.ServiceAccounts.GetByUsername(username)
 		if userFound {
 			grantedQuota = user.GetGrantedQuota()
 		} else {
@@ -237,9 +275,14 @@ func (q QuotaManagementListService) IsQuotaEntitlementActive(kafka *dbapi.KafkaR
 		return true, nil
 	}
 
+	quotaList, err := q.quotaGrantService.GetQuotaManagementList()
+	if err != nil {
+		return false, err
+	}
+
 	var billingModel *quota_management.BillingModel
 
-	org, orgFound := q.quotaManagementList.QuotaList.Organisations.GetById(kafka.OrganisationId)
+	org, orgFound := quotaList.Organisations.GetById(kafka.OrganisationId)
 	if orgFound && org.IsUserRegistered(kafka.Owner) {
 		logger.Logger.Infof("user registered by organisation, checking quota entitlement for organisation %q", org.Id)
 		bm, ok := org.GetBillingModel(kafka.InstanceType, kafka.ActualKafkaBillingModel)
@@ -248,7 +291,7 @@ func (q QuotaManagementListService) IsQuotaEntitlementActive(kafka *dbapi.KafkaR
 		}
 	} else {
 		logger.Logger.Infof("user is not registered by organisation, checking quota entitlement for %q as an individual account", kafka.Owner)
-		account, accountFound := q.quotaManagementList.QuotaList.ServiceAccounts.GetByUsername(kafka.Owner)
+		account, accountFound := quotaList.ServiceAccounts.GetByUsername(kafka.Owner)
 		if accountFound {
 			bm, ok := account.GetBillingModel(kafka.InstanceType, kafka.ActualKafkaBillingModel)
 			if ok {
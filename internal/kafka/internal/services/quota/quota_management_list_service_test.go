@@ -273,7 +273,7 @@ func Test_QuotaManagementListCheckQuota(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList, &defaultKafkaConf)
+			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList, newQuotaGrantServiceMock(tt.fields.QuotaManagementList), &defaultKafkaConf)
 			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Owner:          "username",
@@ -538,7 +538,7 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 			if tt.setupFn != nil {
 				tt.setupFn()
 			}
-			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList, &defaultKafkaConf)
+			factory := NewDefaultQuotaServiceFactory(nil, tt.fields.connectionFactory, tt.fields.QuotaManagementList, newQuotaGrantServiceMock(tt.fields.QuotaManagementList), &defaultKafkaConf)
 			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
 			kafka := &dbapi.KafkaRequest{
 				Owner:          "username",
@@ -551,6 +551,91 @@ func Test_QuotaManagementListReserveQuota(t *testing.T) {
 		})
 	}
 }
+func Test_QuotaManagementListReserveQuotaForResize(t *testing.T) {
+	standard := test.NewQuotaListTestKafkaSupportedInstanceTypesConfig().Configuration.SupportedKafkaInstanceTypes[0]
+	standard.SupportedBillingModels = []config.KafkaBillingModel{
+		{
+			ID:               "standard",
+			AMSResource:      "rhosak",
+			AMSProduct:       "RHOSAK",
+			AMSBillingModels: []string{"standard"},
+		},
+	}
+	x2 := standard.Sizes[0]
+	x2.Id = "x2"
+	x2.CapacityConsumed = 2
+	standard.Sizes = []config.KafkaInstanceSize{standard.Sizes[0], x2}
+	kafkaConfig := &config.KafkaConfig{
+		Quota: config.NewKafkaQuotaConfig(),
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{standard},
+			},
+		},
+	}
+
+	quotaManagementList := &quota_management.QuotaManagementListConfig{
+		EnableInstanceLimitControl: true,
+		QuotaList: quota_management.RegisteredUsersListConfiguration{
+			Organisations: quota_management.OrganisationList{
+				quota_management.Organisation{
+					Id:                  "org-id",
+					MaxAllowedInstances: 2,
+					AnyUser:             true,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		otherKafkas []map[string]interface{}
+		wantErr     *errors.ServiceError
+	}{
+		{
+			name: "do not return an error when the new size of the kafka is within the organisation limits",
+		},
+		{
+			name:        "return an error when the new size of the kafka and the other kafkas of the organisation exceed the organisation limits",
+			otherKafkas: converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) { kafkaRequest.ID = "other-kafka" })),
+			wantErr: &errors.ServiceError{
+				HttpCode: http.StatusForbidden,
+				Reason:   "organization 'org-id' has reached a maximum number of 2 allowed streaming units",
+				Code:     5,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			// the kafka being resized is not counted with its current size
+			mocket.Catcher.NewMock().
+				WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type = $1 AND (actual_kafka_billing_model = $2 or desired_kafka_billing_model = $3) AND (organisation_id = $4) AND id <> $5 AND "kafka_requests"."deleted_at" IS NULL`).
+				WithArgs(types.STANDARD.String(), "standard", "standard", "org-id", "kafka-id").
+				WithReply(tt.otherKafkas)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			factory := NewDefaultQuotaServiceFactory(nil, db.NewMockConnectionFactory(nil), quotaManagementList, newQuotaGrantServiceMock(quotaManagementList), kafkaConfig)
+			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
+			kafka := &dbapi.KafkaRequest{
+				Meta:                    api.Meta{ID: "kafka-id"},
+				Owner:                   "username",
+				OrganisationId:          "org-id",
+				SizeId:                  "x1",
+				InstanceType:            types.STANDARD.String(),
+				ActualKafkaBillingModel: "standard",
+			}
+			_, err := quotaService.ReserveQuotaForResize(kafka, "x2")
+			g.Expect(err).To(gomega.Equal(tt.wantErr))
+			g.Expect(kafka.SizeId).To(gomega.Equal("x1"))
+		})
+	}
+}
+
 func Test_DefaultQuotaServiceFactory_GetQuotaService(t *testing.T) {
 	type fields struct {
 		QuotaServiceContainer map[api.QuotaType]services.QuotaService
@@ -781,7 +866,7 @@ func TestQuotaManagementListService_IsQuotaEntitlementActive(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
-			factory := NewDefaultQuotaServiceFactory(nil, nil, tt.fields.quotaManagementList, &defaultKafkaConf)
+			factory := NewDefaultQuotaServiceFactory(nil, nil, tt.fields.quotaManagementList, newQuotaGrantServiceMock(tt.fields.quotaManagementList), &defaultKafkaConf)
 			quotaService, _ := factory.GetQuotaService(api.QuotaManagementListQuotaType)
 
 			got, err := quotaService.IsQuotaEntitlementActive(tt.args.kafka)
@@ -790,3 +875,15 @@ func TestQuotaManagementListService_IsQuotaEntitlementActive(t *testing.T) {
 		})
 	}
 }
+
+// newQuotaGrantServiceMock returns a quota grant service whose quota management list is the one of the configuration
+func newQuotaGrantServiceMock(quotaManagementList *quota_management.QuotaManagementListConfig) services.QuotaGrantService {
+	return &services.QuotaGrantServiceMock{
+		GetQuotaManagementListFunc: func() (*quota_management.RegisteredUsersListConfiguration, *errors.ServiceError) {
+			if quotaManagementList == nil {
+				return &quota_management.RegisteredUsersListConfiguration{}, nil
+			}
+			return &quotaManagementList.QuotaList, nil
+		},
+	}
+}
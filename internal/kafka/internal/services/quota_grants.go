@@ -0,0 +1,301 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/patrickmn/go-cache"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const keyQuotaManagementList = "quotaManagementList"
+
+// QuotaGrantQuery selects quota grants. The empty fields do not restrict the selection
+type QuotaGrantQuery struct {
+	SubjectType    dbapi.QuotaGrantSubjectType
+	SubjectID      string
+	InstanceTypeID string
+	BillingModelID string
+}
+
+//go:generate moq -out quota_grants_moq.go . QuotaGrantService
+type QuotaGrantService interface {
+	// Create grants the quota. It fails with a conflict when the subject has already been granted quota for the instance type and
+	// billing model
+	Create(grant *dbapi.QuotaGrant) *errors.ServiceError
+	// Get returns the quota grant with the given id
+	Get(id string) (*dbapi.QuotaGrant, *errors.ServiceError)
+	// List returns the quota grants selected by the query, ordered by subject
+	List(query QuotaGrantQuery, listArgs *services.ListArguments) (dbapi.QuotaGrantList, *api.PagingMeta, *errors.ServiceError)
+	// Update updates the maximum allowed instances and the expiration date of the quota grant
+	Update(grant *dbapi.QuotaGrant) *errors.ServiceError
+	// Delete revokes the quota grant with the given id
+	Delete(id string) *errors.ServiceError
+	// GetQuotaManagementList returns the quota management list granting the quota stored in the database. The users registered to
+	// its organisations are the ones of the quota management list configuration, or all the users of the organisations that are
+	// not part of it. The list is cached for the configured TTL, and rebuilt as soon as quota is granted through this instance
+	GetQuotaManagementList() (*quota_management.RegisteredUsersListConfiguration, *errors.ServiceError)
+	// Seed stores the quota granted by the quota management list configuration, when no quota has ever been granted in the
+	// database. Once seeded, the database is the reference and the quota granted by the configuration file is ignored
+	Seed() *errors.ServiceError
+}
+
+type quotaGrantService struct {
+	connectionFactory         *db.ConnectionFactory
+	quotaManagementListConfig *quota_management.QuotaManagementListConfig
+	cache                     *cache.Cache
+}
+
+var _ QuotaGrantService = &quotaGrantService{}
+
+func NewQuotaGrantService(connectionFactory *db.ConnectionFactory, quotaManagementListConfig *quota_management.QuotaManagementListConfig) QuotaGrantService {
+	return &quotaGrantService{
+		connectionFactory:         connectionFactory,
+		quotaManagementListConfig: quotaManagementListConfig,
+		cache:                     cache.New(quotaManagementListConfig.QuotaListCacheTTL, 2*quotaManagementListConfig.QuotaListCacheTTL),
+	}
+}
+
+func (s *quotaGrantService) Create(grant *dbapi.QuotaGrant) *errors.ServiceError {
+	var count int64
+	if err := s.connectionFactory.New().Model(&dbapi.QuotaGrant{}).
+		Where("subject_type = ? AND subject_id = ?", grant.SubjectType, grant.SubjectID).
+		Where("lower(instance_type_id) = lower(?) AND lower(billing_model_id) = lower(?)", grant.InstanceTypeID, grant.BillingModelID).
+		Count(&count).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to check the quota granted to %s %q", grant.SubjectType, grant.SubjectID)
+	}
+	if count > 0 {
+		return errors.Conflict("%s %q has already been granted quota for instance type %q and billing model %q", grant.SubjectType, grant.SubjectID, grant.InstanceTypeID, grant.BillingModelID)
+	}
+
+	grant.ID = api.NewID()
+	if err := s.connectionFactory.New().Create(grant).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to grant quota to %s %q", grant.SubjectType, grant.SubjectID)
+	}
+	s.cache.Delete(keyQuotaManagementList)
+
+	return nil
+}
+
+func (s *quotaGrantService) Get(id string) (*dbapi.QuotaGrant, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var grant dbapi.QuotaGrant
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&grant).Error; err != nil {
+		return nil, services.HandleGetError("QuotaGrant", "id", id, err)
+	}
+
+	return &grant, nil
+}
+
+func (s *quotaGrantService) List(query QuotaGrantQuery, listArgs *services.ListArguments) (dbapi.QuotaGrantList, *api.PagingMeta, *errors.ServiceError) {
+	var grants dbapi.QuotaGrantList
+	dbConn := s.connectionFactory.New().Model(&dbapi.QuotaGrant{})
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	if query.SubjectType != "" {
+		dbConn = dbConn.Where("subject_type = ?", query.SubjectType)
+	}
+	if query.SubjectID != "" {
+		dbConn = dbConn.Where("subject_id = ?", query.SubjectID)
+	}
+	if query.InstanceTypeID != "" {
+		dbConn = dbConn.Where("lower(instance_type_id) = lower(?)", query.InstanceTypeID)
+	}
+	if query.BillingModelID != "" {
+		dbConn = dbConn.Where("lower(billing_model_id) = lower(?)", query.BillingModelID)
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Count(&total).Error; err != nil {
+		return grants, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the quota grants")
+	}
+	pagingMeta.Total = int(total)
+
+	if err := dbConn.
+		Order("subject_type, subject_id, instance_type_id, billing_model_id").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&grants).Error; err != nil {
+		return grants, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the quota grants")
+	}
+	pagingMeta.Size = len(grants)
+
+	return grants, pagingMeta, nil
+}
+
+func (s *quotaGrantService) Update(grant *dbapi.QuotaGrant) *errors.ServiceError {
+	// the fields are selected so that an unset expiration date is updated too
+	if err := s.connectionFactory.New().Model(&dbapi.QuotaGrant{Meta: api.Meta{ID: grant.ID}}).
+		Select("max_allowed_instances", "expiration_date").
+		Updates(grant).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update quota grant %q", grant.ID)
+	}
+	s.cache.Delete(keyQuotaManagementList)
+
+	return nil
+}
+
+func (s *quotaGrantService) Delete(id string) *errors.ServiceError {
+	if err := s.connectionFactory.New().Where("id = ?", id).Delete(&dbapi.QuotaGrant{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to revoke quota grant %q", id)
+	}
+	s.cache.Delete(keyQuotaManagementList)
+
+	return nil
+}
+
+func (s *quotaGrantService) GetQuotaManagementList() (*quota_management.RegisteredUsersListConfiguration, *errors.ServiceError) {
+	if cached, ok := s.cache.Get(keyQuotaManagementList); ok {
+		if quotaList, ok := cached.(*quota_management.RegisteredUsersListConfiguration); ok {
+			return quotaList, nil
+		}
+	}
+
+	var grants dbapi.QuotaGrantList
+	if err := s.connectionFactory.New().
+		Order("subject_type, subject_id, created_at").
+		Find(&grants).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the quota grants")
+	}
+
+	quotaList := buildQuotaManagementList(grants, s.quotaManagementListConfig.QuotaList)
+	// a TTL of 0 disables the cache, rather than caching the list forever
+	if s.quotaManagementListConfig.QuotaListCacheTTL > 0 {
+		s.cache.Set(keyQuotaManagementList, quotaList, cache.DefaultExpiration)
+	}
+
+	return quotaList, nil
+}
+
+// buildQuotaManagementList builds the quota management list granting the quota of the grants. The users registered to the
+// organisations are taken from the quota management list configuration
+func buildQuotaManagementList(grants dbapi.QuotaGrantList, configuredList quota_management.RegisteredUsersListConfiguration) *quota_management.RegisteredUsersListConfiguration {
+	quotaList := &quota_management.RegisteredUsersListConfiguration{}
+	organisations := map[string]int{}
+	accounts := map[string]int{}
+
+	for _, grant := range grants {
+		switch grant.SubjectType {
+		case dbapi.QuotaGrantSubjectTypeOrganisation:
+			idx, ok := organisations[grant.SubjectID]
+			if !ok {
+				org := quota_management.Organisation{Id: grant.SubjectID, AnyUser: true}
+				if configuredOrg, found := configuredList.Organisations.GetById(grant.SubjectID); found {
+					org.AnyUser = configuredOrg.AnyUser
+					org.RegisteredUsers = configuredOrg.RegisteredUsers
+				}
+				quotaList.Organisations = append(quotaList.Organisations, org)
+				idx = len(quotaList.Organisations) - 1
+				organisations[grant.SubjectID] = idx
+			}
+			quotaList.Organisations[idx].GrantedQuota = addGrantedQuota(quotaList.Organisations[idx].GrantedQuota, grant)
+		case dbapi.QuotaGrantSubjectTypeAccount:
+			idx, ok := accounts[grant.SubjectID]
+			if !ok {
+				quotaList.ServiceAccounts = append(quotaList.ServiceAccounts, quota_management.Account{Username: grant.SubjectID})
+				idx = len(quotaList.ServiceAccounts) - 1
+				accounts[grant.SubjectID] = idx
+			}
+			quotaList.ServiceAccounts[idx].GrantedQuota = addGrantedQuota(quotaList.ServiceAccounts[idx].GrantedQuota, grant)
+		}
+	}
+
+	return quotaList
+}
+
+func addGrantedQuota(grantedQuota quota_management.QuotaList, grant *dbapi.QuotaGrant) quota_management.QuotaList {
+	for i := range grantedQuota {
+		if shared.StringEqualsIgnoreCase(grantedQuota[i].InstanceTypeID, grant.InstanceTypeID) {
+			grantedQuota[i].KafkaBillingModels = append(grantedQuota[i].KafkaBillingModels, grant.BillingModel())
+			return grantedQuota
+		}
+	}
+
+	return append(grantedQuota, quota_management.Quota{
+		InstanceTypeID:     grant.InstanceTypeID,
+		KafkaBillingModels: quota_management.BillingModelList{grant.BillingModel()},
+	})
+}
+
+func (s *quotaGrantService) Seed() *errors.ServiceError {
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// the revoked grants are counted too: the configuration must not grant again the quota that has been revoked
+		var count int64
+		if err := tx.Unscoped().Model(&dbapi.QuotaGrant{}).Count(&count).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the quota grants")
+		}
+		if count > 0 {
+			return nil
+		}
+
+		grants := quotaGrantsOf(s.quotaManagementListConfig.QuotaList)
+		if len(grants) == 0 {
+			return nil
+		}
+
+		// another instance of the fleet manager may be seeding the same grants at the same time
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&grants).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to store the quota granted by the quota management list configuration")
+		}
+		logger.Logger.Infof("stored the %d quota grants of the quota management list configuration", len(grants))
+
+		return nil
+	})
+	if err != nil {
+		return errors.ToServiceError(err)
+	}
+
+	return nil
+}
+
+// quotaGrantsOf returns the quota grants granting the quota of the quota management list
+func quotaGrantsOf(quotaList quota_management.RegisteredUsersListConfiguration) dbapi.QuotaGrantList {
+	var grants dbapi.QuotaGrantList
+	for _, org := range quotaList.Organisations {
+		grants = append(grants, quotaGrantsOfItem(dbapi.QuotaGrantSubjectTypeOrganisation, org.Id, org.MaxAllowedInstances, org.GetGrantedQuota())...)
+	}
+	for _, account := range quotaList.ServiceAccounts {
+		grants = append(grants, quotaGrantsOfItem(dbapi.QuotaGrantSubjectTypeAccount, account.Username, account.MaxAllowedInstances, account.GetGrantedQuota())...)
+	}
+	return grants
+}
+
+func quotaGrantsOfItem(subjectType dbapi.QuotaGrantSubjectType, subjectID string, maxAllowedInstances int, grantedQuota quota_management.QuotaList) dbapi.QuotaGrantList {
+	var grants dbapi.QuotaGrantList
+	for _, quota := range grantedQuota {
+		for _, bm := range quota.GetKafkaBillingModels() {
+			grant := &dbapi.QuotaGrant{
+				Meta:                api.Meta{ID: api.NewID()},
+				SubjectType:         subjectType,
+				SubjectID:           subjectID,
+				InstanceTypeID:      quota.InstanceTypeID,
+				BillingModelID:      bm.Id,
+				MaxAllowedInstances: bm.MaxAllowedInstances,
+			}
+			// the maximum allowed instances of the organisation or of the account apply to the billing models that do not have any
+			if grant.MaxAllowedInstances == 0 {
+				grant.MaxAllowedInstances = maxAllowedInstances
+			}
+			if bm.ExpirationDate != nil {
+				expirationDate := time.Time(*bm.ExpirationDate)
+				grant.ExpirationDate = &expirationDate
+			}
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
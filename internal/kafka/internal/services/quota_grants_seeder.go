@@ -0,0 +1,36 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+)
+
+// QuotaGrantSeeder seeds the quota grants with the quota management list configuration when the fleet manager starts
+type QuotaGrantSeeder struct {
+	quotaGrantService QuotaGrantService
+	kafkaConfig       *config.KafkaConfig
+}
+
+var _ environments.BootService = &QuotaGrantSeeder{}
+
+func NewQuotaGrantSeeder(quotaGrantService QuotaGrantService, kafkaConfig *config.KafkaConfig) *QuotaGrantSeeder {
+	return &QuotaGrantSeeder{
+		quotaGrantService: quotaGrantService,
+		kafkaConfig:       kafkaConfig,
+	}
+}
+
+func (s *QuotaGrantSeeder) Start() {
+	if api.QuotaType(s.kafkaConfig.Quota.Type) == api.AMSQuotaType {
+		return
+	}
+
+	// the quota granted by the configuration can still be granted through the admin API, so the fleet manager starts anyway
+	if err := s.quotaGrantService.Seed(); err != nil {
+		logger.Logger.Errorf("failed to seed the quota grants with the quota management list configuration: %v", err)
+	}
+}
+
+func (s *QuotaGrantSeeder) Stop() {}
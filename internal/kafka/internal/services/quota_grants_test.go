@@ -0,0 +1,244 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/quota_management"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_quotaGrantService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should grant the quota",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "quota_grants"`).
+					WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "quota_grants"`)
+			},
+		},
+		{
+			name: "should return a conflict when the quota has already been granted",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT count(1) FROM "quota_grants"`).
+					WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewQuotaGrantService(db.NewMockConnectionFactory(nil), &quota_management.QuotaManagementListConfig{})
+
+			grant := &dbapi.QuotaGrant{
+				SubjectType:    dbapi.QuotaGrantSubjectTypeAccount,
+				SubjectID:      "testuser",
+				InstanceTypeID: "standard",
+				BillingModelID: "standard",
+			}
+			err := s.Create(grant)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(grant.ID != "").To(gomega.Equal(!tt.wantErr))
+		})
+	}
+}
+
+func Test_quotaGrantService_List(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset().NewMock().
+		WithQuery(`SELECT count(1) FROM "quota_grants"`).
+		WithArgs("organisation", "13640203").
+		WithReply([]map[string]interface{}{{"count": 2}})
+	mocket.Catcher.NewMock().
+		WithQuery(`SELECT * FROM "quota_grants"`).
+		WithArgs("organisation", "13640203").
+		WithReply([]map[string]interface{}{{"id": "grant-1"}, {"id": "grant-2"}})
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+	s := NewQuotaGrantService(db.NewMockConnectionFactory(nil), &quota_management.QuotaManagementListConfig{})
+
+	grants, paging, err := s.List(QuotaGrantQuery{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203"}, &services.ListArguments{Page: 1, Size: 100})
+	g.Expect(err).To(gomega.BeNil())
+	g.Expect(paging.Total).To(gomega.Equal(2))
+	g.Expect(paging.Size).To(gomega.Equal(2))
+	g.Expect(grants).To(gomega.HaveLen(2))
+}
+
+func Test_quotaGrantService_GetQuotaManagementList(t *testing.T) {
+	tests := []struct {
+		name       string
+		ttl        time.Duration
+		wantCached bool
+	}{
+		{
+			name:       "should cache the quota management list",
+			ttl:        time.Minute,
+			wantCached: true,
+		},
+		{
+			name: "should not cache the quota management list when the TTL is 0",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset().NewMock().
+				WithQuery(`SELECT * FROM "quota_grants"`).
+				WithReply([]map[string]interface{}{{"id": "grant-1", "subject_type": "account", "subject_id": "testuser", "instance_type_id": "standard", "billing_model_id": "standard"}})
+			s := NewQuotaGrantService(db.NewMockConnectionFactory(nil), &quota_management.QuotaManagementListConfig{QuotaListCacheTTL: tt.ttl})
+
+			quotaList, err := s.GetQuotaManagementList()
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(quotaList.ServiceAccounts).To(gomega.HaveLen(1))
+
+			// the quota grants cannot be read anymore: only the cached list can be returned
+			mocket.Catcher.Reset().NewMock().WithQueryException().WithExecException()
+			quotaList, err = s.GetQuotaManagementList()
+			g.Expect(err == nil).To(gomega.Equal(tt.wantCached))
+			if tt.wantCached {
+				g.Expect(quotaList.ServiceAccounts).To(gomega.HaveLen(1))
+			}
+		})
+	}
+}
+
+func Test_quotaGrantService_Seed(t *testing.T) {
+	tests := []struct {
+		name       string
+		count      int
+		wantInsert bool
+	}{
+		{
+			name:       "should store the quota granted by the configuration when no quota has ever been granted",
+			wantInsert: true,
+		},
+		{
+			name:  "should not store the quota granted by the configuration once quota has been granted",
+			count: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset().NewMock().
+				WithQuery(`SELECT count(1) FROM "quota_grants"`).
+				WithReply([]map[string]interface{}{{"count": tt.count}})
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "quota_grants"`)
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			s := NewQuotaGrantService(db.NewMockConnectionFactory(nil), &quota_management.QuotaManagementListConfig{
+				QuotaList: quota_management.RegisteredUsersListConfiguration{
+					ServiceAccounts: quota_management.AccountList{{Username: "testuser", MaxAllowedInstances: 2}},
+				},
+			})
+
+			err := s.Seed()
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(insert.Triggered).To(gomega.Equal(tt.wantInsert))
+		})
+	}
+}
+
+func Test_quotaGrantsOf(t *testing.T) {
+	g := gomega.NewWithT(t)
+	expirationDate := quota_management.ExpirationDate(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	grants := quotaGrantsOf(quota_management.RegisteredUsersListConfiguration{
+		Organisations: quota_management.OrganisationList{
+			{
+				Id:                  "13640203",
+				MaxAllowedInstances: 5,
+				GrantedQuota: quota_management.QuotaList{
+					{
+						InstanceTypeID: "standard",
+						KafkaBillingModels: quota_management.BillingModelList{
+							{Id: "standard"},
+							{Id: "enterprise", MaxAllowedInstances: 10, ExpirationDate: &expirationDate},
+						},
+					},
+				},
+			},
+		},
+		ServiceAccounts: quota_management.AccountList{{Username: "testuser", MaxAllowedInstances: 1}},
+	})
+
+	g.Expect(grants).To(gomega.HaveLen(3))
+	for _, grant := range grants {
+		g.Expect(grant.ID).ToNot(gomega.BeEmpty())
+		grant.Meta = api.Meta{}
+	}
+	expirationTime := time.Time(expirationDate)
+	g.Expect(grants).To(gomega.Equal(dbapi.QuotaGrantList{
+		{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203", InstanceTypeID: "standard", BillingModelID: "standard", MaxAllowedInstances: 5},
+		{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203", InstanceTypeID: "standard", BillingModelID: "enterprise", MaxAllowedInstances: 10, ExpirationDate: &expirationTime},
+		{SubjectType: dbapi.QuotaGrantSubjectTypeAccount, SubjectID: "testuser", InstanceTypeID: "standard", BillingModelID: "standard", MaxAllowedInstances: 1},
+	}))
+}
+
+func Test_buildQuotaManagementList(t *testing.T) {
+	g := gomega.NewWithT(t)
+	configuredList := quota_management.RegisteredUsersListConfiguration{
+		Organisations: quota_management.OrganisationList{
+			{Id: "13640203", RegisteredUsers: quota_management.AccountList{{Username: "registereduser"}}},
+		},
+	}
+
+	quotaList := buildQuotaManagementList(dbapi.QuotaGrantList{
+		{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203", InstanceTypeID: "standard", BillingModelID: "standard", MaxAllowedInstances: 5},
+		{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "13640203", InstanceTypeID: "Standard", BillingModelID: "enterprise", MaxAllowedInstances: 10},
+		{SubjectType: dbapi.QuotaGrantSubjectTypeOrganisation, SubjectID: "12147054", InstanceTypeID: "developer", BillingModelID: "standard", MaxAllowedInstances: 1},
+		{SubjectType: dbapi.QuotaGrantSubjectTypeAccount, SubjectID: "testuser", InstanceTypeID: "standard", BillingModelID: "standard", MaxAllowedInstances: 2},
+	}, configuredList)
+
+	g.Expect(quotaList).To(gomega.Equal(&quota_management.RegisteredUsersListConfiguration{
+		Organisations: quota_management.OrganisationList{
+			{
+				Id:              "13640203",
+				RegisteredUsers: quota_management.AccountList{{Username: "registereduser"}},
+				GrantedQuota: quota_management.QuotaList{
+					{
+						InstanceTypeID: "standard",
+						KafkaBillingModels: quota_management.BillingModelList{
+							{Id: "standard", MaxAllowedInstances: 5},
+							{Id: "enterprise", MaxAllowedInstances: 10},
+						},
+					},
+				},
+			},
+			{
+				Id:      "12147054",
+				AnyUser: true,
+				GrantedQuota: quota_management.QuotaList{
+					{InstanceTypeID: "developer", KafkaBillingModels: quota_management.BillingModelList{{Id: "standard", MaxAllowedInstances: 1}}},
+				},
+			},
+		},
+		ServiceAccounts: quota_management.AccountList{
+			{
+				Username: "testuser",
+				GrantedQuota: quota_management.QuotaList{
+					{InstanceTypeID: "standard", KafkaBillingModels: quota_management.BillingModelList{{Id: "standard", MaxAllowedInstances: 2}}},
+				},
+			},
+		},
+	}))
+}
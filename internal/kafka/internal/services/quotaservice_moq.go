@@ -36,6 +36,9 @@ var _ QuotaService = &QuotaServiceMock{}
 //			ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest) (string, *apiErrors.ServiceError) {
 //				panic("mock out the ReserveQuota method")
 //			},
+//			ReserveQuotaForResizeFunc: func(kafka *dbapi.KafkaRequest, sizeID string) (string, *apiErrors.ServiceError) {
+//				panic("mock out the ReserveQuotaForResize method")
+//			},
 //			ReserveQuotaIfNotAlreadyReservedFunc: func(kafka *dbapi.KafkaRequest) (string, *apiErrors.ServiceError) {
 //				panic("mock out the ReserveQuotaIfNotAlreadyReserved method")
 //			},
@@ -64,6 +67,9 @@ type QuotaServiceMock struct {
 	// ReserveQuotaFunc mocks the ReserveQuota method.
 	ReserveQuotaFunc func(kafka *dbapi.KafkaRequest) (string, *apiErrors.ServiceError)
 
+	// ReserveQuotaForResizeFunc mocks the ReserveQuotaForResize method.
+	ReserveQuotaForResizeFunc func(kafka *dbapi.KafkaRequest, sizeID string) (string, *apiErrors.ServiceError)
+
 	// ReserveQuotaIfNotAlreadyReservedFunc mocks the ReserveQuotaIfNotAlreadyReserved method.
 	ReserveQuotaIfNotAlreadyReservedFunc func(kafka *dbapi.KafkaRequest) (string, *apiErrors.ServiceError)
 
@@ -105,6 +111,13 @@ type QuotaServiceMock struct {
 			// Kafka is the kafka argument value.
 			Kafka *dbapi.KafkaRequest
 		}
+		// ReserveQuotaForResize holds details about calls to the ReserveQuotaForResize method.
+		ReserveQuotaForResize []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+			// SizeID is the sizeID argument value.
+			SizeID string
+		}
 		// ReserveQuotaIfNotAlreadyReserved holds details about calls to the ReserveQuotaIfNotAlreadyReserved method.
 		ReserveQuotaIfNotAlreadyReserved []struct {
 			// Kafka is the kafka argument value.
@@ -129,6 +142,7 @@ type QuotaServiceMock struct {
 	lockDeleteQuotaForBillingModel           sync.RWMutex
 	lockIsQuotaEntitlementActive             sync.RWMutex
 	lockReserveQuota                         sync.RWMutex
+	lockReserveQuotaForResize                sync.RWMutex
 	lockReserveQuotaIfNotAlreadyReserved     sync.RWMutex
 	lockValidateBillingAccount               sync.RWMutex
 }
@@ -309,6 +323,42 @@ func (mock *QuotaServiceMock) ReserveQuotaCalls() []struct {
 	return calls
 }
 
+// ReserveQuotaForResize calls ReserveQuotaForResizeFunc.
+func (mock *QuotaServiceMock) ReserveQuotaForResize(kafka *dbapi.KafkaRequest, sizeID string) (string, *apiErrors.ServiceError) {
+	if mock.ReserveQuotaForResizeFunc == nil {
+		panic("QuotaServiceMock.ReserveQuotaForResizeFunc: method is nil but QuotaService.ReserveQuotaForResize was just called")
+	}
+	callInfo := struct {
+		Kafka  *dbapi.KafkaRequest
+		SizeID string
+	}{
+		Kafka:  kafka,
+		SizeID: sizeID,
+	}
+	mock.lockReserveQuotaForResize.Lock()
+	mock.calls.ReserveQuotaForResize = append(mock.calls.ReserveQuotaForResize, callInfo)
+	mock.lockReserveQuotaForResize.Unlock()
+	return mock.ReserveQuotaForResizeFunc(kafka, sizeID)
+}
+
+// ReserveQuotaForResizeCalls gets all the calls that were made to ReserveQuotaForResize.
+// Check the length with:
+//
+//	len(mockedQuotaService.ReserveQuotaForResizeCalls())
+func (mock *QuotaServiceMock) ReserveQuotaForResizeCalls() []struct {
+	Kafka  *dbapi.KafkaRequest
+	SizeID string
+} {
+	var calls []struct {
+		Kafka  *dbapi.KafkaRequest
+		SizeID string
+	}
+	mock.lockReserveQuotaForResize.RLock()
+	calls = mock.calls.ReserveQuotaForResize
+	mock.lockReserveQuotaForResize.RUnlock()
+	return calls
+}
+
 // ReserveQuotaIfNotAlreadyReserved calls ReserveQuotaIfNotAlreadyReservedFunc.
 func (mock *QuotaServiceMock) ReserveQuotaIfNotAlreadyReserved(kafka *dbapi.KafkaRequest) (string, *apiErrors.ServiceError) {
 	if mock.ReserveQuotaIfNotAlreadyReservedFunc == nil {
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// webhookPoster posts JSON documents to HTTP webhooks
+type webhookPoster struct {
+	client *http.Client
+}
+
+func newWebhookPoster(client *http.Client) *webhookPoster {
+	return &webhookPoster{
+		client: client,
+	}
+}
+
+// post posts the payload, marshalled as JSON, to the url. Only the 2xx responses of the webhook are successful
+func (p *webhookPoster) post(url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the payload")
+	}
+
+	resp, err := p.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
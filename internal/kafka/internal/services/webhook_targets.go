@@ -0,0 +1,109 @@
+package services
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// internalHostSuffixes are the suffixes of the host names that are only resolvable from inside the control plane
+var internalHostSuffixes = []string{".localhost", ".local", ".localdomain", ".internal", ".svc", ".cluster.local"}
+
+// internalNetworks are the networks not covered by the net.IP predicates that must not be reached by the webhooks given by
+// customers: the "this network", shared address space, IETF protocol assignments, benchmarking, reserved and NAT64 ranges
+var internalNetworks = mustParseCIDRs("0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4", "64:ff9b::/96")
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isInternalIP returns true when the ip is a loopback, private, link-local, multicast or unspecified address, or belongs
+// to one of the internalNetworks
+func isInternalIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWebhookTargetURL returns an error when the webhook URL given by a customer is not a https URL, or when its host is
+// internal to the control plane: an internal IP, or a host name such as localhost, a single label name resolved through the
+// search domains of the cluster, or a name under an internal domain such as .svc or .internal.
+// The host names are not resolved here, as they could resolve to another address when the webhook is called: the resolved
+// addresses are checked when the connections are opened by the client returned by newWebhookTargetsClient
+func ValidateWebhookTargetURL(rawURL string) error {
+	targetURL, err := url.Parse(rawURL)
+	if err != nil || targetURL.Scheme != "https" || targetURL.Hostname() == "" {
+		return fmt.Errorf("%q must be a valid https URL", rawURL)
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(targetURL.Hostname()), ".")
+	if ip := net.ParseIP(host); ip != nil {
+		if isInternalIP(ip) {
+			return fmt.Errorf("%q must not target an internal IP address", rawURL)
+		}
+		return nil
+	}
+
+	if host == "localhost" || !strings.Contains(host, ".") {
+		return fmt.Errorf("%q must target a fully qualified public host name", rawURL)
+	}
+	for _, suffix := range internalHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return fmt.Errorf("%q must not target an internal host name", rawURL)
+		}
+	}
+
+	return nil
+}
+
+// newWebhookTargetsClient returns a HTTP client for the webhooks given by customers. It refuses to connect to the internal IPs,
+// which are checked once the host name is resolved so that a public name resolving to an internal address is refused as well.
+// The client does not use the proxy of the environment, nor follow the redirects, so that only the checked address is called
+func newWebhookTargetsClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || isInternalIP(ip) {
+				return fmt.Errorf("connections to the internal address %q are not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_ValidateWebhookTargetURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name: "should accept a https URL of a public host",
+			url:  "https://alerts.example.com/hooks/kafka",
+		},
+		{
+			name: "should accept a https URL of a public IP",
+			url:  "https://203.0.113.10:8443/alerts",
+		},
+		{
+			name:    "should refuse a http URL",
+			url:     "http://alerts.example.com/hooks/kafka",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a URL without host",
+			url:     "https:///alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a loopback IP",
+			url:     "https://127.0.0.1/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a loopback IPv6",
+			url:     "https://[::1]/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a private IP",
+			url:     "https://10.0.12.3/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a private IP mapped to IPv6",
+			url:     "https://[::ffff:192.168.1.1]/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a link-local IP",
+			url:     "https://169.254.169.254/latest/meta-data",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse the unspecified IP",
+			url:     "https://0.0.0.0/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse localhost",
+			url:     "https://localhost:8000/alerts",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a single label host name",
+			url:     "https://kubernetes/api",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse a cluster service host name",
+			url:     "https://kas-fleet-manager.managed-services.svc/api",
+			wantErr: true,
+		},
+		{
+			name:    "should refuse an internal host name",
+			url:     "https://metadata.google.internal./computeMetadata/v1",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := ValidateWebhookTargetURL(tt.url)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -781,9 +781,9 @@ func (c *ClusterManager) buildResourceSet(cluster api.Cluster) types.ResourceSet
 		kasFleetshardNamespace = kasFleetshardQEAddonNamespace
 	}
 
-	// For standalone clusters, make sure that the namespaces is read from the config
+	// For standalone and kubernetes clusters, make sure that the namespaces is read from the config
 	// and that they are created before the pull secrets that references them
-	if cluster.ProviderType == api.ClusterProviderStandalone {
+	if cluster.ProviderType == api.ClusterProviderStandalone || cluster.ProviderType == api.ClusterProviderKubernetes {
 		strimziNamespace = c.DataplaneClusterConfig.StrimziOperatorOLMConfig.Namespace
 		kasFleetshardNamespace = c.DataplaneClusterConfig.KasFleetshardOperatorOLMConfig.Namespace
 		r = append(r, &k8sCoreV1.Namespace{
@@ -1158,6 +1158,11 @@ func (c *ClusterManager) reconcileClusterIdentityProvider(cluster api.Cluster) e
 		return nil
 	}
 
+	if cluster.ProviderType == api.ClusterProviderKubernetes {
+		glog.Infof("Identity providers are not supported on kubernetes clusters. Skipping configuring the identity provider for ClusterID '%s'", cluster.ClusterID)
+		return nil
+	}
+
 	// identity provider not yet created, let's create a new one.
 	glog.Infof("Setting up the identity provider for cluster %s", cluster.ClusterID)
 	clusterDNS, dnsErr := c.ClusterService.GetClusterDNS(cluster.ClusterID)
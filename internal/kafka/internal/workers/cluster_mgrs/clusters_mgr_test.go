@@ -2413,7 +2413,7 @@ func buildResourceSet(observabilityConfig observatorium.ObservabilityConfigurati
 		},
 	)
 
-	if cluster.ProviderType == api.ClusterProviderStandalone {
+	if cluster.ProviderType == api.ClusterProviderStandalone || cluster.ProviderType == api.ClusterProviderKubernetes {
 		strimziNamespace = clusterConfig.StrimziOperatorOLMConfig.Namespace
 		kasFleetshardNamespace = clusterConfig.KasFleetshardOperatorOLMConfig.Namespace
 		resources = append(resources, &k8sCoreV1.Namespace{
@@ -2645,6 +2645,28 @@ func TestClusterManager_reconcileClusterIdentityProvider(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "should skip the creation of the identity provider for kubernetes clusters",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					GetClusterDNSFunc: nil, // setting it to nill so that it is not called
+				},
+				osdIdpKeycloakService: &sso.OSDKeycloakServiceMock{
+					RegisterClientInSSOFunc: nil, // setting it to nill so that it is not called
+					GetRealmConfigFunc:      nil, // setting it to nill so that it is not called
+				},
+				dataplaneClusterConfig: &config.DataplaneClusterConfig{
+					EnableKafkaSreIdentityProviderConfiguration: true,
+				},
+			},
+			arg: api.Cluster{
+				Meta: api.Meta{
+					ID: "cluster-id",
+				},
+				ProviderType: api.ClusterProviderKubernetes,
+			},
+			wantErr: false,
+		},
 		{
 			name: "should receive error when GetClusterDNSFunc returns error",
 			fields: fields{
@@ -0,0 +1,90 @@
+package cluster_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	drainingClustersWorkerType = "draining_clusters"
+)
+
+// DrainingClustersManager represents a cluster manager that periodically reports the progress of the drain of data plane clusters.
+// The kafkas of a draining cluster are not moved by this manager: they have to be migrated or deleted. Once the cluster is empty,
+// it is removed by the DynamicScaleDownManager.
+type DrainingClustersManager struct {
+	workers.BaseWorker
+	clusterService      services.ClusterService
+	clusterDrainService services.ClusterDrainService
+}
+
+// NewDrainingClustersManager creates a new cluster manager to report the progress of the drain of data plane clusters.
+func NewDrainingClustersManager(reconciler workers.Reconciler, clusterService services.ClusterService, clusterDrainService services.ClusterDrainService) *DrainingClustersManager {
+	return &DrainingClustersManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: drainingClustersWorkerType,
+			Reconciler: reconciler,
+		},
+		clusterService:      clusterService,
+		clusterDrainService: clusterDrainService,
+	}
+}
+
+// Start initializes the cluster manager to report the progress of the drain of data plane clusters.
+func (m *DrainingClustersManager) Start() {
+	m.StartWorker(m)
+}
+
+// Stop causes the process for reporting the progress of the drain of data plane clusters to stop.
+func (m *DrainingClustersManager) Stop() {
+	m.StopWorker(m)
+	metrics.ResetClusterDrainKafkaInstancesMetric()
+}
+
+func (m *DrainingClustersManager) Reconcile() []error {
+	glog.Infoln("reconciling draining clusters")
+
+	var errList fleeterrors.ErrorList
+	drainingClusters, serviceErr := m.clusterService.ListDrainingClusters()
+	if serviceErr != nil {
+		errList.AddErrors(serviceErr)
+		return errList.ToErrorSlice()
+	}
+
+	glog.Infof("draining clusters count = %d", len(drainingClusters))
+
+	// clusters that are no longer draining must not be reported anymore
+	metrics.ResetClusterDrainKafkaInstancesMetric()
+	for _, cluster := range drainingClusters {
+		if err := m.reconcileDrainingCluster(cluster.ClusterID); err != nil {
+			errList.AddErrors(errors.Wrapf(err, "failed to reconcile draining cluster %s", cluster.ClusterID))
+		}
+	}
+
+	return errList.ToErrorSlice()
+}
+
+func (m *DrainingClustersManager) reconcileDrainingCluster(clusterID string) error {
+	drainStatus, err := m.clusterDrainService.GetDrainStatus(clusterID)
+	if err != nil {
+		return err
+	}
+
+	for readiness, count := range drainStatus.CountByReadiness() {
+		metrics.UpdateClusterDrainKafkaInstancesMetric(clusterID, readiness.String(), count)
+	}
+
+	if drainStatus.Drained() {
+		glog.Infof("cluster %q has been drained and can be removed", clusterID)
+	} else {
+		glog.Infof("%d kafkas are still deployed on draining cluster %q", len(drainStatus.Kafkas), clusterID)
+	}
+
+	return nil
+}
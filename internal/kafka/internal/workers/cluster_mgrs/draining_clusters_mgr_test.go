@@ -0,0 +1,98 @@
+package cluster_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func TestDrainingClustersManager_Reconcile(t *testing.T) {
+	type fields struct {
+		clusterService      services.ClusterService
+		clusterDrainService services.ClusterDrainService
+	}
+	tests := []struct {
+		name                    string
+		fields                  fields
+		wantErrCount            int
+		wantGetDrainStatusCalls int
+	}{
+		{
+			name: "should return an error when listing the draining clusters fails",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					ListDrainingClustersFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to list draining clusters")
+					},
+				},
+				clusterDrainService: &services.ClusterDrainServiceMock{},
+			},
+			wantErrCount:            1,
+			wantGetDrainStatusCalls: 0,
+		},
+		{
+			name: "should return an error for each draining cluster whose drain status cannot be retrieved",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					ListDrainingClustersFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+						return []api.Cluster{{ClusterID: "cluster-1"}, {ClusterID: "cluster-2"}}, nil
+					},
+				},
+				clusterDrainService: &services.ClusterDrainServiceMock{
+					GetDrainStatusFunc: func(clusterID string) (*services.ClusterDrainStatus, *apiErrors.ServiceError) {
+						if clusterID == "cluster-1" {
+							return nil, apiErrors.GeneralError("failed to get drain status")
+						}
+						return &services.ClusterDrainStatus{Cluster: &api.Cluster{ClusterID: clusterID}}, nil
+					},
+				},
+			},
+			wantErrCount:            1,
+			wantGetDrainStatusCalls: 2,
+		},
+		{
+			name: "should report the drain status of every draining cluster",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					ListDrainingClustersFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+						return []api.Cluster{{ClusterID: "cluster-1"}, {ClusterID: "cluster-2"}}, nil
+					},
+				},
+				clusterDrainService: &services.ClusterDrainServiceMock{
+					GetDrainStatusFunc: func(clusterID string) (*services.ClusterDrainStatus, *apiErrors.ServiceError) {
+						status := &services.ClusterDrainStatus{Cluster: &api.Cluster{ClusterID: clusterID}}
+						if clusterID == "cluster-1" {
+							status.Kafkas = []services.KafkaDrainStatus{
+								{Kafka: &dbapi.KafkaRequest{}, Readiness: services.KafkaDrainReadinessMigratable},
+								{Kafka: &dbapi.KafkaRequest{}, Readiness: services.KafkaDrainReadinessBlocked},
+							}
+						}
+						return status, nil
+					},
+				},
+			},
+			wantErrCount:            0,
+			wantGetDrainStatusCalls: 2,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			m := &DrainingClustersManager{
+				clusterService:      tt.fields.clusterService,
+				clusterDrainService: tt.fields.clusterDrainService,
+			}
+
+			errs := m.Reconcile()
+			g.Expect(errs).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(tt.fields.clusterDrainService.(*services.ClusterDrainServiceMock).GetDrainStatusCalls()).To(gomega.HaveLen(tt.wantGetDrainStatusCalls))
+		})
+	}
+}
@@ -245,6 +245,7 @@ var _ dynamicScaleDownProcessor = &standardDynamicScaleDownProcessor{}
 // 1. If specified the cluster is empty i.e it does not contain any streaming unit
 // 2. If the cluster can be removed without triggering a scale up action
 // Otherwise false is returned.
+// A draining cluster is removed as soon as it is empty while a cordoned cluster that is not draining is never removed.
 // Note:
 // 1. This method assumes kafkaStreamingUnitCountPerClusterList does not
 // contain elements with the Status attribute with the 'failed' value.
@@ -259,6 +260,29 @@ func (p *standardDynamicScaleDownProcessor) ShouldScaleDown() (bool, error) {
 		return false, nil
 	}
 
+	// the suspended kafkas no longer consume streaming units, but they are still placed on the cluster
+	nonEmptyCluster, findClusterErr := p.clusterService.FindNonEmptyClusterByID(p.clusterID)
+	if findClusterErr != nil {
+		return false, findClusterErr
+	}
+	if nonEmptyCluster != nil {
+		glog.Infof("cluster with cluster id %q still has suspended kafkas. It is not going to be removed", p.clusterID)
+		return false, nil
+	}
+
+	for _, i := range p.indexesOfStreamingUnitForSameClusterID {
+		suCount := p.kafkaStreamingUnitCountPerClusterList[i]
+		if suCount.Draining {
+			glog.Infof("cluster with cluster id %q has been drained. It is going to be removed", p.clusterID)
+			return true, nil
+		}
+
+		if suCount.Cordoned {
+			glog.Infof("cluster with cluster id %q is cordoned. It is not going to be removed until it is drained", p.clusterID)
+			return false, nil
+		}
+	}
+
 	// let's check if the cluster can be safely removed without causing a scale up event
 	if len(p.regionsSupportedInstanceType) == 0 { // if no region limits are available it means that this cluster is in a region that's not supported anymore, we can safely delete it if it is empty
 		glog.Infof("no region limits are available. cluster with cluster id %q is going to be removed as it is empty", p.clusterID)
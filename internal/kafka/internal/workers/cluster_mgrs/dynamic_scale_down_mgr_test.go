@@ -7,6 +7,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/onsi/gomega"
 )
 
@@ -15,6 +16,12 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 		standardDynamicScaleDownProcessor *standardDynamicScaleDownProcessor
 	}
 
+	emptyClusterService := &services.ClusterServiceMock{
+		FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+			return nil, nil
+		},
+	}
+
 	tests := []struct {
 		name    string
 		fields  fields
@@ -25,6 +32,7 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should not scale down if at least one streaming unit count is non zero for the given cluster indexes",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
 					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
 						services.KafkaStreamingUnitCountPerCluster{
 							Status: api.ClusterReady.String(),
@@ -47,10 +55,72 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name: "should scale down a draining cluster as soon as it is empty",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
+					regionsSupportedInstanceType: config.InstanceTypeMap{
+						"instance-type-1": config.InstanceTypeConfig{},
+					},
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status:       api.ClusterReady.String(),
+							Count:        0,
+							InstanceType: "instance-type-1",
+							Cordoned:     true,
+							Draining:     true,
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: false,
+			want:    true,
+		},
+		{
+			name: "should not scale down a draining cluster that is not empty",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status:   api.ClusterReady.String(),
+							Count:    1,
+							Cordoned: true,
+							Draining: true,
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: false,
+			want:    false,
+		},
+		{
+			name: "should not scale down an empty cordoned cluster that is not draining",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService:               emptyClusterService,
+					regionsSupportedInstanceType: config.InstanceTypeMap{}, // an empty supported instance type
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status:   api.ClusterReady.String(),
+							Count:    0,
+							Cordoned: true,
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: false,
+			want:    false,
+		},
 		{
 			name: "should scale down if all streaming unit count are zero for the given cluster indexes and the no supported instance types in the region",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService:               emptyClusterService,
 					regionsSupportedInstanceType: config.InstanceTypeMap{}, // an empty supported instance type
 					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
 						services.KafkaStreamingUnitCountPerCluster{
@@ -78,6 +148,7 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should scale down if all streaming unit count are zero for the given cluster indexes and the instance type is not part of supported instance types in the region",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"some-instance-type": config.InstanceTypeConfig{},
 					},
@@ -109,6 +180,7 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should return an error when evaluating if there is a need to scale up after cluster removal returns an error",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"instance-type-1": config.InstanceTypeConfig{},
 						"instance-type-2": config.InstanceTypeConfig{},
@@ -149,6 +221,7 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should not scale down if after the removal of the cluster there will be a need to scale up because largest size cannot fit in the cluster",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: emptyClusterService,
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"instance-type-1": config.InstanceTypeConfig{},
 						"instance-type-2": config.InstanceTypeConfig{},
@@ -211,7 +284,8 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should not scale down if after the removal of the cluster there will be a need to scale up because region slack won't be honoured",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
-					clusterID: "cluster-1",
+					clusterService: emptyClusterService,
+					clusterID:      "cluster-1",
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"instance-type-1": config.InstanceTypeConfig{
 							MinAvailableCapacitySlackStreamingUnits: 30,
@@ -267,7 +341,8 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should not scale down if after the removal of the cluster there will be a need to scale up because there is no sibling cluster in the region",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
-					clusterID: "cluster-1",
+					clusterService: emptyClusterService,
+					clusterID:      "cluster-1",
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"instance-type-1": config.InstanceTypeConfig{
 							MinAvailableCapacitySlackStreamingUnits: 1,
@@ -324,7 +399,8 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			name: "should scale down if after the removal of the cluster there wont be a need to scale up",
 			fields: fields{
 				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
-					clusterID: "cluster-1",
+					clusterService: emptyClusterService,
+					clusterID:      "cluster-1",
 					regionsSupportedInstanceType: config.InstanceTypeMap{
 						"instance-type-1": config.InstanceTypeConfig{
 							MinAvailableCapacitySlackStreamingUnits: 1,
@@ -379,6 +455,48 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			wantErr: false,
 			want:    true,
 		},
+		{
+			name: "should not scale down an empty cluster that still has suspended kafkas",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: &services.ClusterServiceMock{
+						FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+							return &api.Cluster{ClusterID: clusterID}, nil
+						},
+					},
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status: api.ClusterReady.String(),
+							Count:  0,
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: false,
+			want:    false,
+		},
+		{
+			name: "should return an error when checking whether the cluster still has suspended kafkas fails",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					clusterService: &services.ClusterServiceMock{
+						FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+							return nil, apiErrors.GeneralError("test")
+						},
+					},
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status: api.ClusterReady.String(),
+							Count:  0,
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: true,
+			want:    false,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -547,6 +665,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return nil, errors.New("some errors")
 					},
@@ -567,6 +688,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return nil, nil
 					},
@@ -587,6 +711,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -613,6 +740,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -652,6 +782,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -696,6 +829,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -722,6 +858,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -866,6 +1005,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -1000,6 +1142,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -1078,6 +1223,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -1122,6 +1270,9 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 					},
 				},
 				clusterService: &services.ClusterServiceMock{
+					FindNonEmptyClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
 					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
 						return services.KafkaStreamingUnitCountPerClusterList{
 							services.KafkaStreamingUnitCountPerCluster{
@@ -380,6 +380,8 @@ type instanceTypeConsumptionSummaryCalculator struct {
 //   - Clusters that are still not ready to accept kafka instance but that
 //     should eventually accept them (like accepted state for example)
 //     are included
+//   - Cordoned clusters only contribute their consumed streaming units, as
+//     their remaining capacity cannot receive new kafka instances
 //
 // For the calculation of whether a scale up actions is ongoing:
 //   - A scale up action is ongoing if there is at least one cluster in the
@@ -427,11 +429,18 @@ func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeCons
 			continue
 		}
 
+		consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+
+		// the kafkas of a cordoned cluster still count against the region limit but its remaining capacity is not available
+		if kafkaStreamingUnitCountPerCluster.Cordoned {
+			maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			continue
+		}
+
 		if kafkaStreamingUnitCountPerCluster.FreeStreamingUnits() >= int32(biggestKafkaInstanceSizeCapacityConsumption) {
 			atLeastOneClusterHasCapacityForBiggestInstanceType = true
 		}
 
-		consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
 		maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.MaxUnits)
 	}
 
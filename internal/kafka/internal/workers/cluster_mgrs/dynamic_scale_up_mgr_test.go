@@ -941,6 +941,40 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Cordoned clusters that match the locator only contribute their consumed streaming units",
+			fields: fields{
+				locator: newTestHelperBaseSupportedInstanceTypeLocator(),
+				kafkaStreamingUnitCountPerClusterListFactory: func() services.KafkaStreamingUnitCountPerClusterList {
+					locator := newTestHelperBaseSupportedInstanceTypeLocator()
+					res := []services.KafkaStreamingUnitCountPerCluster(newTestHelperBaseKafkaStreamingUnitCountPerClusterList())
+					cordonedClusterInfo := services.KafkaStreamingUnitCountPerCluster{
+						CloudProvider: locator.provider,
+						Region:        locator.region,
+						InstanceType:  locator.instanceTypeName,
+						Count:         2,
+						MaxUnits:      10,
+						Status:        api.ClusterReady.String(),
+						ClusterType:   locator.clusterType,
+						Cordoned:      true,
+					}
+
+					res = append(res, cordonedClusterInfo)
+					return res
+				},
+				supportedKafkaInstanceTypesConfigFactory: func() *config.SupportedKafkaInstanceTypesConfig {
+					return newTestHelperBaseSupportedKafkaInstanceTypesConfig()
+				},
+			},
+			want: instanceTypeConsumptionSummary{
+				maxStreamingUnits:                    10,
+				freeStreamingUnits:                   3,
+				consumedStreamingUnits:               7,
+				ongoingScaleUpAction:                 false,
+				biggestInstanceSizeCapacityAvailable: true,
+			},
+			wantErr: false,
+		},
 		{
 			name: "Cluster information that does not match the provided locator's region is ignored",
 			fields: fields{
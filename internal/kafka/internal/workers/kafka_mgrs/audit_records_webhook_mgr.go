@@ -0,0 +1,75 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// AuditRecordsWebhookManager posts the audit records pending in the database to the audit records webhook, so that the webhook
+// is not called on the path of the audited requests. The records are posted in the order they were made, and are only marked as
+// posted once the webhook has accepted them: a record that could not be posted is retried at the next reconcile
+type AuditRecordsWebhookManager struct {
+	workers.BaseWorker
+	auditRecordService   services.AuditRecordService
+	auditRecordPublisher services.AuditRecordPublisher
+	auditRecordsConfig   *config.AuditRecordsConfig
+}
+
+var _ workers.Worker = &AuditRecordsWebhookManager{}
+
+func NewAuditRecordsWebhookManager(auditRecordService services.AuditRecordService, auditRecordPublisher services.AuditRecordPublisher,
+	auditRecordsConfig *config.AuditRecordsConfig, reconciler workers.Reconciler) *AuditRecordsWebhookManager {
+	return &AuditRecordsWebhookManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "audit_records_webhook",
+			Reconciler: reconciler,
+		},
+		auditRecordService:   auditRecordService,
+		auditRecordPublisher: auditRecordPublisher,
+		auditRecordsConfig:   auditRecordsConfig,
+	}
+}
+
+func (k *AuditRecordsWebhookManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *AuditRecordsWebhookManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *AuditRecordsWebhookManager) Reconcile() []error {
+	if k.auditRecordsConfig.WebhookURL == "" {
+		return nil
+	}
+
+	glog.Infoln("posting audit records to the webhook")
+
+	records, listErr := k.auditRecordService.ListWebhookPending(k.auditRecordsConfig.WebhookBatchSize)
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list the audit records pending to be posted to the webhook")}
+	}
+
+	for _, record := range records {
+		if err := k.auditRecordPublisher.Publish(record); err != nil {
+			var errs []error
+			errs = append(errs, errors.Wrapf(err, "failed to post audit record %q", record.ID))
+			if recordErr := k.auditRecordService.RecordFailedAttempt(record); recordErr != nil {
+				errs = append(errs, errors.Wrapf(recordErr, "failed to record the attempt to post audit record %q", record.ID))
+			}
+			// the following records are not posted so that the webhook receives the records in order
+			return errs
+		}
+
+		if err := k.auditRecordService.MarkPublished(record); err != nil {
+			return []error{errors.Wrapf(err, "failed to mark audit record %q as posted", record.ID)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestAuditRecordsWebhookManager_Reconcile(t *testing.T) {
+	buildRecords := func() api.AuditRecordList {
+		return api.AuditRecordList{
+			{Meta: api.Meta{ID: "record-1"}, Actor: "admin-user", WebhookPending: true},
+			{Meta: api.Meta{ID: "record-2"}, Actor: "admin-user", WebhookPending: true},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		webhookURL        string
+		listErr           *errors.ServiceError
+		publishErr        map[string]error
+		wantErr           bool
+		wantList          bool
+		wantPublished     []string
+		wantFailedAttempt []string
+	}{
+		{
+			name:          "should post the records and mark them as posted",
+			webhookURL:    "https://siem.example.com/hooks/audit",
+			wantList:      true,
+			wantPublished: []string{"record-1", "record-2"},
+		},
+		{
+			name: "should do nothing when no webhook is configured",
+		},
+		{
+			name:       "should return an error when the records cannot be listed",
+			webhookURL: "https://siem.example.com/hooks/audit",
+			listErr:    errors.GeneralError("db error"),
+			wantErr:    true,
+			wantList:   true,
+		},
+		{
+			name:              "should not post the records following a record that cannot be posted",
+			webhookURL:        "https://siem.example.com/hooks/audit",
+			publishErr:        map[string]error{"record-1": fmt.Errorf("webhook unavailable")},
+			wantErr:           true,
+			wantList:          true,
+			wantFailedAttempt: []string{"record-1"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var published, failedAttempts []string
+			auditRecordService := &services.AuditRecordServiceMock{
+				ListWebhookPendingFunc: func(limit int) (api.AuditRecordList, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					return buildRecords(), nil
+				},
+				MarkPublishedFunc: func(record *api.AuditRecord) *errors.ServiceError {
+					published = append(published, record.ID)
+					return nil
+				},
+				RecordFailedAttemptFunc: func(record *api.AuditRecord) *errors.ServiceError {
+					failedAttempts = append(failedAttempts, record.ID)
+					return nil
+				},
+			}
+			publisher := &services.AuditRecordPublisherMock{
+				PublishFunc: func(record *api.AuditRecord) error {
+					return tt.publishErr[record.ID]
+				},
+			}
+
+			auditRecordsConfig := config.NewAuditRecordsConfig()
+			auditRecordsConfig.WebhookURL = tt.webhookURL
+			k := NewAuditRecordsWebhookManager(auditRecordService, publisher, auditRecordsConfig, w.Reconciler{})
+			errs := k.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(published).To(gomega.Equal(tt.wantPublished))
+			g.Expect(failedAttempts).To(gomega.Equal(tt.wantFailedAttempt))
+			g.Expect(len(auditRecordService.ListWebhookPendingCalls()) > 0).To(gomega.Equal(tt.wantList))
+		})
+	}
+}
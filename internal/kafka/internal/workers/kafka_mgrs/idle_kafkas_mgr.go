@@ -0,0 +1,103 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// IdleKafkaManager suspends the ready kafkas of the user suspendable instance types that had no produce nor consume traffic
+// during the idle suspension period, so that their capacity can be given to other kafkas. The owners of the kafkas resume them
+// through the public API
+type IdleKafkaManager struct {
+	workers.BaseWorker
+	kafkaService         services.KafkaService
+	observatoriumService services.ObservatoriumService
+	suspensionConfig     *config.KafkaSuspensionConfig
+}
+
+var _ workers.Worker = &IdleKafkaManager{}
+
+func NewIdleKafkaManager(kafkaService services.KafkaService, observatoriumService services.ObservatoriumService,
+	suspensionConfig *config.KafkaSuspensionConfig, reconciler workers.Reconciler) *IdleKafkaManager {
+	return &IdleKafkaManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "idle_kafka",
+			Reconciler: reconciler,
+		},
+		kafkaService:         kafkaService,
+		observatoriumService: observatoriumService,
+		suspensionConfig:     suspensionConfig,
+	}
+}
+
+func (k *IdleKafkaManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *IdleKafkaManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *IdleKafkaManager) Reconcile() []error {
+	if !k.suspensionConfig.EnableIdleSuspension {
+		return nil
+	}
+
+	glog.Infoln("suspending idle kafkas")
+
+	kafkas, listErr := k.kafkaService.ListByStatus(constants.KafkaRequestStatusReady)
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list ready kafkas")}
+	}
+
+	var errs []error
+	now := time.Now()
+	for _, kafka := range kafkas {
+		if !k.canBeSuspendedWhenIdle(kafka, now) {
+			continue
+		}
+
+		traffic, err := k.observatoriumService.GetKafkaTraffic(kafka, k.suspensionConfig.IdleSuspensionPeriod)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to check whether kafka %q is idle", kafka.ID))
+			continue
+		}
+
+		// the kafka is not suspended when its traffic is unknown
+		if !traffic.Reported || traffic.Bytes > 0 {
+			continue
+		}
+
+		glog.Infof("kafka %q had no traffic during the last %s. Suspending", kafka.ID, k.suspensionConfig.IdleSuspensionPeriod)
+		if err := k.kafkaService.Suspend(context.Background(), kafka, dbapi.KafkaSuspensionReasonIdle); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to suspend idle kafka %q", kafka.ID))
+		}
+	}
+
+	return errs
+}
+
+// canBeSuspendedWhenIdle returns whether the kafka is of a user suspendable instance type and has been running for at least the
+// idle suspension period, i.e. since its creation or its last resumption
+func (k *IdleKafkaManager) canBeSuspendedWhenIdle(kafka *dbapi.KafkaRequest, now time.Time) bool {
+	if !k.suspensionConfig.IsUserSuspendable(kafka.InstanceType) || kafka.MigrationStatus.InProgress() {
+		return false
+	}
+
+	runningSince := kafka.CreatedAt
+	if kafka.ResumedAt.Valid && kafka.ResumedAt.Time.After(runningSince) {
+		runningSince = kafka.ResumedAt.Time
+	}
+
+	return now.Sub(runningSince) >= k.suspensionConfig.IdleSuspensionPeriod
+}
@@ -0,0 +1,139 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func TestIdleKafkaManager_Reconcile(t *testing.T) {
+	now := time.Now()
+	buildKafka := func(modifyFn func(kafka *dbapi.KafkaRequest)) *dbapi.KafkaRequest {
+		kafka := &dbapi.KafkaRequest{
+			Meta:         api.Meta{ID: "kafka-1", CreatedAt: now.Add(-48 * time.Hour)},
+			Namespace:    "kafka-1-namespace",
+			InstanceType: types.DEVELOPER.String(),
+			Status:       constants.KafkaRequestStatusReady.String(),
+		}
+		if modifyFn != nil {
+			modifyFn(kafka)
+		}
+		return kafka
+	}
+
+	tests := []struct {
+		name                 string
+		disabled             bool
+		kafka                *dbapi.KafkaRequest
+		traffic              observatorium.KafkaTraffic
+		trafficErr           *errors.ServiceError
+		wantErr              bool
+		wantTrafficChecked   bool
+		wantSuspended        bool
+		wantSuspensionReason dbapi.KafkaSuspensionReason
+	}{
+		{
+			name:                 "should suspend a developer kafka that had no traffic during the period",
+			kafka:                buildKafka(nil),
+			traffic:              observatorium.KafkaTraffic{Reported: true},
+			wantTrafficChecked:   true,
+			wantSuspended:        true,
+			wantSuspensionReason: dbapi.KafkaSuspensionReasonIdle,
+		},
+		{
+			name:               "should not suspend a kafka that had traffic during the period",
+			kafka:              buildKafka(nil),
+			traffic:            observatorium.KafkaTraffic{Reported: true, Bytes: 1024},
+			wantTrafficChecked: true,
+		},
+		{
+			name:               "should not suspend a kafka whose traffic is unknown",
+			kafka:              buildKafka(nil),
+			traffic:            observatorium.KafkaTraffic{Reported: false},
+			wantTrafficChecked: true,
+		},
+		{
+			name:               "should return an error when the traffic cannot be retrieved",
+			kafka:              buildKafka(nil),
+			trafficErr:         errors.GeneralError("observatorium unavailable"),
+			wantErr:            true,
+			wantTrafficChecked: true,
+		},
+		{
+			name: "should not check the traffic of a standard kafka",
+			kafka: buildKafka(func(kafka *dbapi.KafkaRequest) {
+				kafka.InstanceType = types.STANDARD.String()
+			}),
+		},
+		{
+			name: "should not check the traffic of a kafka created during the period",
+			kafka: buildKafka(func(kafka *dbapi.KafkaRequest) {
+				kafka.CreatedAt = now.Add(-time.Hour)
+			}),
+		},
+		{
+			name: "should not check the traffic of a kafka resumed during the period",
+			kafka: buildKafka(func(kafka *dbapi.KafkaRequest) {
+				kafka.ResumedAt = sql.NullTime{Time: now.Add(-time.Hour), Valid: true}
+			}),
+		},
+		{
+			name: "should not check the traffic of a kafka being migrated",
+			kafka: buildKafka(func(kafka *dbapi.KafkaRequest) {
+				kafka.MigrationStatus = dbapi.KafkaMigrationStatusProvisioning
+			}),
+		},
+		{
+			name:     "should not check the traffic of the kafkas when the idle suspension is disabled",
+			disabled: true,
+			kafka:    buildKafka(nil),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			kafkaService := &services.KafkaServiceMock{
+				ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return []*dbapi.KafkaRequest{tt.kafka}, nil
+				},
+				SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+					return nil
+				},
+			}
+			observatoriumService := &services.ObservatoriumServiceMock{
+				GetKafkaTrafficFunc: func(kafkaRequest *dbapi.KafkaRequest, period time.Duration) (observatorium.KafkaTraffic, *errors.ServiceError) {
+					return tt.traffic, tt.trafficErr
+				},
+			}
+			suspensionConfig := config.NewKafkaSuspensionConfig()
+			suspensionConfig.EnableIdleSuspension = !tt.disabled
+
+			k := NewIdleKafkaManager(kafkaService, observatoriumService, suspensionConfig, w.Reconciler{})
+			errs := k.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(len(observatoriumService.GetKafkaTrafficCalls()) > 0).To(gomega.Equal(tt.wantTrafficChecked))
+			if tt.wantTrafficChecked {
+				g.Expect(observatoriumService.GetKafkaTrafficCalls()[0].Period).To(gomega.Equal(24 * time.Hour))
+			}
+			g.Expect(len(kafkaService.SuspendCalls()) > 0).To(gomega.Equal(tt.wantSuspended))
+			if tt.wantSuspended {
+				g.Expect(kafkaService.SuspendCalls()[0].Reason).To(gomega.Equal(tt.wantSuspensionReason))
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package kafka_mgrs
+
+import (
+	"math"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	pModel "github.com/prometheus/common/model"
+)
+
+const (
+	storageUsedBytesMetric      = "kafka_broker_quota_totalstorageusedbytes"
+	storageSoftLimitBytesMetric = "kafka_broker_quota_softlimitbytes"
+	consumerGroupLagMetric      = "kafka_consumergroup_lag"
+	topicPartitionsMetric       = "kafka_topic:kafka_topic_partitions:sum"
+	connectionCountMetric       = "kafka_namespace:kafka_server_socket_server_metrics_connection_count:sum"
+)
+
+// kafkaAlertRuleMetricSources are the metrics of Observatorium each alert rule metric is computed from
+var kafkaAlertRuleMetricSources = map[dbapi.KafkaAlertRuleMetric][]string{
+	dbapi.KafkaAlertRuleMetricDiskUsagePercentage: {storageUsedBytesMetric, storageSoftLimitBytesMetric},
+	dbapi.KafkaAlertRuleMetricConsumerLag:         {consumerGroupLagMetric},
+	dbapi.KafkaAlertRuleMetricPartitionCount:      {topicPartitionsMetric},
+	dbapi.KafkaAlertRuleMetricConnectionCount:     {connectionCountMetric},
+}
+
+// KafkaAlertRulesManager evaluates the alert rules of the ready kafkas against their metrics in Observatorium, and delivers the
+// alerts to the notification targets of the rules when they start firing and when they are resolved. The metrics of a kafka are
+// retrieved once for all its rules
+type KafkaAlertRulesManager struct {
+	workers.BaseWorker
+	kafkaAlertRuleService services.KafkaAlertRuleService
+	kafkaService          services.KafkaService
+	observatoriumService  services.ObservatoriumService
+	notifier              services.KafkaAlertNotifier
+	alertRulesConfig      *config.KafkaAlertRulesConfig
+}
+
+var _ workers.Worker = &KafkaAlertRulesManager{}
+
+func NewKafkaAlertRulesManager(kafkaAlertRuleService services.KafkaAlertRuleService, kafkaService services.KafkaService,
+	observatoriumService services.ObservatoriumService, notifier services.KafkaAlertNotifier,
+	alertRulesConfig *config.KafkaAlertRulesConfig, reconciler workers.Reconciler) *KafkaAlertRulesManager {
+	return &KafkaAlertRulesManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_alert_rules",
+			Reconciler: reconciler,
+		},
+		kafkaAlertRuleService: kafkaAlertRuleService,
+		kafkaService:          kafkaService,
+		observatoriumService:  observatoriumService,
+		notifier:              notifier,
+		alertRulesConfig:      alertRulesConfig,
+	}
+}
+
+func (k *KafkaAlertRulesManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaAlertRulesManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaAlertRulesManager) Reconcile() []error {
+	glog.Infoln("evaluating kafka alert rules")
+
+	var errs []error
+	if err := k.kafkaAlertRuleService.DeleteOrphans(); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to delete the alert rules of the deleted kafkas"))
+	}
+
+	now := time.Now()
+	rules, listErr := k.kafkaAlertRuleService.ListDueForEvaluation(now.Add(-k.alertRulesConfig.EvaluationInterval))
+	if listErr != nil {
+		return append(errs, errors.Wrap(listErr, "failed to list the kafka alert rules due for evaluation"))
+	}
+
+	// the rules are ordered by kafka
+	for start := 0; start < len(rules); {
+		end := start + 1
+		for end < len(rules) && rules[end].KafkaID == rules[start].KafkaID {
+			end++
+		}
+		errs = append(errs, k.evaluateKafkaAlertRules(rules[start].KafkaID, rules[start:end], now)...)
+		start = end
+	}
+
+	return errs
+}
+
+func (k *KafkaAlertRulesManager) evaluateKafkaAlertRules(kafkaID string, rules dbapi.KafkaAlertRuleList, now time.Time) []error {
+	kafkaRequest, err := k.kafkaService.GetByID(kafkaID)
+	if err != nil {
+		return []error{errors.Wrapf(err, "failed to get kafka %q to evaluate its alert rules", kafkaID)}
+	}
+
+	var filters []string
+	for _, rule := range rules {
+		for _, metric := range kafkaAlertRuleMetricSources[rule.Metric] {
+			if !arrays.Contains(filters, metric) {
+				filters = append(filters, metric)
+			}
+		}
+	}
+
+	// the rules are left untouched when the metrics cannot be retrieved: they are evaluated again at the next reconcile
+	kafkaMetrics := observatorium.KafkaMetrics{}
+	query := observatorium.MetricsReqParams{ResultType: observatorium.Query, Filters: filters}
+	if err := k.observatoriumService.GetKafkaMetrics(kafkaRequest, &kafkaMetrics, query); err != nil {
+		return []error{errors.Wrapf(err, "failed to get the metrics of kafka %q to evaluate its alert rules", kafkaID)}
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		k.evaluate(kafkaRequest, rule, kafkaMetrics, now)
+		if err := k.kafkaAlertRuleService.UpdateEvaluation(rule); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update the evaluation of alert rule %q of kafka %q", rule.ID, kafkaID))
+		}
+	}
+
+	return errs
+}
+
+// evaluate updates the state of the rule with the value of its metric, and delivers the alert when the state has changed since
+// the last alert delivered. A metric that is not reported neither fires nor resolves the rule
+func (k *KafkaAlertRulesManager) evaluate(kafkaRequest *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule, kafkaMetrics observatorium.KafkaMetrics, now time.Time) {
+	rule.EvaluatedAt = &now
+	rule.Value = nil
+
+	if value, reported := kafkaAlertRuleMetricValue(rule.Metric, kafkaMetrics); reported {
+		rule.Value = &value
+		state := dbapi.KafkaAlertRuleStateInactive
+		if rule.IsFiringFor(value) {
+			state = dbapi.KafkaAlertRuleStateFiring
+		}
+		if state != rule.State {
+			glog.Infof("alert rule %q of kafka %q is now %s: %s is %v, the threshold is %v", rule.ID, kafkaRequest.ID, state, rule.Metric, value, rule.Threshold)
+			rule.State = state
+			rule.StateChangedAt = &now
+		}
+	}
+
+	if !rule.HasPendingNotifications() {
+		return
+	}
+
+	if err := k.notifier.Notify(kafkaRequest, rule); err != nil {
+		glog.Errorf("failed to deliver the alert of rule %q of kafka %q: %v", rule.ID, kafkaRequest.ID, err)
+		rule.NotificationError = err.Error()
+		return
+	}
+	rule.NotifiedState = rule.State
+	rule.NotificationError = ""
+}
+
+// kafkaAlertRuleMetricValue computes the value of the alert rule metric from the metrics of the kafka. It returns false when the
+// metrics it is computed from have not been reported
+func kafkaAlertRuleMetricValue(metric dbapi.KafkaAlertRuleMetric, kafkaMetrics observatorium.KafkaMetrics) (float64, bool) {
+	samples := map[string][]*pModel.Sample{}
+	for _, m := range kafkaMetrics {
+		for _, sample := range m.Vector {
+			name := string(sample.Metric[pModel.MetricNameLabel])
+			samples[name] = append(samples[name], sample)
+		}
+	}
+
+	switch metric {
+	case dbapi.KafkaAlertRuleMetricDiskUsagePercentage:
+		// every broker reports the storage used across the kafka
+		used, usedReported := maxSampleValue(samples[storageUsedBytesMetric])
+		softLimit, softLimitReported := maxSampleValue(samples[storageSoftLimitBytesMetric])
+		if !usedReported || !softLimitReported || softLimit <= 0 {
+			return 0, false
+		}
+		return used / softLimit * 100, true
+	case dbapi.KafkaAlertRuleMetricConsumerLag:
+		// the lag of a consumer group is the sum of its lag on all the partitions it consumes
+		lagByConsumerGroup := map[string]float64{}
+		for _, sample := range samples[consumerGroupLagMetric] {
+			lagByConsumerGroup[string(sample.Metric["consumergroup"])] += float64(sample.Value)
+		}
+		if len(lagByConsumerGroup) == 0 {
+			return 0, false
+		}
+		maxLag := math.Inf(-1)
+		for _, lag := range lagByConsumerGroup {
+			maxLag = math.Max(maxLag, lag)
+		}
+		return maxLag, true
+	case dbapi.KafkaAlertRuleMetricPartitionCount:
+		return sumSampleValues(samples[topicPartitionsMetric])
+	case dbapi.KafkaAlertRuleMetricConnectionCount:
+		return sumSampleValues(samples[connectionCountMetric])
+	default:
+		return 0, false
+	}
+}
+
+func maxSampleValue(samples []*pModel.Sample) (float64, bool) {
+	if len(samples) == 0 {
+		return 0, false
+	}
+	value := math.Inf(-1)
+	for _, sample := range samples {
+		value = math.Max(value, float64(sample.Value))
+	}
+	return value, true
+}
+
+func sumSampleValues(samples []*pModel.Sample) (float64, bool) {
+	if len(samples) == 0 {
+		return 0, false
+	}
+	var value float64
+	for _, sample := range samples {
+		value += float64(sample.Value)
+	}
+	return value, true
+}
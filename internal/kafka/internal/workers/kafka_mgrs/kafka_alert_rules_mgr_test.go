@@ -0,0 +1,217 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+	pModel "github.com/prometheus/common/model"
+)
+
+func buildAlertRuleSample(name string, value float64, labels ...string) *pModel.Sample {
+	metric := pModel.Metric{pModel.MetricNameLabel: pModel.LabelValue(name)}
+	for i := 0; i+1 < len(labels); i += 2 {
+		metric[pModel.LabelName(labels[i])] = pModel.LabelValue(labels[i+1])
+	}
+	return &pModel.Sample{Metric: metric, Value: pModel.SampleValue(value)}
+}
+
+func TestKafkaAlertRulesManager_Reconcile(t *testing.T) {
+	diskUsageMetrics := func(usedBytes float64) observatorium.KafkaMetrics {
+		return observatorium.KafkaMetrics{
+			{Vector: pModel.Vector{
+				buildAlertRuleSample(storageUsedBytesMetric, usedBytes),
+				buildAlertRuleSample(storageSoftLimitBytesMetric, 1000),
+			}},
+		}
+	}
+	buildRule := func(state dbapi.KafkaAlertRuleState) *dbapi.KafkaAlertRule {
+		return &dbapi.KafkaAlertRule{
+			Meta:          api.Meta{ID: "rule-1"},
+			KafkaID:       "kafka-1",
+			Metric:        dbapi.KafkaAlertRuleMetricDiskUsagePercentage,
+			Threshold:     90,
+			State:         state,
+			NotifiedState: state,
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		rule                  *dbapi.KafkaAlertRule
+		metrics               observatorium.KafkaMetrics
+		metricsErr            *errors.ServiceError
+		notifyErr             error
+		wantErr               bool
+		wantUpdated           bool
+		wantState             dbapi.KafkaAlertRuleState
+		wantNotified          bool
+		wantNotifiedState     dbapi.KafkaAlertRuleState
+		wantNotificationError bool
+	}{
+		{
+			name:              "should fire and notify when the metric exceeds the threshold",
+			rule:              buildRule(dbapi.KafkaAlertRuleStateInactive),
+			metrics:           diskUsageMetrics(950),
+			wantUpdated:       true,
+			wantState:         dbapi.KafkaAlertRuleStateFiring,
+			wantNotified:      true,
+			wantNotifiedState: dbapi.KafkaAlertRuleStateFiring,
+		},
+		{
+			name:              "should resolve and notify when the metric no longer exceeds the threshold",
+			rule:              buildRule(dbapi.KafkaAlertRuleStateFiring),
+			metrics:           diskUsageMetrics(500),
+			wantUpdated:       true,
+			wantState:         dbapi.KafkaAlertRuleStateInactive,
+			wantNotified:      true,
+			wantNotifiedState: dbapi.KafkaAlertRuleStateInactive,
+		},
+		{
+			name:              "should not notify when the state has not changed",
+			rule:              buildRule(dbapi.KafkaAlertRuleStateFiring),
+			metrics:           diskUsageMetrics(950),
+			wantUpdated:       true,
+			wantState:         dbapi.KafkaAlertRuleStateFiring,
+			wantNotifiedState: dbapi.KafkaAlertRuleStateFiring,
+		},
+		{
+			name:              "should leave the state untouched when the metric is not reported",
+			rule:              buildRule(dbapi.KafkaAlertRuleStateFiring),
+			metrics:           observatorium.KafkaMetrics{},
+			wantUpdated:       true,
+			wantState:         dbapi.KafkaAlertRuleStateFiring,
+			wantNotifiedState: dbapi.KafkaAlertRuleStateFiring,
+		},
+		{
+			name:                  "should keep the notification pending when it cannot be delivered",
+			rule:                  buildRule(dbapi.KafkaAlertRuleStateInactive),
+			metrics:               diskUsageMetrics(950),
+			notifyErr:             fmt.Errorf("webhook unavailable"),
+			wantUpdated:           true,
+			wantState:             dbapi.KafkaAlertRuleStateFiring,
+			wantNotified:          true,
+			wantNotifiedState:     dbapi.KafkaAlertRuleStateInactive,
+			wantNotificationError: true,
+		},
+		{
+			name:              "should return an error and not evaluate the rules when the metrics cannot be retrieved",
+			rule:              buildRule(dbapi.KafkaAlertRuleStateInactive),
+			metricsErr:        errors.GeneralError("observatorium unavailable"),
+			wantErr:           true,
+			wantState:         dbapi.KafkaAlertRuleStateInactive,
+			wantNotifiedState: dbapi.KafkaAlertRuleStateInactive,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			alertRuleService := &services.KafkaAlertRuleServiceMock{
+				DeleteOrphansFunc: func() *errors.ServiceError {
+					return nil
+				},
+				ListDueForEvaluationFunc: func(evaluatedBefore time.Time) (dbapi.KafkaAlertRuleList, *errors.ServiceError) {
+					return dbapi.KafkaAlertRuleList{tt.rule}, nil
+				},
+				UpdateEvaluationFunc: func(rule *dbapi.KafkaAlertRule) *errors.ServiceError {
+					return nil
+				},
+			}
+			kafkaService := &services.KafkaServiceMock{
+				GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}, Namespace: "kafka-1-namespace"}, nil
+				},
+			}
+			observatoriumService := &services.ObservatoriumServiceMock{
+				GetKafkaMetricsFunc: func(kafkaRequest *dbapi.KafkaRequest, kafkaMetrics *observatorium.KafkaMetrics, query observatorium.MetricsReqParams) *errors.ServiceError {
+					*kafkaMetrics = append(*kafkaMetrics, tt.metrics...)
+					return tt.metricsErr
+				},
+			}
+			notifier := &services.KafkaAlertNotifierMock{
+				NotifyFunc: func(kafkaRequest *dbapi.KafkaRequest, rule *dbapi.KafkaAlertRule) error {
+					return tt.notifyErr
+				},
+			}
+
+			k := NewKafkaAlertRulesManager(alertRuleService, kafkaService, observatoriumService, notifier, config.NewKafkaAlertRulesConfig(), w.Reconciler{})
+			errs := k.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(observatoriumService.GetKafkaMetricsCalls()[0].Query.Filters).To(gomega.Equal([]string{storageUsedBytesMetric, storageSoftLimitBytesMetric}))
+			g.Expect(len(alertRuleService.UpdateEvaluationCalls()) > 0).To(gomega.Equal(tt.wantUpdated))
+			g.Expect(len(notifier.NotifyCalls()) > 0).To(gomega.Equal(tt.wantNotified))
+			g.Expect(tt.rule.State).To(gomega.Equal(tt.wantState))
+			g.Expect(tt.rule.NotifiedState).To(gomega.Equal(tt.wantNotifiedState))
+			g.Expect(tt.rule.NotificationError != "").To(gomega.Equal(tt.wantNotificationError))
+		})
+	}
+}
+
+func Test_kafkaAlertRuleMetricValue(t *testing.T) {
+	kafkaMetrics := observatorium.KafkaMetrics{
+		{Vector: pModel.Vector{
+			buildAlertRuleSample(storageUsedBytesMetric, 400, "broker_id", "0"),
+			buildAlertRuleSample(storageUsedBytesMetric, 450, "broker_id", "1"),
+			buildAlertRuleSample(storageSoftLimitBytesMetric, 500),
+		}},
+		{Vector: pModel.Vector{
+			buildAlertRuleSample(consumerGroupLagMetric, 10, "consumergroup", "group-1", "partition", "0"),
+			buildAlertRuleSample(consumerGroupLagMetric, 20, "consumergroup", "group-1", "partition", "1"),
+			buildAlertRuleSample(consumerGroupLagMetric, 25, "consumergroup", "group-2", "partition", "0"),
+			buildAlertRuleSample(topicPartitionsMetric, 6, "topic", "orders"),
+			buildAlertRuleSample(topicPartitionsMetric, 3, "topic", "payments"),
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		metric       dbapi.KafkaAlertRuleMetric
+		wantValue    float64
+		wantReported bool
+	}{
+		{
+			name:         "should compute the disk usage percentage from the storage used and the soft limit",
+			metric:       dbapi.KafkaAlertRuleMetricDiskUsagePercentage,
+			wantValue:    90,
+			wantReported: true,
+		},
+		{
+			name:         "should return the highest lag of the consumer groups",
+			metric:       dbapi.KafkaAlertRuleMetricConsumerLag,
+			wantValue:    30,
+			wantReported: true,
+		},
+		{
+			name:         "should sum the partitions of the topics",
+			metric:       dbapi.KafkaAlertRuleMetricPartitionCount,
+			wantValue:    9,
+			wantReported: true,
+		},
+		{
+			name:         "should not report a metric that is missing",
+			metric:       dbapi.KafkaAlertRuleMetricConnectionCount,
+			wantReported: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			value, reported := kafkaAlertRuleMetricValue(tt.metric, kafkaMetrics)
+			g.Expect(reported).To(gomega.Equal(tt.wantReported))
+			g.Expect(value).To(gomega.Equal(tt.wantValue))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaRolloutManager represents a kafka manager that periodically progresses the rollouts of new strimzi and kafka versions.
+//
+// A rollout upgrades its kafkas batch after batch:
+//   - the desired versions of the kafkas of a batch are set to the target versions of the rollout.
+//   - a kafka is upgraded once the data plane cluster reports it as ready with the target versions as its actual versions.
+//     It fails to be upgraded when it has not reported them after the upgrade timeout of the rollout. The time during which
+//     the upgrade is held back by the maintenance window of the kafka does not count towards the timeout.
+//   - the next batch is started once all the kafkas of the current batch have been processed and the pause between batches has elapsed.
+//     No new batch is started while the rollout is paused, the kafkas of the current batch being processed in the meantime.
+//
+// The rollout is stopped as soon as the percentage of failed kafkas exceeds its maximum failure percentage.
+type KafkaRolloutManager struct {
+	workers.BaseWorker
+	kafkaRolloutService services.KafkaRolloutService
+	kafkaService        services.KafkaService
+	clusterService      services.ClusterService
+}
+
+var _ workers.Worker = &KafkaRolloutManager{}
+
+// NewKafkaRolloutManager creates a new kafka manager to progress the rollouts of new strimzi and kafka versions.
+func NewKafkaRolloutManager(kafkaRolloutService services.KafkaRolloutService, kafkaService services.KafkaService, clusterService services.ClusterService, reconciler workers.Reconciler) *KafkaRolloutManager {
+	return &KafkaRolloutManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_rollout",
+			Reconciler: reconciler,
+		},
+		kafkaRolloutService: kafkaRolloutService,
+		kafkaService:        kafkaService,
+		clusterService:      clusterService,
+	}
+}
+
+// Start initializes the kafka manager to progress the rollouts.
+func (k *KafkaRolloutManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for progressing the rollouts to stop.
+func (k *KafkaRolloutManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaRolloutManager) Reconcile() []error {
+	glog.Infoln("reconciling kafka rollouts")
+	var encounteredErrors []error
+
+	rollouts, serviceErr := k.kafkaRolloutService.ListActive()
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list active kafka rollouts")}
+	}
+	glog.Infof("active kafka rollouts count = %d", len(rollouts))
+
+	for _, rollout := range rollouts {
+		if err := k.reconcileRollout(rollout, time.Now()); err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile kafka rollout %s", rollout.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+func (k *KafkaRolloutManager) reconcileRollout(rollout *dbapi.KafkaRollout, now time.Time) error {
+	for i := range rollout.Items {
+		item := &rollout.Items[i]
+		if item.Status != dbapi.KafkaRolloutItemStatusUpgrading {
+			continue
+		}
+		if err := k.reconcileUpgradingItem(rollout, item, now); err != nil {
+			return err
+		}
+	}
+
+	if rollout.FailurePercentageExceeded() {
+		counts := rollout.CountItemsByStatus()
+		reason := fmt.Sprintf("%d out of %d kafkas failed to be upgraded, which exceeds the maximum failure percentage of %d%%", counts[dbapi.KafkaRolloutItemStatusFailed], len(rollout.Items), rollout.MaxFailurePercentage)
+		glog.Infof("stopping kafka rollout %q: %s", rollout.ID, reason)
+		if err := k.kafkaRolloutService.Update(rollout, map[string]interface{}{
+			"status":        dbapi.KafkaRolloutStatusFailed,
+			"failed_reason": reason,
+		}); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	counts := rollout.CountItemsByStatus()
+	if counts[dbapi.KafkaRolloutItemStatusUpgrading] > 0 {
+		return nil
+	}
+
+	if counts[dbapi.KafkaRolloutItemStatusPending] == 0 {
+		glog.Infof("kafka rollout %q completed", rollout.ID)
+		if err := k.kafkaRolloutService.Update(rollout, map[string]interface{}{
+			"status": dbapi.KafkaRolloutStatusCompleted,
+		}); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	// the kafkas of the current batch of a paused rollout are still tracked above, so that they do not time out once the
+	// rollout is resumed, but no new batch is started
+	if rollout.Status == dbapi.KafkaRolloutStatusPaused {
+		return nil
+	}
+
+	// the current batch has been processed, wait for the pause between batches to elapse before starting the next one
+	if rollout.CurrentBatch > 0 {
+		if rollout.NextBatchAt == nil {
+			nextBatchAt := now.Add(time.Duration(rollout.BatchPauseSeconds) * time.Second)
+			if err := k.kafkaRolloutService.Update(rollout, map[string]interface{}{"next_batch_at": &nextBatchAt}); err != nil {
+				return err
+			}
+			rollout.NextBatchAt = &nextBatchAt
+		}
+		if now.Before(*rollout.NextBatchAt) {
+			return nil
+		}
+	}
+
+	return k.startNextBatch(rollout, now)
+}
+
+func (k *KafkaRolloutManager) reconcileUpgradingItem(rollout *dbapi.KafkaRollout, item *dbapi.KafkaRolloutItem, now time.Time) error {
+	kafka, serviceErr := k.kafkaService.GetByID(item.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return errors.Wrapf(serviceErr, "failed to get kafka %s", item.KafkaID)
+	}
+
+	switch {
+	case kafka == nil || kafka.Status == constants.KafkaRequestStatusDeprovision.String() || kafka.Status == constants.KafkaRequestStatusDeleting.String():
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusSkipped, "kafka was deleted while being upgraded", now)
+	case kafka.Status == constants.KafkaRequestStatusFailed.String():
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusFailed, fmt.Sprintf("kafka failed while being upgraded: %s", kafka.FailedReason), now)
+	case kafka.Status == constants.KafkaRequestStatusReady.String() && rollout.IsUpgradedBy(kafka):
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusUpgraded, "", now)
+	case !kafka.CanBeUpgradedAt(now):
+		// the upgrade is held back by the maintenance window of the kafka, it does not count towards the upgrade timeout
+		if err := k.kafkaRolloutService.UpdateItem(item, map[string]interface{}{"started_at": &now}); err != nil {
+			return err
+		}
+		item.StartedAt = &now
+	case item.StartedAt != nil && now.Sub(*item.StartedAt) > time.Duration(rollout.UpgradeTimeoutSeconds)*time.Second:
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusFailed, fmt.Sprintf("kafka was not upgraded within %d seconds", rollout.UpgradeTimeoutSeconds), now)
+	}
+
+	return nil
+}
+
+func (k *KafkaRolloutManager) startNextBatch(rollout *dbapi.KafkaRollout, now time.Time) error {
+	batch := 0
+	for _, item := range rollout.Items {
+		if item.Status == dbapi.KafkaRolloutItemStatusPending && (batch == 0 || item.Batch < batch) {
+			batch = item.Batch
+		}
+	}
+	glog.Infof("starting batch %d of kafka rollout %q", batch, rollout.ID)
+
+	for i := range rollout.Items {
+		item := &rollout.Items[i]
+		if item.Batch != batch || item.Status != dbapi.KafkaRolloutItemStatusPending {
+			continue
+		}
+		if err := k.startItem(rollout, item, now); err != nil {
+			return err
+		}
+	}
+
+	if err := k.kafkaRolloutService.Update(rollout, map[string]interface{}{
+		"current_batch": batch,
+		"next_batch_at": nil,
+	}); err != nil {
+		return err
+	}
+	rollout.CurrentBatch = batch
+	rollout.NextBatchAt = nil
+
+	return nil
+}
+
+func (k *KafkaRolloutManager) startItem(rollout *dbapi.KafkaRollout, item *dbapi.KafkaRolloutItem, now time.Time) error {
+	kafka, serviceErr := k.kafkaService.GetByID(item.KafkaID)
+	if serviceErr != nil && !serviceErr.Is404() {
+		return errors.Wrapf(serviceErr, "failed to get kafka %s", item.KafkaID)
+	}
+
+	if kafka == nil || kafka.Status != constants.KafkaRequestStatusReady.String() {
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusSkipped, "kafka was no longer ready when its batch started", now)
+	}
+
+	if rollout.IsUpgradedBy(kafka) {
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusUpgraded, "", now)
+	}
+
+	desiredVersions := map[string]interface{}{
+		"desired_strimzi_version":   kafka.DesiredStrimziVersion,
+		"desired_kafka_version":     kafka.DesiredKafkaVersion,
+		"desired_kafka_ibp_version": kafka.DesiredKafkaIBPVersion,
+	}
+	if rollout.DesiredStrimziVersion != "" {
+		desiredVersions["desired_strimzi_version"] = rollout.DesiredStrimziVersion
+	}
+	if rollout.DesiredKafkaVersion != "" {
+		desiredVersions["desired_kafka_version"] = rollout.DesiredKafkaVersion
+	}
+	if rollout.DesiredKafkaIBPVersion != "" {
+		desiredVersions["desired_kafka_ibp_version"] = rollout.DesiredKafkaIBPVersion
+	}
+
+	if reason, err := k.checkVersionsAvailable(kafka.ClusterID, desiredVersions); err != nil {
+		return err
+	} else if reason != "" {
+		return k.completeItem(item, dbapi.KafkaRolloutItemStatusFailed, reason, now)
+	}
+
+	if serviceErr := k.kafkaService.Updates(kafka, desiredVersions); serviceErr != nil {
+		return errors.Wrapf(serviceErr, "failed to update the desired versions of kafka %s", kafka.ID)
+	}
+
+	if err := k.kafkaRolloutService.UpdateItem(item, map[string]interface{}{
+		"status":     dbapi.KafkaRolloutItemStatusUpgrading,
+		"started_at": &now,
+	}); err != nil {
+		return err
+	}
+	item.Status = dbapi.KafkaRolloutItemStatusUpgrading
+	item.StartedAt = &now
+
+	return nil
+}
+
+// checkVersionsAvailable returns the reason why the given desired versions cannot be rolled out on the data plane cluster, if any
+func (k *KafkaRolloutManager) checkVersionsAvailable(clusterID string, desiredVersions map[string]interface{}) (string, error) {
+	cluster, serviceErr := k.clusterService.FindClusterByID(clusterID)
+	if serviceErr != nil {
+		return "", errors.Wrapf(serviceErr, "failed to find cluster %s", clusterID)
+	}
+	if cluster == nil {
+		return fmt.Sprintf("data plane cluster %q of the kafka was not found", clusterID), nil
+	}
+
+	strimziVersion := desiredVersions["desired_strimzi_version"].(string)
+	ready, err := k.clusterService.CheckStrimziVersionReady(cluster, strimziVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to check strimzi version %q of cluster %s", strimziVersion, clusterID)
+	}
+	if !ready {
+		return fmt.Sprintf("strimzi version %q is not ready in data plane cluster %q", strimziVersion, clusterID), nil
+	}
+
+	kafkaVersion := desiredVersions["desired_kafka_version"].(string)
+	ibpVersion := desiredVersions["desired_kafka_ibp_version"].(string)
+	available, err := k.clusterService.IsStrimziKafkaVersionAvailableInCluster(cluster, strimziVersion, kafkaVersion, ibpVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to check kafka versions of cluster %s", clusterID)
+	}
+	if !available {
+		return fmt.Sprintf("kafka version %q with ibp version %q is not available for strimzi version %q in data plane cluster %q", kafkaVersion, ibpVersion, strimziVersion, clusterID), nil
+	}
+
+	return "", nil
+}
+
+func (k *KafkaRolloutManager) completeItem(item *dbapi.KafkaRolloutItem, status dbapi.KafkaRolloutItemStatus, reason string, now time.Time) error {
+	if status == dbapi.KafkaRolloutItemStatusFailed {
+		glog.Warningf("kafka %q of rollout %q failed to be upgraded: %s", item.KafkaID, item.RolloutID, reason)
+	}
+
+	if err := k.kafkaRolloutService.UpdateItem(item, map[string]interface{}{
+		"status":        status,
+		"failed_reason": reason,
+		"completed_at":  &now,
+	}); err != nil {
+		return err
+	}
+	item.Status = status
+	item.FailedReason = reason
+	item.CompletedAt = &now
+
+	return nil
+}
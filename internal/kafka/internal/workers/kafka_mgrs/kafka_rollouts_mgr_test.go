@@ -0,0 +1,238 @@
+package kafka_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+)
+
+func TestKafkaRolloutManager_reconcileRollout(t *testing.T) {
+	const oldStrimziVersion = "strimzi-cluster-operator.v0.31.0-0"
+	const newStrimziVersion = "strimzi-cluster-operator.v0.32.0-0"
+	now := time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC)
+	longAgo := now.Add(-2 * time.Hour)
+	recently := now.Add(-time.Minute)
+
+	kafka := func(id string, status constants.KafkaStatus, actualStrimziVersion string, options ...mockKafkas.KafkaRequestBuildOption) *dbapi.KafkaRequest {
+		options = append([]mockKafkas.KafkaRequestBuildOption{
+			mockKafkas.WithPredefinedTestValues(),
+			mockKafkas.With(mockKafkas.ID, id),
+			mockKafkas.With(mockKafkas.STATUS, status.String()),
+			mockKafkas.With(mockKafkas.ACTUAL_STRIMZI_VERSION, actualStrimziVersion),
+			mockKafkas.With(mockKafkas.DESIRED_STRIMZI_VERSION, actualStrimziVersion),
+			mockKafkas.With(mockKafkas.ACTUAL_KAFKA_VERSION, "3.3.1"),
+			mockKafkas.With(mockKafkas.DESIRED_KAFKA_VERSION, "3.3.1"),
+			mockKafkas.With(mockKafkas.ACTUAL_KAFKA_IBP_VERSION, "3.3"),
+			mockKafkas.With(mockKafkas.DESIRED_KAFKA_IBP_VERSION, "3.3"),
+		}, options...)
+		return mockKafkas.BuildKafkaRequest(options...)
+	}
+
+	paused := func(rollout *dbapi.KafkaRollout) *dbapi.KafkaRollout {
+		rollout.Status = dbapi.KafkaRolloutStatusPaused
+		return rollout
+	}
+
+	rollout := func(currentBatch int, nextBatchAt *time.Time, items ...dbapi.KafkaRolloutItem) *dbapi.KafkaRollout {
+		return &dbapi.KafkaRollout{
+			Meta:                  api.Meta{ID: "rollout-id"},
+			Status:                dbapi.KafkaRolloutStatusInProgress,
+			DesiredStrimziVersion: newStrimziVersion,
+			BatchSize:             1,
+			BatchPauseSeconds:     600,
+			UpgradeTimeoutSeconds: 3600,
+			CurrentBatch:          currentBatch,
+			NextBatchAt:           nextBatchAt,
+			Items:                 items,
+		}
+	}
+
+	item := func(kafkaID string, batch int, status dbapi.KafkaRolloutItemStatus, startedAt *time.Time) dbapi.KafkaRolloutItem {
+		return dbapi.KafkaRolloutItem{
+			Meta:      api.Meta{ID: kafkaID + "-item"},
+			RolloutID: "rollout-id",
+			KafkaID:   kafkaID,
+			Batch:     batch,
+			Status:    status,
+			StartedAt: startedAt,
+		}
+	}
+
+	closedWindow := api.JSON(`{"day_of_week":"monday","start_hour":0,"duration_hours":1,"timezone":"UTC"}`)
+
+	type fields struct {
+		kafkas           []*dbapi.KafkaRequest
+		versionAvailable bool
+	}
+
+	tests := []struct {
+		name              string
+		fields            fields
+		rollout           *dbapi.KafkaRollout
+		wantItemStatuses  map[string]dbapi.KafkaRolloutItemStatus
+		wantRolloutValues map[string]interface{}
+		wantKafkaUpdates  map[string]interface{}
+	}{
+		{
+			name: "should complete the rollout once its last kafka reports the target version",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, newStrimziVersion)},
+			},
+			rollout:           rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &recently)),
+			wantItemStatuses:  map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgraded},
+			wantRolloutValues: map[string]interface{}{"status": dbapi.KafkaRolloutStatusCompleted},
+		},
+		{
+			name: "should wait for a kafka that has not reported the target version yet",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, oldStrimziVersion)},
+			},
+			rollout:          rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &recently), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil)),
+			wantItemStatuses: map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgrading, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+		},
+		{
+			name: "should stop the rollout when a kafka times out and no failure is tolerated",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, oldStrimziVersion)},
+			},
+			rollout:          rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &longAgo), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil)),
+			wantItemStatuses: map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusFailed, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+			wantRolloutValues: map[string]interface{}{
+				"status":        dbapi.KafkaRolloutStatusFailed,
+				"failed_reason": "1 out of 2 kafkas failed to be upgraded, which exceeds the maximum failure percentage of 0%",
+			},
+		},
+		{
+			name: "should not time out a kafka whose upgrade is held back by its maintenance window",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, oldStrimziVersion, func(kafka *dbapi.KafkaRequest) {
+					kafka.DesiredStrimziVersion = newStrimziVersion
+					kafka.MaintenanceWindow = closedWindow
+				})},
+			},
+			rollout:          rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &longAgo)),
+			wantItemStatuses: map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgrading},
+		},
+		{
+			name: "should skip a kafka deleted while being upgraded",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusDeprovision, oldStrimziVersion)},
+			},
+			rollout:           rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &recently)),
+			wantItemStatuses:  map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusSkipped},
+			wantRolloutValues: map[string]interface{}{"status": dbapi.KafkaRolloutStatusCompleted},
+		},
+		{
+			name: "should start the first batch straight away",
+			fields: fields{
+				kafkas:           []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, oldStrimziVersion)},
+				versionAvailable: true,
+			},
+			rollout:           rollout(0, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusPending, nil), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil)),
+			wantItemStatuses:  map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgrading, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+			wantRolloutValues: map[string]interface{}{"current_batch": 1, "next_batch_at": nil},
+			wantKafkaUpdates: map[string]interface{}{
+				"desired_strimzi_version":   newStrimziVersion,
+				"desired_kafka_version":     "3.3.1",
+				"desired_kafka_ibp_version": "3.3",
+			},
+		},
+		{
+			name: "should pause before starting the next batch",
+			fields: fields{
+				versionAvailable: true,
+			},
+			rollout:           rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgraded, nil), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil)),
+			wantItemStatuses:  map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgraded, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+			wantRolloutValues: map[string]interface{}{"next_batch_at": &[]time.Time{now.Add(600 * time.Second)}[0]},
+		},
+		{
+			name: "should keep tracking the kafkas of the current batch of a paused rollout",
+			fields: fields{
+				kafkas: []*dbapi.KafkaRequest{kafka("kafka-1", constants.KafkaRequestStatusReady, newStrimziVersion)},
+			},
+			rollout:          paused(rollout(1, nil, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgrading, &longAgo), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil))),
+			wantItemStatuses: map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgraded, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+		},
+		{
+			name: "should not start the next batch of a paused rollout",
+			fields: fields{
+				kafkas:           []*dbapi.KafkaRequest{kafka("kafka-2", constants.KafkaRequestStatusReady, oldStrimziVersion)},
+				versionAvailable: true,
+			},
+			rollout:          paused(rollout(1, &longAgo, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgraded, nil), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil))),
+			wantItemStatuses: map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgraded, "kafka-2": dbapi.KafkaRolloutItemStatusPending},
+		},
+		{
+			name: "should fail a kafka whose target version is not available in its data plane cluster",
+			fields: fields{
+				kafkas:           []*dbapi.KafkaRequest{kafka("kafka-2", constants.KafkaRequestStatusReady, oldStrimziVersion)},
+				versionAvailable: false,
+			},
+			rollout:           rollout(1, &longAgo, item("kafka-1", 1, dbapi.KafkaRolloutItemStatusUpgraded, nil), item("kafka-2", 2, dbapi.KafkaRolloutItemStatusPending, nil)),
+			wantItemStatuses:  map[string]dbapi.KafkaRolloutItemStatus{"kafka-1": dbapi.KafkaRolloutItemStatusUpgraded, "kafka-2": dbapi.KafkaRolloutItemStatusFailed},
+			wantRolloutValues: map[string]interface{}{"current_batch": 2, "next_batch_at": nil},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var rolloutValues map[string]interface{}
+			var kafkaUpdates map[string]interface{}
+			k := &KafkaRolloutManager{
+				kafkaRolloutService: &services.KafkaRolloutServiceMock{
+					UpdateFunc: func(rollout *dbapi.KafkaRollout, fields map[string]interface{}) *errors.ServiceError {
+						rolloutValues = fields
+						return nil
+					},
+					UpdateItemFunc: func(item *dbapi.KafkaRolloutItem, fields map[string]interface{}) *errors.ServiceError {
+						return nil
+					},
+				},
+				kafkaService: &services.KafkaServiceMock{
+					GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						for _, kafka := range tt.fields.kafkas {
+							if kafka.ID == id {
+								return kafka, nil
+							}
+						}
+						return nil, errors.NotFound("kafka %s not found", id)
+					},
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						kafkaUpdates = values
+						return nil
+					},
+				},
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID}, nil
+					},
+					CheckStrimziVersionReadyFunc: func(cluster *api.Cluster, strimziVersion string) (bool, error) {
+						return true, nil
+					},
+					IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion string, kafkaVersion string, ibpVersion string) (bool, error) {
+						return tt.fields.versionAvailable, nil
+					},
+				},
+			}
+
+			g.Expect(k.reconcileRollout(tt.rollout, now)).To(gomega.Succeed())
+			for _, item := range tt.rollout.Items {
+				g.Expect(item.Status).To(gomega.Equal(tt.wantItemStatuses[item.KafkaID]), "status of %s", item.KafkaID)
+			}
+			g.Expect(rolloutValues).To(gomega.Equal(tt.wantRolloutValues))
+			g.Expect(kafkaUpdates).To(gomega.Equal(tt.wantKafkaUpdates))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaStatusEventsManager publishes the kafka status events recorded in the outbox to the configured sink.
+// The events are published in the order they occurred, and are only marked as published once the sink has accepted them:
+// an event that could not be published is retried at the next reconcile, even when it is run by another leader, until it
+// has failed the configured maximum number of attempts. It is then dead-lettered so that it no longer holds back the
+// following events
+type KafkaStatusEventsManager struct {
+	workers.BaseWorker
+	kafkaStatusEventService services.KafkaStatusEventService
+	sink                    services.KafkaStatusEventSink
+	statusEventsConfig      *config.KafkaStatusEventsConfig
+}
+
+var _ workers.Worker = &KafkaStatusEventsManager{}
+
+func NewKafkaStatusEventsManager(kafkaStatusEventService services.KafkaStatusEventService, sink services.KafkaStatusEventSink,
+	statusEventsConfig *config.KafkaStatusEventsConfig, reconciler workers.Reconciler) *KafkaStatusEventsManager {
+	return &KafkaStatusEventsManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_status_events",
+			Reconciler: reconciler,
+		},
+		kafkaStatusEventService: kafkaStatusEventService,
+		sink:                    sink,
+		statusEventsConfig:      statusEventsConfig,
+	}
+}
+
+func (k *KafkaStatusEventsManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaStatusEventsManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaStatusEventsManager) Reconcile() []error {
+	glog.Infoln("publishing kafka status events")
+
+	events, listErr := k.kafkaStatusEventService.ListUnpublished(k.statusEventsConfig.BatchSize, k.statusEventsConfig.MaxAttempts)
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list unpublished kafka status events")}
+	}
+
+	for _, event := range events {
+		if err := k.sink.Publish(event); err != nil {
+			var errs []error
+			errs = append(errs, errors.Wrapf(err, "failed to publish kafka status event %q", event.ID))
+			if recordErr := k.kafkaStatusEventService.RecordFailedAttempt(event); recordErr != nil {
+				errs = append(errs, errors.Wrapf(recordErr, "failed to record the publishing attempt of kafka status event %q", event.ID))
+			} else if event.Attempts >= k.statusEventsConfig.MaxAttempts {
+				glog.Errorf("kafka status event %q of kafka %q failed to be published %d times, it is dead-lettered", event.ID, event.KafkaID, event.Attempts)
+				metrics.IncreaseKafkaStatusEventsDeadLetteredCountMetric(event.KafkaID)
+			}
+			// the following events are not published so that the consumers receive the events in order
+			return errs
+		}
+
+		if err := k.kafkaStatusEventService.MarkPublished(event); err != nil {
+			return []error{errors.Wrapf(err, "failed to mark kafka status event %q as published", event.ID)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestKafkaStatusEventsManager_Reconcile(t *testing.T) {
+	buildEvents := func() dbapi.KafkaStatusEventList {
+		return dbapi.KafkaStatusEventList{
+			{Meta: api.Meta{ID: "event-1"}, KafkaID: "kafka-1", OldStatus: "provisioning", NewStatus: "ready"},
+			{Meta: api.Meta{ID: "event-2"}, KafkaID: "kafka-2", OldStatus: "provisioning", NewStatus: "failed"},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		listErr           *errors.ServiceError
+		publishErr        map[string]error
+		previousAttempts  int
+		wantErr           bool
+		wantPublished     []string
+		wantFailedAttempt []string
+		wantDeadLettered  string
+	}{
+		{
+			name:          "should publish the events and mark them as published",
+			wantPublished: []string{"event-1", "event-2"},
+		},
+		{
+			name:    "should return an error when the events cannot be listed",
+			listErr: errors.GeneralError("db error"),
+			wantErr: true,
+		},
+		{
+			name:              "should stop at the first event that cannot be published",
+			publishErr:        map[string]error{"event-1": fmt.Errorf("webhook unavailable")},
+			wantErr:           true,
+			wantFailedAttempt: []string{"event-1"},
+		},
+		{
+			name:              "should not publish the events following an event that cannot be published",
+			publishErr:        map[string]error{"event-2": fmt.Errorf("webhook unavailable")},
+			wantErr:           true,
+			wantPublished:     []string{"event-1"},
+			wantFailedAttempt: []string{"event-2"},
+		},
+		{
+			name:              "should dead-letter an event that failed to be published the maximum number of attempts",
+			publishErr:        map[string]error{"event-1": fmt.Errorf("invalid event")},
+			previousAttempts:  99,
+			wantErr:           true,
+			wantFailedAttempt: []string{"event-1"},
+			wantDeadLettered: `
+# HELP kas_fleet_manager_kafka_status_events_dead_lettered_count number of kafka status events that are no longer published as they failed to be published too many times
+# TYPE kas_fleet_manager_kafka_status_events_dead_lettered_count counter
+kas_fleet_manager_kafka_status_events_dead_lettered_count{id="kafka-1"} 1
+`,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			metrics.Reset()
+
+			var published, failedAttempts []string
+			eventService := &services.KafkaStatusEventServiceMock{
+				ListUnpublishedFunc: func(limit int, maxAttempts int) (dbapi.KafkaStatusEventList, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					events := buildEvents()
+					for _, event := range events {
+						event.Attempts = tt.previousAttempts
+					}
+					return events, nil
+				},
+				MarkPublishedFunc: func(event *dbapi.KafkaStatusEvent) *errors.ServiceError {
+					published = append(published, event.ID)
+					return nil
+				},
+				RecordFailedAttemptFunc: func(event *dbapi.KafkaStatusEvent) *errors.ServiceError {
+					failedAttempts = append(failedAttempts, event.ID)
+					event.Attempts++
+					return nil
+				},
+			}
+			sink := &services.KafkaStatusEventSinkMock{
+				PublishFunc: func(event *dbapi.KafkaStatusEvent) error {
+					return tt.publishErr[event.ID]
+				},
+			}
+
+			k := NewKafkaStatusEventsManager(eventService, sink, config.NewKafkaStatusEventsConfig(), w.Reconciler{})
+			errs := k.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(published).To(gomega.Equal(tt.wantPublished))
+			g.Expect(failedAttempts).To(gomega.Equal(tt.wantFailedAttempt))
+			g.Expect(eventService.ListUnpublishedCalls()[0].Limit).To(gomega.Equal(100))
+			g.Expect(eventService.ListUnpublishedCalls()[0].MaxAttempts).To(gomega.Equal(100))
+			g.Expect(testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(tt.wantDeadLettered),
+				metrics.KasFleetManager+"_"+metrics.KafkaStatusEventsDeadLetteredCount)).To(gomega.Succeed())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"math"
@@ -112,9 +113,10 @@ func (k *KafkaManager) Reconcile() []error {
 	accessControlListConfig := k.accessControlListConfig
 	if accessControlListConfig.EnableDenyList {
 		glog.Infoln("Reconciling denied kafka owners")
-		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(accessControlListConfig.DenyList)
+		deniedUsers := accessControlListConfig.GetDeniedUsers()
+		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(deniedUsers)
 		if kafkaDeprovisioningForDeniedOwnersErr != nil {
-			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "failed to deprovision kafka for denied owners %s", accessControlListConfig.DenyList)
+			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "failed to deprovision kafka for denied owners %s", deniedUsers)
 			encounteredErrors = append(encounteredErrors, wrappedError)
 		}
 	}
@@ -149,7 +151,7 @@ func (k *KafkaManager) Reconcile() []error {
 		if remainingLifespan.LessThanOrEqual(float64(bm.GracePeriodDays)) {
 			glog.Infof("cluster with ID '%s' entered its grace period. Suspending", kafka.ID)
 			// the instance is in grace period
-			_, err := k.kafkaService.UpdateStatus(kafka.ID, constants.KafkaRequestStatusSuspending)
+			err := k.kafkaService.Suspend(context.Background(), kafka, dbapi.KafkaSuspensionReasonGracePeriod)
 			if err != nil {
 				wrappedError := errors.Wrap(err, "failed to suspend expired Kafka instances")
 				encounteredErrors = append(encounteredErrors, wrappedError)
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
@@ -196,9 +197,9 @@ func TestKafkaManager_Reconcile(t *testing.T) {
 }
 
 func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
-	type updateStatusCall struct {
+	type suspendCall struct {
 		count  int
-		status constants.KafkaStatus
+		reason dbapi.KafkaSuspensionReason
 	}
 
 	type fields struct {
@@ -210,10 +211,10 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 		kafkaConfig             config.KafkaConfig
 	}
 	tests := []struct {
-		name             string
-		fields           fields
-		wantErr          bool
-		updateStatusCall updateStatusCall
+		name        string
+		fields      fields
+		wantErr     bool
+		suspendCall suspendCall
 	}{
 		{
 			name: "should suspend kafka in grace period",
@@ -240,8 +241,8 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 					DeprovisionExpiredKafkasFunc: func() *errors.ServiceError {
 						return nil
 					},
-					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
-						return true, nil
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						return nil
 					},
 					IsQuotaEntitlementActiveFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 						return false, nil
@@ -261,9 +262,9 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				}(),
 			},
 			wantErr: false,
-			updateStatusCall: updateStatusCall{
+			suspendCall: suspendCall{
 				count:  1,
-				status: constants.KafkaRequestStatusSuspending,
+				reason: dbapi.KafkaSuspensionReasonGracePeriod,
 			},
 		},
 		{
@@ -291,8 +292,8 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 					DeprovisionExpiredKafkasFunc: func() *errors.ServiceError {
 						return nil
 					},
-					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
-						return true, nil
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						return nil
 					},
 					IsQuotaEntitlementActiveFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 						return false, nil
@@ -312,7 +313,7 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				}(),
 			},
 			wantErr: false,
-			updateStatusCall: updateStatusCall{
+			suspendCall: suspendCall{
 				count: 0,
 			},
 		},
@@ -341,8 +342,8 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 					DeprovisionExpiredKafkasFunc: func() *errors.ServiceError {
 						return nil
 					},
-					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
-						return true, nil
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						return nil
 					},
 					IsQuotaEntitlementActiveFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 						return false, nil
@@ -362,7 +363,7 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				}(),
 			},
 			wantErr: false,
-			updateStatusCall: updateStatusCall{
+			suspendCall: suspendCall{
 				count: 0,
 			},
 		},
@@ -391,8 +392,8 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 					DeprovisionExpiredKafkasFunc: func() *errors.ServiceError {
 						return nil
 					},
-					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
-						return true, nil
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						return nil
 					},
 					IsQuotaEntitlementActiveFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 						return false, nil
@@ -412,7 +413,7 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				}(),
 			},
 			wantErr: false,
-			updateStatusCall: updateStatusCall{
+			suspendCall: suspendCall{
 				count: 0,
 			},
 		},
@@ -441,8 +442,8 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 					DeprovisionExpiredKafkasFunc: func() *errors.ServiceError {
 						return nil
 					},
-					UpdateStatusFunc: func(id string, status constants.KafkaStatus) (bool, *errors.ServiceError) {
-						return true, nil
+					SuspendFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest, reason dbapi.KafkaSuspensionReason) *errors.ServiceError {
+						return nil
 					},
 					IsQuotaEntitlementActiveFunc: func(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 						return true, nil
@@ -462,7 +463,7 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 				}(),
 			},
 			wantErr: false,
-			updateStatusCall: updateStatusCall{
+			suspendCall: suspendCall{
 				count: 0,
 			},
 		},
@@ -484,9 +485,9 @@ func TestKafkaManager_ReconcileExpiredKafkas(t *testing.T) {
 
 			//k.Reconcile()
 			g.Expect(len(k.Reconcile()) > 0).To(gomega.Equal(tt.wantErr))
-			g.Expect(tt.fields.kafkaService.UpdateStatusCalls()).To(gomega.HaveLen(tt.updateStatusCall.count))
-			if tt.updateStatusCall.count > 0 {
-				g.Expect(tt.fields.kafkaService.UpdateStatusCalls()[0].Status).To(gomega.BeEquivalentTo(tt.updateStatusCall.status))
+			g.Expect(tt.fields.kafkaService.SuspendCalls()).To(gomega.HaveLen(tt.suspendCall.count))
+			if tt.suspendCall.count > 0 {
+				g.Expect(tt.fields.kafkaService.SuspendCalls()[0].Reason).To(gomega.Equal(tt.suspendCall.reason))
 			}
 
 		})
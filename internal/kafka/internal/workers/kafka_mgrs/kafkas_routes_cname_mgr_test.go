@@ -3,7 +3,6 @@ package kafka_mgrs
 import (
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
@@ -16,8 +15,7 @@ import (
 )
 
 func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
-	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
+	testChangeID := "route53:/change/C2682N5HXP0BZ4"
 
 	type fields struct {
 		kafkaService services.KafkaService
@@ -39,12 +37,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
+						return &services.KafkaDNSChange{
+							ID:     testChangeID,
+							InSync: true,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -70,20 +66,19 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
+						return &services.KafkaDNSChange{
+							ID:     testChangeID,
+							InSync: true,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
-					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.CNameRecordStatus, error) {
-						return &services.CNameRecordStatus{
-							Status: &testChangeINSYNC,
+					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.KafkaDNSChange, error) {
+						return &services.KafkaDNSChange{
+							ID:     "test",
+							InSync: true,
 						}, nil
 					},
 				},
@@ -106,18 +101,16 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
+						return &services.KafkaDNSChange{
+							ID:     testChangeID,
+							InSync: true,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
-					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.CNameRecordStatus, error) {
+					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.KafkaDNSChange, error) {
 						return nil, errors.GeneralError("failed to get cname record status")
 					},
 				},
@@ -161,12 +154,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
+						return &services.KafkaDNSChange{
+							ID:     testChangeID,
+							InSync: true,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -206,7 +197,7 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
 						return nil, errors.GeneralError("failed to create CNAME")
 					},
 				},
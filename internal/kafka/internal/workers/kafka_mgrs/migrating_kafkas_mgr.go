@@ -0,0 +1,215 @@
+package kafka_mgrs
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// MigratingKafkaManager represents a kafka manager that periodically reconciles kafkas being migrated to another data plane cluster.
+//
+// A migration goes through the following statuses:
+//   - pending: the migration has been requested. The manager reserves capacity on the target cluster and assigns a new placement id.
+//   - provisioning: the kafka is provisioned on the target cluster in addition to the source cluster.
+//   - target_ready: the target cluster reported the kafka as ready. The manager switches the DNS records over to the target cluster
+//     and assigns the kafka to it.
+//   - source_deleting: the kafka is being deleted from the source cluster. The migration is complete once it has been deleted.
+//
+// The provisioning and source_deleting statuses are progressed by the statuses reported by the data plane clusters.
+type MigratingKafkaManager struct {
+	workers.BaseWorker
+	kafkaService                      services.KafkaService
+	clusterPlacementEvaluationService services.ClusterPlacementEvaluationService
+	kafkaConfig                       *config.KafkaConfig
+}
+
+var _ workers.Worker = &MigratingKafkaManager{}
+
+// NewMigratingKafkaManager creates a new kafka manager to reconcile kafkas being migrated.
+func NewMigratingKafkaManager(kafkaService services.KafkaService, clusterPlacementEvaluationService services.ClusterPlacementEvaluationService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *MigratingKafkaManager {
+	return &MigratingKafkaManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "migrating_kafka",
+			Reconciler: reconciler,
+		},
+		kafkaService:                      kafkaService,
+		clusterPlacementEvaluationService: clusterPlacementEvaluationService,
+		kafkaConfig:                       kafkaConfig,
+	}
+}
+
+// Start initializes the kafka manager to reconcile kafkas being migrated.
+func (k *MigratingKafkaManager) Start() {
+	k.StartWorker(k)
+}
+
+// Stop causes the process for reconciling kafkas being migrated to stop.
+func (k *MigratingKafkaManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *MigratingKafkaManager) Reconcile() []error {
+	glog.Infoln("reconciling kafkas to be migrated")
+	var encounteredErrors []error
+
+	kafkas, serviceErr := k.kafkaService.ListKafkasToBeMigrated()
+	if serviceErr != nil {
+		return []error{errors.Wrap(serviceErr, "failed to list kafkas to be migrated")}
+	}
+	glog.Infof("kafkas to be migrated count = %d", len(kafkas))
+
+	for _, kafka := range kafkas {
+		var err error
+		switch kafka.MigrationStatus {
+		case dbapi.KafkaMigrationStatusPending:
+			err = k.reconcilePendingMigration(kafka)
+		case dbapi.KafkaMigrationStatusTargetReady:
+			err = k.reconcileTargetReadyMigration(kafka)
+		}
+
+		if err != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile migration of kafka %s", kafka.ID))
+		}
+	}
+
+	return encounteredErrors
+}
+
+// reconcilePendingMigration reserves the capacity of the kafka on the target cluster of the migration.
+// If no target cluster was requested, the one preferred by the placement strategy is used.
+func (k *MigratingKafkaManager) reconcilePendingMigration(kafka *dbapi.KafkaRequest) error {
+	if kafka.Status != constants.KafkaRequestStatusReady.String() {
+		return k.failMigration(kafka, fmt.Sprintf("only kafkas in %q status can be migrated, the kafka is in %q status", constants.KafkaRequestStatusReady, kafka.Status))
+	}
+
+	evaluation, err := k.clusterPlacementEvaluationService.EvaluatePlacement(kafka)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate the placement of the kafka")
+	}
+
+	target, reason := selectMigrationTargetCluster(kafka, evaluation)
+	if target == nil {
+		return k.failMigration(kafka, reason)
+	}
+
+	glog.Infof("migrating kafka %q from cluster %q to cluster %q", kafka.ID, kafka.ClusterID, target.ClusterID)
+	if err := k.kafkaService.Updates(kafka, map[string]interface{}{
+		"migration_status":       dbapi.KafkaMigrationStatusProvisioning,
+		"migration_cluster_id":   target.ClusterID,
+		"migration_placement_id": api.NewID(),
+		"migration_routes":       nil,
+		"migration_details":      "",
+	}); err != nil {
+		return errors.Wrapf(err, "failed to reserve capacity on cluster %q", target.ClusterID)
+	}
+
+	return nil
+}
+
+// reconcileTargetReadyMigration switches the DNS records of the kafka over to the target cluster of the migration and
+// assigns the kafka to it. The source cluster becomes the other cluster of the migration, from which the kafka is deleted.
+func (k *MigratingKafkaManager) reconcileTargetReadyMigration(kafka *dbapi.KafkaRequest) error {
+	routes, err := kafka.GetMigrationRoutes()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the routes of the kafka on the target cluster")
+	}
+	if len(routes) == 0 {
+		return errors.Errorf("kafka has no routes on the target cluster %q", kafka.MigrationClusterID)
+	}
+
+	values := map[string]interface{}{
+		"cluster_id":             kafka.MigrationClusterID,
+		"placement_id":           kafka.MigrationPlacementId,
+		"routes":                 kafka.MigrationRoutes,
+		"migration_cluster_id":   kafka.ClusterID,
+		"migration_placement_id": kafka.PlacementId,
+		"migration_routes":       nil,
+		"migration_status":       dbapi.KafkaMigrationStatusSourceDeleting,
+	}
+
+	if k.kafkaConfig.EnableKafkaCNAMERegistration {
+		targetKafka := *kafka
+		targetKafka.Routes = kafka.MigrationRoutes
+		glog.Infof("switching CNAME records of kafka %q over to cluster %q", kafka.ID, kafka.MigrationClusterID)
+		change, err := k.kafkaService.ChangeKafkaCNAMErecords(&targetKafka, services.KafkaRoutesActionUpsert)
+		if err != nil {
+			return errors.Wrap(err, "failed to switch the CNAME records over to the target cluster")
+		}
+		// the KafkaRoutesCNAMEManager keeps track of the change until the records are in sync
+		values["routes_creation_id"] = change.ID
+		values["routes_created"] = change.InSync
+	} else {
+		values["routes_creation_id"] = ""
+		values["routes_created"] = true
+	}
+
+	if err := k.kafkaService.Updates(kafka, values); err != nil {
+		return errors.Wrapf(err, "failed to assign the kafka to cluster %q", kafka.MigrationClusterID)
+	}
+
+	return nil
+}
+
+func (k *MigratingKafkaManager) failMigration(kafka *dbapi.KafkaRequest, reason string) error {
+	glog.Infof("migration of kafka %q failed: %s", kafka.ID, reason)
+	if err := k.kafkaService.Updates(kafka, map[string]interface{}{
+		"migration_status":     dbapi.KafkaMigrationStatusFailed,
+		"migration_cluster_id": "",
+		"migration_details":    reason,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update migration status")
+	}
+
+	return nil
+}
+
+// selectMigrationTargetCluster returns the cluster the kafka should be migrated to, or the reason why it cannot be migrated.
+// The requested target cluster has to be able to receive the kafka. Otherwise, the cluster chosen by the placement strategy is used,
+// unless it is the current cluster of the kafka, in which case the eligible cluster with the highest score is used.
+func selectMigrationTargetCluster(kafka *dbapi.KafkaRequest, evaluation *services.ClusterPlacementEvaluation) (*api.Cluster, string) {
+	if kafka.MigrationClusterID != "" {
+		if kafka.MigrationClusterID == kafka.ClusterID {
+			return nil, fmt.Sprintf("kafka is already placed on cluster %q", kafka.ClusterID)
+		}
+		for _, candidate := range evaluation.Candidates {
+			if candidate.Cluster.ClusterID != kafka.MigrationClusterID {
+				continue
+			}
+			if !candidate.Eligible {
+				return nil, fmt.Sprintf("target cluster %q cannot receive the kafka: %s", kafka.MigrationClusterID, candidate.RejectionReason)
+			}
+			return candidate.Cluster, ""
+		}
+		return nil, fmt.Sprintf("target cluster %q is not a data plane cluster of region %q of cloud provider %q", kafka.MigrationClusterID, kafka.Region, kafka.CloudProvider)
+	}
+
+	if evaluation.ChosenCluster != nil && evaluation.ChosenCluster.ClusterID != kafka.ClusterID {
+		return evaluation.ChosenCluster, ""
+	}
+
+	var target *services.ClusterPlacementCandidateEvaluation
+	for i := range evaluation.Candidates {
+		candidate := &evaluation.Candidates[i]
+		if !candidate.Eligible || candidate.Cluster.ClusterID == kafka.ClusterID {
+			continue
+		}
+		if target == nil || (candidate.Score != nil && target.Score != nil && *candidate.Score > *target.Score) {
+			target = candidate
+		}
+	}
+
+	if target == nil {
+		return nil, "no other data plane cluster can receive the kafka"
+	}
+
+	return target.Cluster, ""
+}
@@ -0,0 +1,278 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	"github.com/onsi/gomega"
+
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+)
+
+func TestMigratingKafkaManager_Reconcile(t *testing.T) {
+	testChangeID := "route53:/change/C2682N5HXP0BZ4"
+	testMigrationRoutes := api.JSON(`[{"domain":"test.target.example.com","router":"router.target.example.com"}]`)
+
+	sourceCluster := &api.Cluster{ClusterID: mockKafkas.DefaultClusterID}
+	targetCluster := &api.Cluster{ClusterID: "target-cluster-id"}
+	otherCluster := &api.Cluster{ClusterID: "other-cluster-id"}
+	lowScore, highScore := 0.2, 0.8
+
+	evaluation := &services.ClusterPlacementEvaluation{
+		ChosenCluster: targetCluster,
+		Candidates: []services.ClusterPlacementCandidateEvaluation{
+			{Cluster: sourceCluster, Eligible: true, RejectionReason: "another cluster is preferred"},
+			{Cluster: targetCluster, Eligible: true},
+			{Cluster: otherCluster, Eligible: false, RejectionReason: "cluster is in \"deprovisioning\" status"},
+		},
+	}
+
+	placementEvaluation := func(evaluation *services.ClusterPlacementEvaluation) services.ClusterPlacementEvaluationService {
+		return &services.ClusterPlacementEvaluationServiceMock{
+			EvaluatePlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementEvaluation, *errors.ServiceError) {
+				return evaluation, nil
+			},
+		}
+	}
+
+	pendingKafka := func(options ...mockKafkas.KafkaRequestBuildOption) []*dbapi.KafkaRequest {
+		options = append([]mockKafkas.KafkaRequestBuildOption{
+			mockKafkas.WithPredefinedTestValues(),
+			mockKafkas.With(mockKafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusPending.String()),
+		}, options...)
+		return []*dbapi.KafkaRequest{mockKafkas.BuildKafkaRequest(options...)}
+	}
+
+	targetReadyKafka := func(options ...mockKafkas.KafkaRequestBuildOption) []*dbapi.KafkaRequest {
+		options = append([]mockKafkas.KafkaRequestBuildOption{
+			mockKafkas.WithPredefinedTestValues(),
+			func(kafkaRequest *dbapi.KafkaRequest) { kafkaRequest.PlacementId = "source-placement-id" },
+			mockKafkas.With(mockKafkas.MIGRATION_STATUS, dbapi.KafkaMigrationStatusTargetReady.String()),
+			mockKafkas.With(mockKafkas.MIGRATION_CLUSTER_ID, targetCluster.ClusterID),
+			mockKafkas.With(mockKafkas.MIGRATION_PLACEMENT_ID, "target-placement-id"),
+		}, options...)
+		return []*dbapi.KafkaRequest{mockKafkas.BuildKafkaRequest(options...)}
+	}
+
+	type fields struct {
+		kafkas                            []*dbapi.KafkaRequest
+		listErr                           *errors.ServiceError
+		clusterPlacementEvaluationService services.ClusterPlacementEvaluationService
+		kafkaConfig                       *config.KafkaConfig
+		changeCNAMErecordsErr             *errors.ServiceError
+	}
+
+	tests := []struct {
+		name                 string
+		fields               fields
+		wantErr              bool
+		wantValues           map[string]interface{}
+		wantCNAMErecordsCall bool
+	}{
+		{
+			name: "should return an error when listing kafkas to be migrated fails",
+			fields: fields{
+				listErr: errors.GeneralError("failed to list kafkas"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reserve capacity on the requested target cluster",
+			fields: fields{
+				kafkas:                            pendingKafka(mockKafkas.With(mockKafkas.MIGRATION_CLUSTER_ID, targetCluster.ClusterID)),
+				clusterPlacementEvaluationService: placementEvaluation(evaluation),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusProvisioning,
+				"migration_cluster_id": targetCluster.ClusterID,
+				"migration_details":    "",
+			},
+		},
+		{
+			name: "should fail the migration when the requested target cluster cannot receive the kafka",
+			fields: fields{
+				kafkas:                            pendingKafka(mockKafkas.With(mockKafkas.MIGRATION_CLUSTER_ID, otherCluster.ClusterID)),
+				clusterPlacementEvaluationService: placementEvaluation(evaluation),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusFailed,
+				"migration_cluster_id": "",
+				"migration_details":    `target cluster "other-cluster-id" cannot receive the kafka: cluster is in "deprovisioning" status`,
+			},
+		},
+		{
+			name: "should fail the migration when the requested target cluster is not in the region of the kafka",
+			fields: fields{
+				kafkas:                            pendingKafka(mockKafkas.With(mockKafkas.MIGRATION_CLUSTER_ID, "unknown-cluster-id")),
+				clusterPlacementEvaluationService: placementEvaluation(evaluation),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusFailed,
+				"migration_cluster_id": "",
+				"migration_details":    `target cluster "unknown-cluster-id" is not a data plane cluster of region "us-east-1" of cloud provider "aws"`,
+			},
+		},
+		{
+			name: "should fail the migration when the kafka is no longer ready",
+			fields: fields{
+				kafkas: pendingKafka(mockKafkas.With(mockKafkas.STATUS, constants.KafkaRequestStatusDeprovision.String())),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusFailed,
+				"migration_cluster_id": "",
+				"migration_details":    `only kafkas in "ready" status can be migrated, the kafka is in "deprovision" status`,
+			},
+		},
+		{
+			name: "should reserve capacity on the cluster chosen by the placement strategy when no target cluster was requested",
+			fields: fields{
+				kafkas:                            pendingKafka(),
+				clusterPlacementEvaluationService: placementEvaluation(evaluation),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusProvisioning,
+				"migration_cluster_id": targetCluster.ClusterID,
+			},
+		},
+		{
+			name: "should reserve capacity on the eligible cluster with the highest score when the placement strategy chooses the current cluster",
+			fields: fields{
+				kafkas: pendingKafka(),
+				clusterPlacementEvaluationService: placementEvaluation(&services.ClusterPlacementEvaluation{
+					ChosenCluster: sourceCluster,
+					Candidates: []services.ClusterPlacementCandidateEvaluation{
+						{Cluster: sourceCluster, Eligible: true},
+						{Cluster: otherCluster, Eligible: true, Score: &lowScore, RejectionReason: "another cluster is preferred"},
+						{Cluster: targetCluster, Eligible: true, Score: &highScore, RejectionReason: "another cluster is preferred"},
+					},
+				}),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":     dbapi.KafkaMigrationStatusProvisioning,
+				"migration_cluster_id": targetCluster.ClusterID,
+			},
+		},
+		{
+			name: "should fail the migration when no other cluster can receive the kafka",
+			fields: fields{
+				kafkas: pendingKafka(),
+				clusterPlacementEvaluationService: placementEvaluation(&services.ClusterPlacementEvaluation{
+					Candidates: []services.ClusterPlacementCandidateEvaluation{
+						{Cluster: sourceCluster, Eligible: true},
+						{Cluster: otherCluster, Eligible: false, RejectionReason: "cluster is full"},
+					},
+				}),
+			},
+			wantValues: map[string]interface{}{
+				"migration_status":  dbapi.KafkaMigrationStatusFailed,
+				"migration_details": "no other data plane cluster can receive the kafka",
+			},
+		},
+		{
+			name: "should return an error when the placement evaluation fails",
+			fields: fields{
+				kafkas: pendingKafka(),
+				clusterPlacementEvaluationService: &services.ClusterPlacementEvaluationServiceMock{
+					EvaluatePlacementFunc: func(kafka *dbapi.KafkaRequest) (*services.ClusterPlacementEvaluation, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to evaluate placement")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the kafka has no routes on the target cluster",
+			fields: fields{
+				kafkas: targetReadyKafka(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should assign the kafka to the target cluster without changing CNAME records when their registration is disabled",
+			fields: fields{
+				kafkas:      targetReadyKafka(mockKafkas.WithMigrationRoutes(testMigrationRoutes)),
+				kafkaConfig: &config.KafkaConfig{EnableKafkaCNAMERegistration: false},
+			},
+			wantValues: map[string]interface{}{
+				"cluster_id":             targetCluster.ClusterID,
+				"placement_id":           "target-placement-id",
+				"routes":                 testMigrationRoutes,
+				"migration_cluster_id":   mockKafkas.DefaultClusterID,
+				"migration_placement_id": "source-placement-id",
+				"migration_status":       dbapi.KafkaMigrationStatusSourceDeleting,
+				"routes_creation_id":     "",
+				"routes_created":         true,
+			},
+		},
+		{
+			name: "should switch the CNAME records over to the target cluster and assign the kafka to it",
+			fields: fields{
+				kafkas:      targetReadyKafka(mockKafkas.WithMigrationRoutes(testMigrationRoutes)),
+				kafkaConfig: &config.KafkaConfig{EnableKafkaCNAMERegistration: true},
+			},
+			wantValues: map[string]interface{}{
+				"cluster_id":         targetCluster.ClusterID,
+				"migration_status":   dbapi.KafkaMigrationStatusSourceDeleting,
+				"routes_creation_id": testChangeID,
+				"routes_created":     false,
+			},
+			wantCNAMErecordsCall: true,
+		},
+		{
+			name: "should return an error when switching the CNAME records fails",
+			fields: fields{
+				kafkas:                targetReadyKafka(mockKafkas.WithMigrationRoutes(testMigrationRoutes)),
+				kafkaConfig:           &config.KafkaConfig{EnableKafkaCNAMERegistration: true},
+				changeCNAMErecordsErr: errors.GeneralError("failed to change CNAME records"),
+			},
+			wantErr:              true,
+			wantCNAMErecordsCall: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		test := testcase
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			kafkaService := &services.KafkaServiceMock{
+				ListKafkasToBeMigratedFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return test.fields.kafkas, test.fields.listErr
+				},
+				UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+					return nil
+				},
+				ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*services.KafkaDNSChange, *errors.ServiceError) {
+					g.Expect(action).To(gomega.Equal(services.KafkaRoutesActionUpsert))
+					g.Expect(kafkaRequest.Routes).To(gomega.Equal(testMigrationRoutes))
+					if test.fields.changeCNAMErecordsErr != nil {
+						return nil, test.fields.changeCNAMErecordsErr
+					}
+					return &services.KafkaDNSChange{
+						ID:     testChangeID,
+						InSync: false,
+					}, nil
+				},
+			}
+
+			errs := NewMigratingKafkaManager(kafkaService, test.fields.clusterPlacementEvaluationService,
+				test.fields.kafkaConfig, w.Reconciler{}).Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(test.wantErr))
+			g.Expect(len(kafkaService.ChangeKafkaCNAMErecordsCalls()) > 0).To(gomega.Equal(test.wantCNAMErecordsCall))
+
+			if test.wantValues != nil {
+				g.Expect(kafkaService.UpdatesCalls()).To(gomega.HaveLen(1))
+				values := kafkaService.UpdatesCalls()[0].Values
+				for key, value := range test.wantValues {
+					g.Expect(values).To(gomega.HaveKeyWithValue(key, value))
+				}
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/workers/kafka_mgrs/promotion"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
+	coreAcl "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/acl"
 	observatoriumClient "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	environments2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/providers"
@@ -48,6 +49,11 @@ func ConfigProviders() di.Option {
 		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewKasFleetshardConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewKafkaStatusEventsConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewKafkaDNSConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewKafkaSuspensionConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewAuditRecordsConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewKafkaAlertRulesConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(acl.NewEnterpriseClusterRegistrationAccessControlListConfig, di.As(new(environments2.ConfigModule))),
 
@@ -68,6 +74,22 @@ func ServiceProviders() di.Option {
 		di.Provide(services.NewObservatoriumService),
 		di.Provide(services.NewKasFleetshardOperatorAddon),
 		di.Provide(services.NewClusterPlacementStrategy),
+		di.Provide(services.NewClusterPlacementEvaluationService),
+		di.Provide(services.NewClusterDrainService),
+		di.Provide(services.NewKafkaRolloutService),
+		di.Provide(services.NewKafkaStatusEventService),
+		di.Provide(services.NewKafkaStatusEventSink),
+		di.Provide(services.NewKafkaDNSProvider),
+		di.Provide(services.NewKafkaEventService),
+		di.Provide(services.NewAuditRecordService),
+		di.Provide(services.NewAuditRecordPublisher),
+		di.Provide(services.NewQuotaGrantService),
+		di.Provide(services.NewQuotaGrantSeeder, di.As(new(environments2.BootService))),
+		di.Provide(services.NewAccessControlListEntryService, di.As(new(coreAcl.AccessControlListSource))),
+		di.Provide(services.NewKafkaOwnershipTransferService),
+		di.Provide(services.NewKafkaAlertRuleService),
+		di.Provide(services.NewKafkaAlertNotifier),
+		di.Provide(coreAcl.NewAccessControlListReloader, di.As(new(environments2.BootService))),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneKafkaService, di.As(new(services.DataPlaneKafkaService))),
 		di.Provide(handlers.NewAuthenticationBuilder),
@@ -79,6 +101,7 @@ func ServiceProviders() di.Option {
 		di.Provide(cluster_mgrs.NewCleanupClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDeprovisioningClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDynamicScaleDownManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewDrainingClustersManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewAcceptedKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewPreparingKafkaManager, di.As(new(workers.Worker))),
@@ -86,6 +109,12 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewMigratingKafkaManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaRolloutManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaStatusEventsManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewAuditRecordsWebhookManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewIdleKafkaManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaAlertRulesManager, di.As(new(workers.Worker))),
 		di.Provide(promotion.NewPromotionKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(acl.NewEnterpriseClusterRegistrationAccessListMiddleware),
 	)
@@ -1628,7 +1628,7 @@ func TestKafkaDelete_DeleteDuringCreation(t *testing.T) {
 				return err
 			}
 
-			kafkaList, resp, err := privateClient.AgentClustersApi.GetKafkas(ctx, dataplaneCluster.ClusterID)
+			kafkaList, resp, err := privateClient.AgentClustersApi.GetKafkas(ctx, dataplaneCluster.ClusterID, nil)
 			if resp != nil {
 				resp.Body.Close()
 			}
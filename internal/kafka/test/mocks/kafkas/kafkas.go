@@ -60,6 +60,10 @@ const (
 	ACTUAL_KAFKA_BILLING_MODEL
 	PROMOTION_STATUS
 	PROMOTION_DETAILS
+	MIGRATION_STATUS
+	MIGRATION_CLUSTER_ID
+	MIGRATION_PLACEMENT_ID
+	MIGRATION_DETAILS
 )
 
 type KafkaAttribute int
@@ -117,6 +121,14 @@ func With(attribute KafkaRequestAttribute, value string) KafkaRequestBuildOption
 			request.PromotionStatus = dbapi.KafkaPromotionStatus(value)
 		case PROMOTION_DETAILS:
 			request.PromotionDetails = value
+		case MIGRATION_STATUS:
+			request.MigrationStatus = dbapi.KafkaMigrationStatus(value)
+		case MIGRATION_CLUSTER_ID:
+			request.MigrationClusterID = value
+		case MIGRATION_PLACEMENT_ID:
+			request.MigrationPlacementId = value
+		case MIGRATION_DETAILS:
+			request.MigrationDetails = value
 		}
 	}
 }
@@ -127,6 +139,24 @@ func WithRoutes(routes api.JSON) KafkaRequestBuildOption {
 	}
 }
 
+func WithMigrationRoutes(routes api.JSON) KafkaRequestBuildOption {
+	return func(request *dbapi.KafkaRequest) {
+		request.MigrationRoutes = routes
+	}
+}
+
+func WithMaintenanceWindow(window api.JSON) KafkaRequestBuildOption {
+	return func(request *dbapi.KafkaRequest) {
+		request.MaintenanceWindow = window
+	}
+}
+
+func WithLabels(labels ...dbapi.KafkaLabel) KafkaRequestBuildOption {
+	return func(request *dbapi.KafkaRequest) {
+		request.Labels = labels
+	}
+}
+
 func WithReauthenticationEnabled(enabled bool) KafkaRequestBuildOption {
 	return func(request *dbapi.KafkaRequest) {
 		request.ReauthenticationEnabled = enabled
@@ -157,6 +187,12 @@ func WithExpiresAt(expiresAt sql.NullTime) KafkaRequestBuildOption {
 	}
 }
 
+func WithSuspensionReason(reason dbapi.KafkaSuspensionReason) KafkaRequestBuildOption {
+	return func(request *dbapi.KafkaRequest) {
+		request.SuspensionReason = reason
+	}
+}
+
 func WithPredefinedTestValues() KafkaRequestBuildOption {
 	return func(request *dbapi.KafkaRequest) {
 		request.Meta = api.Meta{
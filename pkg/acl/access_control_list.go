@@ -1,6 +1,11 @@
 package acl
 
 import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/spf13/pflag"
@@ -25,6 +30,14 @@ type AccessControlListConfig struct {
 	AccessListConfigFile string
 	EnableDenyList       bool
 	EnableAccessList     bool
+	// ReloadInterval is how often the stored entries are reloaded, the configuration files being reloaded as soon as they change.
+	// The files are only reloaded at this interval when they cannot be watched. Nothing is reloaded when it is 0
+	ReloadInterval time.Duration
+
+	// lock guards the lists, which are reloaded while the requests are being authorized
+	lock sync.RWMutex
+	// storedEntries are the entries managed through the admin API
+	storedEntries api.AccessControlListEntryList
 }
 
 func NewAccessControlListConfig() *AccessControlListConfig {
@@ -33,6 +46,7 @@ func NewAccessControlListConfig() *AccessControlListConfig {
 		AccessListConfigFile: "config/access-list-configuration.yaml",
 		EnableDenyList:       false,
 		EnableAccessList:     false,
+		ReloadInterval:       30 * time.Second,
 	}
 }
 
@@ -41,6 +55,7 @@ func (c *AccessControlListConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.EnableDenyList, "enable-deny-list", c.EnableDenyList, "Enable access control via the denied list of users")
 	fs.StringVar(&c.AccessListConfigFile, "access-list-config-file", c.AccessListConfigFile, "AccessList configuration file")
 	fs.BoolVar(&c.EnableAccessList, "enable-access-list", c.EnableAccessList, "Enable access control via the accepted list of organisations")
+	fs.DurationVar(&c.ReloadInterval, "access-control-list-reload-interval", c.ReloadInterval, "How often the entries of the deny list and the access list managed through the admin API are reloaded, which is how long the other instances of the fleet manager can take to enforce a change of the entries. The configuration files are reloaded as soon as they change, and only at this interval when they cannot be watched. Nothing is reloaded after startup when set to 0")
 }
 
 func (c *AccessControlListConfig) ReadFiles() (err error) {
@@ -60,6 +75,79 @@ func (c *AccessControlListConfig) ReadFiles() (err error) {
 	return nil
 }
 
+// ReloadFiles reads the lists of the configuration files again. The lists are left untouched when a file cannot be read, and
+// it returns whether any of them has changed
+func (c *AccessControlListConfig) ReloadFiles() (bool, error) {
+	var denyList DeniedUsers
+	var accessList AcceptedOrganisations
+
+	if c.EnableDenyList {
+		if err := readDenyListConfigFile(c.DenyListConfigFile, &denyList); err != nil {
+			return false, err
+		}
+	}
+
+	if c.EnableAccessList {
+		if err := readAccessListConfigFile(c.AccessListConfigFile, &accessList); err != nil {
+			return false, err
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	changed := !reflect.DeepEqual(c.DenyList, denyList) || !reflect.DeepEqual(c.AccessList, accessList)
+	c.DenyList = denyList
+	c.AccessList = accessList
+
+	return changed, nil
+}
+
+// SetStoredEntries replaces the entries managed through the admin API
+func (c *AccessControlListConfig) SetStoredEntries(entries api.AccessControlListEntryList) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.storedEntries = entries
+}
+
+// IsUserDenied returns whether the user is part of the deny list of the configuration file or is denied by an entry that has
+// not expired
+func (c *AccessControlListConfig) IsUserDenied(username string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.DenyList.IsUserDenied(username) || c.hasStoredEntry(api.AccessControlListEntryTypeDeniedUser, username)
+}
+
+// IsOrganisationAccepted returns whether the organisation is part of the access list of the configuration file or is accepted
+// by an entry that has not expired
+func (c *AccessControlListConfig) IsOrganisationAccepted(orgId string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.AccessList.IsOrganisationAccepted(orgId) || c.hasStoredEntry(api.AccessControlListEntryTypeAcceptedOrganisation, orgId)
+}
+
+// GetDeniedUsers returns the users of the deny list of the configuration file along with the ones denied by the entries that
+// have not expired
+func (c *AccessControlListConfig) GetDeniedUsers() DeniedUsers {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	deniedUsers := append(DeniedUsers{}, c.DenyList...)
+	now := time.Now()
+	for _, entry := range c.storedEntries {
+		if entry.Type == api.AccessControlListEntryTypeDeniedUser && !entry.HasExpired(now) && !deniedUsers.IsUserDenied(entry.Value) {
+			deniedUsers = append(deniedUsers, entry.Value)
+		}
+	}
+	return deniedUsers
+}
+
+func (c *AccessControlListConfig) hasStoredEntry(entryType api.AccessControlListEntryType, value string) bool {
+	now := time.Now()
+	return arrays.AnyMatch(c.storedEntries, func(entry *api.AccessControlListEntry) bool {
+		return entry.Type == entryType && entry.Value == value && !entry.HasExpired(now)
+	})
+}
+
 // Read the contents of file into the deny list config
 func readDenyListConfigFile(file string, val *DeniedUsers) error {
 	fileContents, err := shared.ReadFile(file)
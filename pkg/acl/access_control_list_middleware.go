@@ -32,7 +32,7 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		username, _ := claims.GetUsername()
 
 		if middleware.accessControlListConfig.EnableDenyList {
-			userIsDenied := middleware.accessControlListConfig.DenyList.IsUserDenied(username)
+			userIsDenied := middleware.accessControlListConfig.IsUserDenied(username)
 			if userIsDenied {
 				shared.HandleError(r, w, errors.New(errors.ErrorForbidden, "user '%s' is not authorized to access the service.", username))
 				return
@@ -42,7 +42,7 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		orgId, _ := claims.GetOrgId()
 
 		if middleware.accessControlListConfig.EnableAccessList {
-			orgIsAccepted := middleware.accessControlListConfig.AccessList.IsOrganisationAccepted(orgId)
+			orgIsAccepted := middleware.accessControlListConfig.IsOrganisationAccepted(orgId)
 			if !orgIsAccepted {
 				shared.HandleError(r, w, errors.New(errors.ErrorServiceIsUnderMaintenance, "organisation '%s' is not authorized to access the service during the current service maintenance.", orgId))
 				return
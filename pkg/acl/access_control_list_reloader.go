@@ -0,0 +1,204 @@
+package acl
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/fsnotify/fsnotify"
+)
+
+// configMapDataDir is the symbolic link to the directory holding the current files of a ConfigMap mounted as a volume
+const configMapDataDir = "..data"
+
+// AccessControlListSource provides the access control list entries managed outside of the configuration files
+//
+//go:generate moq -out access_control_list_source_moq.go . AccessControlListSource
+type AccessControlListSource interface {
+	// ListActiveEntries returns the entries that have not expired yet
+	ListActiveEntries() (api.AccessControlListEntryList, *errors.ServiceError)
+}
+
+// AccessControlListReloader reloads the deny list and the access list when their configuration files change, and the entries of
+// the AccessControlListSource at the configured interval, so that users can be denied without restarting the fleet manager
+type AccessControlListReloader struct {
+	accessControlListConfig *AccessControlListConfig
+	source                  AccessControlListSource
+	tearDown                chan struct{}
+	// reloadLock prevents the reloads triggered by the changes of the files, by the interval and by a change of the entries from
+	// overwriting each other
+	reloadLock sync.Mutex
+}
+
+var _ environments.BootService = &AccessControlListReloader{}
+
+func NewAccessControlListReloader(accessControlListConfig *AccessControlListConfig, source AccessControlListSource) *AccessControlListReloader {
+	return &AccessControlListReloader{
+		accessControlListConfig: accessControlListConfig,
+		source:                  source,
+	}
+}
+
+func (r *AccessControlListReloader) Start() {
+	// the entries are loaded once when the fleet manager starts, the configuration files having already been read
+	r.ReloadEntries()
+
+	watcher, err := r.watchFiles()
+	if err != nil {
+		logger.Logger.Errorf("failed to watch the access control list configuration files, they are reloaded at the reload interval instead: %v", err)
+	}
+
+	var ticker *time.Ticker
+	if r.accessControlListConfig.ReloadInterval > 0 {
+		ticker = time.NewTicker(r.accessControlListConfig.ReloadInterval)
+	}
+
+	if watcher == nil && ticker == nil {
+		return
+	}
+
+	r.tearDown = make(chan struct{})
+	go func() {
+		var events <-chan fsnotify.Event
+		var watchErrors <-chan error
+		if watcher != nil {
+			defer watcher.Close()
+			events = watcher.Events
+			watchErrors = watcher.Errors
+		}
+
+		var ticks <-chan time.Time
+		if ticker != nil {
+			defer ticker.Stop()
+			ticks = ticker.C
+		}
+
+		for {
+			select {
+			case event := <-events:
+				if r.isConfigFileEvent(event) {
+					r.Reload()
+				}
+			case err := <-watchErrors:
+				logger.Logger.Errorf("failed to watch the access control list configuration files: %v", err)
+			case <-ticks:
+				// the files are only reloaded at the interval when they cannot be watched
+				if watcher == nil {
+					r.Reload()
+				} else {
+					r.ReloadEntries()
+				}
+			case <-r.tearDown:
+				return
+			}
+		}
+	}()
+}
+
+func (r *AccessControlListReloader) Stop() {
+	if r.tearDown == nil {
+		return
+	}
+	select {
+	case <-r.tearDown:
+		return //already closed/stopped
+	default:
+		close(r.tearDown)
+	}
+}
+
+// watchFiles watches the directories of the configuration files of the enabled lists. The directories are watched rather than
+// the files, as the files of the ConfigMaps are symbolic links that Kubernetes swaps when the ConfigMaps change.
+// It returns a nil watcher when no list is enabled
+func (r *AccessControlListReloader) watchFiles() (*fsnotify.Watcher, error) {
+	files := r.configFiles()
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dir := range files {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+
+	return watcher, nil
+}
+
+// configFiles returns the names of the configuration files of the enabled lists, mapped to the directories they are in
+func (r *AccessControlListReloader) configFiles() map[string]string {
+	files := map[string]string{}
+	if r.accessControlListConfig.EnableDenyList {
+		if path := shared.BuildFullFilePath(r.accessControlListConfig.DenyListConfigFile); path != "" {
+			files[filepath.Base(path)] = filepath.Dir(path)
+		}
+	}
+	if r.accessControlListConfig.EnableAccessList {
+		if path := shared.BuildFullFilePath(r.accessControlListConfig.AccessListConfigFile); path != "" {
+			files[filepath.Base(path)] = filepath.Dir(path)
+		}
+	}
+	return files
+}
+
+// isConfigFileEvent returns whether the event changes one of the configuration files, or the ..data symbolic link Kubernetes
+// swaps to update all the files of a ConfigMap at once
+func (r *AccessControlListReloader) isConfigFileEvent(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	name := filepath.Base(event.Name)
+	if name == configMapDataDir {
+		return true
+	}
+	_, ok := r.configFiles()[name]
+	return ok
+}
+
+// Reload reloads the lists of the configuration files and the entries of the source. A list that cannot be reloaded is left
+// untouched, so that a malformed file does not lift the denial of the users
+func (r *AccessControlListReloader) Reload() {
+	r.reloadLock.Lock()
+	defer r.reloadLock.Unlock()
+
+	changed, err := r.accessControlListConfig.ReloadFiles()
+	if err != nil {
+		logger.Logger.Errorf("failed to reload the access control list configuration files: %v", err)
+	} else if changed {
+		logger.Logger.Infof("reloaded the access control list configuration files")
+	}
+
+	r.loadEntries()
+}
+
+// ReloadEntries only reloads the entries of the source, e.g. once they have been changed through the admin API. Only the
+// entries of this instance of the fleet manager are reloaded: the other instances reload them at their reload interval
+func (r *AccessControlListReloader) ReloadEntries() {
+	r.reloadLock.Lock()
+	defer r.reloadLock.Unlock()
+	r.loadEntries()
+}
+
+func (r *AccessControlListReloader) loadEntries() {
+	if r.source == nil {
+		return
+	}
+
+	entries, err := r.source.ListActiveEntries()
+	if err != nil {
+		logger.Logger.Errorf("failed to reload the access control list entries: %v", err)
+		return
+	}
+	r.accessControlListConfig.SetStoredEntries(entries)
+}
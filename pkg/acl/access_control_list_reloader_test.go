@@ -0,0 +1,112 @@
+package acl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_AccessControlListReloader_ReloadEntries(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         AccessControlListSource
+		storedEntries  api.AccessControlListEntryList
+		wantUserDenied bool
+	}{
+		{
+			name: "should load the entries of the source",
+			source: &AccessControlListSourceMock{
+				ListActiveEntriesFunc: func() (api.AccessControlListEntryList, *errors.ServiceError) {
+					return api.AccessControlListEntryList{
+						{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user1"},
+					}, nil
+				},
+			},
+			wantUserDenied: true,
+		},
+		{
+			name: "should keep the current entries when the entries of the source cannot be listed",
+			source: &AccessControlListSourceMock{
+				ListActiveEntriesFunc: func() (api.AccessControlListEntryList, *errors.ServiceError) {
+					return nil, errors.GeneralError("unable to list the active access control list entries")
+				},
+			},
+			storedEntries: api.AccessControlListEntryList{
+				{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user1"},
+			},
+			wantUserDenied: true,
+		},
+		{
+			name:   "should not fail when there is no source",
+			source: nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			aclConfig := &AccessControlListConfig{}
+			aclConfig.SetStoredEntries(tt.storedEntries)
+			reloader := NewAccessControlListReloader(aclConfig, tt.source)
+			reloader.ReloadEntries()
+			g.Expect(aclConfig.IsUserDenied("user1")).To(gomega.Equal(tt.wantUserDenied))
+		})
+	}
+}
+
+func Test_AccessControlListReloader_Reload(t *testing.T) {
+	g := gomega.NewWithT(t)
+	source := &AccessControlListSourceMock{
+		ListActiveEntriesFunc: func() (api.AccessControlListEntryList, *errors.ServiceError) {
+			return api.AccessControlListEntryList{}, nil
+		},
+	}
+	aclConfig := &AccessControlListConfig{
+		DenyListConfigFile: "invalid-path",
+		EnableDenyList:     true,
+		DenyList:           DeniedUsers{"user1"},
+	}
+	reloader := NewAccessControlListReloader(aclConfig, source)
+	reloader.Reload()
+
+	// the deny list cannot be read, the users of the previous one are still denied and the entries are reloaded anyway
+	g.Expect(aclConfig.IsUserDenied("user1")).To(gomega.BeTrue())
+	g.Expect(source.ListActiveEntriesCalls()).To(gomega.HaveLen(1))
+}
+
+func Test_AccessControlListReloader_WatchFiles(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// the files are laid out as in a mounted ConfigMap: the file is a symbolic link to the ..data symbolic link, which points
+	// to the directory holding the current version of the files
+	dir := t.TempDir()
+	writeVersion := func(version string, content string) {
+		versionDir := filepath.Join(dir, version)
+		g.Expect(os.Mkdir(versionDir, 0700)).To(gomega.Succeed())
+		g.Expect(os.WriteFile(filepath.Join(versionDir, "deny-list-configuration.yaml"), []byte(content), 0600)).To(gomega.Succeed())
+		g.Expect(os.Symlink(version, filepath.Join(dir, "..data_tmp"))).To(gomega.Succeed())
+		g.Expect(os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, configMapDataDir))).To(gomega.Succeed())
+	}
+	writeVersion("..v1", "- user1\n")
+	denyListFile := filepath.Join(dir, "deny-list-configuration.yaml")
+	g.Expect(os.Symlink(filepath.Join(configMapDataDir, "deny-list-configuration.yaml"), denyListFile)).To(gomega.Succeed())
+
+	aclConfig := &AccessControlListConfig{
+		DenyListConfigFile: denyListFile,
+		EnableDenyList:     true,
+	}
+	g.Expect(aclConfig.ReadFiles()).To(gomega.Succeed())
+
+	reloader := NewAccessControlListReloader(aclConfig, nil)
+	reloader.Start()
+	defer reloader.Stop()
+
+	writeVersion("..v2", "- user1\n- user2\n")
+	g.Eventually(func() bool { return aclConfig.IsUserDenied("user2") }, 5*time.Second).Should(gomega.BeTrue())
+}
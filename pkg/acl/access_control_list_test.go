@@ -1,8 +1,12 @@
 package acl
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/onsi/gomega"
 )
 
@@ -278,3 +282,110 @@ func Test_ReadAccessListConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func Test_AccessControlListConfig_ReloadFiles(t *testing.T) {
+	g := gomega.NewWithT(t)
+	denyListFile := filepath.Join(t.TempDir(), "deny-list-configuration.yaml")
+	g.Expect(os.WriteFile(denyListFile, []byte("- user1\n"), 0600)).To(gomega.Succeed())
+
+	aclConfig := &AccessControlListConfig{
+		DenyListConfigFile: denyListFile,
+		EnableDenyList:     true,
+	}
+	g.Expect(aclConfig.ReadFiles()).To(gomega.Succeed())
+	g.Expect(aclConfig.IsUserDenied("user2")).To(gomega.BeFalse())
+
+	changed, err := aclConfig.ReloadFiles()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeFalse())
+
+	g.Expect(os.WriteFile(denyListFile, []byte("- user1\n- user2\n"), 0600)).To(gomega.Succeed())
+	changed, err = aclConfig.ReloadFiles()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(aclConfig.IsUserDenied("user2")).To(gomega.BeTrue())
+
+	// a malformed file must not lift the denial of the users
+	g.Expect(os.WriteFile(denyListFile, []byte("user1: [\n"), 0600)).To(gomega.Succeed())
+	_, err = aclConfig.ReloadFiles()
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(aclConfig.IsUserDenied("user2")).To(gomega.BeTrue())
+}
+
+func Test_AccessControlListConfig_StoredEntries(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	notExpired := time.Now().Add(time.Hour)
+
+	aclConfig := &AccessControlListConfig{
+		DenyList:   DeniedUsers{"user1"},
+		AccessList: AcceptedOrganisations{"org1"},
+	}
+	aclConfig.SetStoredEntries(api.AccessControlListEntryList{
+		{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user2"},
+		{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user3", ExpiresAt: &notExpired},
+		{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user4", ExpiresAt: &expired},
+		{Type: api.AccessControlListEntryTypeDeniedUser, Value: "user1"},
+		{Type: api.AccessControlListEntryTypeAcceptedOrganisation, Value: "org2"},
+		{Type: api.AccessControlListEntryTypeAcceptedOrganisation, Value: "org3", ExpiresAt: &expired},
+	})
+
+	tests := []struct {
+		name string
+		got  func() bool
+		want bool
+	}{
+		{
+			name: "should deny a user of the configuration file",
+			got:  func() bool { return aclConfig.IsUserDenied("user1") },
+			want: true,
+		},
+		{
+			name: "should deny a user of an entry that never expires",
+			got:  func() bool { return aclConfig.IsUserDenied("user2") },
+			want: true,
+		},
+		{
+			name: "should deny a user of an entry that has not expired yet",
+			got:  func() bool { return aclConfig.IsUserDenied("user3") },
+			want: true,
+		},
+		{
+			name: "should not deny a user of an entry that has expired",
+			got:  func() bool { return aclConfig.IsUserDenied("user4") },
+			want: false,
+		},
+		{
+			name: "should not deny a user accepted as an organisation",
+			got:  func() bool { return aclConfig.IsUserDenied("org2") },
+			want: false,
+		},
+		{
+			name: "should accept an organisation of the configuration file",
+			got:  func() bool { return aclConfig.IsOrganisationAccepted("org1") },
+			want: true,
+		},
+		{
+			name: "should accept an organisation of an entry",
+			got:  func() bool { return aclConfig.IsOrganisationAccepted("org2") },
+			want: true,
+		},
+		{
+			name: "should not accept an organisation of an entry that has expired",
+			got:  func() bool { return aclConfig.IsOrganisationAccepted("org3") },
+			want: false,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(tt.got()).To(gomega.Equal(tt.want))
+		})
+	}
+
+	t.Run("should return the denied users of the configuration file and of the entries that have not expired", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		g.Expect(aclConfig.GetDeniedUsers()).To(gomega.Equal(DeniedUsers{"user1", "user2", "user3"}))
+	})
+}
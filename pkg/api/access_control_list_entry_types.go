@@ -0,0 +1,55 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AccessControlListEntryType string
+
+const (
+	// AccessControlListEntryTypeDeniedUser is the type of the entries denying a user the access to the service
+	AccessControlListEntryTypeDeniedUser AccessControlListEntryType = "denied_user"
+	// AccessControlListEntryTypeAcceptedOrganisation is the type of the entries accepting an organisation when the access list is enabled
+	AccessControlListEntryTypeAcceptedOrganisation AccessControlListEntryType = "accepted_organisation"
+)
+
+func (t AccessControlListEntryType) String() string {
+	return string(t)
+}
+
+// AccessControlListEntryTypes are all the types of access control list entries
+var AccessControlListEntryTypes = []AccessControlListEntryType{
+	AccessControlListEntryTypeDeniedUser,
+	AccessControlListEntryTypeAcceptedOrganisation,
+}
+
+// AccessControlListEntry is an entry of the deny list or of the access list managed through the admin API, in addition to the
+// ones of the configuration files
+type AccessControlListEntry struct {
+	Meta
+	Type AccessControlListEntryType
+	// Value is the username of the denied user or the id of the accepted organisation
+	Value string
+	// Reason explains why the entry has been added, e.g. the incident a user is denied for
+	Reason string
+	// ExpiresAt is when the entry stops being enforced. The entry never expires when it is not set
+	ExpiresAt *time.Time
+	// CreatedBy is the username of the administrator who added the entry
+	CreatedBy string
+}
+
+type AccessControlListEntryList []*AccessControlListEntry
+
+func (entry *AccessControlListEntry) BeforeCreate(tx *gorm.DB) error {
+	if entry.ID == "" {
+		entry.ID = NewID()
+	}
+	return nil
+}
+
+// HasExpired returns whether the entry has stopped being enforced at the given time
+func (entry *AccessControlListEntry) HasExpired(now time.Time) bool {
+	return entry.ExpiresAt != nil && !now.Before(*entry.ExpiresAt)
+}
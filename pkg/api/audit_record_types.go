@@ -0,0 +1,41 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// AuditRecord records a call to an audited endpoint of the API: who made it, what it asked for on which resource and its outcome
+type AuditRecord struct {
+	Meta
+	// Actor is the username of the caller
+	Actor string
+	// OrgID is the organisation of the caller, if any
+	OrgID string
+	// Roles are the realm roles of the caller, as a JSON array
+	Roles JSON
+	// Action is the type of the log event of the route that was called, or the method of the request when the route has no name
+	Action       string
+	Method       string
+	RequestURI   string
+	RemoteAddr   string
+	ResourceType string
+	ResourceID   string
+	// RequestDiff is the JSON body of the request, i.e. the changes asked for by the caller
+	RequestDiff JSON
+	StatusCode  int
+	// OperationID is the id of the operation returned to the caller, which correlates the record with the logs of the request
+	OperationID string
+	// WebhookPending is true until the record has been posted to the audit records webhook, when one is configured
+	WebhookPending bool
+	// WebhookAttempts is the number of attempts to post the record to the audit records webhook
+	WebhookAttempts int
+}
+
+type AuditRecordList []*AuditRecord
+
+func (record *AuditRecord) BeforeCreate(tx *gorm.DB) error {
+	if record.ID == "" {
+		record.ID = NewID()
+	}
+	return nil
+}
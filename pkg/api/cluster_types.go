@@ -79,6 +79,8 @@ func (p *ClusterProviderType) UnmarshalYAML(unmarshal func(interface{}) error) e
 		*p = ClusterProviderAwsEKS
 	case ClusterProviderStandalone.String():
 		*p = ClusterProviderStandalone
+	case ClusterProviderKubernetes.String():
+		*p = ClusterProviderKubernetes
 	default:
 		return errors.Errorf("invalid value %s", s)
 	}
@@ -108,6 +110,8 @@ const (
 	ClusterProviderOCM        ClusterProviderType = "ocm"
 	ClusterProviderAwsEKS     ClusterProviderType = "aws_eks"
 	ClusterProviderStandalone ClusterProviderType = "standalone"
+	// ClusterProviderKubernetes is used for conformant Kubernetes clusters reached through a kubeconfig or a service account token
+	ClusterProviderKubernetes ClusterProviderType = "kubernetes"
 
 	EnterpriseDataPlaneClusterType DataPlaneClusterType = "enterprise"
 	ManagedDataPlaneClusterType    DataPlaneClusterType = "managed"
@@ -180,6 +184,11 @@ type Cluster struct {
 
 	// AccessKafkasViaPrivateNetwork indicates whether Kafkas deployed on this OSD cluster have to be accessed via private network
 	AccessKafkasViaPrivateNetwork bool `json:"access_kafkas_via_private_network"`
+
+	// Cordoned indicates that no new kafkas can be placed on this cluster. Kafkas already placed on it are not affected
+	Cordoned bool `json:"cordoned"`
+	// Draining indicates that the cluster is cordoned in order to be removed once all its kafkas have been migrated or deleted
+	Draining bool `json:"draining"`
 }
 
 type ClusterList []*Cluster
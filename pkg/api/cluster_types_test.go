@@ -966,6 +966,11 @@ func Test_ClusterProviderType_String(t *testing.T) {
 			providerType: ClusterProviderOCM,
 			want:         "ocm",
 		},
+		{
+			name:         "returns kubernetes cluster provider type string",
+			providerType: ClusterProviderKubernetes,
+			want:         "kubernetes",
+		},
 	}
 
 	for _, testcase := range tests {
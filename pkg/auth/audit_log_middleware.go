@@ -1,40 +1,87 @@
 package auth
 
 import (
-	"fmt"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server/logging"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
-	"io"
-	"net/http"
+	"github.com/gorilla/mux"
+	pkgerrors "github.com/pkg/errors"
 )
 
+// maxAuditedRequestBodySize is the maximum size of a request body recorded as the request diff of an audit record.
+// Larger bodies are still passed to the handlers, but are not recorded
+const maxAuditedRequestBodySize = 64 * 1024
+
+// AuditSink receives the audit records of the calls to the audited endpoints
+//
+//go:generate moq -out audit_sink_moq.go . AuditSink
+type AuditSink interface {
+	// Record records the audit record. It is called on the request path once the response has been written, so an error does
+	// not fail the call but the sink should not make the request wait on remote calls
+	Record(ctx context.Context, record *api.AuditRecord) error
+}
+
 type AuditLogMiddleware interface {
+	// AuditLog records every call to the endpoints
 	AuditLog(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
+	// AuditLogChanges only records the calls that may change a resource, i.e. all but the GET, HEAD and OPTIONS requests
+	AuditLogChanges(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler
 }
 
 type auditInfo struct {
-	Type               string        `json:"type"`
-	Username           string        `json:"username"`
-	Method             string        `json:"request_method,omitempty"`
-	RequestURI         string        `json:"request_url,omitempty"`
-	Body               io.ReadCloser `json:"request_body,omitempty"`
-	RemoteAddr         string        `json:"request_remote_ip,omitempty"`
-	ResponseStatusCode int           `json:"response_status_code,omitempty"`
+	Type               string   `json:"type"`
+	Username           string   `json:"username"`
+	OrgID              string   `json:"org_id,omitempty"`
+	Roles              []string `json:"roles,omitempty"`
+	Action             string   `json:"action,omitempty"`
+	Method             string   `json:"request_method,omitempty"`
+	RequestURI         string   `json:"request_url,omitempty"`
+	RemoteAddr         string   `json:"request_remote_ip,omitempty"`
+	ResourceType       string   `json:"resource_type,omitempty"`
+	ResourceID         string   `json:"resource_id,omitempty"`
+	RequestDiff        api.JSON `json:"request_diff,omitempty"`
+	ResponseStatusCode int      `json:"response_status_code,omitempty"`
 }
 
 type auditLogMiddleware struct {
+	sinks []AuditSink
 }
 
 var _ AuditLogMiddleware = &auditLogMiddleware{}
 
-func NewAuditLogMiddleware() AuditLogMiddleware {
-	return &auditLogMiddleware{}
+// NewAuditLogMiddleware returns a middleware logging the audit records, and passing them to the given sinks
+func NewAuditLogMiddleware(sinks ...AuditSink) AuditLogMiddleware {
+	return &auditLogMiddleware{
+		sinks: sinks,
+	}
 }
 
 func (a *auditLogMiddleware) AuditLog(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler {
+	return a.audit(code, true)
+}
+
+func (a *auditLogMiddleware) AuditLogChanges(code errors.ServiceErrorCode) func(handler http.Handler) http.Handler {
+	return a.audit(code, false)
+}
+
+func (a *auditLogMiddleware) audit(code errors.ServiceErrorCode, auditReads bool) func(handler http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if !auditReads && isReadOnlyMethod(request.Method) {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
 			ctx := request.Context()
 			claims, err := GetClaimsFromContext(ctx)
 			serviceErr := errors.New(code, "")
@@ -42,34 +89,136 @@ func (a *auditLogMiddleware) AuditLog(code errors.ServiceErrorCode) func(handler
 				shared.HandleError(request, writer, serviceErr)
 				return
 			}
+
+			requestDiff, err := readRequestDiff(request)
+			if err != nil {
+				shared.HandleError(request, writer, errors.GeneralError("unable to read the request body"))
+				return
+			}
+
 			username, _ := claims.GetUsername()
+			orgID, _ := claims.GetOrgId()
+			roles := getRealmRolesClaim(claims)
+			resourceType, resourceID := auditedResource(request)
 			info := auditInfo{
-				Type:       "audit",
-				Username:   username,
-				Method:     request.Method,
-				RequestURI: request.RequestURI,
-				Body:       request.Body,
-				RemoteAddr: request.RemoteAddr,
+				Type:         "audit",
+				Username:     username,
+				OrgID:        orgID,
+				Roles:        roles,
+				Action:       auditedAction(request),
+				Method:       request.Method,
+				RequestURI:   request.RequestURI,
+				RemoteAddr:   request.RemoteAddr,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				RequestDiff:  requestDiff,
 			}
+
 			logWriter := logging.NewLoggingWriter(writer, request, logging.NewJSONLogFormatter())
-			err = logWriter.LogObject(info, nil)
-			if err != nil {
-				shared.HandleError(request, writer, serviceErr)
-				return
-			}
 			next.ServeHTTP(logWriter, request)
-			statusCode := logWriter.GetResponseStatusCode()
-			info = auditInfo{
-				Type:               "audit",
-				ResponseStatusCode: statusCode,
+
+			info.ResponseStatusCode = logWriter.GetResponseStatusCode()
+			if info.ResponseStatusCode == 0 {
+				// the handler wrote the body without writing the header first
+				info.ResponseStatusCode = http.StatusOK
 			}
-			// the logger will also add the operationId prefix to each of the log message, which can then be used to associated the two log messages together
-			err = logWriter.LogObject(info, nil)
-			if err != nil {
-				// response is already returned, just log the error if there is any
-				logWriter.Log(fmt.Sprintf("failed to log object %v", info), err)
+
+			// the response is already returned, just log the errors if there is any
+			ulog := logger.NewUHCLogger(ctx)
+			if err := logWriter.LogObject(info, nil); err != nil {
+				ulog.Error(pkgerrors.Wrapf(err, "failed to log audit info %v", info))
+			}
+
+			if len(a.sinks) == 0 {
 				return
 			}
+			record := newAuditRecord(info, logger.GetOperationID(ctx))
+			for _, sink := range a.sinks {
+				if err := sink.Record(ctx, record); err != nil {
+					ulog.Error(pkgerrors.Wrapf(err, "failed to record the audit record of %s %s", request.Method, request.RequestURI))
+				}
+			}
 		})
 	}
 }
+
+func newAuditRecord(info auditInfo, operationID string) *api.AuditRecord {
+	roles, _ := json.Marshal(info.Roles)
+	return &api.AuditRecord{
+		Meta: api.Meta{
+			ID:        api.NewID(),
+			CreatedAt: time.Now(),
+		},
+		Actor:        info.Username,
+		OrgID:        info.OrgID,
+		Roles:        roles,
+		Action:       info.Action,
+		Method:       info.Method,
+		RequestURI:   info.RequestURI,
+		RemoteAddr:   info.RemoteAddr,
+		ResourceType: info.ResourceType,
+		ResourceID:   info.ResourceID,
+		RequestDiff:  info.RequestDiff,
+		StatusCode:   info.ResponseStatusCode,
+		OperationID:  operationID,
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// readRequestDiff returns the body of the request when it is a JSON document of at most maxAuditedRequestBodySize bytes.
+// The body can still be read in full by the handler afterwards
+func readRequestDiff(request *http.Request) (api.JSON, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(request.Body, maxAuditedRequestBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), request.Body), request.Body}
+
+	if len(body) > maxAuditedRequestBodySize || !json.Valid(body) {
+		return nil, nil
+	}
+	return api.JSON(body), nil
+}
+
+// auditedAction returns the type of the log event naming the route of the request, or the method of the request when the route
+// has no name
+func auditedAction(request *http.Request) string {
+	if route := mux.CurrentRoute(request); route != nil && route.GetName() != "" {
+		return logger.NewLogEventFromString(route.GetName()).Type
+	}
+	return request.Method
+}
+
+// auditedResource returns the type and the id of the resource targeted by the request, from the path template of its route.
+// The id is the value of the first variable of the path and the type is the segment preceding it, e.g. "kafkas" and the id of
+// the kafka for /api/kafkas_mgmt/v1/admin/kafkas/{id}/migrate. When the path has no variable, the type is its last segment
+// and there is no id, e.g. "kafkas" for /api/kafkas_mgmt/v1/admin/kafkas
+func auditedResource(request *http.Request) (string, string) {
+	route := mux.CurrentRoute(request)
+	if route == nil {
+		return "", ""
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return "", ""
+	}
+
+	segments := strings.Split(strings.Trim(template, "/"), "/")
+	for i, segment := range segments {
+		if i > 0 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := strings.SplitN(strings.Trim(segment, "{}"), ":", 2)[0]
+			return segments[i-1], mux.Vars(request)[name]
+		}
+	}
+	return segments[len(segments)-1], ""
+}
@@ -1,13 +1,20 @@
 package auth
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
 	"github.com/onsi/gomega"
 )
 
@@ -58,3 +65,139 @@ func TestAuditLogMiddleware_AuditLog(t *testing.T) {
 		})
 	}
 }
+
+func TestAuditLogMiddleware_Sinks(t *testing.T) {
+	token := &jwt.Token{Claims: jwt.MapClaims{
+		"username": "test-user",
+		"org_id":   "test-org",
+		"realm_access": map[string]interface{}{
+			"roles": []interface{}{"kas-fleet-manager-admin-full"},
+		},
+	}}
+
+	tests := []struct {
+		name        string
+		changesOnly bool
+		method      string
+		path        string
+		body        string
+		wantCode    int
+		wantRecord  *api.AuditRecord
+	}{
+		{
+			name:     "should record a change of a resource with the changes asked for",
+			method:   http.MethodPatch,
+			path:     "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+			body:     `{"reauthentication_enabled":false}`,
+			wantCode: http.StatusAccepted,
+			wantRecord: &api.AuditRecord{
+				Actor:        "test-user",
+				OrgID:        "test-org",
+				Roles:        api.JSON(`["kas-fleet-manager-admin-full"]`),
+				Action:       "admin-update-kafka",
+				Method:       http.MethodPatch,
+				RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+				RemoteAddr:   "192.0.2.1:1234",
+				ResourceType: "kafkas",
+				ResourceID:   "kafka-id",
+				RequestDiff:  api.JSON(`{"reauthentication_enabled":false}`),
+				StatusCode:   http.StatusAccepted,
+			},
+		},
+		{
+			name:     "should record a read of a collection without resource id nor request diff",
+			method:   http.MethodGet,
+			path:     "/api/kafkas_mgmt/v1/admin/kafkas",
+			wantCode: http.StatusOK,
+			wantRecord: &api.AuditRecord{
+				Actor:        "test-user",
+				OrgID:        "test-org",
+				Roles:        api.JSON(`["kas-fleet-manager-admin-full"]`),
+				Action:       "admin-list-kafkas",
+				Method:       http.MethodGet,
+				RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas",
+				RemoteAddr:   "192.0.2.1:1234",
+				ResourceType: "kafkas",
+				StatusCode:   http.StatusOK,
+			},
+		},
+		{
+			name:     "should not record a request body that is not JSON",
+			method:   http.MethodPatch,
+			path:     "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+			body:     "not json",
+			wantCode: http.StatusAccepted,
+			wantRecord: &api.AuditRecord{
+				Actor:        "test-user",
+				OrgID:        "test-org",
+				Roles:        api.JSON(`["kas-fleet-manager-admin-full"]`),
+				Action:       "admin-update-kafka",
+				Method:       http.MethodPatch,
+				RequestURI:   "/api/kafkas_mgmt/v1/admin/kafkas/kafka-id",
+				RemoteAddr:   "192.0.2.1:1234",
+				ResourceType: "kafkas",
+				ResourceID:   "kafka-id",
+				StatusCode:   http.StatusAccepted,
+			},
+		},
+		{
+			name:        "should not record a read when only the changes are audited",
+			changesOnly: true,
+			method:      http.MethodGet,
+			path:        "/api/kafkas_mgmt/v1/admin/kafkas",
+			wantCode:    http.StatusOK,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			sink := &AuditSinkMock{
+				RecordFunc: func(ctx context.Context, record *api.AuditRecord) error {
+					return fmt.Errorf("a failing sink does not fail the call")
+				},
+			}
+			auditLogMW := NewAuditLogMiddleware(sink)
+			auditLog := auditLogMW.AuditLog(errors.ErrorNotFound)
+			if tt.changesOnly {
+				auditLog = auditLogMW.AuditLogChanges(errors.ErrorNotFound)
+			}
+
+			router := mux.NewRouter()
+			adminRouter := router.PathPrefix("/api/kafkas_mgmt/v1/admin").Subrouter()
+			adminRouter.Use(func(next http.Handler) http.Handler {
+				return setContextToken(next, token)
+			})
+			adminRouter.Use(auditLog)
+			adminRouter.HandleFunc("/kafkas", func(writer http.ResponseWriter, request *http.Request) {
+				_, _ = writer.Write([]byte("{}"))
+			}).Name(logger.NewLogEvent("admin-list-kafkas", "[admin] list all kafkas").ToString()).Methods(http.MethodGet)
+			adminRouter.HandleFunc("/kafkas/{id}", func(writer http.ResponseWriter, request *http.Request) {
+				// the handler still reads the whole body
+				body, _ := io.ReadAll(request.Body)
+				g.Expect(string(body)).To(gomega.Equal(tt.body))
+				shared.WriteJSONResponse(writer, http.StatusAccepted, "")
+			}).Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).Methods(http.MethodPatch)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.RemoteAddr = "192.0.2.1:1234"
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+			resp := recorder.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantCode))
+			_ = resp.Body.Close()
+
+			if tt.wantRecord == nil {
+				g.Expect(sink.RecordCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(sink.RecordCalls()).To(gomega.HaveLen(1))
+			record := sink.RecordCalls()[0].Record
+			g.Expect(record.ID).ToNot(gomega.BeEmpty())
+			g.Expect(record.CreatedAt).ToNot(gomega.BeZero())
+			record.Meta = api.Meta{}
+			g.Expect(record).To(gomega.Equal(tt.wantRecord))
+		})
+	}
+}
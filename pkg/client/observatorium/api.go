@@ -3,12 +3,14 @@ package observatorium
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
 	"github.com/golang/glog"
 
 	"github.com/pkg/errors"
+	pModel "github.com/prometheus/common/model"
 )
 
 const privateTopicFilter string = "topic!~'__redhat_.*|__consumer_offsets|__transaction_state'"
@@ -16,6 +18,9 @@ const privateTopicFilter string = "topic!~'__redhat_.*|__consumer_offsets|__tran
 type APIObservatoriumService interface {
 	GetKafkaState(name string, namespaceName string) (KafkaState, error)
 	GetMetrics(csMetrics *KafkaMetrics, resourceNamespace string, rq *MetricsReqParams) error
+	// GetKafkaTraffic returns the bytes produced to and consumed from the topics of the users of the kafka in the given namespace
+	// during the given period
+	GetKafkaTraffic(namespace string, period time.Duration) (KafkaTraffic, error)
 }
 type fetcher struct {
 	metric string
@@ -53,6 +58,23 @@ func (obs *ServiceObservatorium) GetKafkaState(name string, resourceNamespace st
 	return KafkaState, nil
 }
 
+func (obs *ServiceObservatorium) GetKafkaTraffic(namespace string, period time.Duration) (KafkaTraffic, error) {
+	traffic := KafkaTraffic{}
+	query := fmt.Sprintf(`sum(increase({__name__=~'kafka_server_brokertopicmetrics_bytes_in_total|kafka_server_brokertopicmetrics_bytes_out_total', strimzi_io_kind=~'Kafka', %s, namespace=~'%s'}[%s]))`,
+		privateTopicFilter, namespace, pModel.Duration(period))
+	result := obs.client.QueryRaw(query)
+	if result.Err != nil {
+		return traffic, result.Err
+	}
+
+	// the sum has no sample when none of the metrics has been reported during the period
+	for _, s := range result.Vector {
+		traffic.Reported = true
+		traffic.Bytes += float64(s.Value)
+	}
+	return traffic, nil
+}
+
 // buildQueries takes a list of requested metrics and a list of filters and computes the minimum number of queries
 // to run. We need to run one query per label selector, but multiple metrics can share the same label selector.
 func (obs *ServiceObservatorium) buildQueries(fetchers []fetcher, rq *MetricsReqParams) []string {
@@ -212,6 +234,11 @@ func (obs *ServiceObservatorium) GetMetrics(metrics *KafkaMetrics, namespace str
 			`kafka_instance_connection_creation_rate_limit`,
 			fmt.Sprintf(`namespace=~'%s'`, namespace),
 		},
+		//Check metrics for the lag of the consumer groups per partition
+		{
+			`kafka_consumergroup_lag`,
+			fmt.Sprintf(`%s, consumergroup!~'__redhat_.*', namespace=~'%s'`, privateTopicFilter, namespace),
+		},
 	}
 
 	// build query per label to reduce query count
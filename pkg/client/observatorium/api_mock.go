@@ -216,6 +216,14 @@ func fakeMetricData(name string, value int) *pModel.SampleStream {
 
 var queryData = map[string]pModel.Vector{
 
+	"sum(increase({__name__=~'kafka_server_brokertopicmetrics_bytes_in_total|kafka_server_brokertopicmetrics_bytes_out_total'": pModel.Vector{
+		&pModel.Sample{
+			Metric:    pModel.Metric{},
+			Timestamp: pModel.Time(1607506882175),
+			Value:     446368,
+		},
+	},
+
 	"strimzi_resource_state": pModel.Vector{
 		&pModel.Sample{
 			Metric: pModel.Metric{
@@ -2,6 +2,7 @@ package observatorium
 
 import (
 	"testing"
+	"time"
 
 	"github.com/onsi/gomega"
 )
@@ -55,6 +56,21 @@ func Test_GetKafkaState(t *testing.T) {
 	}
 }
 
+func TestServiceObservatorium_GetKafkaTraffic(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	obsClientMock, err := NewClientMock(&Configuration{})
+	g.Expect(err).ToNot(gomega.HaveOccurred(), "failed to create a mock observatorium client")
+
+	obs := &ServiceObservatorium{
+		client: obsClientMock,
+	}
+
+	traffic, err := obs.GetKafkaTraffic("kafka-test", 24*time.Hour)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(traffic).To(gomega.Equal(KafkaTraffic{Reported: true, Bytes: 446368}))
+}
+
 func TestServiceObservatorium_GetMetrics(t *testing.T) {
 	g := gomega.NewWithT(t)
 	type fields struct {
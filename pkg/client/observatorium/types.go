@@ -21,6 +21,14 @@ type KafkaState struct {
 	State State `json:",omitempty"`
 }
 
+// KafkaTraffic is the produce and consume traffic of a kafka during a period
+type KafkaTraffic struct {
+	// Reported is false when the traffic metrics of the kafka have not been reported during the period, e.g. when it was not running.
+	// The traffic of the kafka is unknown in that case
+	Reported bool
+	Bytes    float64
+}
+
 type KafkaMetrics []Metric
 
 // Metric holds the Prometheus Matrix or Vector model, which contains instant vector or range vector with time series (depending on result type)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/compat"
 
@@ -35,6 +37,14 @@ type EventStream struct {
 	Close        func()
 }
 
+// VersionedResult is the result of an action of a HandleGet whose version is returned in the ETag header of the response.
+// A nil Result tells that the resource did not change since the version in the If-None-Match header of the request,
+// the response is then a 304 Not Modified
+type VersionedResult struct {
+	Result  interface{}
+	Version string
+}
+
 type Validate func() *errors.ServiceError
 type ErrorHandlerFunc func(r *http.Request, w http.ResponseWriter, err *errors.ServiceError)
 type HttpAction func() (interface{}, *errors.ServiceError)
@@ -136,13 +146,34 @@ func HandleGet(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 	}
 
 	result, serviceErr := cfg.Action()
-	switch {
-	case serviceErr == nil:
-		shared.WriteJSONResponse(w, http.StatusOK, result)
-		success(r)
-	default:
+	if serviceErr != nil {
 		errorHandler(r, w, cfg, serviceErr)
+		return
 	}
+
+	if versioned, ok := result.(VersionedResult); ok {
+		if versioned.Version != "" {
+			w.Header().Set("ETag", strconv.Quote(versioned.Version))
+		}
+		if versioned.Result == nil {
+			w.Header().Set("Vary", "Authorization")
+			w.WriteHeader(http.StatusNotModified)
+			success(r)
+			return
+		}
+		result = versioned.Result
+	}
+
+	shared.WriteJSONResponse(w, http.StatusOK, result)
+	success(r)
+}
+
+// IfNoneMatch returns the first version of the If-None-Match header of the request, without its quotes and weak validator prefix.
+// It returns an empty string when the header is not set
+func IfNoneMatch(r *http.Request) string {
+	version, _, _ := strings.Cut(r.Header.Get("If-None-Match"), ",")
+	version = strings.TrimPrefix(strings.TrimSpace(version), "W/")
+	return strings.Trim(version, `"`)
 }
 
 func HandleList(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
@@ -319,6 +319,82 @@ func Test_HandleGet(t *testing.T) {
 	}
 }
 
+func Test_HandleGet_VersionedResult(t *testing.T) {
+	tests := []struct {
+		name           string
+		result         VersionedResult
+		wantStatusCode int
+		wantETag       string
+	}{
+		{
+			name:           "should return the result along with its version",
+			result:         VersionedResult{Result: map[string]string{"kind": "Test"}, Version: "1-abc"},
+			wantStatusCode: http.StatusOK,
+			wantETag:       `"1-abc"`,
+		},
+		{
+			name:           "should return a 304 Not Modified when there is no result",
+			result:         VersionedResult{Version: "2-abc"},
+			wantStatusCode: http.StatusNotModified,
+			wantETag:       `"2-abc"`,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
+			HandleGet(rw, req, &HandlerConfig{
+				Action: func() (interface{}, *errors.ServiceError) {
+					return tt.result, nil
+				},
+			})
+			g.Expect(rw.Code).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(rw.Header().Get("ETag")).To(gomega.Equal(tt.wantETag))
+			g.Expect(rw.Body.Len() > 0).To(gomega.Equal(tt.result.Result != nil))
+		})
+	}
+}
+
+func Test_IfNoneMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{
+			name:   "should return an empty version when the header is not set",
+			header: "",
+			want:   "",
+		},
+		{
+			name:   "should return the version without its quotes",
+			header: `"1-abc"`,
+			want:   "1-abc",
+		},
+		{
+			name:   "should return the first version without its weak validator prefix",
+			header: `W/"1-abc", "2-abc"`,
+			want:   "1-abc",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			req, _ := GetHandlerParams("GET", "/{id}", nil, t)
+			if tt.header != "" {
+				req.Header.Set("If-None-Match", tt.header)
+			}
+			g.Expect(IfNoneMatch(req)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func Test_HandleList(t *testing.T) {
 	req, rw := GetHandlerParams("GET", "/", nil, t)
 	type args struct {
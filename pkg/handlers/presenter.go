@@ -1,9 +1,18 @@
 package handlers
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/compat"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 )
 
+// referenceFields are always presented when only some of the fields of an object are selected
+var referenceFields = []string{"id", "kind", "href"}
+
 func PresentReferenceWith(id, obj interface{}, ObjectKind func(i interface{}) string, ObjectPath func(id string, obj interface{}) string) compat.ObjectReference {
 	refId, ok := MakeReferenceId(id)
 
@@ -33,3 +42,51 @@ func MakeReferenceId(id interface{}) (string, bool) {
 
 	return refId, refId != ""
 }
+
+// GetJSONFieldNames returns the names of the fields of the object once marshalled to JSON
+func GetJSONFieldNames(obj interface{}) []string {
+	var names []string
+	objType := reflect.TypeOf(obj)
+	for i := 0; i < objType.NumField(); i++ {
+		name := strings.Split(objType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// PresentListWithFields presents the items of the list with only the given fields, along with their id, kind and href.
+// The list is returned unchanged when no field is given. The items are expected to be read with only the columns of the
+// given fields: the other fields are removed here as they would be presented with their zero value, or computed anyway
+func PresentListWithFields(list interface{}, fields []string) (interface{}, *errors.ServiceError) {
+	if len(fields) == 0 {
+		return list, nil
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present the selected fields")
+	}
+	var presented map[string]interface{}
+	if err := json.Unmarshal(b, &presented); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present the selected fields")
+	}
+
+	items, _ := presented["items"].([]interface{})
+	for i, item := range items {
+		itemFields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		selected := map[string]interface{}{}
+		for name, value := range itemFields {
+			if arrays.Contains(fields, name) || arrays.Contains(referenceFields, name) {
+				selected[name] = value
+			}
+		}
+		items[i] = selected
+	}
+
+	return presented, nil
+}
@@ -86,3 +86,70 @@ func Test_PresentReferenceWith(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetJSONFieldNames(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	type object struct {
+		Id       string `json:"id"`
+		Name     string `json:"name,omitempty"`
+		Internal string `json:"-"`
+		Ignored  string
+	}
+
+	g.Expect(GetJSONFieldNames(object{})).To(gomega.Equal([]string{"id", "name"}))
+}
+
+func Test_PresentListWithFields(t *testing.T) {
+	type item struct {
+		Id     string `json:"id"`
+		Kind   string `json:"kind"`
+		Href   string `json:"href"`
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Region string `json:"region"`
+	}
+	type list struct {
+		Kind  string `json:"kind"`
+		Total int    `json:"total"`
+		Items []item `json:"items"`
+	}
+
+	l := list{
+		Kind:  "ItemList",
+		Total: 1,
+		Items: []item{{Id: "1", Kind: "Item", Href: "/items/1", Name: "test", Status: "ready", Region: "us-east-1"}},
+	}
+
+	tests := []struct {
+		name   string
+		fields []string
+		want   interface{}
+	}{
+		{
+			name: "should present the list unchanged when no field is selected",
+			want: l,
+		},
+		{
+			name:   "should present the selected fields along with the reference of the items",
+			fields: []string{"name", "status"},
+			want: map[string]interface{}{
+				"kind":  "ItemList",
+				"total": float64(1),
+				"items": []interface{}{
+					map[string]interface{}{"id": "1", "kind": "Item", "href": "/items/1", "name": "test", "status": "ready"},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			presented, err := PresentListWithFields(l, tt.fields)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(presented).To(gomega.Equal(tt.want))
+		})
+	}
+}
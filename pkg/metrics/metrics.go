@@ -36,6 +36,9 @@ const (
 	KafkaRequestsStatusCount        = "kafka_requests_status_count"
 	KafkaRequestsCurrentStatusInfo  = "kafka_requests_current_status_info"
 
+	// KafkaStatusEventsDeadLetteredCount - name of the metric for the kafka status events that are no longer published
+	KafkaStatusEventsDeadLetteredCount = "kafka_status_events_dead_lettered_count"
+
 	// ClusterOperationsSuccessCount - name of the metric for cluster-related successful operations
 	ClusterOperationsSuccessCount = "cluster_operations_success_count"
 	// ClusterOperationsTotalCount - name of the metric for all cluster-related operations
@@ -83,6 +86,9 @@ const (
 	// PrewarmingStatusInfoCount - metric name for the total number of prewarmed instances per cluster_id, status and instance type.
 	PrewarmingStatusInfoCount = "prewarmed_kafka_instances"
 
+	// ClusterDrainKafkaInstances - metric name for the number of kafka instances still deployed on a draining cluster per cluster_id and drain readiness.
+	ClusterDrainKafkaInstances = "cluster_drain_kafka_instances"
+
 	LabelStatusCode = "code"
 	LabelMethod     = "method"
 	LabelPath       = "path"
@@ -100,6 +106,9 @@ const (
 	prewarmingStatusLabel       = "status"
 	prewarmingInstanceTypeLabel = "instance_type"
 	prewarmingClusterIDLabel    = "cluster_id"
+
+	// cluster drain metric labels
+	clusterDrainReadinessLabel = "readiness"
 )
 
 // JobType metric to capture
@@ -194,6 +203,11 @@ var prewarmingMetricLabels = []string{
 	prewarmingStatusLabel,
 }
 
+var clusterDrainMetricLabels = []string{
+	LabelClusterID,
+	clusterDrainReadinessLabel,
+}
+
 // #### Metrics for Dataplane clusters - Start ####
 // create a new histogramVec for cluster creation duration
 var requestClusterCreationDurationMetric = prometheus.NewHistogramVec(
@@ -556,6 +570,24 @@ func IncreaseKafkaTotalOperationsCountMetric(operation constants.KafkaOperation)
 	kafkaOperationsTotalCountMetric.With(labels).Inc()
 }
 
+// create a new counterVec for the kafka status events that failed to be published too many times
+var kafkaStatusEventsDeadLetteredCountMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaStatusEventsDeadLetteredCount,
+		Help:      "number of kafka status events that are no longer published as they failed to be published too many times",
+	},
+	[]string{LabelID},
+)
+
+// IncreaseKafkaStatusEventsDeadLetteredCountMetric - increase counter for the kafkaStatusEventsDeadLetteredCountMetric
+func IncreaseKafkaStatusEventsDeadLetteredCountMetric(kafkaID string) {
+	labels := prometheus.Labels{
+		LabelID: kafkaID,
+	}
+	kafkaStatusEventsDeadLetteredCountMetric.With(labels).Inc()
+}
+
 // #### Metrics for Kafkas - End ####
 
 // #### Metrics for Reconcilers - Start ####
@@ -809,6 +841,31 @@ func UpdateClusterPrewarmingStatusInfoCountMetric(prewarmingStatusInfo Prewarmin
 	prewarmingStatusInfoCountMetric.With(labels).Set(float64(prewarmingStatusInfo.Count))
 }
 
+// create a new gaugeVec for the number of kafka instances still deployed on a draining cluster per cluster_id and drain readiness.
+var clusterDrainKafkaInstancesMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ClusterDrainKafkaInstances,
+		Help:      "count of kafka instances with a given drain readiness still deployed on the given draining cluster_id.",
+	},
+	clusterDrainMetricLabels,
+)
+
+// UpdateClusterDrainKafkaInstancesMetric - Updates the kas_fleet_manager_cluster_drain_kafka_instances metric.
+func UpdateClusterDrainKafkaInstancesMetric(clusterID string, readiness string, count int) {
+	labels := prometheus.Labels{
+		LabelClusterID:             clusterID,
+		clusterDrainReadinessLabel: readiness,
+	}
+	clusterDrainKafkaInstancesMetric.With(labels).Set(float64(count))
+}
+
+// ResetClusterDrainKafkaInstancesMetric - Resets the kas_fleet_manager_cluster_drain_kafka_instances metric so that clusters
+// that are no longer draining are not reported anymore.
+func ResetClusterDrainKafkaInstancesMetric() {
+	clusterDrainKafkaInstancesMetric.Reset()
+}
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -823,6 +880,7 @@ func init() {
 	prometheus.MustRegister(clusterStatusCapacityAvailableMetric)
 	prometheus.MustRegister(clusterProviderResourceQuotaConsumedMetric)
 	prometheus.MustRegister(prewarmingStatusInfoCountMetric)
+	prometheus.MustRegister(clusterDrainKafkaInstancesMetric)
 	prometheus.MustRegister(clusterProviderResourceQuotaMaxAllowedMetric)
 
 	// metrics for Kafkas
@@ -832,6 +890,7 @@ func init() {
 	prometheus.MustRegister(kafkaStatusSinceCreatedMetric)
 	prometheus.MustRegister(kafkaRequestsCurrentStatusInfoMetric)
 	prometheus.MustRegister(KafkaStatusCountMetric)
+	prometheus.MustRegister(kafkaStatusEventsDeadLetteredCountMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -895,6 +954,7 @@ func Reset() {
 	kafkaPerClusterCountMetric.Reset()
 	clusterStatusCapacityMaxMetric.Reset()
 	prewarmingStatusInfoCountMetric.Reset()
+	clusterDrainKafkaInstancesMetric.Reset()
 	clusterStatusCapacityUsedMetric.Reset()
 	clusterStatusCapacityAvailableMetric.Reset()
 	clusterProviderResourceQuotaConsumedMetric.Reset()
@@ -905,6 +965,7 @@ func Reset() {
 	kafkaOperationsTotalCountMetric.Reset()
 	kafkaStatusSinceCreatedMetric.Reset()
 	KafkaStatusCountMetric.Reset()
+	kafkaStatusEventsDeadLetteredCountMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
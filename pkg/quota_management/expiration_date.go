@@ -55,6 +55,20 @@ func (e *ExpirationDate) UnmarshalYAML(unmarshal func(any) error) error {
 	return nil
 }
 
+// ParseExpirationDate parses an expiration date in the "YYYY-MM-DD {+,-}{HH:MM}" format, e.g. "2022-12-06 +01:00"
+func ParseExpirationDate(value string) (ExpirationDate, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return ExpirationDate{}, err
+	}
+	return ExpirationDate(t), nil
+}
+
+// String formats the expiration date in the "YYYY-MM-DD {+,-}{HH:MM}" format
+func (e ExpirationDate) String() string {
+	return time.Time(e).Format(layout)
+}
+
 func (e *ExpirationDate) HasExpired() bool {
 	if e == nil {
 		// a nil expiration date means 'never expire'
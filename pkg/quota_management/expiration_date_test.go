@@ -204,3 +204,39 @@ func Test_UnmarshalYAML(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseExpirationDate(t *testing.T) {
+
+	cetTimezone, _ := time.LoadLocation("CET")
+
+	tests := []struct {
+		name    string
+		value   string
+		expect  time.Time
+		wantErr bool
+	}{
+		{
+			name:   "Test valid date 01:00 (CET)",
+			value:  "2022-12-06 +01:00",
+			expect: time.Date(2022, 12, 06, 0, 0, 0, 0, cetTimezone),
+		},
+		{
+			name:    "Test invalid date (no TZ)",
+			value:   "2022-12-06",
+			wantErr: true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			dt, err := ParseExpirationDate(tt.value)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr), "Error: %v", err)
+			g.Expect(time.Time(dt)).To(gomega.BeTemporally("==", tt.expect))
+			if !tt.wantErr {
+				g.Expect(dt.String()).To(gomega.Equal(tt.value))
+			}
+		})
+	}
+}
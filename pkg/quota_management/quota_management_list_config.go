@@ -6,18 +6,25 @@ import (
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
 	"os"
+	"time"
 )
 
 type QuotaManagementListConfig struct {
+	// QuotaList is the quota management list read from the configuration file. Its granted quota seeds the quota grants stored in
+	// the database, when none has ever been stored, while the users registered to its organisations keep being read from it
 	QuotaList                  RegisteredUsersListConfiguration
 	QuotaListConfigFile        string
 	EnableInstanceLimitControl bool
+	// QuotaListCacheTTL is how long the quota management list built from the quota grants stored in the database is cached for.
+	// The quota granted through another instance of the fleet manager is seen once it expires
+	QuotaListCacheTTL time.Duration
 }
 
 func NewQuotaManagementListConfig() *QuotaManagementListConfig {
 	return &QuotaManagementListConfig{
 		QuotaListConfigFile:        "config/quota-management-list-configuration.yaml",
 		EnableInstanceLimitControl: false,
+		QuotaListCacheTTL:          30 * time.Second,
 	}
 }
 
@@ -25,6 +32,7 @@ func (c *QuotaManagementListConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.QuotaListConfigFile, "quota-management-list-config-file", c.QuotaListConfigFile, "QuotaList configuration file")
 	fs.IntVar(&MaxAllowedInstances, "max-allowed-instances", MaxAllowedInstances, "Default maximum number of allowed instances that can be created by a user")
 	fs.BoolVar(&c.EnableInstanceLimitControl, "enable-instance-limit-control", c.EnableInstanceLimitControl, "Enable to enforce limits on how much instances a user can create")
+	fs.DurationVar(&c.QuotaListCacheTTL, "quota-management-list-cache-ttl", c.QuotaListCacheTTL, "How long the quota management list built from the quota grants stored in the database is cached for")
 }
 
 func (c *QuotaManagementListConfig) ReadFiles() error {
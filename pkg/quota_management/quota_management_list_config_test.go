@@ -23,6 +23,7 @@ func Test_NewQuotaManagementListConfig(t *testing.T) {
 				},
 				QuotaListConfigFile:        "config/quota-management-list-configuration.yaml",
 				EnableInstanceLimitControl: false,
+				QuotaListCacheTTL:          30 * time.Second,
 			},
 		},
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/state_machine"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/stringscanner"
@@ -11,37 +12,72 @@ import (
 	"github.com/pkg/errors"
 )
 
-var validColumns = []string{"region", "name", "cloud_provider", "status", "owner", "cluster_id"}
+var validColumns = []string{"region", "name", "cloud_provider", "status", "owner", "cluster_id", "instance_type", "created_at", "updated_at"}
+
+// validTimestampColumns are the columns that can be compared with the `<`, `<=`, `>` and `>=` operators
+var validTimestampColumns = []string{"created_at", "updated_at"}
+
+// timestampLayouts are the accepted formats of the values compared to a timestamp column
+var timestampLayouts = []string{time.RFC3339, "2006-01-02"}
 
 const (
-	braceTokenFamily       = "BRACE"
-	opTokenFamily          = "OP"
-	logicalOpTokenFamily   = "LOGICAL"
-	columnTokenFamily      = "COLUMN"
-	valueTokenFamily       = "VALUE"
-	quotedValueTokenFamily = "QUOTED"
-
-	openBrace   = "OPEN_BRACE"
-	closedBrace = "CLOSED_BRACE"
-	column      = "COLUMN"
-	value       = "VALUE"
-	quotedValue = "QUOTED_VALUE"
-	eq          = "EQ"
-	notEq       = "NOT_EQ"
-	like        = "LIKE"
-	ilike       = "ILIKE"
-	and         = "AND"
-	or          = "OR"
+	braceTokenFamily                = "BRACE"
+	listBraceTokenFamily            = "LIST_BRACE"
+	listSeparatorTokenFamily        = "LIST_SEPARATOR"
+	opTokenFamily                   = "OP"
+	comparisonOpTokenFamily         = "COMPARISON"
+	logicalOpTokenFamily            = "LOGICAL"
+	columnTokenFamily               = "COLUMN"
+	labelColumnTokenFamily          = "LABEL_COLUMN"
+	valueTokenFamily                = "VALUE"
+	quotedValueTokenFamily          = "QUOTED"
+	timestampValueTokenFamily       = "TIMESTAMP"
+	quotedTimestampValueTokenFamily = "QUOTED_TIMESTAMP"
+
+	openBrace            = "OPEN_BRACE"
+	closedBrace          = "CLOSED_BRACE"
+	openListBrace        = "OPEN_LIST_BRACE"
+	closedListBrace      = "CLOSED_LIST_BRACE"
+	listSeparator        = "LIST_SEPARATOR"
+	column               = "COLUMN"
+	labelColumn          = "LABEL_COLUMN"
+	value                = "VALUE"
+	quotedValue          = "QUOTED_VALUE"
+	listValue            = "LIST_VALUE"
+	quotedListValue      = "QUOTED_LIST_VALUE"
+	timestampValue       = "TIMESTAMP_VALUE"
+	quotedTimestampValue = "QUOTED_TIMESTAMP_VALUE"
+	eq                   = "EQ"
+	notEq                = "NOT_EQ"
+	lt                   = "LT"
+	lte                  = "LTE"
+	gt                   = "GT"
+	gte                  = "GTE"
+	like                 = "LIKE"
+	ilike                = "ILIKE"
+	in                   = "IN"
+	not                  = "NOT"
+	is                   = "IS"
+	isNot                = "IS_NOT"
+	null                 = "NULL"
+	and                  = "AND"
+	or                   = "OR"
 )
 const MaximumComplexity = 10
 
 type checkUnbalancedBraces func() error
 
 type DBQuery struct {
-	Query        string
-	Values       []interface{}
-	ValidColumns []string
-	ColumnPrefix string
+	Query            string
+	Values           []interface{}
+	ValidColumns     []string
+	TimestampColumns []string
+	ColumnPrefix     string
+	// LabelsColumn is the name of the pseudo column whose `<LabelsColumn>.<key>` sub columns are the values of the labels
+	// with the given key e.g. `labels.env`. The labels can not be searched when it is empty
+	LabelsColumn string
+	// LabelsQuery is the sub query selecting the value of a label. Its only placeholder is bound to the key of the label
+	LabelsQuery string
 }
 
 // QueryParser - This object is to be used to parse and validate WHERE clauses (only portion after the `WHERE` is supported)
@@ -58,31 +94,65 @@ var _ QueryParser = &queryParser{}
 // initStateMachine
 // This will be our grammar (each Token will eat the spaces after the Token itself):
 // Tokens:
-// OPEN_BRACE       = (
-// CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
-// VALUE            = [^ ^(^)]+
-// QUOTED_VALUE     = `'([^']|\\')*'`
-// EQ               = =
-// NOT_EQ           = <>
-// LIKE             = [Ll][Ii][Kk][Ee]
-// ILIKE             = [Ii][Ll][Ii][Kk][Ee]
-// AND              = [Aa][Nn][Dd]
-// OR               = [Oo][Rr]
+// OPEN_BRACE             = (
+// CLOSED_BRACE           = )
+// OPEN_LIST_BRACE        = (
+// CLOSED_LIST_BRACE      = )
+// LIST_SEPARATOR         = ,
+// COLUMN -               = [A-Za-z][A-Za-z0-9_]*
+// LABEL_COLUMN           = [A-Za-z][A-Za-z0-9_]*\.[A-Za-z0-9][-A-Za-z0-9_./]*
+// VALUE                  = [^ ^(^)]+
+// QUOTED_VALUE           = `'([^']|\\')*'`
+// LIST_VALUE             = [^ ^(^)^,]+
+// QUOTED_LIST_VALUE      = `'([^']|\\')*'`
+// TIMESTAMP_VALUE        = [^ ^(^)]+
+// QUOTED_TIMESTAMP_VALUE = `'([^']|\\')*'`
+// EQ                     = =
+// NOT_EQ                 = <>
+// LT                     = <
+// LTE                    = <=
+// GT                     = >
+// GTE                    = >=
+// LIKE                   = [Ll][Ii][Kk][Ee]
+// ILIKE                  = [Ii][Ll][Ii][Kk][Ee]
+// IN                     = [Ii][Nn]
+// NOT                    = [Nn][Oo][Tt]
+// IS                     = [Ii][Ss]
+// IS_NOT                 = [Nn][Oo][Tt]
+// NULL                   = [Nn][Uu][Ll][Ll]
+// AND                    = [Aa][Nn][Dd]
+// OR                     = [Oo][Rr]
 //
 // VALID TRANSITIONS:
-// START        -> COLUMN | OPEN_BRACE
-// OPEN_BRACE   -> OPEN_BRACE | COLUMN
-// COLUMN       -> EQ | NOT_EQ | LIKE | ILIKE
-// EQ           -> VALUE | QUOTED_VALUE
-// NOT_EQ       -> VALUE | QUOTED_VALUE
-// LIKE         -> VALUE | QUOTED_VALUE
-// ILIKE        -> VALUE | QUOTED_VALUE
-// VALUE        -> OR | AND | CLOSED_BRACE | [END]
-// QUOTED_VALUE -> OR | AND | CLOSED_BRACE | [END]
-// CLOSED_BRACE -> OR | AND | CLOSED_BRACE | [END]
-// AND          -> COLUMN | OPEN_BRACE
-// OR           -> COLUMN | OPEN_BRACE
+// START                  -> COLUMN | LABEL_COLUMN | OPEN_BRACE
+// OPEN_BRACE             -> OPEN_BRACE | COLUMN | LABEL_COLUMN
+// COLUMN                 -> EQ | NOT_EQ | LT | LTE | GT | GTE | LIKE | ILIKE | IN | NOT | IS
+// LABEL_COLUMN           -> EQ | NOT_EQ | LIKE | ILIKE | IN | NOT | IS
+// EQ                     -> VALUE | QUOTED_VALUE
+// NOT_EQ                 -> VALUE | QUOTED_VALUE
+// LT                     -> TIMESTAMP_VALUE | QUOTED_TIMESTAMP_VALUE
+// LTE                    -> TIMESTAMP_VALUE | QUOTED_TIMESTAMP_VALUE
+// GT                     -> TIMESTAMP_VALUE | QUOTED_TIMESTAMP_VALUE
+// GTE                    -> TIMESTAMP_VALUE | QUOTED_TIMESTAMP_VALUE
+// LIKE                   -> VALUE | QUOTED_VALUE
+// ILIKE                  -> VALUE | QUOTED_VALUE
+// NOT                    -> LIKE | ILIKE | IN
+// IN                     -> OPEN_LIST_BRACE
+// OPEN_LIST_BRACE        -> LIST_VALUE | QUOTED_LIST_VALUE
+// LIST_VALUE             -> LIST_SEPARATOR | CLOSED_LIST_BRACE
+// QUOTED_LIST_VALUE      -> LIST_SEPARATOR | CLOSED_LIST_BRACE
+// LIST_SEPARATOR         -> LIST_VALUE | QUOTED_LIST_VALUE
+// IS                     -> NULL | IS_NOT
+// IS_NOT                 -> NULL
+// VALUE                  -> OR | AND | CLOSED_BRACE | [END]
+// QUOTED_VALUE           -> OR | AND | CLOSED_BRACE | [END]
+// TIMESTAMP_VALUE        -> OR | AND | CLOSED_BRACE | [END]
+// QUOTED_TIMESTAMP_VALUE -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_LIST_BRACE      -> OR | AND | CLOSED_BRACE | [END]
+// NULL                   -> OR | AND | CLOSED_BRACE | [END]
+// CLOSED_BRACE           -> OR | AND | CLOSED_BRACE | [END]
+// AND                    -> COLUMN | LABEL_COLUMN | OPEN_BRACE
+// OR                     -> COLUMN | LABEL_COLUMN | OPEN_BRACE
 func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedBraces) {
 
 	// counts the number of joins
@@ -105,6 +175,19 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 		return nil
 	}
 
+	// the column the current condition applies to
+	currentColumn := ""
+
+	// the list values are separated by the LIST_SEPARATOR token: the placeholder of the value follows the separator
+	valuePlaceholder := func(tokenName string) string {
+		switch tokenName {
+		case listValue, quotedListValue:
+			return "?"
+		default:
+			return " ?"
+		}
+	}
+
 	onNewToken := func(token *state_machine.ParsedToken) error {
 		switch token.Family {
 		case braceTokenFamily:
@@ -113,19 +196,40 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			}
 			p.dbqry.Query += token.Value
 			return nil
+		case listBraceTokenFamily:
+			if token.Name == openListBrace {
+				p.dbqry.Query += " "
+			}
+			p.dbqry.Query += token.Value
+			return nil
+		case listSeparatorTokenFamily:
+			p.dbqry.Query += token.Value + " "
+			return nil
 		case valueTokenFamily:
-			p.dbqry.Query += " ?"
+			p.dbqry.Query += valuePlaceholder(token.Name)
 			p.dbqry.Values = append(p.dbqry.Values, token.Value)
 			return nil
 		case quotedValueTokenFamily:
+			p.dbqry.Query += valuePlaceholder(token.Name)
+			p.dbqry.Values = append(p.dbqry.Values, unquote(token.Value))
+			return nil
+		case timestampValueTokenFamily, quotedTimestampValueTokenFamily:
+			tmp := token.Value
+			if token.Family == quotedTimestampValueTokenFamily {
+				tmp = unquote(tmp)
+			}
+			timestamp, err := parseTimestamp(tmp)
+			if err != nil {
+				return err
+			}
 			p.dbqry.Query += " ?"
-			// unescape
-			tmp := strings.ReplaceAll(token.Value, `\'`, "'")
-			// remove quotes:
-			if len(tmp) > 1 {
-				tmp = string([]rune(tmp)[1 : len(tmp)-1])
+			p.dbqry.Values = append(p.dbqry.Values, timestamp)
+			return nil
+		case comparisonOpTokenFamily:
+			if !contains(p.dbqry.TimestampColumns, currentColumn) {
+				return fmt.Errorf("operator '%s' is only supported for the timestamp columns: %v", token.Value, p.dbqry.TimestampColumns)
 			}
-			p.dbqry.Values = append(p.dbqry.Values, tmp)
+			p.dbqry.Query += " " + token.Value
 			return nil
 		case logicalOpTokenFamily:
 			complexity++
@@ -138,13 +242,23 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			// we want column names to be lowercase
 			columnName := strings.ToLower(token.Value)
 			if !contains(p.dbqry.ValidColumns, columnName) {
-				return fmt.Errorf("invalid column name: '%s', valid values are: %v", token.Value, p.dbqry.ValidColumns)
+				return fmt.Errorf("invalid column name: '%s', valid values are: %v", token.Value, p.validColumnNames())
 			}
+			currentColumn = columnName
 			if p.dbqry.ColumnPrefix != "" && !strings.HasPrefix(columnName, p.dbqry.ColumnPrefix+".") {
 				columnName = p.dbqry.ColumnPrefix + "." + columnName
 			}
 			p.dbqry.Query += columnName
 			return nil
+		case labelColumnTokenFamily:
+			labelsColumn, key, _ := strings.Cut(token.Value, ".")
+			if p.dbqry.LabelsColumn == "" || strings.ToLower(labelsColumn) != p.dbqry.LabelsColumn {
+				return fmt.Errorf("invalid column name: '%s', valid values are: %v", token.Value, p.validColumnNames())
+			}
+			currentColumn = p.dbqry.LabelsColumn + "." + key
+			p.dbqry.Query += p.dbqry.LabelsQuery
+			p.dbqry.Values = append(p.dbqry.Values, key)
+			return nil
 		default:
 			p.dbqry.Query += " " + token.Value
 			return nil
@@ -156,28 +270,62 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			{Name: openBrace, Family: braceTokenFamily, AcceptPattern: `\(`},
 			{Name: closedBrace, Family: braceTokenFamily, AcceptPattern: `\)`},
 			{Name: column, Family: columnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*`},
+			{Name: labelColumn, Family: labelColumnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*\.[A-Za-z0-9][-A-Za-z0-9_./]*`},
+			{Name: openListBrace, Family: listBraceTokenFamily, AcceptPattern: `\(`},
+			{Name: closedListBrace, Family: listBraceTokenFamily, AcceptPattern: `\)`},
+			{Name: listSeparator, Family: listSeparatorTokenFamily, AcceptPattern: `,`},
 			{Name: value, Family: valueTokenFamily, AcceptPattern: `[^'][^ ^(^)]*`},
 			{Name: quotedValue, Family: quotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
+			{Name: listValue, Family: valueTokenFamily, AcceptPattern: `[^'^,][^ ^(^)^,]*`},
+			{Name: quotedListValue, Family: quotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
+			{Name: timestampValue, Family: timestampValueTokenFamily, AcceptPattern: `[^'][^ ^(^)]*`},
+			{Name: quotedTimestampValue, Family: quotedTimestampValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: eq, Family: opTokenFamily, AcceptPattern: `=`},
 			{Name: notEq, Family: opTokenFamily, AcceptPattern: `<>`},
+			{Name: lt, Family: comparisonOpTokenFamily, AcceptPattern: `<`},
+			{Name: lte, Family: comparisonOpTokenFamily, AcceptPattern: `<=`},
+			{Name: gt, Family: comparisonOpTokenFamily, AcceptPattern: `>`},
+			{Name: gte, Family: comparisonOpTokenFamily, AcceptPattern: `>=`},
 			{Name: like, Family: opTokenFamily, AcceptPattern: `[Ll][Ii][Kk][Ee]`},
 			{Name: ilike, Family: opTokenFamily, AcceptPattern: `[Ii][Ll][Ii][Kk][Ee]`},
+			{Name: in, Family: opTokenFamily, AcceptPattern: `[Ii][Nn]`},
+			{Name: not, Family: opTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: is, Family: opTokenFamily, AcceptPattern: `[Ii][Ss]`},
+			{Name: isNot, Family: opTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: null, Family: opTokenFamily, AcceptPattern: `[Nn][Uu][Ll][Ll]`},
 			{Name: and, Family: logicalOpTokenFamily, AcceptPattern: `[Aa][Nn][Dd]`},
 			{Name: or, Family: logicalOpTokenFamily, AcceptPattern: `[Oo][Rr]`},
 		},
 		Transitions: []state_machine.TokenTransitions{
-			{TokenName: state_machine.StartState, ValidTransitions: []string{column, openBrace}},
-			{TokenName: openBrace, ValidTransitions: []string{column, openBrace}},
-			{TokenName: column, ValidTransitions: []string{eq, notEq, like, ilike}},
+			{TokenName: state_machine.StartState, ValidTransitions: []string{column, labelColumn, openBrace}},
+			{TokenName: openBrace, ValidTransitions: []string{column, labelColumn, openBrace}},
+			{TokenName: column, ValidTransitions: []string{eq, notEq, lt, lte, gt, gte, like, ilike, in, not, is}},
+			{TokenName: labelColumn, ValidTransitions: []string{eq, notEq, like, ilike, in, not, is}},
 			{TokenName: eq, ValidTransitions: []string{quotedValue, value}},
 			{TokenName: notEq, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: lt, ValidTransitions: []string{quotedTimestampValue, timestampValue}},
+			{TokenName: lte, ValidTransitions: []string{quotedTimestampValue, timestampValue}},
+			{TokenName: gt, ValidTransitions: []string{quotedTimestampValue, timestampValue}},
+			{TokenName: gte, ValidTransitions: []string{quotedTimestampValue, timestampValue}},
 			{TokenName: like, ValidTransitions: []string{quotedValue, value}},
 			{TokenName: ilike, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: not, ValidTransitions: []string{like, ilike, in}},
+			{TokenName: in, ValidTransitions: []string{openListBrace}},
+			{TokenName: openListBrace, ValidTransitions: []string{quotedListValue, listValue}},
+			{TokenName: quotedListValue, ValidTransitions: []string{listSeparator, closedListBrace}},
+			{TokenName: listValue, ValidTransitions: []string{listSeparator, closedListBrace}},
+			{TokenName: listSeparator, ValidTransitions: []string{quotedListValue, listValue}},
+			{TokenName: is, ValidTransitions: []string{null, isNot}},
+			{TokenName: isNot, ValidTransitions: []string{null}},
 			{TokenName: quotedValue, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
 			{TokenName: value, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
+			{TokenName: quotedTimestampValue, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
+			{TokenName: timestampValue, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
+			{TokenName: closedListBrace, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
+			{TokenName: null, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
 			{TokenName: closedBrace, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
-			{TokenName: and, ValidTransitions: []string{column, openBrace}},
-			{TokenName: or, ValidTransitions: []string{column, openBrace}},
+			{TokenName: and, ValidTransitions: []string{column, labelColumn, openBrace}},
+			{TokenName: or, ValidTransitions: []string{column, labelColumn, openBrace}},
 		},
 	}
 
@@ -220,6 +368,34 @@ func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 	return &p.dbqry, nil
 }
 
+// validColumnNames returns the names of the columns that can be searched, including the labels pseudo column if any
+func (p *queryParser) validColumnNames() []string {
+	if p.dbqry.LabelsColumn == "" {
+		return p.dbqry.ValidColumns
+	}
+	return append(append([]string{}, p.dbqry.ValidColumns...), p.dbqry.LabelsColumn+".<key>")
+}
+
+// unquote removes the quotes around a quoted value and unescapes the quotes it contains
+func unquote(quoted string) string {
+	// unescape
+	tmp := strings.ReplaceAll(quoted, `\'`, "'")
+	// remove quotes:
+	if len(tmp) > 1 {
+		tmp = string([]rune(tmp)[1 : len(tmp)-1])
+	}
+	return tmp
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if timestamp, err := time.Parse(layout, value); err == nil {
+			return timestamp, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp: '%s', timestamps must be in the RFC3339 format (e.g. 2023-01-02T15:04:05Z) or dates (e.g. 2023-01-02)", value)
+}
+
 func NewQueryParser(columns ...string) QueryParser {
 	return NewQueryParserWithColumnPrefix("", columns...)
 }
@@ -231,6 +407,20 @@ func NewQueryParserWithColumnPrefix(columnsPrefix string, columns ...string) Que
 	} else {
 		query.ValidColumns = columns
 	}
+	for _, validColumn := range query.ValidColumns {
+		if arrays.Contains(validTimestampColumns, validColumn) {
+			query.TimestampColumns = append(query.TimestampColumns, validColumn)
+		}
+	}
 	query.ColumnPrefix = columnsPrefix
 	return &queryParser{dbqry: query}
 }
+
+// NewQueryParserWithLabels returns a parser accepting the `<labelsColumn>.<key>` columns on top of the given ones.
+// Those columns are replaced by the labelsQuery, whose placeholder is bound to the key of the label
+func NewQueryParserWithLabels(labelsColumn string, labelsQuery string, columns ...string) QueryParser {
+	parser := NewQueryParser(columns...).(*queryParser)
+	parser.dbqry.LabelsColumn = labelsColumn
+	parser.dbqry.LabelsQuery = labelsQuery
+	return parser
+}
@@ -2,6 +2,7 @@ package queryparser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/onsi/gomega"
 )
@@ -138,6 +139,106 @@ func Test_QueryParser(t *testing.T) {
 			qryParser: NewQueryParser(),
 			wantErr:   true,
 		},
+		{
+			name:      "Testing IN",
+			qry:       "status in (ready, 'failed', 'deprovision')",
+			qryParser: NewQueryParser(),
+			outQry:    "status in (?, ?, ?)",
+			outValues: []interface{}{"ready", "failed", "deprovision"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing IN without spaces and with quoted commas",
+			qry:       "status in (ready,'fail,ed') and name=test",
+			qryParser: NewQueryParser(),
+			outQry:    "status in (?, ?) and name = ?",
+			outValues: []interface{}{"ready", "fail,ed", "test"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing NOT IN",
+			qry:       "(status NOT IN (ready)) or region = us-east-1",
+			qryParser: NewQueryParser(),
+			outQry:    "(status NOT IN (?)) or region = ?",
+			outValues: []interface{}{"ready", "us-east-1"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing empty IN list",
+			qry:       "status in ()",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing IN list with a trailing separator",
+			qry:       "status in (ready,)",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing NOT LIKE and NOT ILIKE",
+			qry:       "name not like 'test%' and owner NOT ILIKE %ADMIN%",
+			qryParser: NewQueryParser(),
+			outQry:    "name not like ? and owner NOT ILIKE ?",
+			outValues: []interface{}{"test%", "%ADMIN%"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing unquoted commas outside of an IN list",
+			qry:       "name = a,b and owner like %a,b% and status in (ready,failed)",
+			qryParser: NewQueryParser(),
+			outQry:    "name = ? and owner like ? and status in (?, ?)",
+			outValues: []interface{}{"a,b", "%a,b%", "ready", "failed"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing NOT without operator",
+			qry:       "name not 'test'",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing IS NULL and IS NOT NULL",
+			qry:       "cluster_id is null or (region IS NOT NULL and name = test)",
+			qryParser: NewQueryParser(),
+			outQry:    "cluster_id is null or (region IS NOT NULL and name = ?)",
+			outValues: []interface{}{"test"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing IS with a value",
+			qry:       "cluster_id is 'test'",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing timestamp comparisons",
+			qry:       "created_at >= 2023-01-02T15:04:05Z and updated_at < '2023-02-01'",
+			qryParser: NewQueryParser(),
+			outQry:    "created_at >= ? and updated_at < ?",
+			outValues: []interface{}{time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC), time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing timestamp comparisons with column prefix",
+			qry:       "created_at>2023-01-02",
+			qryParser: NewQueryParserWithColumnPrefix("prefix", "name", "created_at"),
+			outQry:    "prefix.created_at > ?",
+			outValues: []interface{}{time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing invalid timestamp",
+			qry:       "created_at > yesterday",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing comparison on a column that is not a timestamp",
+			qry:       "name > test",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
 		{
 			name:      "Parse with column prefix",
 			qry:       "((cloud_provider = Value and name = value1) and (owner <> value2 or region=b ) ) or owner=c or name=e and region LIKE '%test%'",
@@ -146,6 +247,32 @@ func Test_QueryParser(t *testing.T) {
 			outValues: []interface{}{"Value", "value1", "value2", "b", "c", "e", "%test%"},
 			wantErr:   false,
 		},
+		{
+			name:      "Parse with labels",
+			qry:       "labels.env = 'prod' and (labels.example.com/team in (a, b) or name = test)",
+			qryParser: NewQueryParserWithLabels("labels", "(SELECT value FROM labels WHERE key = ?)"),
+			outQry:    "(SELECT value FROM labels WHERE key = ?) = ? and ((SELECT value FROM labels WHERE key = ?) in (?, ?) or name = ?)",
+			outValues: []interface{}{"env", "prod", "example.com/team", "a", "b", "test"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing labels when the labels can not be searched",
+			qry:       "labels.env = 'prod'",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing labels with an unknown labels column",
+			qry:       "tags.env = 'prod'",
+			qryParser: NewQueryParserWithLabels("labels", "(SELECT value FROM labels WHERE key = ?)"),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing timestamp comparison on a label",
+			qry:       "labels.env > 2023-01-02",
+			qryParser: NewQueryParserWithLabels("labels", "(SELECT value FROM labels WHERE key = ?)"),
+			wantErr:   true,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -19,6 +19,7 @@ type ListArguments struct {
 	Preloads []string
 	Search   string
 	OrderBy  []string
+	Fields   []string
 }
 
 // NewListArguments - Create ListArguments from url query parameters with sane defaults
@@ -49,6 +50,13 @@ func NewListArguments(params url.Values) *ListArguments {
 			listArgs.OrderBy[i] = strings.Trim(s, " ")
 		}
 	}
+	if v := params.Get("fields"); v != "" {
+		listArgs.Fields = strings.Split(v, ",")
+		// remove spaces
+		for i, s := range listArgs.Fields {
+			listArgs.Fields[i] = strings.ToLower(strings.Trim(s, " "))
+		}
+	}
 	return listArgs
 }
 
@@ -87,3 +95,14 @@ func (la *ListArguments) Validate(acceptedOrderByParams []string) error {
 
 	return nil
 }
+
+// ValidateFields checks that the fields selected with the `fields` parameter are all accepted
+func (la *ListArguments) ValidateFields(acceptedFields []string) error {
+	for _, field := range la.Fields {
+		if !arrays.Contains(acceptedFields, field) {
+			return errors.Errorf("unknown field '%s'", field)
+		}
+	}
+
+	return nil
+}
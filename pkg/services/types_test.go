@@ -107,6 +107,11 @@ func Test_NewListArguments(t *testing.T) {
 		Size:   65500,
 		Search: "search",
 	}
+	listArgsWithFields := &ListArguments{
+		Page:   1,
+		Size:   100,
+		Fields: []string{"name", "status", "region"},
+	}
 	type args struct {
 		params url.Values
 	}
@@ -127,6 +132,15 @@ func Test_NewListArguments(t *testing.T) {
 			},
 			want: overriddenListArgs,
 		},
+		{
+			name: "should split the selected fields",
+			args: args{
+				params: url.Values{
+					"fields": []string{"name, Status ,region"},
+				},
+			},
+			want: listArgsWithFields,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -220,3 +234,36 @@ func TestListArguments_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestListArguments_ValidateFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		wantErr bool
+	}{
+		{
+			name:    "should accept no field",
+			wantErr: false,
+		},
+		{
+			name:    "should accept known fields",
+			fields:  []string{"name", "status"},
+			wantErr: false,
+		},
+		{
+			name:    "should return an error for an unknown field",
+			fields:  []string{"name", "canary_service_account_client_secret"},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			la := &ListArguments{Fields: tt.fields}
+			g.Expect(la.ValidateFields(getValidTestParams()) != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
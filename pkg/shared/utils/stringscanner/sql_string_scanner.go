@@ -1,6 +1,10 @@
 package stringscanner
 
-import "github.com/pkg/errors"
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
 
 const (
 	OP = iota
@@ -8,10 +12,11 @@ const (
 	LITERAL
 	QUOTED_LITERAL
 	NO_TOKEN
+	SEPARATOR
 )
 
 // scanner - This scanner is to be used to parse SQL Strings. It splits the provided string by whole words
-// or sentences if it finds quotes. Nested round braces are supported too.
+// or sentences if it finds quotes. Nested round braces and the comma separated lists of the IN operator are supported too.
 type scanner struct {
 	tokens []Token
 	pos    int
@@ -25,6 +30,8 @@ func (s *scanner) Init(txt string) {
 
 	quoted := false
 	escaped := false
+	// openBraces tells, for each brace that has not been closed yet, whether it opens the list of an IN operator
+	var openBraces []bool
 
 	sendCurrentTokens := func() {
 		res := ""
@@ -48,11 +55,33 @@ func (s *scanner) Init(txt string) {
 				sendCurrentTokens()
 			}
 		case '(':
-			fallthrough
+			// found openbrace Token
+			sendCurrentTokens()
+			openBraces = append(openBraces, s.isInOperator())
+			s.tokens = append(s.tokens, Token{TokenType: BRACE, Value: string(currentChar), Position: i})
 		case ')':
 			// found closebrace Token
 			sendCurrentTokens()
+			if len(openBraces) > 0 {
+				openBraces = openBraces[:len(openBraces)-1]
+			}
 			s.tokens = append(s.tokens, Token{TokenType: BRACE, Value: string(currentChar), Position: i})
+		case ',':
+			switch {
+			case quoted:
+				tokens = append(tokens, Token{TokenType: LITERAL, Value: ",", Position: i})
+			case len(openBraces) > 0 && openBraces[len(openBraces)-1]:
+				// found separator Token between the values of an IN list
+				sendCurrentTokens()
+				s.tokens = append(s.tokens, Token{TokenType: SEPARATOR, Value: ",", Position: i})
+			default:
+				// outside of an IN list, a comma is part of the literal, e.g. name = a,b
+				if currentTokenType != NO_TOKEN && currentTokenType != LITERAL && currentTokenType != QUOTED_LITERAL {
+					sendCurrentTokens()
+				}
+				currentTokenType = LITERAL
+				tokens = append(tokens, Token{TokenType: LITERAL, Value: ",", Position: i})
+			}
 		case '=':
 			fallthrough
 		case '<':
@@ -103,6 +132,15 @@ func (s *scanner) Init(txt string) {
 	sendCurrentTokens()
 }
 
+// isInOperator returns whether the last token found is the IN operator, whose list is opened by the next brace
+func (s *scanner) isInOperator() bool {
+	if len(s.tokens) == 0 {
+		return false
+	}
+	last := s.tokens[len(s.tokens)-1]
+	return last.TokenType == LITERAL && strings.EqualFold(last.Value, "in")
+}
+
 func (s *scanner) Next() bool {
 	if s.pos < (len(s.tokens) - 1) {
 		s.pos++
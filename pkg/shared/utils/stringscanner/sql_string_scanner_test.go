@@ -67,6 +67,59 @@ func Test_SQLStringScanner(t *testing.T) {
 				{TokenType: LITERAL, Value: "3", Position: 61},
 			},
 		},
+		{
+			name:  "Test SQL with a list",
+			value: `SELECT * FROM ADDRESS_BOOK WHERE SURNAME IN ('Mouse', 'Duck,Donald',Goofy)`,
+			expectedTokens: []Token{
+				{TokenType: LITERAL, Value: "SELECT", Position: 0},
+				{TokenType: LITERAL, Value: "*", Position: 7},
+				{TokenType: LITERAL, Value: "FROM", Position: 9},
+				{TokenType: LITERAL, Value: "ADDRESS_BOOK", Position: 14},
+				{TokenType: LITERAL, Value: "WHERE", Position: 27},
+				{TokenType: LITERAL, Value: "SURNAME", Position: 33},
+				{TokenType: LITERAL, Value: "IN", Position: 41},
+				{TokenType: BRACE, Value: "(", Position: 44},
+				{TokenType: LITERAL, Value: "'Mouse'", Position: 45},
+				{TokenType: SEPARATOR, Value: ",", Position: 52},
+				{TokenType: LITERAL, Value: "'Duck,Donald'", Position: 54},
+				{TokenType: SEPARATOR, Value: ",", Position: 67},
+				{TokenType: LITERAL, Value: "Goofy", Position: 68},
+				{TokenType: BRACE, Value: ")", Position: 73},
+			},
+		},
+		{
+			name:  "Test SQL with unquoted commas outside of a list",
+			value: `name = a,b and label like %a,b%`,
+			expectedTokens: []Token{
+				{TokenType: LITERAL, Value: "name", Position: 0},
+				{TokenType: OP, Value: "=", Position: 5},
+				{TokenType: LITERAL, Value: "a,b", Position: 7},
+				{TokenType: LITERAL, Value: "and", Position: 11},
+				{TokenType: LITERAL, Value: "label", Position: 15},
+				{TokenType: LITERAL, Value: "like", Position: 21},
+				{TokenType: LITERAL, Value: "%a,b%", Position: 26},
+			},
+		},
+		{
+			name:  "Test SQL with a list nested in braces",
+			value: `(name = a,b or name not in (c,d))`,
+			expectedTokens: []Token{
+				{TokenType: BRACE, Value: "(", Position: 0},
+				{TokenType: LITERAL, Value: "name", Position: 1},
+				{TokenType: OP, Value: "=", Position: 6},
+				{TokenType: LITERAL, Value: "a,b", Position: 8},
+				{TokenType: LITERAL, Value: "or", Position: 12},
+				{TokenType: LITERAL, Value: "name", Position: 15},
+				{TokenType: LITERAL, Value: "not", Position: 20},
+				{TokenType: LITERAL, Value: "in", Position: 24},
+				{TokenType: BRACE, Value: "(", Position: 27},
+				{TokenType: LITERAL, Value: "c", Position: 28},
+				{TokenType: SEPARATOR, Value: ",", Position: 29},
+				{TokenType: LITERAL, Value: "d", Position: 30},
+				{TokenType: BRACE, Value: ")", Position: 31},
+				{TokenType: BRACE, Value: ")", Position: 32},
+			},
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase